@@ -0,0 +1,131 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/spf13/cobra"
+	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/bag"
+)
+
+var playCmd = &cobra.Command{
+	Use:   "play <bag>",
+	Short: "Play back an MCAP bag",
+	Long: `Publish the messages of an MCAP bag directory or file with their original
+timing. ROS 2 arguments can be passed between --ros-args and --.
+
+While playing, playback can be controlled by writing commands followed by a
+newline to the standard input: "p" toggles pausing and "s" publishes the next
+message while paused.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		rclArgs, rest, err := rclgo.ParseArgs(args)
+		if err != nil {
+			return fmt.Errorf("failed to parse ROS args: %v", err)
+		}
+		if len(rest) != 1 {
+			return errors.New("exactly one bag path is required")
+		}
+		opts, err := getPlayerOptions(cmd)
+		if err != nil {
+			return err
+		}
+
+		rclCtx, err := rclgo.NewContext(0, rclArgs)
+		if err != nil {
+			return fmt.Errorf("failed to initialize rclgo: %v", err)
+		}
+		defer rclCtx.Close()
+		node, err := rclCtx.NewNode("rclgo_bag_player", "")
+		if err != nil {
+			return fmt.Errorf("failed to create node: %v", err)
+		}
+		player, err := bag.NewPlayer(node, rest[0], opts)
+		if err != nil {
+			return fmt.Errorf("failed to create player: %v", err)
+		}
+		defer player.Close()
+
+		ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+		defer cancel()
+		go readPlayerCommands(player)
+		err = player.Play(ctx)
+		if errors.Is(err, context.Canceled) {
+			return nil
+		}
+		return err
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(playCmd)
+	flags := playCmd.Flags()
+	flags.Float64P("rate", "r", 1, "Rate at which to play back messages")
+	flags.Duration("start-offset", 0, "Start playback this far into the bag")
+	flags.StringSlice("topics", nil, "Topics to play back, all topics are played back by default")
+	flags.StringSlice("remap", nil, "Topic remappings in format old_topic:=new_topic")
+	flags.BoolP("loop", "l", false, "Restart playback after reaching the end of the bag")
+	flags.BoolP("start-paused", "p", false, "Start playback in the paused state")
+	flags.Float64("clock", 0, "Publish the bag time to /clock at the given frequency in Hz")
+}
+
+func readPlayerCommands(player *bag.Player) {
+	scanner := bufio.NewScanner(os.Stdin)
+	for scanner.Scan() {
+		switch strings.TrimSpace(scanner.Text()) {
+		case "p":
+			if player.IsPaused() {
+				player.Resume()
+				fmt.Fprintln(os.Stderr, "Resumed")
+			} else {
+				player.Pause()
+				fmt.Fprintln(os.Stderr, "Paused at", player.BagTime())
+			}
+		case "s":
+			if !player.Step() {
+				fmt.Fprintln(os.Stderr, "Stepping requires playback to be paused")
+			}
+		}
+	}
+}
+
+func getPlayerOptions(cmd *cobra.Command) (*bag.PlayerOptions, error) {
+	flags := cmd.Flags()
+	opts := bag.NewDefaultPlayerOptions()
+	opts.Rate, _ = flags.GetFloat64("rate")
+	if opts.Rate <= 0 {
+		return nil, fmt.Errorf("rate must be positive, got %v", opts.Rate)
+	}
+	opts.StartOffset, _ = flags.GetDuration("start-offset")
+	opts.Topics, _ = flags.GetStringSlice("topics")
+	remaps, _ := flags.GetStringSlice("remap")
+	if len(remaps) > 0 {
+		opts.Remap = make(map[string]string)
+	}
+	for _, r := range remaps {
+		from, to, ok := strings.Cut(r, ":=")
+		if !ok || from == "" || to == "" {
+			return nil, fmt.Errorf("invalid remapping %q, expected old_topic:=new_topic", r)
+		}
+		opts.Remap[from] = to
+	}
+	opts.Loop, _ = flags.GetBool("loop")
+	opts.StartPaused, _ = flags.GetBool("start-paused")
+	opts.ClockFrequency, _ = flags.GetFloat64("clock")
+	return opts, nil
+}
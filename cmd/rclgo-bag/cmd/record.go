@@ -0,0 +1,110 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/signal"
+	"regexp"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/bag"
+)
+
+var recordCmd = &cobra.Command{
+	Use:   "record [topics...]",
+	Short: "Record topics to an MCAP bag",
+	Long: `Record the given topics, the topics matching --regex or all topics to an
+MCAP bag. New topics are discovered while recording. ROS 2 arguments can be
+passed between --ros-args and --.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		rclArgs, topics, err := rclgo.ParseArgs(args)
+		if err != nil {
+			return fmt.Errorf("failed to parse ROS args: %v", err)
+		}
+		opts, err := getRecorderOptions(cmd, topics)
+		if err != nil {
+			return err
+		}
+		output, _ := cmd.Flags().GetString("output")
+		if output == "" {
+			output = time.Now().Format("rosbag2_2006_01_02-15_04_05")
+		}
+
+		rclCtx, err := rclgo.NewContext(0, rclArgs)
+		if err != nil {
+			return fmt.Errorf("failed to initialize rclgo: %v", err)
+		}
+		defer rclCtx.Close()
+		node, err := rclCtx.NewNode("rclgo_bag_recorder", "")
+		if err != nil {
+			return fmt.Errorf("failed to create node: %v", err)
+		}
+		recorder, err := bag.NewRecorder(node, output, opts)
+		if err != nil {
+			return fmt.Errorf("failed to create recorder: %v", err)
+		}
+
+		ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+		defer cancel()
+		err = recorder.Record(ctx)
+		if errors.Is(err, context.Canceled) {
+			err = nil
+		}
+		return errors.Join(err, recorder.Close())
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(recordCmd)
+	flags := recordCmd.Flags()
+	flags.StringP("output", "o", "", "Destination directory of the bag (default rosbag2_<timestamp>)")
+	flags.BoolP("all", "a", false, "Record all topics")
+	flags.StringP("regex", "e", "", "Record topics matching the regular expression")
+	flags.StringP("exclude", "x", "", "Exclude topics matching the regular expression")
+	flags.Uint64P("max-bag-size", "b", 0, "Maximum size of a bag file in bytes before splitting, 0 disables splitting by size")
+	flags.DurationP("max-bag-duration", "d", 0, "Maximum duration of a bag file before splitting, 0 disables splitting by duration")
+	flags.Int("chunk-size", bag.DefaultChunkSize, "Uncompressed size of MCAP chunks in bytes")
+	flags.Duration("polling-interval", bag.DefaultDiscoveryInterval, "Interval at which new topics are discovered")
+}
+
+func getRecorderOptions(cmd *cobra.Command, topics []string) (*bag.RecorderOptions, error) {
+	flags := cmd.Flags()
+	opts := bag.NewDefaultRecorderOptions()
+	opts.All, _ = flags.GetBool("all")
+	opts.Topics = topics
+	if s, _ := flags.GetString("regex"); s != "" {
+		re, err := regexp.Compile(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid regex: %v", err)
+		}
+		opts.TopicRegex = re
+	}
+	if s, _ := flags.GetString("exclude"); s != "" {
+		re, err := regexp.Compile(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid exclude regex: %v", err)
+		}
+		opts.Exclude = re
+	}
+	if !opts.All && len(opts.Topics) == 0 && opts.TopicRegex == nil {
+		return nil, errors.New("no topics selected, pass topic names, --regex or --all")
+	}
+	opts.Writer.MaxFileSize, _ = flags.GetUint64("max-bag-size")
+	opts.Writer.MaxFileDuration, _ = flags.GetDuration("max-bag-duration")
+	opts.Writer.ChunkSize, _ = flags.GetInt("chunk-size")
+	opts.DiscoveryInterval, _ = flags.GetDuration("polling-interval")
+	return opts, nil
+}
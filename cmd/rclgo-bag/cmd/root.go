@@ -0,0 +1,27 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// rootCmd represents the base command when called without any subcommands
+var rootCmd = &cobra.Command{
+	Use:   "rclgo-bag",
+	Short: "ROS2 client library in Golang - MCAP bag tool",
+	Long:  `Call this program to record ROS2 topics to MCAP bags compatible with rosbag2 and to play them back.`,
+}
+
+// Execute adds all child commands to the root command and sets flags appropriately.
+// This is called by main.main(). It only needs to happen once to the rootCmd.
+func Execute() {
+	cobra.CheckErr(rootCmd.Execute())
+}
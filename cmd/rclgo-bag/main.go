@@ -0,0 +1,16 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package main
+
+import cmd "github.com/tiiuae/rclgo/cmd/rclgo-bag/cmd"
+
+func main() {
+	cmd.Execute()
+}
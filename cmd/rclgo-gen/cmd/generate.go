@@ -15,7 +15,9 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"regexp"
 	"strings"
+	"text/template"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -24,9 +26,7 @@ import (
 	"golang.org/x/tools/go/packages"
 )
 
-const correctDistro = "humble"
-
-func validateGenerateArgs(cmd *cobra.Command, _ []string) error {
+func validateRootPaths(cmd *cobra.Command) error {
 	rootPaths := getRootPaths(cmd)
 	if len(rootPaths) == 0 {
 		if os.Getenv("AMENT_PREFIX_PATH") == "" {
@@ -34,14 +34,25 @@ func validateGenerateArgs(cmd *cobra.Command, _ []string) error {
 		}
 		return fmt.Errorf("root-path is required")
 	}
+	return nil
+}
+
+func validateGenerateArgs(cmd *cobra.Command, _ []string) error {
+	if err := validateRootPaths(cmd); err != nil {
+		return err
+	}
 
 	distro := os.Getenv("ROS_DISTRO")
+	targetDistro, err := getTargetDistro(cmd)
+	if err != nil {
+		return err
+	}
 	if getBool(cmd, "ignore-ros-distro-mismatch") {
-		if distro != correctDistro {
-			gogen.PrintErrf("NOTE: Environment variable ROS_DISTRO is set to %q, generating files for %q\n", distro, correctDistro)
+		if distro != targetDistro {
+			gogen.PrintErrf("NOTE: Environment variable ROS_DISTRO is set to %q, generating files for %q\n", distro, targetDistro)
 		}
-	} else if distro != correctDistro {
-		return fmt.Errorf("ROS_DISTRO should be set to %q", correctDistro)
+	} else if distro != targetDistro {
+		return fmt.Errorf("ROS_DISTRO should be set to %q", targetDistro)
 	}
 
 	destPath := getString(cmd, "dest-path")
@@ -49,7 +60,7 @@ func validateGenerateArgs(cmd *cobra.Command, _ []string) error {
 		return fmt.Errorf("dest-path is required")
 	}
 
-	_, err := os.Stat(destPath)
+	_, err = os.Stat(destPath)
 	if errors.Is(err, os.ErrNotExist) {
 		//#nosec G301 -- The generated directory doesn't contain secrets.
 		err = os.MkdirAll(destPath, 0755)
@@ -70,18 +81,52 @@ var generateCmd = &cobra.Command{
 			return err
 		}
 		gen := gogen.New(config)
+		if config.DiagnosticsFormat == gogen.DiagnosticsJSON {
+			defer func() {
+				if err := gogen.WriteDiagnosticsJSON(cmd.OutOrStdout(), gen.Diagnostics()); err != nil {
+					gogen.PrintErrf("Failed to write diagnostics: %v\n", err)
+				}
+			}()
+		}
 		if err := gen.GenerateGolangMessageTypes(); err != nil {
+			cmd.SilenceUsage = true
 			return fmt.Errorf("failed to generate interface bindings: %w", err)
 		}
 		if err := gen.GenerateROS2AllMessagesImporter(); err != nil {
 			return fmt.Errorf("failed to generate all importer: %w", err)
 		}
+		if config.Check {
+			return checkGenerated(cmd, gen)
+		}
 		if err := gen.GenerateCGOFlags(); err != nil {
 			return fmt.Errorf("failed to generate CGO flags: %w", err)
 		}
 		return nil
 	},
-	Args: validateGenerateArgs,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if err := loadProjectConfig(cmd); err != nil {
+			cmd.SilenceUsage = true
+			return err
+		}
+		return validateGenerateArgs(cmd, args)
+	},
+}
+
+// checkGenerated reports the differences found by gen in check mode and fails
+// if the destination directory is not up to date.
+func checkGenerated(cmd *cobra.Command, gen *gogen.Generator) error {
+	result, err := gen.CheckResult()
+	if err != nil {
+		return fmt.Errorf("failed to check generated files: %w", err)
+	}
+	if result.OK() {
+		return nil
+	}
+	if err := result.WriteReport(cmd.OutOrStdout()); err != nil {
+		return err
+	}
+	cmd.SilenceUsage = true
+	return errors.New("generated files are not up to date, run rclgo-gen generate to update them")
 }
 
 var generateRclgoCmd = &cobra.Command{
@@ -113,6 +158,15 @@ var generateRclgoCmd = &cobra.Command{
 func init() {
 	rootCmd.AddCommand(generateCmd)
 	configureFlags(generateCmd, ".")
+	generateCmd.PersistentFlags().Bool("check", false, "Compare the files which would be generated to the files in dest-path without writing anything. Exits with an error and prints a diff if they differ. CGO flags are not checked.")
+	generateCmd.PersistentFlags().Bool("strict", false, "Exit with an error if any errors are found while generating interfaces. Generation still continues after an error so that all errors are reported.")
+	generateCmd.PersistentFlags().String("diagnostics-format", gogen.DiagnosticsText, `Format of reported errors and warnings. If "text", they are printed to stderr as they are found. If "json", they are written to stdout as a JSON array when generation finishes.`)
+	generateCmd.PersistentFlags().StringArray("template", nil, `Replace a built-in template with the template in a file, for example "message=msg.tmpl". The kind of the template is message, service, action or common. Can be passed multiple times.`)
+	generateCmd.PersistentFlags().StringArray("extra-template", nil, `Generate an additional file for each interface of a kind using the template in a file, for example "message:_validate=validate.tmpl" generates <message name>_validate.gen.go for each message. The kind is message, service or action. Can be passed multiple times.`)
+	generateCmd.PersistentFlags().StringArray("blacklist", nil, "Skip interface files whose paths match a regex in addition to the built-in blacklist. Can be passed multiple times.")
+	generateCmd.PersistentFlags().StringArray("type-rename", nil, `Change the name of the Go type generated for a message, for example "std_msgs/msg/String=StringMsg". Can be passed multiple times.`)
+	generateCmd.PersistentFlags().String("project-config", "", "Path to the project configuration file. By default "+gogen.ProjectConfigFileName+" in the root of the current Go module is used if it exists. Flags take precedence over the file.")
+	bindPFlags(generateCmd)
 
 	rootCmd.AddCommand(generateRclgoCmd)
 	configureFlags(generateRclgoCmd, gogen.RclgoRepoRootPath())
@@ -126,9 +180,11 @@ func configureFlags(cmd *cobra.Command, destPathDefault string) {
 	cmd.PersistentFlags().StringArray("include-package", nil, "Include only packages matching a regex. Can be passed multiple times. If multiple include options are passed, the union of the matches is generated.")
 	cmd.PersistentFlags().StringArray("include-package-deps", nil, "Include only packages which are dependencies of listed packages. Can be passed multiple times. If multiple include options are passed, the union of the matches is generated.")
 	cmd.PersistentFlags().StringArray("include-go-package-deps", nil, "Include only packages which are dependencies of listed Go packages. Can be passed multiple times. If multiple include options are passed, the union of the matches is generated.")
-	cmd.PersistentFlags().Bool("ignore-ros-distro-mismatch", false, "If true, ignores possible mismatches in sourced and supported ROS distro")
+	cmd.PersistentFlags().String("target-distro", "", "ROS 2 distribution to generate files for: "+strings.Join(gogen.DistroNames(), ", ")+". Defaults to $ROS_DISTRO if it is supported and "+gogen.DefaultDistro+" otherwise.")
+	cmd.PersistentFlags().Bool("ignore-ros-distro-mismatch", false, "If true, ignores possible mismatches in sourced and targeted ROS distro")
 	cmd.PersistentFlags().String("license-header-path", "", "Path to a file containing a license header to be added to generated files. By default no license is added.")
 	cmd.PersistentFlags().String("cgo-flags-path", "cgo-flags.env", `Path to file where CGO flags are written. If empty, no flags are written. If "-", flags are written to stdout.`)
+	cmd.PersistentFlags().StringArray("enum-field", nil, `Make a field use the Go enum type generated for a group of constants, for example "action_msgs/msg/GoalStatus.status=STATUS". Can be passed multiple times.`)
 	bindPFlags(cmd)
 }
 
@@ -170,15 +226,42 @@ func getGogenConfig(cmd *cobra.Command) (*gogen.Config, error) {
 	modulePrefix := getString(cmd, "message-module-prefix")
 
 	if modulePrefix == gogen.DefaultConfig.MessageModulePrefix {
-		pkgs, err := packages.Load(&packages.Config{})
-		if err == nil && len(pkgs) > 0 {
-			modulePrefix = path.Join(pkgs[0].PkgPath, destPath)
-		}
+		modulePrefix = defaultModulePrefix(destPath, modulePrefix)
 	}
 	rules, err := getPackageRules(cmd)
 	if err != nil {
 		return nil, err
 	}
+	enumFields, err := getEnumFields(cmd)
+	if err != nil {
+		return nil, err
+	}
+	blacklist, err := getBlacklist(cmd)
+	if err != nil {
+		return nil, err
+	}
+	typeRenames, err := getTypeRenames(cmd)
+	if err != nil {
+		return nil, err
+	}
+	overrides, err := getTemplateOverrides(cmd)
+	if err != nil {
+		return nil, err
+	}
+	extraTemplates, err := getExtraTemplates(cmd)
+	if err != nil {
+		return nil, err
+	}
+	targetDistro, err := getTargetDistro(cmd)
+	if err != nil {
+		return nil, err
+	}
+	diagnosticsFormat := getString(cmd, "diagnostics-format")
+	switch diagnosticsFormat {
+	case "", gogen.DiagnosticsText, gogen.DiagnosticsJSON:
+	default:
+		return nil, fmt.Errorf("invalid diagnostics format %q, must be %q or %q", diagnosticsFormat, gogen.DiagnosticsText, gogen.DiagnosticsJSON)
+	}
 	licenseHeader := getString(cmd, "license-header-path")
 	if licenseHeader != "" {
 		headerBytes, err := os.ReadFile(licenseHeader)
@@ -199,9 +282,37 @@ func getGogenConfig(cmd *cobra.Command) (*gogen.Config, error) {
 		GoPkgIncludes:  viper.GetStringSlice(getPrefix(cmd) + "include-go-package-deps"),
 
 		LicenseHeader: licenseHeader,
+		Check:         getBool(cmd, "check"),
+		EnumFields:    enumFields,
+
+		Strict:            getBool(cmd, "strict"),
+		DiagnosticsFormat: diagnosticsFormat,
+
+		TemplateOverrides: overrides,
+		ExtraTemplates:    extraTemplates,
+
+		Blacklist:   blacklist,
+		TypeRenames: typeRenames,
+
+		TargetDistro: targetDistro,
 	}, nil
 }
 
+// getTargetDistro returns the name of the distribution to generate files for.
+func getTargetDistro(cmd *cobra.Command) (string, error) {
+	name := getString(cmd, "target-distro")
+	if name == "" {
+		name = os.Getenv("ROS_DISTRO")
+		if _, ok := gogen.Distros[name]; !ok {
+			name = gogen.DefaultDistro
+		}
+	}
+	if _, err := gogen.LookupDistro(name); err != nil {
+		return "", err
+	}
+	return name, nil
+}
+
 func getRootPaths(cmd *cobra.Command) []string {
 	pathLists := viper.GetStringSlice(getPrefix(cmd) + "root-path")
 	found := make(map[string]bool)
@@ -228,3 +339,98 @@ func getPackageRules(cmd *cobra.Command) (_ gogen.RuleSet, err error) {
 	}
 	return rules, nil
 }
+
+func getEnumFields(cmd *cobra.Command) (map[string]string, error) {
+	fields := map[string]string{}
+	for _, mapping := range viper.GetStringSlice(getPrefix(cmd) + "enum-field") {
+		field, prefix, ok := strings.Cut(mapping, "=")
+		if !ok || field == "" || prefix == "" {
+			return nil, fmt.Errorf("invalid enum field mapping %q, expected <package>/<type>/<name>.<field>=<prefix>", mapping)
+		}
+		fields[field] = prefix
+	}
+	return fields, nil
+}
+
+// defaultModulePrefix returns the import path of destPath if it is in the
+// current Go module, or fallback otherwise.
+func defaultModulePrefix(destPath, fallback string) string {
+	if !filepath.IsAbs(destPath) {
+		pkgs, err := packages.Load(&packages.Config{})
+		if err == nil && len(pkgs) > 0 {
+			return path.Join(pkgs[0].PkgPath, destPath)
+		}
+		return fallback
+	}
+	// Paths from the project configuration file are absolute.
+	pkgs, err := packages.Load(&packages.Config{Mode: packages.NeedModule})
+	if err != nil || len(pkgs) == 0 || pkgs[0].Module == nil {
+		return fallback
+	}
+	rel, err := filepath.Rel(pkgs[0].Module.Dir, destPath)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return fallback
+	}
+	return path.Join(pkgs[0].Module.Path, filepath.ToSlash(rel))
+}
+
+func getBlacklist(cmd *cobra.Command) ([]*regexp.Regexp, error) {
+	var blacklist []*regexp.Regexp
+	for _, pattern := range viper.GetStringSlice(getPrefix(cmd) + "blacklist") {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid blacklist regex %q: %w", pattern, err)
+		}
+		blacklist = append(blacklist, re)
+	}
+	return blacklist, nil
+}
+
+func getTypeRenames(cmd *cobra.Command) (map[string]string, error) {
+	renames := map[string]string{}
+	for _, mapping := range viper.GetStringSlice(getPrefix(cmd) + "type-rename") {
+		rosType, goName, ok := strings.Cut(mapping, "=")
+		if !ok || rosType == "" || goName == "" {
+			return nil, fmt.Errorf("invalid type rename %q, expected <package>/msg/<name>=<Go name>", mapping)
+		}
+		renames[rosType] = goName
+	}
+	return renames, nil
+}
+
+func getTemplateOverrides(cmd *cobra.Command) (map[gogen.TemplateKind]*template.Template, error) {
+	overrides := map[gogen.TemplateKind]*template.Template{}
+	for _, mapping := range viper.GetStringSlice(getPrefix(cmd) + "template") {
+		kind, file, ok := strings.Cut(mapping, "=")
+		if !ok || kind == "" || file == "" {
+			return nil, fmt.Errorf("invalid template %q, expected <kind>=<file>", mapping)
+		}
+		tmpl, err := gogen.ParseTemplateFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse template %q: %w", mapping, err)
+		}
+		overrides[gogen.TemplateKind(kind)] = tmpl
+	}
+	return overrides, nil
+}
+
+func getExtraTemplates(cmd *cobra.Command) ([]gogen.ExtraTemplate, error) {
+	var extras []gogen.ExtraTemplate
+	for _, mapping := range viper.GetStringSlice(getPrefix(cmd) + "extra-template") {
+		kindAndSuffix, file, ok1 := strings.Cut(mapping, "=")
+		kind, suffix, ok2 := strings.Cut(kindAndSuffix, ":")
+		if !ok1 || !ok2 || kind == "" || suffix == "" || file == "" {
+			return nil, fmt.Errorf("invalid extra template %q, expected <kind>:<suffix>=<file>", mapping)
+		}
+		tmpl, err := gogen.ParseTemplateFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse extra template %q: %w", mapping, err)
+		}
+		extras = append(extras, gogen.ExtraTemplate{
+			Kind:     gogen.TemplateKind(kind),
+			Suffix:   suffix,
+			Template: tmpl,
+		})
+	}
+	return extras, nil
+}
@@ -0,0 +1,107 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/spf13/cobra"
+	"github.com/tiiuae/rclgo/pkg/gogen"
+)
+
+var interfacesCmd = &cobra.Command{
+	Use:   "interfaces",
+	Short: "Inspect ROS2 interface definitions under <root-path>",
+}
+
+var interfacesListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the interfaces found under <root-path>",
+	Args:  validateInterfacesArgs(cobra.NoArgs),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		config, err := getGogenConfig(cmd)
+		if err != nil {
+			return err
+		}
+		typ := getString(cmd, "type")
+		switch typ {
+		case "", "msg", "srv", "action":
+		default:
+			return fmt.Errorf("invalid interface type %q, must be msg, srv or action", typ)
+		}
+		var pkgPattern *regexp.Regexp
+		if pattern := getString(cmd, "package"); pattern != "" {
+			pkgPattern, err = regexp.Compile("^" + pattern + "$")
+			if err != nil {
+				return fmt.Errorf("invalid package regex: %w", err)
+			}
+		}
+		for _, iface := range gogen.New(config).ListInterfaces() {
+			if typ != "" && iface.Type != typ {
+				continue
+			}
+			if pkgPattern != nil && !pkgPattern.MatchString(iface.Package) {
+				continue
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), iface.String())
+		}
+		return nil
+	},
+}
+
+var interfacesShowCmd = &cobra.Command{
+	Use:   "show <package>/<type>/<name>",
+	Short: "Show an interface definition and the Go types it is translated to",
+	Args:  validateInterfacesArgs(cobra.ExactArgs(1)),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		config, err := getGogenConfig(cmd)
+		if err != nil {
+			return err
+		}
+		cmd.SilenceUsage = true
+		return gogen.New(config).WriteInterface(cmd.OutOrStdout(), args[0], getBool(cmd, "expand"))
+	},
+}
+
+func validateInterfacesArgs(validateArgs cobra.PositionalArgs) cobra.PositionalArgs {
+	return func(cmd *cobra.Command, args []string) error {
+		if err := validateArgs(cmd, args); err != nil {
+			return err
+		}
+		if err := loadProjectConfig(cmd); err != nil {
+			cmd.SilenceUsage = true
+			return err
+		}
+		return validateRootPaths(cmd)
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(interfacesCmd)
+
+	interfacesCmd.AddCommand(interfacesListCmd)
+	configureInterfacesFlags(interfacesListCmd)
+	interfacesListCmd.PersistentFlags().String("type", "", "List only interfaces of a type: msg, srv or action")
+	interfacesListCmd.PersistentFlags().String("package", "", "List only interfaces of packages matching a regex")
+	bindPFlags(interfacesListCmd)
+
+	interfacesCmd.AddCommand(interfacesShowCmd)
+	configureInterfacesFlags(interfacesShowCmd)
+	interfacesShowCmd.PersistentFlags().Bool("expand", false, "Show the fields of nested messages too")
+	bindPFlags(interfacesShowCmd)
+}
+
+func configureInterfacesFlags(cmd *cobra.Command) {
+	configureFlags(cmd, ".")
+	cmd.PersistentFlags().StringArray("blacklist", nil, "Skip interface files whose paths match a regex in addition to the built-in blacklist. Can be passed multiple times.")
+	cmd.PersistentFlags().StringArray("type-rename", nil, `Change the name of the Go type generated for a message, for example "std_msgs/msg/String=StringMsg". Can be passed multiple times.`)
+	cmd.PersistentFlags().String("project-config", "", "Path to the project configuration file. By default "+gogen.ProjectConfigFileName+" in the root of the current Go module is used if it exists. Flags take precedence over the file.")
+}
@@ -0,0 +1,76 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package cmd
+
+import (
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tiiuae/rclgo/pkg/gogen"
+)
+
+// loadProjectConfig merges the settings of the project configuration file into
+// the configuration of cmd. Flags and environment variables take precedence
+// over the file.
+func loadProjectConfig(cmd *cobra.Command) error {
+	p := getString(cmd, "project-config")
+	if p == "" {
+		wd, err := os.Getwd()
+		if err != nil {
+			return err
+		}
+		p, err = gogen.FindProjectConfig(wd)
+		if err != nil || p == "" {
+			return err
+		}
+	}
+	pc, err := gogen.LoadProjectConfig(p)
+	if err != nil {
+		return err
+	}
+	gogen.PrintErrf("Using project config file: %s\n", p)
+
+	settings := map[string]interface{}{}
+	setString := func(key, value string) {
+		if value != "" {
+			settings[key] = value
+		}
+	}
+	setSlice := func(key string, values []string) {
+		if len(values) > 0 {
+			settings[key] = values
+		}
+	}
+	setSlice("root-path", pc.RootPaths)
+	setString("dest-path", pc.DestPath)
+	setString("message-module-prefix", pc.MessageModulePrefix)
+	setString("cgo-flags-path", pc.CGOFlagsPath)
+	setSlice("include-package", pc.IncludePackages)
+	setSlice("include-package-deps", pc.IncludePackageDeps)
+	setSlice("include-go-package-deps", pc.IncludeGoPackageDeps)
+	setSlice("blacklist", pc.Blacklist)
+	setString("license-header-path", pc.LicenseHeaderPath)
+	setString("target-distro", pc.TargetDistro)
+	renames := make([]string, 0, len(pc.TypeRenames))
+	for rosType, goName := range pc.TypeRenames {
+		renames = append(renames, rosType+"="+goName)
+	}
+	sort.Strings(renames)
+	setSlice("type-rename", renames)
+
+	parts := strings.Split(strings.TrimSuffix(getPrefix(cmd), "."), ".")
+	for i := len(parts) - 1; i >= 0; i-- {
+		settings = map[string]interface{}{parts[i]: settings}
+	}
+	return viper.MergeConfigMap(settings)
+}
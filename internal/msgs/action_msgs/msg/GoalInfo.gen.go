@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	builtin_interfaces_msg "github.com/tiiuae/rclgo/internal/msgs/builtin_interfaces/msg"
@@ -56,6 +58,38 @@ func (t *GoalInfo) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *GoalInfo) Equal(other *GoalInfo) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *GoalInfo) EqualApprox(other *GoalInfo, tol float64) bool {
+	if !t.GoalId.EqualApprox(&other.GoalId, tol) {
+		return false
+	}
+	if !t.Stamp.EqualApprox(&other.Stamp, tol) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *GoalInfo) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *GoalInfo) HashTo(h *msgcmp.Hasher) {
+	t.GoalId.HashTo(h)
+	t.Stamp.HashTo(h)
+}
+
 func (t *GoalInfo) SetDefaults() {
 	t.GoalId.SetDefaults()
 	t.Stamp.SetDefaults()
@@ -65,6 +99,48 @@ func (t *GoalInfo) GetTypeSupport() types.MessageTypeSupport {
 	return GoalInfoTypeSupport
 }
 
+func (t *GoalInfo) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *GoalInfo) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *GoalInfo) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *GoalInfo) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *GoalInfo) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *GoalInfo) EncodeCDR(e *cdr.Encoder) {
+	t.GoalId.EncodeCDR(e)
+	t.Stamp.EncodeCDR(e)
+}
+
+func (t *GoalInfo) DecodeCDR(d *cdr.Decoder) {
+	t.GoalId.DecodeCDR(d)
+	t.Stamp.DecodeCDR(d)
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *GoalInfo) Validate() error {
+	if err := t.GoalId.Validate(); err != nil {
+		return rclgo.WrapValidationError("goal_id", -1, err)
+	}
+	if err := t.Stamp.Validate(); err != nil {
+		return rclgo.WrapValidationError("stamp", -1, err)
+	}
+	return nil
+}
+
 // GoalInfoPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type GoalInfoPublisher struct {
@@ -122,6 +198,17 @@ func CloneGoalInfoSlice(dst, src []GoalInfo) {
 	}
 }
 
+// GoalInfoDescriptor describes the fields of GoalInfo. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var GoalInfoDescriptor = &types.MessageDescriptor{
+	TypeName: "action_msgs/msg/GoalInfo",
+	Fields: []types.FieldDescriptor{
+		{Name: "goal_id", Type: "unique_identifier_msgs/msg/UUID", GoIndex: 0, Message: unique_identifier_msgs_msg.UUIDDescriptor},
+		{Name: "stamp", Type: "builtin_interfaces/msg/Time", GoIndex: 1, Message: builtin_interfaces_msg.TimeDescriptor},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var GoalInfoTypeSupport types.MessageTypeSupport = _GoalInfoTypeSupport{}
 
@@ -157,6 +244,35 @@ func (t _GoalInfoTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__action_msgs__msg__GoalInfo())
 }
 
+func (t _GoalInfoTypeSupport) Descriptor() *types.MessageDescriptor {
+	return GoalInfoDescriptor
+}
+
+func (t _GoalInfoTypeSupport) TypeHash() string {
+	return `RIHS01_6398fe763154554353930716b225947f93b672f0fb2e49fdd01bb7a7e37933e9`
+}
+
+func (t _GoalInfoTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "action_msgs/msg/GoalInfo", "fields": [{"name": "goal_id", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "unique_identifier_msgs/msg/UUID"}, "default_value": ""}, {"name": "stamp", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "builtin_interfaces/msg/Time"}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "builtin_interfaces/msg/Time", "fields": [{"name": "sec", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "nanosec", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "unique_identifier_msgs/msg/UUID", "fields": [{"name": "uuid", "type": {"type_id": 51, "capacity": 16, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}]}`
+}
+
+func (t _GoalInfoTypeSupport) MessageDefinition() string {
+	return `# Goal ID
+unique_identifier_msgs/UUID goal_id
+# Time when the goal was accepted
+builtin_interfaces/Time stamp
+================================================================================
+MSG: unique_identifier_msgs/msg/UUID
+uint8[16] uuid
+================================================================================
+MSG: builtin_interfaces/msg/Time
+# The seconds component, valid over all int32 values.
+int32 sec
+# The nanoseconds component, valid in the range [0, 10e9).
+uint32 nanosec
+`
+}
+
 type CGoalInfo = C.action_msgs__msg__GoalInfo
 type CGoalInfo__Sequence = C.action_msgs__msg__GoalInfo__Sequence
 
@@ -14,8 +14,11 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
+	 "strconv"
 	
 )
 /*
@@ -40,6 +43,52 @@ const (
 	GoalStatus_STATUS_ABORTED int8 = 6// The goal was terminated by the action server without an external request.
 )
 
+// GoalStatus_Status is the type of the GoalStatus_STATUS_* constants.
+type GoalStatus_Status int8
+
+// GoalStatus_StatusValues contains the distinct values of the
+// GoalStatus_STATUS_* constants.
+var GoalStatus_StatusValues = []GoalStatus_Status{
+	GoalStatus_Status(GoalStatus_STATUS_UNKNOWN),
+	GoalStatus_Status(GoalStatus_STATUS_ACCEPTED),
+	GoalStatus_Status(GoalStatus_STATUS_EXECUTING),
+	GoalStatus_Status(GoalStatus_STATUS_CANCELING),
+	GoalStatus_Status(GoalStatus_STATUS_SUCCEEDED),
+	GoalStatus_Status(GoalStatus_STATUS_CANCELED),
+	GoalStatus_Status(GoalStatus_STATUS_ABORTED),
+}
+
+// String returns the ROS name of the constant whose value is v.
+func (v GoalStatus_Status) String() string {
+	switch v {
+	case GoalStatus_Status(GoalStatus_STATUS_UNKNOWN):
+		return "STATUS_UNKNOWN"
+	case GoalStatus_Status(GoalStatus_STATUS_ACCEPTED):
+		return "STATUS_ACCEPTED"
+	case GoalStatus_Status(GoalStatus_STATUS_EXECUTING):
+		return "STATUS_EXECUTING"
+	case GoalStatus_Status(GoalStatus_STATUS_CANCELING):
+		return "STATUS_CANCELING"
+	case GoalStatus_Status(GoalStatus_STATUS_SUCCEEDED):
+		return "STATUS_SUCCEEDED"
+	case GoalStatus_Status(GoalStatus_STATUS_CANCELED):
+		return "STATUS_CANCELED"
+	case GoalStatus_Status(GoalStatus_STATUS_ABORTED):
+		return "STATUS_ABORTED"
+	}
+	return "GoalStatus_Status(" + strconv.FormatInt(int64(v), 10) + ")"
+}
+
+// IsValid reports whether v is the value of one of the
+// GoalStatus_STATUS_* constants.
+func (v GoalStatus_Status) IsValid() bool {
+	switch v {
+	case GoalStatus_Status(GoalStatus_STATUS_UNKNOWN), GoalStatus_Status(GoalStatus_STATUS_ACCEPTED), GoalStatus_Status(GoalStatus_STATUS_EXECUTING), GoalStatus_Status(GoalStatus_STATUS_CANCELING), GoalStatus_Status(GoalStatus_STATUS_SUCCEEDED), GoalStatus_Status(GoalStatus_STATUS_CANCELED), GoalStatus_Status(GoalStatus_STATUS_ABORTED):
+		return true
+	}
+	return false
+}
+
 type GoalStatus struct {
 	GoalInfo GoalInfo `yaml:"goal_info"`// Goal info (contains ID and timestamp).
 	Status int8 `yaml:"status"`// Action goal state-machine status.
@@ -63,6 +112,38 @@ func (t *GoalStatus) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *GoalStatus) Equal(other *GoalStatus) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *GoalStatus) EqualApprox(other *GoalStatus, tol float64) bool {
+	if !t.GoalInfo.EqualApprox(&other.GoalInfo, tol) {
+		return false
+	}
+	if t.Status != other.Status {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *GoalStatus) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *GoalStatus) HashTo(h *msgcmp.Hasher) {
+	t.GoalInfo.HashTo(h)
+	h.Int8(t.Status)
+}
+
 func (t *GoalStatus) SetDefaults() {
 	t.GoalInfo.SetDefaults()
 	t.Status = 0
@@ -71,6 +152,45 @@ func (t *GoalStatus) SetDefaults() {
 func (t *GoalStatus) GetTypeSupport() types.MessageTypeSupport {
 	return GoalStatusTypeSupport
 }
+
+func (t *GoalStatus) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *GoalStatus) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *GoalStatus) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *GoalStatus) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *GoalStatus) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *GoalStatus) EncodeCDR(e *cdr.Encoder) {
+	t.GoalInfo.EncodeCDR(e)
+	e.Int8(t.Status)
+}
+
+func (t *GoalStatus) DecodeCDR(d *cdr.Decoder) {
+	t.GoalInfo.DecodeCDR(d)
+	t.Status = d.Int8()
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *GoalStatus) Validate() error {
+	if err := t.GoalInfo.Validate(); err != nil {
+		return rclgo.WrapValidationError("goal_info", -1, err)
+	}
+	return nil
+}
 func (t *GoalStatus) GetGoalID() *types.GoalID {
 	return (*types.GoalID)(&t.GoalInfo.GoalId.Uuid)
 }
@@ -136,6 +256,17 @@ func CloneGoalStatusSlice(dst, src []GoalStatus) {
 	}
 }
 
+// GoalStatusDescriptor describes the fields of GoalStatus. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var GoalStatusDescriptor = &types.MessageDescriptor{
+	TypeName: "action_msgs/msg/GoalStatus",
+	Fields: []types.FieldDescriptor{
+		{Name: "goal_info", Type: "action_msgs/msg/GoalInfo", GoIndex: 0, Message: GoalInfoDescriptor},
+		{Name: "status", Type: "int8", GoIndex: 1},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var GoalStatusTypeSupport types.MessageTypeSupport = _GoalStatusTypeSupport{}
 
@@ -171,6 +302,56 @@ func (t _GoalStatusTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__action_msgs__msg__GoalStatus())
 }
 
+func (t _GoalStatusTypeSupport) Descriptor() *types.MessageDescriptor {
+	return GoalStatusDescriptor
+}
+
+func (t _GoalStatusTypeSupport) TypeHash() string {
+	return `RIHS01_32f4cfd717735d17657e1178f24431c1ce996c878c515230f6c5b3476819dbb9`
+}
+
+func (t _GoalStatusTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "action_msgs/msg/GoalStatus", "fields": [{"name": "goal_info", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "action_msgs/msg/GoalInfo"}, "default_value": ""}, {"name": "status", "type": {"type_id": 2, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "action_msgs/msg/GoalInfo", "fields": [{"name": "goal_id", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "unique_identifier_msgs/msg/UUID"}, "default_value": ""}, {"name": "stamp", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "builtin_interfaces/msg/Time"}, "default_value": ""}]}, {"type_name": "builtin_interfaces/msg/Time", "fields": [{"name": "sec", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "nanosec", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "unique_identifier_msgs/msg/UUID", "fields": [{"name": "uuid", "type": {"type_id": 51, "capacity": 16, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}]}`
+}
+
+func (t _GoalStatusTypeSupport) MessageDefinition() string {
+	return `# Indicates status has not been properly set.
+int8 STATUS_UNKNOWN=0
+# The goal has been accepted and is awaiting execution.
+int8 STATUS_ACCEPTED=1
+# The goal is currently being executed by the action server.
+int8 STATUS_EXECUTING=2
+# The client has requested that the goal be canceled and the action server hasaccepted the cancel request.
+int8 STATUS_CANCELING=3
+# The goal was achieved successfully by the action server.
+int8 STATUS_SUCCEEDED=4
+# The goal was canceled after an external request from an action client.
+int8 STATUS_CANCELED=5
+# The goal was terminated by the action server without an external request.
+int8 STATUS_ABORTED=6
+
+# Goal info (contains ID and timestamp).
+GoalInfo goal_info
+# Action goal state-machine status.
+int8 status
+================================================================================
+MSG: action_msgs/msg/GoalInfo
+# Goal ID
+unique_identifier_msgs/UUID goal_id
+# Time when the goal was accepted
+builtin_interfaces/Time stamp
+================================================================================
+MSG: unique_identifier_msgs/msg/UUID
+uint8[16] uuid
+================================================================================
+MSG: builtin_interfaces/msg/Time
+# The seconds component, valid over all int32 values.
+int32 sec
+# The nanoseconds component, valid in the range [0, 10e9).
+uint32 nanosec
+`
+}
+
 type CGoalStatus = C.action_msgs__msg__GoalStatus
 type CGoalStatus__Sequence = C.action_msgs__msg__GoalStatus__Sequence
 
@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	
@@ -55,6 +57,42 @@ func (t *GoalStatusArray) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *GoalStatusArray) Equal(other *GoalStatusArray) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *GoalStatusArray) EqualApprox(other *GoalStatusArray, tol float64) bool {
+	if len(t.StatusList) != len(other.StatusList) {
+		return false
+	}
+	for i := range t.StatusList {
+		if !t.StatusList[i].EqualApprox(&other.StatusList[i], tol) {
+			return false
+		}
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *GoalStatusArray) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *GoalStatusArray) HashTo(h *msgcmp.Hasher) {
+	h.Len(len(t.StatusList))
+	for i := range t.StatusList {
+		t.StatusList[i].HashTo(h)
+	}
+}
+
 func (t *GoalStatusArray) SetDefaults() {
 	t.StatusList = nil
 }
@@ -62,6 +100,51 @@ func (t *GoalStatusArray) SetDefaults() {
 func (t *GoalStatusArray) GetTypeSupport() types.MessageTypeSupport {
 	return GoalStatusArrayTypeSupport
 }
+
+func (t *GoalStatusArray) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *GoalStatusArray) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *GoalStatusArray) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *GoalStatusArray) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *GoalStatusArray) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *GoalStatusArray) EncodeCDR(e *cdr.Encoder) {
+	e.SequenceLength(len(t.StatusList), 0)
+	for i := range t.StatusList {
+		t.StatusList[i].EncodeCDR(e)
+	}
+}
+
+func (t *GoalStatusArray) DecodeCDR(d *cdr.Decoder) {
+	t.StatusList = cdr.MakeSlice[GoalStatus](d, 0)
+	for i := range t.StatusList {
+		t.StatusList[i].DecodeCDR(d)
+	}
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *GoalStatusArray) Validate() error {
+	for i := range t.StatusList {
+		if err := t.StatusList[i].Validate(); err != nil {
+			return rclgo.WrapValidationError("status_list", i, err)
+		}
+	}
+	return nil
+}
 func (t *GoalStatusArray) CallForEach(f func(interface{})) {
 	for i := range t.StatusList {
 		f(&t.StatusList[i])
@@ -125,6 +208,16 @@ func CloneGoalStatusArraySlice(dst, src []GoalStatusArray) {
 	}
 }
 
+// GoalStatusArrayDescriptor describes the fields of GoalStatusArray. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var GoalStatusArrayDescriptor = &types.MessageDescriptor{
+	TypeName: "action_msgs/msg/GoalStatusArray",
+	Fields: []types.FieldDescriptor{
+		{Name: "status_list", Type: "action_msgs/msg/GoalStatus", GoIndex: 0, IsArray: true, Message: GoalStatusDescriptor},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var GoalStatusArrayTypeSupport types.MessageTypeSupport = _GoalStatusArrayTypeSupport{}
 
@@ -158,6 +251,60 @@ func (t _GoalStatusArrayTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__action_msgs__msg__GoalStatusArray())
 }
 
+func (t _GoalStatusArrayTypeSupport) Descriptor() *types.MessageDescriptor {
+	return GoalStatusArrayDescriptor
+}
+
+func (t _GoalStatusArrayTypeSupport) TypeHash() string {
+	return `RIHS01_6c1684b00f177d37438febe6e709fc4e2b0d4248dca4854946f9ed8b30cda83e`
+}
+
+func (t _GoalStatusArrayTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "action_msgs/msg/GoalStatusArray", "fields": [{"name": "status_list", "type": {"type_id": 145, "capacity": 0, "string_capacity": 0, "nested_type_name": "action_msgs/msg/GoalStatus"}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "action_msgs/msg/GoalInfo", "fields": [{"name": "goal_id", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "unique_identifier_msgs/msg/UUID"}, "default_value": ""}, {"name": "stamp", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "builtin_interfaces/msg/Time"}, "default_value": ""}]}, {"type_name": "action_msgs/msg/GoalStatus", "fields": [{"name": "goal_info", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "action_msgs/msg/GoalInfo"}, "default_value": ""}, {"name": "status", "type": {"type_id": 2, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "builtin_interfaces/msg/Time", "fields": [{"name": "sec", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "nanosec", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "unique_identifier_msgs/msg/UUID", "fields": [{"name": "uuid", "type": {"type_id": 51, "capacity": 16, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}]}`
+}
+
+func (t _GoalStatusArrayTypeSupport) MessageDefinition() string {
+	return `# An array of goal statuses.
+GoalStatus[] status_list
+================================================================================
+MSG: action_msgs/msg/GoalStatus
+# Indicates status has not been properly set.
+int8 STATUS_UNKNOWN=0
+# The goal has been accepted and is awaiting execution.
+int8 STATUS_ACCEPTED=1
+# The goal is currently being executed by the action server.
+int8 STATUS_EXECUTING=2
+# The client has requested that the goal be canceled and the action server hasaccepted the cancel request.
+int8 STATUS_CANCELING=3
+# The goal was achieved successfully by the action server.
+int8 STATUS_SUCCEEDED=4
+# The goal was canceled after an external request from an action client.
+int8 STATUS_CANCELED=5
+# The goal was terminated by the action server without an external request.
+int8 STATUS_ABORTED=6
+
+# Goal info (contains ID and timestamp).
+GoalInfo goal_info
+# Action goal state-machine status.
+int8 status
+================================================================================
+MSG: action_msgs/msg/GoalInfo
+# Goal ID
+unique_identifier_msgs/UUID goal_id
+# Time when the goal was accepted
+builtin_interfaces/Time stamp
+================================================================================
+MSG: unique_identifier_msgs/msg/UUID
+uint8[16] uuid
+================================================================================
+MSG: builtin_interfaces/msg/Time
+# The seconds component, valid over all int32 values.
+int32 sec
+# The nanoseconds component, valid in the range [0, 10e9).
+uint32 nanosec
+`
+}
+
 type CGoalStatusArray = C.action_msgs__msg__GoalStatusArray
 type CGoalStatusArray__Sequence = C.action_msgs__msg__GoalStatusArray__Sequence
 
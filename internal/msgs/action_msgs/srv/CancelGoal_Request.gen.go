@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	action_msgs_msg "github.com/tiiuae/rclgo/internal/msgs/action_msgs/msg"
@@ -53,6 +55,34 @@ func (t *CancelGoal_Request) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *CancelGoal_Request) Equal(other *CancelGoal_Request) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *CancelGoal_Request) EqualApprox(other *CancelGoal_Request, tol float64) bool {
+	if !t.GoalInfo.EqualApprox(&other.GoalInfo, tol) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *CancelGoal_Request) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *CancelGoal_Request) HashTo(h *msgcmp.Hasher) {
+	t.GoalInfo.HashTo(h)
+}
+
 func (t *CancelGoal_Request) SetDefaults() {
 	t.GoalInfo.SetDefaults()
 }
@@ -60,6 +90,43 @@ func (t *CancelGoal_Request) SetDefaults() {
 func (t *CancelGoal_Request) GetTypeSupport() types.MessageTypeSupport {
 	return CancelGoal_RequestTypeSupport
 }
+
+func (t *CancelGoal_Request) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *CancelGoal_Request) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *CancelGoal_Request) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *CancelGoal_Request) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *CancelGoal_Request) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *CancelGoal_Request) EncodeCDR(e *cdr.Encoder) {
+	t.GoalInfo.EncodeCDR(e)
+}
+
+func (t *CancelGoal_Request) DecodeCDR(d *cdr.Decoder) {
+	t.GoalInfo.DecodeCDR(d)
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *CancelGoal_Request) Validate() error {
+	if err := t.GoalInfo.Validate(); err != nil {
+		return rclgo.WrapValidationError("goal_info", -1, err)
+	}
+	return nil
+}
 func (t *CancelGoal_Request) GetGoalID() *types.GoalID {
 	return (*types.GoalID)(&t.GoalInfo.GoalId.Uuid)
 }
@@ -125,6 +192,16 @@ func CloneCancelGoal_RequestSlice(dst, src []CancelGoal_Request) {
 	}
 }
 
+// CancelGoal_RequestDescriptor describes the fields of CancelGoal_Request. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var CancelGoal_RequestDescriptor = &types.MessageDescriptor{
+	TypeName: "action_msgs/srv/CancelGoal_Request",
+	Fields: []types.FieldDescriptor{
+		{Name: "goal_info", Type: "action_msgs/msg/GoalInfo", GoIndex: 0, Message: action_msgs_msg.GoalInfoDescriptor},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var CancelGoal_RequestTypeSupport types.MessageTypeSupport = _CancelGoal_RequestTypeSupport{}
 
@@ -158,6 +235,39 @@ func (t _CancelGoal_RequestTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__action_msgs__srv__CancelGoal_Request())
 }
 
+func (t _CancelGoal_RequestTypeSupport) Descriptor() *types.MessageDescriptor {
+	return CancelGoal_RequestDescriptor
+}
+
+func (t _CancelGoal_RequestTypeSupport) TypeHash() string {
+	return `RIHS01_3d3c84653c1f96918086887e1dcb236faec88b81a5b14fd4cf4840065bcdf8af`
+}
+
+func (t _CancelGoal_RequestTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "action_msgs/srv/CancelGoal_Request", "fields": [{"name": "goal_info", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "action_msgs/msg/GoalInfo"}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "action_msgs/msg/GoalInfo", "fields": [{"name": "goal_id", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "unique_identifier_msgs/msg/UUID"}, "default_value": ""}, {"name": "stamp", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "builtin_interfaces/msg/Time"}, "default_value": ""}]}, {"type_name": "builtin_interfaces/msg/Time", "fields": [{"name": "sec", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "nanosec", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "unique_identifier_msgs/msg/UUID", "fields": [{"name": "uuid", "type": {"type_id": 51, "capacity": 16, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}]}`
+}
+
+func (t _CancelGoal_RequestTypeSupport) MessageDefinition() string {
+	return `# Goal info describing the goals to cancel, see above.
+GoalInfo goal_info
+================================================================================
+MSG: action_msgs/msg/GoalInfo
+# Goal ID
+unique_identifier_msgs/UUID goal_id
+# Time when the goal was accepted
+builtin_interfaces/Time stamp
+================================================================================
+MSG: unique_identifier_msgs/msg/UUID
+uint8[16] uuid
+================================================================================
+MSG: builtin_interfaces/msg/Time
+# The seconds component, valid over all int32 values.
+int32 sec
+# The nanoseconds component, valid in the range [0, 10e9).
+uint32 nanosec
+`
+}
+
 type CCancelGoal_Request = C.action_msgs__srv__CancelGoal_Request
 type CCancelGoal_Request__Sequence = C.action_msgs__srv__CancelGoal_Request__Sequence
 
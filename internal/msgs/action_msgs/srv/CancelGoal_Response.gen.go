@@ -14,9 +14,12 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	action_msgs_msg "github.com/tiiuae/rclgo/internal/msgs/action_msgs/msg"
+	 "strconv"
 	
 )
 /*
@@ -38,6 +41,43 @@ const (
 	CancelGoal_Response_ERROR_GOAL_TERMINATED int8 = 3// Indicates the goal is not cancelable because it is already in a terminal state.No goals have transitioned to the CANCELING state. The goals_canceling list isempty.
 )
 
+// CancelGoal_Response_Error is the type of the CancelGoal_Response_ERROR_* constants.
+type CancelGoal_Response_Error int8
+
+// CancelGoal_Response_ErrorValues contains the distinct values of the
+// CancelGoal_Response_ERROR_* constants.
+var CancelGoal_Response_ErrorValues = []CancelGoal_Response_Error{
+	CancelGoal_Response_Error(CancelGoal_Response_ERROR_NONE),
+	CancelGoal_Response_Error(CancelGoal_Response_ERROR_REJECTED),
+	CancelGoal_Response_Error(CancelGoal_Response_ERROR_UNKNOWN_GOAL_ID),
+	CancelGoal_Response_Error(CancelGoal_Response_ERROR_GOAL_TERMINATED),
+}
+
+// String returns the ROS name of the constant whose value is v.
+func (v CancelGoal_Response_Error) String() string {
+	switch v {
+	case CancelGoal_Response_Error(CancelGoal_Response_ERROR_NONE):
+		return "ERROR_NONE"
+	case CancelGoal_Response_Error(CancelGoal_Response_ERROR_REJECTED):
+		return "ERROR_REJECTED"
+	case CancelGoal_Response_Error(CancelGoal_Response_ERROR_UNKNOWN_GOAL_ID):
+		return "ERROR_UNKNOWN_GOAL_ID"
+	case CancelGoal_Response_Error(CancelGoal_Response_ERROR_GOAL_TERMINATED):
+		return "ERROR_GOAL_TERMINATED"
+	}
+	return "CancelGoal_Response_Error(" + strconv.FormatInt(int64(v), 10) + ")"
+}
+
+// IsValid reports whether v is the value of one of the
+// CancelGoal_Response_ERROR_* constants.
+func (v CancelGoal_Response_Error) IsValid() bool {
+	switch v {
+	case CancelGoal_Response_Error(CancelGoal_Response_ERROR_NONE), CancelGoal_Response_Error(CancelGoal_Response_ERROR_REJECTED), CancelGoal_Response_Error(CancelGoal_Response_ERROR_UNKNOWN_GOAL_ID), CancelGoal_Response_Error(CancelGoal_Response_ERROR_GOAL_TERMINATED):
+		return true
+	}
+	return false
+}
+
 type CancelGoal_Response struct {
 	ReturnCode int8 `yaml:"return_code"`// Return code, see above definitions.
 	GoalsCanceling []action_msgs_msg.GoalInfo `yaml:"goals_canceling"`// Goals that accepted the cancel request.
@@ -64,6 +104,46 @@ func (t *CancelGoal_Response) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *CancelGoal_Response) Equal(other *CancelGoal_Response) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *CancelGoal_Response) EqualApprox(other *CancelGoal_Response, tol float64) bool {
+	if t.ReturnCode != other.ReturnCode {
+		return false
+	}
+	if len(t.GoalsCanceling) != len(other.GoalsCanceling) {
+		return false
+	}
+	for i := range t.GoalsCanceling {
+		if !t.GoalsCanceling[i].EqualApprox(&other.GoalsCanceling[i], tol) {
+			return false
+		}
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *CancelGoal_Response) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *CancelGoal_Response) HashTo(h *msgcmp.Hasher) {
+	h.Int8(t.ReturnCode)
+	h.Len(len(t.GoalsCanceling))
+	for i := range t.GoalsCanceling {
+		t.GoalsCanceling[i].HashTo(h)
+	}
+}
+
 func (t *CancelGoal_Response) SetDefaults() {
 	t.ReturnCode = 0
 	t.GoalsCanceling = nil
@@ -72,6 +152,53 @@ func (t *CancelGoal_Response) SetDefaults() {
 func (t *CancelGoal_Response) GetTypeSupport() types.MessageTypeSupport {
 	return CancelGoal_ResponseTypeSupport
 }
+
+func (t *CancelGoal_Response) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *CancelGoal_Response) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *CancelGoal_Response) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *CancelGoal_Response) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *CancelGoal_Response) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *CancelGoal_Response) EncodeCDR(e *cdr.Encoder) {
+	e.Int8(t.ReturnCode)
+	e.SequenceLength(len(t.GoalsCanceling), 0)
+	for i := range t.GoalsCanceling {
+		t.GoalsCanceling[i].EncodeCDR(e)
+	}
+}
+
+func (t *CancelGoal_Response) DecodeCDR(d *cdr.Decoder) {
+	t.ReturnCode = d.Int8()
+	t.GoalsCanceling = cdr.MakeSlice[action_msgs_msg.GoalInfo](d, 0)
+	for i := range t.GoalsCanceling {
+		t.GoalsCanceling[i].DecodeCDR(d)
+	}
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *CancelGoal_Response) Validate() error {
+	for i := range t.GoalsCanceling {
+		if err := t.GoalsCanceling[i].Validate(); err != nil {
+			return rclgo.WrapValidationError("goals_canceling", i, err)
+		}
+	}
+	return nil
+}
 func (t *CancelGoal_Response) CallForEach(f func(interface{})) {
 	for i := range t.GoalsCanceling {
 		f((*types.GoalID)(&t.GoalsCanceling[i].GoalId.Uuid))
@@ -135,6 +262,17 @@ func CloneCancelGoal_ResponseSlice(dst, src []CancelGoal_Response) {
 	}
 }
 
+// CancelGoal_ResponseDescriptor describes the fields of CancelGoal_Response. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var CancelGoal_ResponseDescriptor = &types.MessageDescriptor{
+	TypeName: "action_msgs/srv/CancelGoal_Response",
+	Fields: []types.FieldDescriptor{
+		{Name: "return_code", Type: "int8", GoIndex: 0},
+		{Name: "goals_canceling", Type: "action_msgs/msg/GoalInfo", GoIndex: 1, IsArray: true, Message: action_msgs_msg.GoalInfoDescriptor},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var CancelGoal_ResponseTypeSupport types.MessageTypeSupport = _CancelGoal_ResponseTypeSupport{}
 
@@ -170,6 +308,50 @@ func (t _CancelGoal_ResponseTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__action_msgs__srv__CancelGoal_Response())
 }
 
+func (t _CancelGoal_ResponseTypeSupport) Descriptor() *types.MessageDescriptor {
+	return CancelGoal_ResponseDescriptor
+}
+
+func (t _CancelGoal_ResponseTypeSupport) TypeHash() string {
+	return `RIHS01_35e682cf3f510e83c70a82a4aac888496dedee56773bf9d8e5e0aa81f9e1c960`
+}
+
+func (t _CancelGoal_ResponseTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "action_msgs/srv/CancelGoal_Response", "fields": [{"name": "return_code", "type": {"type_id": 2, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "goals_canceling", "type": {"type_id": 145, "capacity": 0, "string_capacity": 0, "nested_type_name": "action_msgs/msg/GoalInfo"}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "action_msgs/msg/GoalInfo", "fields": [{"name": "goal_id", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "unique_identifier_msgs/msg/UUID"}, "default_value": ""}, {"name": "stamp", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "builtin_interfaces/msg/Time"}, "default_value": ""}]}, {"type_name": "builtin_interfaces/msg/Time", "fields": [{"name": "sec", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "nanosec", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "unique_identifier_msgs/msg/UUID", "fields": [{"name": "uuid", "type": {"type_id": 51, "capacity": 16, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}]}`
+}
+
+func (t _CancelGoal_ResponseTypeSupport) MessageDefinition() string {
+	return `# Indicates the request was accepted without any errors.One or more goals have transitioned to the CANCELING state. Thegoals_canceling list is not empty.
+int8 ERROR_NONE=0
+# Indicates the request was rejected.No goals have transitioned to the CANCELING state. The goals_canceling list isempty.
+int8 ERROR_REJECTED=1
+# Indicates the requested goal ID does not exist.No goals have transitioned to the CANCELING state. The goals_canceling list isempty.
+int8 ERROR_UNKNOWN_GOAL_ID=2
+# Indicates the goal is not cancelable because it is already in a terminal state.No goals have transitioned to the CANCELING state. The goals_canceling list isempty.
+int8 ERROR_GOAL_TERMINATED=3
+
+# Return code, see above definitions.
+int8 return_code
+# Goals that accepted the cancel request.
+GoalInfo[] goals_canceling
+================================================================================
+MSG: action_msgs/msg/GoalInfo
+# Goal ID
+unique_identifier_msgs/UUID goal_id
+# Time when the goal was accepted
+builtin_interfaces/Time stamp
+================================================================================
+MSG: unique_identifier_msgs/msg/UUID
+uint8[16] uuid
+================================================================================
+MSG: builtin_interfaces/msg/Time
+# The seconds component, valid over all int32 values.
+int32 sec
+# The nanoseconds component, valid in the range [0, 10e9).
+uint32 nanosec
+`
+}
+
 type CCancelGoal_Response = C.action_msgs__srv__CancelGoal_Response
 type CCancelGoal_Response__Sequence = C.action_msgs__srv__CancelGoal_Response__Sequence
 
@@ -14,8 +14,11 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
+	 "time"
 	
 )
 /*
@@ -54,6 +57,38 @@ func (t *Duration) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *Duration) Equal(other *Duration) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *Duration) EqualApprox(other *Duration, tol float64) bool {
+	if t.Sec != other.Sec {
+		return false
+	}
+	if t.Nanosec != other.Nanosec {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *Duration) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *Duration) HashTo(h *msgcmp.Hasher) {
+	h.Int32(t.Sec)
+	h.Uint32(t.Nanosec)
+}
+
 func (t *Duration) SetDefaults() {
 	t.Sec = 0
 	t.Nanosec = 0
@@ -63,6 +98,67 @@ func (t *Duration) GetTypeSupport() types.MessageTypeSupport {
 	return DurationTypeSupport
 }
 
+func (t *Duration) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *Duration) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *Duration) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *Duration) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *Duration) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *Duration) EncodeCDR(e *cdr.Encoder) {
+	e.Int32(t.Sec)
+	e.Uint32(t.Nanosec)
+}
+
+func (t *Duration) DecodeCDR(d *cdr.Decoder) {
+	t.Sec = d.Int32()
+	t.Nanosec = d.Uint32()
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *Duration) Validate() error {
+	return nil
+}
+
+// ToDuration returns t as a time.Duration. Durations which don't fit in a
+// time.Duration overflow.
+func (t *Duration) ToDuration() time.Duration {
+	return time.Duration(t.Sec)*time.Second + time.Duration(t.Nanosec)
+}
+
+// FromDuration sets t to d. Like in rclcpp, Nanosec is always non-negative, so
+// negative durations have a negative Sec and a positive Nanosec.
+func (t *Duration) FromDuration(d time.Duration) {
+	sec, nsec := d/time.Second, d%time.Second
+	if nsec < 0 {
+		sec--
+		nsec += time.Second
+	}
+	t.Sec = int32(sec)
+	t.Nanosec = uint32(nsec)
+}
+
+// DurationFrom returns d as a Duration.
+func DurationFrom(d time.Duration) *Duration {
+	t := NewDuration()
+	t.FromDuration(d)
+	return t
+}
+
 // DurationPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type DurationPublisher struct {
@@ -120,6 +216,17 @@ func CloneDurationSlice(dst, src []Duration) {
 	}
 }
 
+// DurationDescriptor describes the fields of Duration. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var DurationDescriptor = &types.MessageDescriptor{
+	TypeName: "builtin_interfaces/msg/Duration",
+	Fields: []types.FieldDescriptor{
+		{Name: "sec", Type: "int32", GoIndex: 0},
+		{Name: "nanosec", Type: "uint32", GoIndex: 1},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var DurationTypeSupport types.MessageTypeSupport = _DurationTypeSupport{}
 
@@ -155,6 +262,26 @@ func (t _DurationTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__builtin_interfaces__msg__Duration())
 }
 
+func (t _DurationTypeSupport) Descriptor() *types.MessageDescriptor {
+	return DurationDescriptor
+}
+
+func (t _DurationTypeSupport) TypeHash() string {
+	return `RIHS01_e8d009f659816f758b75334ee1a9ca5b5c0b859843261f14c7f937349599d93b`
+}
+
+func (t _DurationTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "builtin_interfaces/msg/Duration", "fields": [{"name": "sec", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "nanosec", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": []}`
+}
+
+func (t _DurationTypeSupport) MessageDefinition() string {
+	return `# Seconds component, range is valid over any possible int32 value.
+int32 sec
+# Nanoseconds component in the range of [0, 10e9).
+uint32 nanosec
+`
+}
+
 type CDuration = C.builtin_interfaces__msg__Duration
 type CDuration__Sequence = C.builtin_interfaces__msg__Duration__Sequence
 
@@ -14,8 +14,11 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
+	 "time"
 	
 )
 /*
@@ -54,6 +57,38 @@ func (t *Time) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *Time) Equal(other *Time) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *Time) EqualApprox(other *Time, tol float64) bool {
+	if t.Sec != other.Sec {
+		return false
+	}
+	if t.Nanosec != other.Nanosec {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *Time) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *Time) HashTo(h *msgcmp.Hasher) {
+	h.Int32(t.Sec)
+	h.Uint32(t.Nanosec)
+}
+
 func (t *Time) SetDefaults() {
 	t.Sec = 0
 	t.Nanosec = 0
@@ -63,6 +98,69 @@ func (t *Time) GetTypeSupport() types.MessageTypeSupport {
 	return TimeTypeSupport
 }
 
+func (t *Time) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *Time) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *Time) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *Time) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *Time) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *Time) EncodeCDR(e *cdr.Encoder) {
+	e.Int32(t.Sec)
+	e.Uint32(t.Nanosec)
+}
+
+func (t *Time) DecodeCDR(d *cdr.Decoder) {
+	t.Sec = d.Int32()
+	t.Nanosec = d.Uint32()
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *Time) Validate() error {
+	return nil
+}
+
+// ToTime returns t as a time.Time.
+func (t *Time) ToTime() time.Time {
+	return time.Unix(int64(t.Sec), int64(t.Nanosec))
+}
+
+// FromTime sets t to tm. The seconds of tm are truncated to 32 bits.
+func (t *Time) FromTime(tm time.Time) {
+	t.Sec = int32(tm.Unix())
+	t.Nanosec = uint32(tm.Nanosecond())
+}
+
+// TimeFrom returns tm as a Time.
+func TimeFrom(tm time.Time) *Time {
+	t := NewTime()
+	t.FromTime(tm)
+	return t
+}
+
+// Now returns the current time of clock.
+func Now(clock *rclgo.Clock) (*Time, error) {
+	now, err := clock.Now()
+	if err != nil {
+		return nil, err
+	}
+	return TimeFrom(now), nil
+}
+
 // TimePublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type TimePublisher struct {
@@ -120,6 +218,17 @@ func CloneTimeSlice(dst, src []Time) {
 	}
 }
 
+// TimeDescriptor describes the fields of Time. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var TimeDescriptor = &types.MessageDescriptor{
+	TypeName: "builtin_interfaces/msg/Time",
+	Fields: []types.FieldDescriptor{
+		{Name: "sec", Type: "int32", GoIndex: 0},
+		{Name: "nanosec", Type: "uint32", GoIndex: 1},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var TimeTypeSupport types.MessageTypeSupport = _TimeTypeSupport{}
 
@@ -155,6 +264,26 @@ func (t _TimeTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__builtin_interfaces__msg__Time())
 }
 
+func (t _TimeTypeSupport) Descriptor() *types.MessageDescriptor {
+	return TimeDescriptor
+}
+
+func (t _TimeTypeSupport) TypeHash() string {
+	return `RIHS01_b106235e25a4c5ed35098aa0a61a3ee9c9b18d197f398b0e4206cea9acf9c197`
+}
+
+func (t _TimeTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "builtin_interfaces/msg/Time", "fields": [{"name": "sec", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "nanosec", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": []}`
+}
+
+func (t _TimeTypeSupport) MessageDefinition() string {
+	return `# The seconds component, valid over all int32 values.
+int32 sec
+# The nanoseconds component, valid in the range [0, 10e9).
+uint32 nanosec
+`
+}
+
 type CTime = C.builtin_interfaces__msg__Time
 type CTime__Sequence = C.builtin_interfaces__msg__Time__Sequence
 
@@ -100,6 +100,14 @@ func (s _FibonacciTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_action_type_support_handle__example_interfaces__action__Fibonacci())
 }
 
+func (s _FibonacciTypeSupport) TypeHash() string {
+	return `RIHS01_9508051da1ea4658de144b09bd0690ff3de52104683d847aed764d2915906f51`
+}
+
+func (s _FibonacciTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "example_interfaces/action/Fibonacci", "fields": [{"name": "goal", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "example_interfaces/action/Fibonacci_Goal"}, "default_value": ""}, {"name": "result", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "example_interfaces/action/Fibonacci_Result"}, "default_value": ""}, {"name": "feedback", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "example_interfaces/action/Fibonacci_Feedback"}, "default_value": ""}, {"name": "send_goal_service", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "example_interfaces/action/Fibonacci_SendGoal"}, "default_value": ""}, {"name": "get_result_service", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "example_interfaces/action/Fibonacci_GetResult"}, "default_value": ""}, {"name": "feedback_message", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "example_interfaces/action/Fibonacci_FeedbackMessage"}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "builtin_interfaces/msg/Time", "fields": [{"name": "sec", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "nanosec", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "example_interfaces/action/Fibonacci_Feedback", "fields": [{"name": "sequence", "type": {"type_id": 150, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "example_interfaces/action/Fibonacci_FeedbackMessage", "fields": [{"name": "goal_id", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "unique_identifier_msgs/msg/UUID"}, "default_value": ""}, {"name": "feedback", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "example_interfaces/action/Fibonacci_Feedback"}, "default_value": ""}]}, {"type_name": "example_interfaces/action/Fibonacci_GetResult", "fields": [{"name": "request_message", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "example_interfaces/action/Fibonacci_GetResult_Request"}, "default_value": ""}, {"name": "response_message", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "example_interfaces/action/Fibonacci_GetResult_Response"}, "default_value": ""}, {"name": "event_message", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "example_interfaces/action/Fibonacci_GetResult_Event"}, "default_value": ""}]}, {"type_name": "example_interfaces/action/Fibonacci_GetResult_Event", "fields": [{"name": "info", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "service_msgs/msg/ServiceEventInfo"}, "default_value": ""}, {"name": "request", "type": {"type_id": 97, "capacity": 1, "string_capacity": 0, "nested_type_name": "example_interfaces/action/Fibonacci_GetResult_Request"}, "default_value": ""}, {"name": "response", "type": {"type_id": 97, "capacity": 1, "string_capacity": 0, "nested_type_name": "example_interfaces/action/Fibonacci_GetResult_Response"}, "default_value": ""}]}, {"type_name": "example_interfaces/action/Fibonacci_GetResult_Request", "fields": [{"name": "goal_id", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "unique_identifier_msgs/msg/UUID"}, "default_value": ""}]}, {"type_name": "example_interfaces/action/Fibonacci_GetResult_Response", "fields": [{"name": "status", "type": {"type_id": 2, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "result", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "example_interfaces/action/Fibonacci_Result"}, "default_value": ""}]}, {"type_name": "example_interfaces/action/Fibonacci_Goal", "fields": [{"name": "order", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "example_interfaces/action/Fibonacci_Result", "fields": [{"name": "sequence", "type": {"type_id": 150, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "example_interfaces/action/Fibonacci_SendGoal", "fields": [{"name": "request_message", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "example_interfaces/action/Fibonacci_SendGoal_Request"}, "default_value": ""}, {"name": "response_message", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "example_interfaces/action/Fibonacci_SendGoal_Response"}, "default_value": ""}, {"name": "event_message", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "example_interfaces/action/Fibonacci_SendGoal_Event"}, "default_value": ""}]}, {"type_name": "example_interfaces/action/Fibonacci_SendGoal_Event", "fields": [{"name": "info", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "service_msgs/msg/ServiceEventInfo"}, "default_value": ""}, {"name": "request", "type": {"type_id": 97, "capacity": 1, "string_capacity": 0, "nested_type_name": "example_interfaces/action/Fibonacci_SendGoal_Request"}, "default_value": ""}, {"name": "response", "type": {"type_id": 97, "capacity": 1, "string_capacity": 0, "nested_type_name": "example_interfaces/action/Fibonacci_SendGoal_Response"}, "default_value": ""}]}, {"type_name": "example_interfaces/action/Fibonacci_SendGoal_Request", "fields": [{"name": "goal_id", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "unique_identifier_msgs/msg/UUID"}, "default_value": ""}, {"name": "goal", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "example_interfaces/action/Fibonacci_Goal"}, "default_value": ""}]}, {"type_name": "example_interfaces/action/Fibonacci_SendGoal_Response", "fields": [{"name": "accepted", "type": {"type_id": 15, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "stamp", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "builtin_interfaces/msg/Time"}, "default_value": ""}]}, {"type_name": "service_msgs/msg/ServiceEventInfo", "fields": [{"name": "event_type", "type": {"type_id": 3, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "stamp", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "builtin_interfaces/msg/Time"}, "default_value": ""}, {"name": "client_gid", "type": {"type_id": 51, "capacity": 16, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "sequence_number", "type": {"type_id": 8, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "unique_identifier_msgs/msg/UUID", "fields": [{"name": "uuid", "type": {"type_id": 51, "capacity": 16, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}]}`
+}
+
 // Modifying this variable is undefined behavior.
 var FibonacciTypeSupport types.ActionTypeSupport = _FibonacciTypeSupport{}
 
@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	primitives "github.com/tiiuae/rclgo/pkg/rclgo/primitives"
@@ -56,6 +58,37 @@ func (t *Fibonacci_Feedback) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *Fibonacci_Feedback) Equal(other *Fibonacci_Feedback) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *Fibonacci_Feedback) EqualApprox(other *Fibonacci_Feedback, tol float64) bool {
+	if !msgcmp.Slices(t.Sequence, other.Sequence) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *Fibonacci_Feedback) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *Fibonacci_Feedback) HashTo(h *msgcmp.Hasher) {
+	h.Len(len(t.Sequence))
+	for i := range t.Sequence {
+		h.Int32(t.Sequence[i])
+	}
+}
+
 func (t *Fibonacci_Feedback) SetDefaults() {
 	t.Sequence = nil
 }
@@ -64,6 +97,46 @@ func (t *Fibonacci_Feedback) GetTypeSupport() types.MessageTypeSupport {
 	return Fibonacci_FeedbackTypeSupport
 }
 
+func (t *Fibonacci_Feedback) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *Fibonacci_Feedback) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *Fibonacci_Feedback) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *Fibonacci_Feedback) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *Fibonacci_Feedback) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *Fibonacci_Feedback) EncodeCDR(e *cdr.Encoder) {
+	e.SequenceLength(len(t.Sequence), 0)
+	for i := range t.Sequence {
+		e.Int32(t.Sequence[i])
+	}
+}
+
+func (t *Fibonacci_Feedback) DecodeCDR(d *cdr.Decoder) {
+	t.Sequence = cdr.MakeSlice[int32](d, 0)
+	for i := range t.Sequence {
+		t.Sequence[i] = d.Int32()
+	}
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *Fibonacci_Feedback) Validate() error {
+	return nil
+}
+
 // Fibonacci_FeedbackPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type Fibonacci_FeedbackPublisher struct {
@@ -121,6 +194,16 @@ func CloneFibonacci_FeedbackSlice(dst, src []Fibonacci_Feedback) {
 	}
 }
 
+// Fibonacci_FeedbackDescriptor describes the fields of Fibonacci_Feedback. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var Fibonacci_FeedbackDescriptor = &types.MessageDescriptor{
+	TypeName: "example_interfaces/action/Fibonacci_Feedback",
+	Fields: []types.FieldDescriptor{
+		{Name: "sequence", Type: "int32", GoIndex: 0, IsArray: true},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var Fibonacci_FeedbackTypeSupport types.MessageTypeSupport = _Fibonacci_FeedbackTypeSupport{}
 
@@ -154,6 +237,24 @@ func (t _Fibonacci_FeedbackTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__example_interfaces__action__Fibonacci_Feedback())
 }
 
+func (t _Fibonacci_FeedbackTypeSupport) Descriptor() *types.MessageDescriptor {
+	return Fibonacci_FeedbackDescriptor
+}
+
+func (t _Fibonacci_FeedbackTypeSupport) TypeHash() string {
+	return `RIHS01_2b12e37361da6f408d4c85bc24a18de64333f29082f2ca34b5ee33dc4c8b42a9`
+}
+
+func (t _Fibonacci_FeedbackTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "example_interfaces/action/Fibonacci_Feedback", "fields": [{"name": "sequence", "type": {"type_id": 150, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": []}`
+}
+
+func (t _Fibonacci_FeedbackTypeSupport) MessageDefinition() string {
+	return `# Feedback
+int32[] sequence
+`
+}
+
 type CFibonacci_Feedback = C.example_interfaces__action__Fibonacci_Feedback
 type CFibonacci_Feedback__Sequence = C.example_interfaces__action__Fibonacci_Feedback__Sequence
 
@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	unique_identifier_msgs_msg "github.com/tiiuae/rclgo/internal/msgs/unique_identifier_msgs/msg"
@@ -55,6 +57,38 @@ func (t *Fibonacci_FeedbackMessage) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *Fibonacci_FeedbackMessage) Equal(other *Fibonacci_FeedbackMessage) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *Fibonacci_FeedbackMessage) EqualApprox(other *Fibonacci_FeedbackMessage, tol float64) bool {
+	if !t.GoalID.EqualApprox(&other.GoalID, tol) {
+		return false
+	}
+	if !t.Feedback.EqualApprox(&other.Feedback, tol) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *Fibonacci_FeedbackMessage) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *Fibonacci_FeedbackMessage) HashTo(h *msgcmp.Hasher) {
+	t.GoalID.HashTo(h)
+	t.Feedback.HashTo(h)
+}
+
 func (t *Fibonacci_FeedbackMessage) SetDefaults() {
 	t.GoalID.SetDefaults()
 	t.Feedback.SetDefaults()
@@ -63,6 +97,48 @@ func (t *Fibonacci_FeedbackMessage) SetDefaults() {
 func (t *Fibonacci_FeedbackMessage) GetTypeSupport() types.MessageTypeSupport {
 	return Fibonacci_FeedbackMessageTypeSupport
 }
+
+func (t *Fibonacci_FeedbackMessage) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *Fibonacci_FeedbackMessage) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *Fibonacci_FeedbackMessage) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *Fibonacci_FeedbackMessage) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *Fibonacci_FeedbackMessage) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *Fibonacci_FeedbackMessage) EncodeCDR(e *cdr.Encoder) {
+	t.GoalID.EncodeCDR(e)
+	t.Feedback.EncodeCDR(e)
+}
+
+func (t *Fibonacci_FeedbackMessage) DecodeCDR(d *cdr.Decoder) {
+	t.GoalID.DecodeCDR(d)
+	t.Feedback.DecodeCDR(d)
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *Fibonacci_FeedbackMessage) Validate() error {
+	if err := t.GoalID.Validate(); err != nil {
+		return rclgo.WrapValidationError("goal_id", -1, err)
+	}
+	if err := t.Feedback.Validate(); err != nil {
+		return rclgo.WrapValidationError("feedback", -1, err)
+	}
+	return nil
+}
 func (t *Fibonacci_FeedbackMessage) GetGoalID() *types.GoalID {
 	return (*types.GoalID)(&t.GoalID.Uuid)
 }
@@ -128,6 +204,17 @@ func CloneFibonacci_FeedbackMessageSlice(dst, src []Fibonacci_FeedbackMessage) {
 	}
 }
 
+// Fibonacci_FeedbackMessageDescriptor describes the fields of Fibonacci_FeedbackMessage. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var Fibonacci_FeedbackMessageDescriptor = &types.MessageDescriptor{
+	TypeName: "example_interfaces/action/Fibonacci_FeedbackMessage",
+	Fields: []types.FieldDescriptor{
+		{Name: "goal_id", Type: "unique_identifier_msgs/msg/UUID", GoIndex: 0, Message: unique_identifier_msgs_msg.UUIDDescriptor},
+		{Name: "feedback", Type: "example_interfaces/action/Fibonacci_Feedback", GoIndex: 1, Message: Fibonacci_FeedbackDescriptor},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var Fibonacci_FeedbackMessageTypeSupport types.MessageTypeSupport = _Fibonacci_FeedbackMessageTypeSupport{}
 
@@ -163,6 +250,31 @@ func (t _Fibonacci_FeedbackMessageTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__example_interfaces__action__Fibonacci_FeedbackMessage())
 }
 
+func (t _Fibonacci_FeedbackMessageTypeSupport) Descriptor() *types.MessageDescriptor {
+	return Fibonacci_FeedbackMessageDescriptor
+}
+
+func (t _Fibonacci_FeedbackMessageTypeSupport) TypeHash() string {
+	return `RIHS01_c1de71afd52e49a89c53d8262366884185bc0a02f78ce051c4e46b0a7fe59bb2`
+}
+
+func (t _Fibonacci_FeedbackMessageTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "example_interfaces/action/Fibonacci_FeedbackMessage", "fields": [{"name": "goal_id", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "unique_identifier_msgs/msg/UUID"}, "default_value": ""}, {"name": "feedback", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "example_interfaces/action/Fibonacci_Feedback"}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "example_interfaces/action/Fibonacci_Feedback", "fields": [{"name": "sequence", "type": {"type_id": 150, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "unique_identifier_msgs/msg/UUID", "fields": [{"name": "uuid", "type": {"type_id": 51, "capacity": 16, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}]}`
+}
+
+func (t _Fibonacci_FeedbackMessageTypeSupport) MessageDefinition() string {
+	return `unique_identifier_msgs/UUID goal_id
+example_interfaces/Fibonacci_Feedback feedback
+================================================================================
+MSG: unique_identifier_msgs/msg/UUID
+uint8[16] uuid
+================================================================================
+MSG: example_interfaces/action/Fibonacci_Feedback
+# Feedback
+int32[] sequence
+`
+}
+
 type CFibonacci_FeedbackMessage = C.example_interfaces__action__Fibonacci_FeedbackMessage
 type CFibonacci_FeedbackMessage__Sequence = C.example_interfaces__action__Fibonacci_FeedbackMessage__Sequence
 
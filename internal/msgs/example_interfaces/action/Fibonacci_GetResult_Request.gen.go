@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	unique_identifier_msgs_msg "github.com/tiiuae/rclgo/internal/msgs/unique_identifier_msgs/msg"
@@ -53,6 +55,34 @@ func (t *Fibonacci_GetResult_Request) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *Fibonacci_GetResult_Request) Equal(other *Fibonacci_GetResult_Request) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *Fibonacci_GetResult_Request) EqualApprox(other *Fibonacci_GetResult_Request, tol float64) bool {
+	if !t.GoalID.EqualApprox(&other.GoalID, tol) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *Fibonacci_GetResult_Request) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *Fibonacci_GetResult_Request) HashTo(h *msgcmp.Hasher) {
+	t.GoalID.HashTo(h)
+}
+
 func (t *Fibonacci_GetResult_Request) SetDefaults() {
 	t.GoalID.SetDefaults()
 }
@@ -60,6 +90,43 @@ func (t *Fibonacci_GetResult_Request) SetDefaults() {
 func (t *Fibonacci_GetResult_Request) GetTypeSupport() types.MessageTypeSupport {
 	return Fibonacci_GetResult_RequestTypeSupport
 }
+
+func (t *Fibonacci_GetResult_Request) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *Fibonacci_GetResult_Request) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *Fibonacci_GetResult_Request) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *Fibonacci_GetResult_Request) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *Fibonacci_GetResult_Request) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *Fibonacci_GetResult_Request) EncodeCDR(e *cdr.Encoder) {
+	t.GoalID.EncodeCDR(e)
+}
+
+func (t *Fibonacci_GetResult_Request) DecodeCDR(d *cdr.Decoder) {
+	t.GoalID.DecodeCDR(d)
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *Fibonacci_GetResult_Request) Validate() error {
+	if err := t.GoalID.Validate(); err != nil {
+		return rclgo.WrapValidationError("goal_id", -1, err)
+	}
+	return nil
+}
 func (t *Fibonacci_GetResult_Request) GetGoalID() *types.GoalID {
 	return (*types.GoalID)(&t.GoalID.Uuid)
 }
@@ -125,6 +192,16 @@ func CloneFibonacci_GetResult_RequestSlice(dst, src []Fibonacci_GetResult_Reques
 	}
 }
 
+// Fibonacci_GetResult_RequestDescriptor describes the fields of Fibonacci_GetResult_Request. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var Fibonacci_GetResult_RequestDescriptor = &types.MessageDescriptor{
+	TypeName: "example_interfaces/action/Fibonacci_GetResult_Request",
+	Fields: []types.FieldDescriptor{
+		{Name: "goal_id", Type: "unique_identifier_msgs/msg/UUID", GoIndex: 0, Message: unique_identifier_msgs_msg.UUIDDescriptor},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var Fibonacci_GetResult_RequestTypeSupport types.MessageTypeSupport = _Fibonacci_GetResult_RequestTypeSupport{}
 
@@ -158,6 +235,26 @@ func (t _Fibonacci_GetResult_RequestTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__example_interfaces__action__Fibonacci_GetResult_Request())
 }
 
+func (t _Fibonacci_GetResult_RequestTypeSupport) Descriptor() *types.MessageDescriptor {
+	return Fibonacci_GetResult_RequestDescriptor
+}
+
+func (t _Fibonacci_GetResult_RequestTypeSupport) TypeHash() string {
+	return `RIHS01_c8a4f5e7d13b81286ee1043e2ecd084281cecf1ff06aaa799464f5f15479f003`
+}
+
+func (t _Fibonacci_GetResult_RequestTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "example_interfaces/action/Fibonacci_GetResult_Request", "fields": [{"name": "goal_id", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "unique_identifier_msgs/msg/UUID"}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "unique_identifier_msgs/msg/UUID", "fields": [{"name": "uuid", "type": {"type_id": 51, "capacity": 16, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}]}`
+}
+
+func (t _Fibonacci_GetResult_RequestTypeSupport) MessageDefinition() string {
+	return `unique_identifier_msgs/UUID goal_id
+================================================================================
+MSG: unique_identifier_msgs/msg/UUID
+uint8[16] uuid
+`
+}
+
 type CFibonacci_GetResult_Request = C.example_interfaces__action__Fibonacci_GetResult_Request
 type CFibonacci_GetResult_Request__Sequence = C.example_interfaces__action__Fibonacci_GetResult_Request__Sequence
 
@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	
@@ -54,6 +56,38 @@ func (t *Fibonacci_GetResult_Response) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *Fibonacci_GetResult_Response) Equal(other *Fibonacci_GetResult_Response) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *Fibonacci_GetResult_Response) EqualApprox(other *Fibonacci_GetResult_Response, tol float64) bool {
+	if t.Status != other.Status {
+		return false
+	}
+	if !t.Result.EqualApprox(&other.Result, tol) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *Fibonacci_GetResult_Response) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *Fibonacci_GetResult_Response) HashTo(h *msgcmp.Hasher) {
+	h.Int8(t.Status)
+	t.Result.HashTo(h)
+}
+
 func (t *Fibonacci_GetResult_Response) SetDefaults() {
 	t.Status = 0
 	t.Result.SetDefaults()
@@ -63,6 +97,45 @@ func (t *Fibonacci_GetResult_Response) GetTypeSupport() types.MessageTypeSupport
 	return Fibonacci_GetResult_ResponseTypeSupport
 }
 
+func (t *Fibonacci_GetResult_Response) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *Fibonacci_GetResult_Response) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *Fibonacci_GetResult_Response) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *Fibonacci_GetResult_Response) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *Fibonacci_GetResult_Response) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *Fibonacci_GetResult_Response) EncodeCDR(e *cdr.Encoder) {
+	e.Int8(t.Status)
+	t.Result.EncodeCDR(e)
+}
+
+func (t *Fibonacci_GetResult_Response) DecodeCDR(d *cdr.Decoder) {
+	t.Status = d.Int8()
+	t.Result.DecodeCDR(d)
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *Fibonacci_GetResult_Response) Validate() error {
+	if err := t.Result.Validate(); err != nil {
+		return rclgo.WrapValidationError("result", -1, err)
+	}
+	return nil
+}
+
 // Fibonacci_GetResult_ResponsePublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type Fibonacci_GetResult_ResponsePublisher struct {
@@ -120,6 +193,17 @@ func CloneFibonacci_GetResult_ResponseSlice(dst, src []Fibonacci_GetResult_Respo
 	}
 }
 
+// Fibonacci_GetResult_ResponseDescriptor describes the fields of Fibonacci_GetResult_Response. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var Fibonacci_GetResult_ResponseDescriptor = &types.MessageDescriptor{
+	TypeName: "example_interfaces/action/Fibonacci_GetResult_Response",
+	Fields: []types.FieldDescriptor{
+		{Name: "status", Type: "int8", GoIndex: 0},
+		{Name: "result", Type: "example_interfaces/action/Fibonacci_Result", GoIndex: 1, Message: Fibonacci_ResultDescriptor},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var Fibonacci_GetResult_ResponseTypeSupport types.MessageTypeSupport = _Fibonacci_GetResult_ResponseTypeSupport{}
 
@@ -155,6 +239,27 @@ func (t _Fibonacci_GetResult_ResponseTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__example_interfaces__action__Fibonacci_GetResult_Response())
 }
 
+func (t _Fibonacci_GetResult_ResponseTypeSupport) Descriptor() *types.MessageDescriptor {
+	return Fibonacci_GetResult_ResponseDescriptor
+}
+
+func (t _Fibonacci_GetResult_ResponseTypeSupport) TypeHash() string {
+	return `RIHS01_6021dc98ab9b4bbe395e48aa4de81ee5f68eb570f88358affcc648146668b24f`
+}
+
+func (t _Fibonacci_GetResult_ResponseTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "example_interfaces/action/Fibonacci_GetResult_Response", "fields": [{"name": "status", "type": {"type_id": 2, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "result", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "example_interfaces/action/Fibonacci_Result"}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "example_interfaces/action/Fibonacci_Result", "fields": [{"name": "sequence", "type": {"type_id": 150, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}]}`
+}
+
+func (t _Fibonacci_GetResult_ResponseTypeSupport) MessageDefinition() string {
+	return `int8 status
+example_interfaces/Fibonacci_Result result
+================================================================================
+MSG: example_interfaces/action/Fibonacci_Result
+# Result
+int32[] sequence`
+}
+
 type CFibonacci_GetResult_Response = C.example_interfaces__action__Fibonacci_GetResult_Response
 type CFibonacci_GetResult_Response__Sequence = C.example_interfaces__action__Fibonacci_GetResult_Response__Sequence
 
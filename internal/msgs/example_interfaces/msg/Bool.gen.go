@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	
@@ -52,6 +54,34 @@ func (t *Bool) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *Bool) Equal(other *Bool) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *Bool) EqualApprox(other *Bool, tol float64) bool {
+	if t.Data != other.Data {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *Bool) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *Bool) HashTo(h *msgcmp.Hasher) {
+	h.Bool(t.Data)
+}
+
 func (t *Bool) SetDefaults() {
 	t.Data = false
 }
@@ -60,6 +90,40 @@ func (t *Bool) GetTypeSupport() types.MessageTypeSupport {
 	return BoolTypeSupport
 }
 
+func (t *Bool) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *Bool) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *Bool) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *Bool) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *Bool) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *Bool) EncodeCDR(e *cdr.Encoder) {
+	e.Bool(t.Data)
+}
+
+func (t *Bool) DecodeCDR(d *cdr.Decoder) {
+	t.Data = d.Bool()
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *Bool) Validate() error {
+	return nil
+}
+
 // BoolPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type BoolPublisher struct {
@@ -117,6 +181,16 @@ func CloneBoolSlice(dst, src []Bool) {
 	}
 }
 
+// BoolDescriptor describes the fields of Bool. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var BoolDescriptor = &types.MessageDescriptor{
+	TypeName: "example_interfaces/msg/Bool",
+	Fields: []types.FieldDescriptor{
+		{Name: "data", Type: "bool", GoIndex: 0},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var BoolTypeSupport types.MessageTypeSupport = _BoolTypeSupport{}
 
@@ -150,6 +224,24 @@ func (t _BoolTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__example_interfaces__msg__Bool())
 }
 
+func (t _BoolTypeSupport) Descriptor() *types.MessageDescriptor {
+	return BoolDescriptor
+}
+
+func (t _BoolTypeSupport) TypeHash() string {
+	return `RIHS01_4765c142500f8fd4e1a32fb3edd7b7d9d822a16ec270445f5120e772c5f9aed5`
+}
+
+func (t _BoolTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "example_interfaces/msg/Bool", "fields": [{"name": "data", "type": {"type_id": 15, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": []}`
+}
+
+func (t _BoolTypeSupport) MessageDefinition() string {
+	return `# This is an example message of using a primitive datatype, bool.If you want to test with this that's fine, but if you are deployingit into a system you should create a semantically meaningful message type.If you want to embed it in another message, use the primitive data type instead.
+bool data
+`
+}
+
 type CBool = C.example_interfaces__msg__Bool
 type CBool__Sequence = C.example_interfaces__msg__Bool__Sequence
 
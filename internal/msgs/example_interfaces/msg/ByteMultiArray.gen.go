@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	primitives "github.com/tiiuae/rclgo/pkg/rclgo/primitives"
@@ -58,6 +60,41 @@ func (t *ByteMultiArray) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *ByteMultiArray) Equal(other *ByteMultiArray) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *ByteMultiArray) EqualApprox(other *ByteMultiArray, tol float64) bool {
+	if !t.Layout.EqualApprox(&other.Layout, tol) {
+		return false
+	}
+	if !msgcmp.Slices(t.Data, other.Data) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *ByteMultiArray) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *ByteMultiArray) HashTo(h *msgcmp.Hasher) {
+	t.Layout.HashTo(h)
+	h.Len(len(t.Data))
+	for i := range t.Data {
+		h.Uint8(t.Data[i])
+	}
+}
+
 func (t *ByteMultiArray) SetDefaults() {
 	t.Layout.SetDefaults()
 	t.Data = nil
@@ -67,6 +104,47 @@ func (t *ByteMultiArray) GetTypeSupport() types.MessageTypeSupport {
 	return ByteMultiArrayTypeSupport
 }
 
+func (t *ByteMultiArray) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *ByteMultiArray) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *ByteMultiArray) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *ByteMultiArray) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *ByteMultiArray) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *ByteMultiArray) EncodeCDR(e *cdr.Encoder) {
+	t.Layout.EncodeCDR(e)
+	e.SequenceLength(len(t.Data), 0)
+	e.Uint8s(t.Data[:])
+}
+
+func (t *ByteMultiArray) DecodeCDR(d *cdr.Decoder) {
+	t.Layout.DecodeCDR(d)
+	t.Data = cdr.MakeSlice[byte](d, 0)
+	d.Uint8s(t.Data[:])
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *ByteMultiArray) Validate() error {
+	if err := t.Layout.Validate(); err != nil {
+		return rclgo.WrapValidationError("layout", -1, err)
+	}
+	return nil
+}
+
 // ByteMultiArrayPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type ByteMultiArrayPublisher struct {
@@ -124,6 +202,17 @@ func CloneByteMultiArraySlice(dst, src []ByteMultiArray) {
 	}
 }
 
+// ByteMultiArrayDescriptor describes the fields of ByteMultiArray. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var ByteMultiArrayDescriptor = &types.MessageDescriptor{
+	TypeName: "example_interfaces/msg/ByteMultiArray",
+	Fields: []types.FieldDescriptor{
+		{Name: "layout", Type: "example_interfaces/msg/MultiArrayLayout", GoIndex: 0, Message: MultiArrayLayoutDescriptor},
+		{Name: "data", Type: "byte", GoIndex: 1, IsArray: true},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var ByteMultiArrayTypeSupport types.MessageTypeSupport = _ByteMultiArrayTypeSupport{}
 
@@ -159,6 +248,40 @@ func (t _ByteMultiArrayTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__example_interfaces__msg__ByteMultiArray())
 }
 
+func (t _ByteMultiArrayTypeSupport) Descriptor() *types.MessageDescriptor {
+	return ByteMultiArrayDescriptor
+}
+
+func (t _ByteMultiArrayTypeSupport) TypeHash() string {
+	return `RIHS01_825dc2acf0a2022e9206614a613ce5e14a3fff8b83934ae64e588a2b77e82384`
+}
+
+func (t _ByteMultiArrayTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "example_interfaces/msg/ByteMultiArray", "fields": [{"name": "layout", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "example_interfaces/msg/MultiArrayLayout"}, "default_value": ""}, {"name": "data", "type": {"type_id": 160, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "example_interfaces/msg/MultiArrayLayout", "fields": [{"name": "dim", "type": {"type_id": 145, "capacity": 0, "string_capacity": 0, "nested_type_name": "std_msgs/msg/MultiArrayDimension"}, "default_value": ""}, {"name": "data_offset", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "std_msgs/msg/MultiArrayDimension", "fields": [{"name": "label", "type": {"type_id": 17, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "size", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "stride", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}]}`
+}
+
+func (t _ByteMultiArrayTypeSupport) MessageDefinition() string {
+	return `# specification of data layout
+MultiArrayLayout layout
+# array of data
+byte[] data
+================================================================================
+MSG: example_interfaces/msg/MultiArrayLayout
+# Array of dimension properties
+std_msgs/MultiArrayDimension[] dim
+# padding bytes at front of data
+uint32 data_offset
+================================================================================
+MSG: std_msgs/msg/MultiArrayDimension
+# label of given dimension
+string label
+# size of given dimension (in type units)
+uint32 size
+# stride of given dimension
+uint32 stride
+`
+}
+
 type CByteMultiArray = C.example_interfaces__msg__ByteMultiArray
 type CByteMultiArray__Sequence = C.example_interfaces__msg__ByteMultiArray__Sequence
 
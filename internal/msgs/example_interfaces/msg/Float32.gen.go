@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	
@@ -52,6 +54,34 @@ func (t *Float32) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *Float32) Equal(other *Float32) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *Float32) EqualApprox(other *Float32, tol float64) bool {
+	if !msgcmp.Float32(t.Data, other.Data, tol) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *Float32) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *Float32) HashTo(h *msgcmp.Hasher) {
+	h.Float32(t.Data)
+}
+
 func (t *Float32) SetDefaults() {
 	t.Data = 0
 }
@@ -60,6 +90,40 @@ func (t *Float32) GetTypeSupport() types.MessageTypeSupport {
 	return Float32TypeSupport
 }
 
+func (t *Float32) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *Float32) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *Float32) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *Float32) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *Float32) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *Float32) EncodeCDR(e *cdr.Encoder) {
+	e.Float32(t.Data)
+}
+
+func (t *Float32) DecodeCDR(d *cdr.Decoder) {
+	t.Data = d.Float32()
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *Float32) Validate() error {
+	return nil
+}
+
 // Float32Publisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type Float32Publisher struct {
@@ -117,6 +181,16 @@ func CloneFloat32Slice(dst, src []Float32) {
 	}
 }
 
+// Float32Descriptor describes the fields of Float32. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var Float32Descriptor = &types.MessageDescriptor{
+	TypeName: "example_interfaces/msg/Float32",
+	Fields: []types.FieldDescriptor{
+		{Name: "data", Type: "float32", GoIndex: 0},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var Float32TypeSupport types.MessageTypeSupport = _Float32TypeSupport{}
 
@@ -150,6 +224,24 @@ func (t _Float32TypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__example_interfaces__msg__Float32())
 }
 
+func (t _Float32TypeSupport) Descriptor() *types.MessageDescriptor {
+	return Float32Descriptor
+}
+
+func (t _Float32TypeSupport) TypeHash() string {
+	return `RIHS01_6a112d9235f8e8088d7a2bc77cb955341ac0d5c9870bdc592651a4186bb246f3`
+}
+
+func (t _Float32TypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "example_interfaces/msg/Float32", "fields": [{"name": "data", "type": {"type_id": 10, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": []}`
+}
+
+func (t _Float32TypeSupport) MessageDefinition() string {
+	return `# This is an example message of using a primitive datatype, float32.If you want to test with this that's fine, but if you are deployingit into a system you should create a semantically meaningful message type.If you want to embed it in another message, use the primitive data type instead.
+float32 data
+`
+}
+
 type CFloat32 = C.example_interfaces__msg__Float32
 type CFloat32__Sequence = C.example_interfaces__msg__Float32__Sequence
 
@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	
@@ -52,6 +54,34 @@ func (t *Float64) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *Float64) Equal(other *Float64) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *Float64) EqualApprox(other *Float64, tol float64) bool {
+	if !msgcmp.Float64(t.Data, other.Data, tol) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *Float64) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *Float64) HashTo(h *msgcmp.Hasher) {
+	h.Float64(t.Data)
+}
+
 func (t *Float64) SetDefaults() {
 	t.Data = 0
 }
@@ -60,6 +90,40 @@ func (t *Float64) GetTypeSupport() types.MessageTypeSupport {
 	return Float64TypeSupport
 }
 
+func (t *Float64) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *Float64) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *Float64) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *Float64) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *Float64) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *Float64) EncodeCDR(e *cdr.Encoder) {
+	e.Float64(t.Data)
+}
+
+func (t *Float64) DecodeCDR(d *cdr.Decoder) {
+	t.Data = d.Float64()
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *Float64) Validate() error {
+	return nil
+}
+
 // Float64Publisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type Float64Publisher struct {
@@ -117,6 +181,16 @@ func CloneFloat64Slice(dst, src []Float64) {
 	}
 }
 
+// Float64Descriptor describes the fields of Float64. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var Float64Descriptor = &types.MessageDescriptor{
+	TypeName: "example_interfaces/msg/Float64",
+	Fields: []types.FieldDescriptor{
+		{Name: "data", Type: "float64", GoIndex: 0},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var Float64TypeSupport types.MessageTypeSupport = _Float64TypeSupport{}
 
@@ -150,6 +224,24 @@ func (t _Float64TypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__example_interfaces__msg__Float64())
 }
 
+func (t _Float64TypeSupport) Descriptor() *types.MessageDescriptor {
+	return Float64Descriptor
+}
+
+func (t _Float64TypeSupport) TypeHash() string {
+	return `RIHS01_74c137b7930c26339425a95fcfab441199bc41e0e572d3a0c9e95badd72b50da`
+}
+
+func (t _Float64TypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "example_interfaces/msg/Float64", "fields": [{"name": "data", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": []}`
+}
+
+func (t _Float64TypeSupport) MessageDefinition() string {
+	return `# This is an example message of using a primitive datatype, float64.If you want to test with this that's fine, but if you are deployingit into a system you should create a semantically meaningful message type.If you want to embed it in another message, use the primitive data type instead.
+float64 data
+`
+}
+
 type CFloat64 = C.example_interfaces__msg__Float64
 type CFloat64__Sequence = C.example_interfaces__msg__Float64__Sequence
 
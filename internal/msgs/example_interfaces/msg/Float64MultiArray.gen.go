@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	primitives "github.com/tiiuae/rclgo/pkg/rclgo/primitives"
@@ -58,6 +60,41 @@ func (t *Float64MultiArray) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *Float64MultiArray) Equal(other *Float64MultiArray) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *Float64MultiArray) EqualApprox(other *Float64MultiArray, tol float64) bool {
+	if !t.Layout.EqualApprox(&other.Layout, tol) {
+		return false
+	}
+	if !msgcmp.Float64s(t.Data, other.Data, tol) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *Float64MultiArray) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *Float64MultiArray) HashTo(h *msgcmp.Hasher) {
+	t.Layout.HashTo(h)
+	h.Len(len(t.Data))
+	for i := range t.Data {
+		h.Float64(t.Data[i])
+	}
+}
+
 func (t *Float64MultiArray) SetDefaults() {
 	t.Layout.SetDefaults()
 	t.Data = nil
@@ -67,6 +104,51 @@ func (t *Float64MultiArray) GetTypeSupport() types.MessageTypeSupport {
 	return Float64MultiArrayTypeSupport
 }
 
+func (t *Float64MultiArray) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *Float64MultiArray) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *Float64MultiArray) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *Float64MultiArray) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *Float64MultiArray) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *Float64MultiArray) EncodeCDR(e *cdr.Encoder) {
+	t.Layout.EncodeCDR(e)
+	e.SequenceLength(len(t.Data), 0)
+	for i := range t.Data {
+		e.Float64(t.Data[i])
+	}
+}
+
+func (t *Float64MultiArray) DecodeCDR(d *cdr.Decoder) {
+	t.Layout.DecodeCDR(d)
+	t.Data = cdr.MakeSlice[float64](d, 0)
+	for i := range t.Data {
+		t.Data[i] = d.Float64()
+	}
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *Float64MultiArray) Validate() error {
+	if err := t.Layout.Validate(); err != nil {
+		return rclgo.WrapValidationError("layout", -1, err)
+	}
+	return nil
+}
+
 // Float64MultiArrayPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type Float64MultiArrayPublisher struct {
@@ -124,6 +206,17 @@ func CloneFloat64MultiArraySlice(dst, src []Float64MultiArray) {
 	}
 }
 
+// Float64MultiArrayDescriptor describes the fields of Float64MultiArray. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var Float64MultiArrayDescriptor = &types.MessageDescriptor{
+	TypeName: "example_interfaces/msg/Float64MultiArray",
+	Fields: []types.FieldDescriptor{
+		{Name: "layout", Type: "example_interfaces/msg/MultiArrayLayout", GoIndex: 0, Message: MultiArrayLayoutDescriptor},
+		{Name: "data", Type: "float64", GoIndex: 1, IsArray: true},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var Float64MultiArrayTypeSupport types.MessageTypeSupport = _Float64MultiArrayTypeSupport{}
 
@@ -159,6 +252,40 @@ func (t _Float64MultiArrayTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__example_interfaces__msg__Float64MultiArray())
 }
 
+func (t _Float64MultiArrayTypeSupport) Descriptor() *types.MessageDescriptor {
+	return Float64MultiArrayDescriptor
+}
+
+func (t _Float64MultiArrayTypeSupport) TypeHash() string {
+	return `RIHS01_1dfd5538969dbf31a54eca5afe783eb46066dfcc7dced5b1b535589ea9f1756c`
+}
+
+func (t _Float64MultiArrayTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "example_interfaces/msg/Float64MultiArray", "fields": [{"name": "layout", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "example_interfaces/msg/MultiArrayLayout"}, "default_value": ""}, {"name": "data", "type": {"type_id": 155, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "example_interfaces/msg/MultiArrayLayout", "fields": [{"name": "dim", "type": {"type_id": 145, "capacity": 0, "string_capacity": 0, "nested_type_name": "std_msgs/msg/MultiArrayDimension"}, "default_value": ""}, {"name": "data_offset", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "std_msgs/msg/MultiArrayDimension", "fields": [{"name": "label", "type": {"type_id": 17, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "size", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "stride", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}]}`
+}
+
+func (t _Float64MultiArrayTypeSupport) MessageDefinition() string {
+	return `# specification of data layout
+MultiArrayLayout layout
+# array of data
+float64[] data
+================================================================================
+MSG: example_interfaces/msg/MultiArrayLayout
+# Array of dimension properties
+std_msgs/MultiArrayDimension[] dim
+# padding bytes at front of data
+uint32 data_offset
+================================================================================
+MSG: std_msgs/msg/MultiArrayDimension
+# label of given dimension
+string label
+# size of given dimension (in type units)
+uint32 size
+# stride of given dimension
+uint32 stride
+`
+}
+
 type CFloat64MultiArray = C.example_interfaces__msg__Float64MultiArray
 type CFloat64MultiArray__Sequence = C.example_interfaces__msg__Float64MultiArray__Sequence
 
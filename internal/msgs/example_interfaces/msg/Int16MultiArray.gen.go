@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	primitives "github.com/tiiuae/rclgo/pkg/rclgo/primitives"
@@ -58,6 +60,41 @@ func (t *Int16MultiArray) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *Int16MultiArray) Equal(other *Int16MultiArray) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *Int16MultiArray) EqualApprox(other *Int16MultiArray, tol float64) bool {
+	if !t.Layout.EqualApprox(&other.Layout, tol) {
+		return false
+	}
+	if !msgcmp.Slices(t.Data, other.Data) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *Int16MultiArray) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *Int16MultiArray) HashTo(h *msgcmp.Hasher) {
+	t.Layout.HashTo(h)
+	h.Len(len(t.Data))
+	for i := range t.Data {
+		h.Int16(t.Data[i])
+	}
+}
+
 func (t *Int16MultiArray) SetDefaults() {
 	t.Layout.SetDefaults()
 	t.Data = nil
@@ -67,6 +104,51 @@ func (t *Int16MultiArray) GetTypeSupport() types.MessageTypeSupport {
 	return Int16MultiArrayTypeSupport
 }
 
+func (t *Int16MultiArray) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *Int16MultiArray) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *Int16MultiArray) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *Int16MultiArray) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *Int16MultiArray) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *Int16MultiArray) EncodeCDR(e *cdr.Encoder) {
+	t.Layout.EncodeCDR(e)
+	e.SequenceLength(len(t.Data), 0)
+	for i := range t.Data {
+		e.Int16(t.Data[i])
+	}
+}
+
+func (t *Int16MultiArray) DecodeCDR(d *cdr.Decoder) {
+	t.Layout.DecodeCDR(d)
+	t.Data = cdr.MakeSlice[int16](d, 0)
+	for i := range t.Data {
+		t.Data[i] = d.Int16()
+	}
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *Int16MultiArray) Validate() error {
+	if err := t.Layout.Validate(); err != nil {
+		return rclgo.WrapValidationError("layout", -1, err)
+	}
+	return nil
+}
+
 // Int16MultiArrayPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type Int16MultiArrayPublisher struct {
@@ -124,6 +206,17 @@ func CloneInt16MultiArraySlice(dst, src []Int16MultiArray) {
 	}
 }
 
+// Int16MultiArrayDescriptor describes the fields of Int16MultiArray. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var Int16MultiArrayDescriptor = &types.MessageDescriptor{
+	TypeName: "example_interfaces/msg/Int16MultiArray",
+	Fields: []types.FieldDescriptor{
+		{Name: "layout", Type: "example_interfaces/msg/MultiArrayLayout", GoIndex: 0, Message: MultiArrayLayoutDescriptor},
+		{Name: "data", Type: "int16", GoIndex: 1, IsArray: true},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var Int16MultiArrayTypeSupport types.MessageTypeSupport = _Int16MultiArrayTypeSupport{}
 
@@ -159,6 +252,40 @@ func (t _Int16MultiArrayTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__example_interfaces__msg__Int16MultiArray())
 }
 
+func (t _Int16MultiArrayTypeSupport) Descriptor() *types.MessageDescriptor {
+	return Int16MultiArrayDescriptor
+}
+
+func (t _Int16MultiArrayTypeSupport) TypeHash() string {
+	return `RIHS01_b7bea9c17ff650fefebee842c63c60d26970e7efa3e296028855da70f3e916d1`
+}
+
+func (t _Int16MultiArrayTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "example_interfaces/msg/Int16MultiArray", "fields": [{"name": "layout", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "example_interfaces/msg/MultiArrayLayout"}, "default_value": ""}, {"name": "data", "type": {"type_id": 148, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "example_interfaces/msg/MultiArrayLayout", "fields": [{"name": "dim", "type": {"type_id": 145, "capacity": 0, "string_capacity": 0, "nested_type_name": "std_msgs/msg/MultiArrayDimension"}, "default_value": ""}, {"name": "data_offset", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "std_msgs/msg/MultiArrayDimension", "fields": [{"name": "label", "type": {"type_id": 17, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "size", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "stride", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}]}`
+}
+
+func (t _Int16MultiArrayTypeSupport) MessageDefinition() string {
+	return `# specification of data layout
+MultiArrayLayout layout
+# array of data
+int16[] data
+================================================================================
+MSG: example_interfaces/msg/MultiArrayLayout
+# Array of dimension properties
+std_msgs/MultiArrayDimension[] dim
+# padding bytes at front of data
+uint32 data_offset
+================================================================================
+MSG: std_msgs/msg/MultiArrayDimension
+# label of given dimension
+string label
+# size of given dimension (in type units)
+uint32 size
+# stride of given dimension
+uint32 stride
+`
+}
+
 type CInt16MultiArray = C.example_interfaces__msg__Int16MultiArray
 type CInt16MultiArray__Sequence = C.example_interfaces__msg__Int16MultiArray__Sequence
 
@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	primitives "github.com/tiiuae/rclgo/pkg/rclgo/primitives"
@@ -58,6 +60,41 @@ func (t *Int32MultiArray) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *Int32MultiArray) Equal(other *Int32MultiArray) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *Int32MultiArray) EqualApprox(other *Int32MultiArray, tol float64) bool {
+	if !t.Layout.EqualApprox(&other.Layout, tol) {
+		return false
+	}
+	if !msgcmp.Slices(t.Data, other.Data) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *Int32MultiArray) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *Int32MultiArray) HashTo(h *msgcmp.Hasher) {
+	t.Layout.HashTo(h)
+	h.Len(len(t.Data))
+	for i := range t.Data {
+		h.Int32(t.Data[i])
+	}
+}
+
 func (t *Int32MultiArray) SetDefaults() {
 	t.Layout.SetDefaults()
 	t.Data = nil
@@ -67,6 +104,51 @@ func (t *Int32MultiArray) GetTypeSupport() types.MessageTypeSupport {
 	return Int32MultiArrayTypeSupport
 }
 
+func (t *Int32MultiArray) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *Int32MultiArray) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *Int32MultiArray) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *Int32MultiArray) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *Int32MultiArray) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *Int32MultiArray) EncodeCDR(e *cdr.Encoder) {
+	t.Layout.EncodeCDR(e)
+	e.SequenceLength(len(t.Data), 0)
+	for i := range t.Data {
+		e.Int32(t.Data[i])
+	}
+}
+
+func (t *Int32MultiArray) DecodeCDR(d *cdr.Decoder) {
+	t.Layout.DecodeCDR(d)
+	t.Data = cdr.MakeSlice[int32](d, 0)
+	for i := range t.Data {
+		t.Data[i] = d.Int32()
+	}
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *Int32MultiArray) Validate() error {
+	if err := t.Layout.Validate(); err != nil {
+		return rclgo.WrapValidationError("layout", -1, err)
+	}
+	return nil
+}
+
 // Int32MultiArrayPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type Int32MultiArrayPublisher struct {
@@ -124,6 +206,17 @@ func CloneInt32MultiArraySlice(dst, src []Int32MultiArray) {
 	}
 }
 
+// Int32MultiArrayDescriptor describes the fields of Int32MultiArray. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var Int32MultiArrayDescriptor = &types.MessageDescriptor{
+	TypeName: "example_interfaces/msg/Int32MultiArray",
+	Fields: []types.FieldDescriptor{
+		{Name: "layout", Type: "example_interfaces/msg/MultiArrayLayout", GoIndex: 0, Message: MultiArrayLayoutDescriptor},
+		{Name: "data", Type: "int32", GoIndex: 1, IsArray: true},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var Int32MultiArrayTypeSupport types.MessageTypeSupport = _Int32MultiArrayTypeSupport{}
 
@@ -159,6 +252,40 @@ func (t _Int32MultiArrayTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__example_interfaces__msg__Int32MultiArray())
 }
 
+func (t _Int32MultiArrayTypeSupport) Descriptor() *types.MessageDescriptor {
+	return Int32MultiArrayDescriptor
+}
+
+func (t _Int32MultiArrayTypeSupport) TypeHash() string {
+	return `RIHS01_3d414b5d2ff2b74bf45ef47614d4c6a0b4f03a21ab5ec22c784ba0b8ba7f6f6d`
+}
+
+func (t _Int32MultiArrayTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "example_interfaces/msg/Int32MultiArray", "fields": [{"name": "layout", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "example_interfaces/msg/MultiArrayLayout"}, "default_value": ""}, {"name": "data", "type": {"type_id": 150, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "example_interfaces/msg/MultiArrayLayout", "fields": [{"name": "dim", "type": {"type_id": 145, "capacity": 0, "string_capacity": 0, "nested_type_name": "std_msgs/msg/MultiArrayDimension"}, "default_value": ""}, {"name": "data_offset", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "std_msgs/msg/MultiArrayDimension", "fields": [{"name": "label", "type": {"type_id": 17, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "size", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "stride", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}]}`
+}
+
+func (t _Int32MultiArrayTypeSupport) MessageDefinition() string {
+	return `# specification of data layout
+MultiArrayLayout layout
+# array of data
+int32[] data
+================================================================================
+MSG: example_interfaces/msg/MultiArrayLayout
+# Array of dimension properties
+std_msgs/MultiArrayDimension[] dim
+# padding bytes at front of data
+uint32 data_offset
+================================================================================
+MSG: std_msgs/msg/MultiArrayDimension
+# label of given dimension
+string label
+# size of given dimension (in type units)
+uint32 size
+# stride of given dimension
+uint32 stride
+`
+}
+
 type CInt32MultiArray = C.example_interfaces__msg__Int32MultiArray
 type CInt32MultiArray__Sequence = C.example_interfaces__msg__Int32MultiArray__Sequence
 
@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	
@@ -52,6 +54,34 @@ func (t *Int64) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *Int64) Equal(other *Int64) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *Int64) EqualApprox(other *Int64, tol float64) bool {
+	if t.Data != other.Data {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *Int64) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *Int64) HashTo(h *msgcmp.Hasher) {
+	h.Int64(t.Data)
+}
+
 func (t *Int64) SetDefaults() {
 	t.Data = 0
 }
@@ -60,6 +90,40 @@ func (t *Int64) GetTypeSupport() types.MessageTypeSupport {
 	return Int64TypeSupport
 }
 
+func (t *Int64) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *Int64) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *Int64) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *Int64) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *Int64) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *Int64) EncodeCDR(e *cdr.Encoder) {
+	e.Int64(t.Data)
+}
+
+func (t *Int64) DecodeCDR(d *cdr.Decoder) {
+	t.Data = d.Int64()
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *Int64) Validate() error {
+	return nil
+}
+
 // Int64Publisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type Int64Publisher struct {
@@ -117,6 +181,16 @@ func CloneInt64Slice(dst, src []Int64) {
 	}
 }
 
+// Int64Descriptor describes the fields of Int64. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var Int64Descriptor = &types.MessageDescriptor{
+	TypeName: "example_interfaces/msg/Int64",
+	Fields: []types.FieldDescriptor{
+		{Name: "data", Type: "int64", GoIndex: 0},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var Int64TypeSupport types.MessageTypeSupport = _Int64TypeSupport{}
 
@@ -150,6 +224,24 @@ func (t _Int64TypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__example_interfaces__msg__Int64())
 }
 
+func (t _Int64TypeSupport) Descriptor() *types.MessageDescriptor {
+	return Int64Descriptor
+}
+
+func (t _Int64TypeSupport) TypeHash() string {
+	return `RIHS01_1b3b9a6502f560d079520c73c685a9550e5a1838d2cefd537fe0aba75a3639a0`
+}
+
+func (t _Int64TypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "example_interfaces/msg/Int64", "fields": [{"name": "data", "type": {"type_id": 8, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": []}`
+}
+
+func (t _Int64TypeSupport) MessageDefinition() string {
+	return `# This is an example message of using a primitive datatype, int64.If you want to test with this that's fine, but if you are deployingit into a system you should create a semantically meaningful message type.If you want to embed it in another message, use the primitive data type instead.
+int64 data
+`
+}
+
 type CInt64 = C.example_interfaces__msg__Int64
 type CInt64__Sequence = C.example_interfaces__msg__Int64__Sequence
 
@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	primitives "github.com/tiiuae/rclgo/pkg/rclgo/primitives"
@@ -57,6 +59,42 @@ func (t *MultiArrayDimension) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *MultiArrayDimension) Equal(other *MultiArrayDimension) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *MultiArrayDimension) EqualApprox(other *MultiArrayDimension, tol float64) bool {
+	if t.Label != other.Label {
+		return false
+	}
+	if t.Size != other.Size {
+		return false
+	}
+	if t.Stride != other.Stride {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *MultiArrayDimension) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *MultiArrayDimension) HashTo(h *msgcmp.Hasher) {
+	h.String(t.Label)
+	h.Uint32(t.Size)
+	h.Uint32(t.Stride)
+}
+
 func (t *MultiArrayDimension) SetDefaults() {
 	t.Label = ""
 	t.Size = 0
@@ -67,6 +105,44 @@ func (t *MultiArrayDimension) GetTypeSupport() types.MessageTypeSupport {
 	return MultiArrayDimensionTypeSupport
 }
 
+func (t *MultiArrayDimension) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *MultiArrayDimension) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *MultiArrayDimension) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *MultiArrayDimension) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *MultiArrayDimension) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *MultiArrayDimension) EncodeCDR(e *cdr.Encoder) {
+	e.String(t.Label)
+	e.Uint32(t.Size)
+	e.Uint32(t.Stride)
+}
+
+func (t *MultiArrayDimension) DecodeCDR(d *cdr.Decoder) {
+	t.Label = d.String()
+	t.Size = d.Uint32()
+	t.Stride = d.Uint32()
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *MultiArrayDimension) Validate() error {
+	return nil
+}
+
 // MultiArrayDimensionPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type MultiArrayDimensionPublisher struct {
@@ -124,6 +200,18 @@ func CloneMultiArrayDimensionSlice(dst, src []MultiArrayDimension) {
 	}
 }
 
+// MultiArrayDimensionDescriptor describes the fields of MultiArrayDimension. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var MultiArrayDimensionDescriptor = &types.MessageDescriptor{
+	TypeName: "example_interfaces/msg/MultiArrayDimension",
+	Fields: []types.FieldDescriptor{
+		{Name: "label", Type: "string", GoIndex: 0},
+		{Name: "size", Type: "uint32", GoIndex: 1},
+		{Name: "stride", Type: "uint32", GoIndex: 2},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var MultiArrayDimensionTypeSupport types.MessageTypeSupport = _MultiArrayDimensionTypeSupport{}
 
@@ -161,6 +249,28 @@ func (t _MultiArrayDimensionTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__example_interfaces__msg__MultiArrayDimension())
 }
 
+func (t _MultiArrayDimensionTypeSupport) Descriptor() *types.MessageDescriptor {
+	return MultiArrayDimensionDescriptor
+}
+
+func (t _MultiArrayDimensionTypeSupport) TypeHash() string {
+	return `RIHS01_a785cb9839e177e3eb760260139a919fec87821edc3314c592f2725abbf0bfcd`
+}
+
+func (t _MultiArrayDimensionTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "example_interfaces/msg/MultiArrayDimension", "fields": [{"name": "label", "type": {"type_id": 17, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "size", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "stride", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": []}`
+}
+
+func (t _MultiArrayDimensionTypeSupport) MessageDefinition() string {
+	return `# label of given dimension
+string label
+# size of given dimension (in type units)
+uint32 size
+# stride of given dimension
+uint32 stride
+`
+}
+
 type CMultiArrayDimension = C.example_interfaces__msg__MultiArrayDimension
 type CMultiArrayDimension__Sequence = C.example_interfaces__msg__MultiArrayDimension__Sequence
 
@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	primitives "github.com/tiiuae/rclgo/pkg/rclgo/primitives"
@@ -53,6 +55,34 @@ func (t *String) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *String) Equal(other *String) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *String) EqualApprox(other *String, tol float64) bool {
+	if t.Data != other.Data {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *String) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *String) HashTo(h *msgcmp.Hasher) {
+	h.String(t.Data)
+}
+
 func (t *String) SetDefaults() {
 	t.Data = ""
 }
@@ -61,6 +91,40 @@ func (t *String) GetTypeSupport() types.MessageTypeSupport {
 	return StringTypeSupport
 }
 
+func (t *String) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *String) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *String) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *String) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *String) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *String) EncodeCDR(e *cdr.Encoder) {
+	e.String(t.Data)
+}
+
+func (t *String) DecodeCDR(d *cdr.Decoder) {
+	t.Data = d.String()
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *String) Validate() error {
+	return nil
+}
+
 // StringPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type StringPublisher struct {
@@ -118,6 +182,16 @@ func CloneStringSlice(dst, src []String) {
 	}
 }
 
+// StringDescriptor describes the fields of String. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var StringDescriptor = &types.MessageDescriptor{
+	TypeName: "example_interfaces/msg/String",
+	Fields: []types.FieldDescriptor{
+		{Name: "data", Type: "string", GoIndex: 0},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var StringTypeSupport types.MessageTypeSupport = _StringTypeSupport{}
 
@@ -151,6 +225,24 @@ func (t _StringTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__example_interfaces__msg__String())
 }
 
+func (t _StringTypeSupport) Descriptor() *types.MessageDescriptor {
+	return StringDescriptor
+}
+
+func (t _StringTypeSupport) TypeHash() string {
+	return `RIHS01_5509d866a579951f2fc6c19577c32605ba16f308cae7b498341d79536d4eb06b`
+}
+
+func (t _StringTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "example_interfaces/msg/String", "fields": [{"name": "data", "type": {"type_id": 17, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": []}`
+}
+
+func (t _StringTypeSupport) MessageDefinition() string {
+	return `# This is an example message of using a primitive datatype, string.If you want to test with this that's fine, but if you are deployingit into a system you should create a semantically meaningful message type.If you want to embed it in another message, use the primitive data type instead.
+string data
+`
+}
+
 type CString = C.example_interfaces__msg__String
 type CString__Sequence = C.example_interfaces__msg__String__Sequence
 
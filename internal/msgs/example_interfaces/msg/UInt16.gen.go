@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	
@@ -52,6 +54,34 @@ func (t *UInt16) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *UInt16) Equal(other *UInt16) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *UInt16) EqualApprox(other *UInt16, tol float64) bool {
+	if t.Data != other.Data {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *UInt16) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *UInt16) HashTo(h *msgcmp.Hasher) {
+	h.Uint16(t.Data)
+}
+
 func (t *UInt16) SetDefaults() {
 	t.Data = 0
 }
@@ -60,6 +90,40 @@ func (t *UInt16) GetTypeSupport() types.MessageTypeSupport {
 	return UInt16TypeSupport
 }
 
+func (t *UInt16) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *UInt16) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *UInt16) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *UInt16) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *UInt16) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *UInt16) EncodeCDR(e *cdr.Encoder) {
+	e.Uint16(t.Data)
+}
+
+func (t *UInt16) DecodeCDR(d *cdr.Decoder) {
+	t.Data = d.Uint16()
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *UInt16) Validate() error {
+	return nil
+}
+
 // UInt16Publisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type UInt16Publisher struct {
@@ -117,6 +181,16 @@ func CloneUInt16Slice(dst, src []UInt16) {
 	}
 }
 
+// UInt16Descriptor describes the fields of UInt16. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var UInt16Descriptor = &types.MessageDescriptor{
+	TypeName: "example_interfaces/msg/UInt16",
+	Fields: []types.FieldDescriptor{
+		{Name: "data", Type: "uint16", GoIndex: 0},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var UInt16TypeSupport types.MessageTypeSupport = _UInt16TypeSupport{}
 
@@ -150,6 +224,24 @@ func (t _UInt16TypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__example_interfaces__msg__UInt16())
 }
 
+func (t _UInt16TypeSupport) Descriptor() *types.MessageDescriptor {
+	return UInt16Descriptor
+}
+
+func (t _UInt16TypeSupport) TypeHash() string {
+	return `RIHS01_e123d0a691fa0ce58b682f1a4eee55137dd3f20c81665f0c556f53596c7fb377`
+}
+
+func (t _UInt16TypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "example_interfaces/msg/UInt16", "fields": [{"name": "data", "type": {"type_id": 5, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": []}`
+}
+
+func (t _UInt16TypeSupport) MessageDefinition() string {
+	return `# This is an example message of using a primitive datatype, uint16.If you want to test with this that's fine, but if you are deployingit into a system you should create a semantically meaningful message type.If you want to embed it in another message, use the primitive data type instead.
+uint16 data
+`
+}
+
 type CUInt16 = C.example_interfaces__msg__UInt16
 type CUInt16__Sequence = C.example_interfaces__msg__UInt16__Sequence
 
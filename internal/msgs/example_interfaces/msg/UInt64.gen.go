@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	
@@ -52,6 +54,34 @@ func (t *UInt64) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *UInt64) Equal(other *UInt64) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *UInt64) EqualApprox(other *UInt64, tol float64) bool {
+	if t.Data != other.Data {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *UInt64) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *UInt64) HashTo(h *msgcmp.Hasher) {
+	h.Uint64(t.Data)
+}
+
 func (t *UInt64) SetDefaults() {
 	t.Data = 0
 }
@@ -60,6 +90,40 @@ func (t *UInt64) GetTypeSupport() types.MessageTypeSupport {
 	return UInt64TypeSupport
 }
 
+func (t *UInt64) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *UInt64) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *UInt64) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *UInt64) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *UInt64) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *UInt64) EncodeCDR(e *cdr.Encoder) {
+	e.Uint64(t.Data)
+}
+
+func (t *UInt64) DecodeCDR(d *cdr.Decoder) {
+	t.Data = d.Uint64()
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *UInt64) Validate() error {
+	return nil
+}
+
 // UInt64Publisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type UInt64Publisher struct {
@@ -117,6 +181,16 @@ func CloneUInt64Slice(dst, src []UInt64) {
 	}
 }
 
+// UInt64Descriptor describes the fields of UInt64. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var UInt64Descriptor = &types.MessageDescriptor{
+	TypeName: "example_interfaces/msg/UInt64",
+	Fields: []types.FieldDescriptor{
+		{Name: "data", Type: "uint64", GoIndex: 0},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var UInt64TypeSupport types.MessageTypeSupport = _UInt64TypeSupport{}
 
@@ -150,6 +224,24 @@ func (t _UInt64TypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__example_interfaces__msg__UInt64())
 }
 
+func (t _UInt64TypeSupport) Descriptor() *types.MessageDescriptor {
+	return UInt64Descriptor
+}
+
+func (t _UInt64TypeSupport) TypeHash() string {
+	return `RIHS01_6a3f8548c5818b7add62dd6cbbd840fd1ab17fbf9d73cad6690557b7326d8908`
+}
+
+func (t _UInt64TypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "example_interfaces/msg/UInt64", "fields": [{"name": "data", "type": {"type_id": 9, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": []}`
+}
+
+func (t _UInt64TypeSupport) MessageDefinition() string {
+	return `# This is an example message of using a primitive datatype, unint64.If you want to test with this that's fine, but if you are deployingit into a system you should create a semantically meaningful message type.If you want to embed it in another message, use the primitive data type instead.
+uint64 data
+`
+}
+
 type CUInt64 = C.example_interfaces__msg__UInt64
 type CUInt64__Sequence = C.example_interfaces__msg__UInt64__Sequence
 
@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	primitives "github.com/tiiuae/rclgo/pkg/rclgo/primitives"
@@ -53,6 +55,34 @@ func (t *WString) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *WString) Equal(other *WString) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *WString) EqualApprox(other *WString, tol float64) bool {
+	if t.Data != other.Data {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *WString) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *WString) HashTo(h *msgcmp.Hasher) {
+	h.String(t.Data)
+}
+
 func (t *WString) SetDefaults() {
 	t.Data = ""
 }
@@ -61,6 +91,40 @@ func (t *WString) GetTypeSupport() types.MessageTypeSupport {
 	return WStringTypeSupport
 }
 
+func (t *WString) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *WString) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *WString) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *WString) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *WString) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *WString) EncodeCDR(e *cdr.Encoder) {
+	e.WString(t.Data)
+}
+
+func (t *WString) DecodeCDR(d *cdr.Decoder) {
+	t.Data = d.WString()
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *WString) Validate() error {
+	return nil
+}
+
 // WStringPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type WStringPublisher struct {
@@ -118,6 +182,16 @@ func CloneWStringSlice(dst, src []WString) {
 	}
 }
 
+// WStringDescriptor describes the fields of WString. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var WStringDescriptor = &types.MessageDescriptor{
+	TypeName: "example_interfaces/msg/WString",
+	Fields: []types.FieldDescriptor{
+		{Name: "data", Type: "wstring", GoIndex: 0},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var WStringTypeSupport types.MessageTypeSupport = _WStringTypeSupport{}
 
@@ -151,6 +225,24 @@ func (t _WStringTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__example_interfaces__msg__WString())
 }
 
+func (t _WStringTypeSupport) Descriptor() *types.MessageDescriptor {
+	return WStringDescriptor
+}
+
+func (t _WStringTypeSupport) TypeHash() string {
+	return `RIHS01_32033e06d9dfe5468c5d6e1dc8b7a23c8910bad071cfd4e151a951d580e68dd8`
+}
+
+func (t _WStringTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "example_interfaces/msg/WString", "fields": [{"name": "data", "type": {"type_id": 18, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": []}`
+}
+
+func (t _WStringTypeSupport) MessageDefinition() string {
+	return `# This is an example message of using a primitive datatype, wstring.If you want to test with this that's fine, but if you are deployingit into a system you should create a semantically meaningful message type.If you want to embed it in another message, use the primitive data type instead.
+wstring data
+`
+}
+
 type CWString = C.example_interfaces__msg__WString
 type CWString__Sequence = C.example_interfaces__msg__WString__Sequence
 
@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	
@@ -54,6 +56,38 @@ func (t *AddTwoInts_Request) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *AddTwoInts_Request) Equal(other *AddTwoInts_Request) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *AddTwoInts_Request) EqualApprox(other *AddTwoInts_Request, tol float64) bool {
+	if t.A != other.A {
+		return false
+	}
+	if t.B != other.B {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *AddTwoInts_Request) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *AddTwoInts_Request) HashTo(h *msgcmp.Hasher) {
+	h.Int64(t.A)
+	h.Int64(t.B)
+}
+
 func (t *AddTwoInts_Request) SetDefaults() {
 	t.A = 0
 	t.B = 0
@@ -63,6 +97,42 @@ func (t *AddTwoInts_Request) GetTypeSupport() types.MessageTypeSupport {
 	return AddTwoInts_RequestTypeSupport
 }
 
+func (t *AddTwoInts_Request) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *AddTwoInts_Request) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *AddTwoInts_Request) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *AddTwoInts_Request) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *AddTwoInts_Request) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *AddTwoInts_Request) EncodeCDR(e *cdr.Encoder) {
+	e.Int64(t.A)
+	e.Int64(t.B)
+}
+
+func (t *AddTwoInts_Request) DecodeCDR(d *cdr.Decoder) {
+	t.A = d.Int64()
+	t.B = d.Int64()
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *AddTwoInts_Request) Validate() error {
+	return nil
+}
+
 // AddTwoInts_RequestPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type AddTwoInts_RequestPublisher struct {
@@ -120,6 +190,17 @@ func CloneAddTwoInts_RequestSlice(dst, src []AddTwoInts_Request) {
 	}
 }
 
+// AddTwoInts_RequestDescriptor describes the fields of AddTwoInts_Request. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var AddTwoInts_RequestDescriptor = &types.MessageDescriptor{
+	TypeName: "example_interfaces/srv/AddTwoInts_Request",
+	Fields: []types.FieldDescriptor{
+		{Name: "a", Type: "int64", GoIndex: 0},
+		{Name: "b", Type: "int64", GoIndex: 1},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var AddTwoInts_RequestTypeSupport types.MessageTypeSupport = _AddTwoInts_RequestTypeSupport{}
 
@@ -155,6 +236,23 @@ func (t _AddTwoInts_RequestTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__example_interfaces__srv__AddTwoInts_Request())
 }
 
+func (t _AddTwoInts_RequestTypeSupport) Descriptor() *types.MessageDescriptor {
+	return AddTwoInts_RequestDescriptor
+}
+
+func (t _AddTwoInts_RequestTypeSupport) TypeHash() string {
+	return `RIHS01_000c5fd92d6b2e1a05949348f584d6d652adea1e92d691792011ac2273508302`
+}
+
+func (t _AddTwoInts_RequestTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "example_interfaces/srv/AddTwoInts_Request", "fields": [{"name": "a", "type": {"type_id": 8, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "b", "type": {"type_id": 8, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": []}`
+}
+
+func (t _AddTwoInts_RequestTypeSupport) MessageDefinition() string {
+	return `int64 a
+int64 b`
+}
+
 type CAddTwoInts_Request = C.example_interfaces__srv__AddTwoInts_Request
 type CAddTwoInts_Request__Sequence = C.example_interfaces__srv__AddTwoInts_Request__Sequence
 
@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	
@@ -52,6 +54,34 @@ func (t *AddTwoInts_Response) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *AddTwoInts_Response) Equal(other *AddTwoInts_Response) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *AddTwoInts_Response) EqualApprox(other *AddTwoInts_Response, tol float64) bool {
+	if t.Sum != other.Sum {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *AddTwoInts_Response) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *AddTwoInts_Response) HashTo(h *msgcmp.Hasher) {
+	h.Int64(t.Sum)
+}
+
 func (t *AddTwoInts_Response) SetDefaults() {
 	t.Sum = 0
 }
@@ -60,6 +90,40 @@ func (t *AddTwoInts_Response) GetTypeSupport() types.MessageTypeSupport {
 	return AddTwoInts_ResponseTypeSupport
 }
 
+func (t *AddTwoInts_Response) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *AddTwoInts_Response) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *AddTwoInts_Response) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *AddTwoInts_Response) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *AddTwoInts_Response) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *AddTwoInts_Response) EncodeCDR(e *cdr.Encoder) {
+	e.Int64(t.Sum)
+}
+
+func (t *AddTwoInts_Response) DecodeCDR(d *cdr.Decoder) {
+	t.Sum = d.Int64()
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *AddTwoInts_Response) Validate() error {
+	return nil
+}
+
 // AddTwoInts_ResponsePublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type AddTwoInts_ResponsePublisher struct {
@@ -117,6 +181,16 @@ func CloneAddTwoInts_ResponseSlice(dst, src []AddTwoInts_Response) {
 	}
 }
 
+// AddTwoInts_ResponseDescriptor describes the fields of AddTwoInts_Response. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var AddTwoInts_ResponseDescriptor = &types.MessageDescriptor{
+	TypeName: "example_interfaces/srv/AddTwoInts_Response",
+	Fields: []types.FieldDescriptor{
+		{Name: "sum", Type: "int64", GoIndex: 0},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var AddTwoInts_ResponseTypeSupport types.MessageTypeSupport = _AddTwoInts_ResponseTypeSupport{}
 
@@ -150,6 +224,23 @@ func (t _AddTwoInts_ResponseTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__example_interfaces__srv__AddTwoInts_Response())
 }
 
+func (t _AddTwoInts_ResponseTypeSupport) Descriptor() *types.MessageDescriptor {
+	return AddTwoInts_ResponseDescriptor
+}
+
+func (t _AddTwoInts_ResponseTypeSupport) TypeHash() string {
+	return `RIHS01_de5c030d4af33cba2749310b249737b631594703f9300495f48bffb2b44dcc2f`
+}
+
+func (t _AddTwoInts_ResponseTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "example_interfaces/srv/AddTwoInts_Response", "fields": [{"name": "sum", "type": {"type_id": 8, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": []}`
+}
+
+func (t _AddTwoInts_ResponseTypeSupport) MessageDefinition() string {
+	return `int64 sum
+`
+}
+
 type CAddTwoInts_Response = C.example_interfaces__srv__AddTwoInts_Response
 type CAddTwoInts_Response__Sequence = C.example_interfaces__srv__AddTwoInts_Response__Sequence
 
@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	primitives "github.com/tiiuae/rclgo/pkg/rclgo/primitives"
@@ -55,6 +57,38 @@ func (t *Trigger_Response) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *Trigger_Response) Equal(other *Trigger_Response) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *Trigger_Response) EqualApprox(other *Trigger_Response, tol float64) bool {
+	if t.Success != other.Success {
+		return false
+	}
+	if t.Message != other.Message {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *Trigger_Response) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *Trigger_Response) HashTo(h *msgcmp.Hasher) {
+	h.Bool(t.Success)
+	h.String(t.Message)
+}
+
 func (t *Trigger_Response) SetDefaults() {
 	t.Success = false
 	t.Message = ""
@@ -64,6 +98,42 @@ func (t *Trigger_Response) GetTypeSupport() types.MessageTypeSupport {
 	return Trigger_ResponseTypeSupport
 }
 
+func (t *Trigger_Response) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *Trigger_Response) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *Trigger_Response) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *Trigger_Response) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *Trigger_Response) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *Trigger_Response) EncodeCDR(e *cdr.Encoder) {
+	e.Bool(t.Success)
+	e.String(t.Message)
+}
+
+func (t *Trigger_Response) DecodeCDR(d *cdr.Decoder) {
+	t.Success = d.Bool()
+	t.Message = d.String()
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *Trigger_Response) Validate() error {
+	return nil
+}
+
 // Trigger_ResponsePublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type Trigger_ResponsePublisher struct {
@@ -121,6 +191,17 @@ func CloneTrigger_ResponseSlice(dst, src []Trigger_Response) {
 	}
 }
 
+// Trigger_ResponseDescriptor describes the fields of Trigger_Response. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var Trigger_ResponseDescriptor = &types.MessageDescriptor{
+	TypeName: "example_interfaces/srv/Trigger_Response",
+	Fields: []types.FieldDescriptor{
+		{Name: "success", Type: "bool", GoIndex: 0},
+		{Name: "message", Type: "string", GoIndex: 1},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var Trigger_ResponseTypeSupport types.MessageTypeSupport = _Trigger_ResponseTypeSupport{}
 
@@ -156,6 +237,26 @@ func (t _Trigger_ResponseTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__example_interfaces__srv__Trigger_Response())
 }
 
+func (t _Trigger_ResponseTypeSupport) Descriptor() *types.MessageDescriptor {
+	return Trigger_ResponseDescriptor
+}
+
+func (t _Trigger_ResponseTypeSupport) TypeHash() string {
+	return `RIHS01_3faa1e36b834f5705a7e9aa990c4720c082f0630bf28abedf315cc69f01dc8fc`
+}
+
+func (t _Trigger_ResponseTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "example_interfaces/srv/Trigger_Response", "fields": [{"name": "success", "type": {"type_id": 15, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "message", "type": {"type_id": 17, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": []}`
+}
+
+func (t _Trigger_ResponseTypeSupport) MessageDefinition() string {
+	return `# indicate successful run of triggered service
+bool success
+# informational, e.g. for error messages.
+string message
+`
+}
+
 type CTrigger_Response = C.example_interfaces__srv__Trigger_Response
 type CTrigger_Response__Sequence = C.example_interfaces__srv__Trigger_Response__Sequence
 
@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	
@@ -54,6 +56,38 @@ func (t *Accel) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *Accel) Equal(other *Accel) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *Accel) EqualApprox(other *Accel, tol float64) bool {
+	if !t.Linear.EqualApprox(&other.Linear, tol) {
+		return false
+	}
+	if !t.Angular.EqualApprox(&other.Angular, tol) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *Accel) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *Accel) HashTo(h *msgcmp.Hasher) {
+	t.Linear.HashTo(h)
+	t.Angular.HashTo(h)
+}
+
 func (t *Accel) SetDefaults() {
 	t.Linear.SetDefaults()
 	t.Angular.SetDefaults()
@@ -63,6 +97,48 @@ func (t *Accel) GetTypeSupport() types.MessageTypeSupport {
 	return AccelTypeSupport
 }
 
+func (t *Accel) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *Accel) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *Accel) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *Accel) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *Accel) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *Accel) EncodeCDR(e *cdr.Encoder) {
+	t.Linear.EncodeCDR(e)
+	t.Angular.EncodeCDR(e)
+}
+
+func (t *Accel) DecodeCDR(d *cdr.Decoder) {
+	t.Linear.DecodeCDR(d)
+	t.Angular.DecodeCDR(d)
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *Accel) Validate() error {
+	if err := t.Linear.Validate(); err != nil {
+		return rclgo.WrapValidationError("linear", -1, err)
+	}
+	if err := t.Angular.Validate(); err != nil {
+		return rclgo.WrapValidationError("angular", -1, err)
+	}
+	return nil
+}
+
 // AccelPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type AccelPublisher struct {
@@ -120,6 +196,17 @@ func CloneAccelSlice(dst, src []Accel) {
 	}
 }
 
+// AccelDescriptor describes the fields of Accel. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var AccelDescriptor = &types.MessageDescriptor{
+	TypeName: "geometry_msgs/msg/Accel",
+	Fields: []types.FieldDescriptor{
+		{Name: "linear", Type: "geometry_msgs/msg/Vector3", GoIndex: 0, Message: Vector3Descriptor},
+		{Name: "angular", Type: "geometry_msgs/msg/Vector3", GoIndex: 1, Message: Vector3Descriptor},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var AccelTypeSupport types.MessageTypeSupport = _AccelTypeSupport{}
 
@@ -155,6 +242,30 @@ func (t _AccelTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__geometry_msgs__msg__Accel())
 }
 
+func (t _AccelTypeSupport) Descriptor() *types.MessageDescriptor {
+	return AccelDescriptor
+}
+
+func (t _AccelTypeSupport) TypeHash() string {
+	return `RIHS01_dc448243ded9b1fcbcca24aba0c22f013dae06c354ba2d849571c0a2a3f57ca0`
+}
+
+func (t _AccelTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "geometry_msgs/msg/Accel", "fields": [{"name": "linear", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "geometry_msgs/msg/Vector3"}, "default_value": ""}, {"name": "angular", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "geometry_msgs/msg/Vector3"}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "geometry_msgs/msg/Vector3", "fields": [{"name": "x", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "y", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "z", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}]}`
+}
+
+func (t _AccelTypeSupport) MessageDefinition() string {
+	return `# This expresses acceleration in free space broken into its linear and angular parts.
+Vector3 linear
+Vector3 angular
+================================================================================
+MSG: geometry_msgs/msg/Vector3
+float64 x
+float64 y
+float64 z
+`
+}
+
 type CAccel = C.geometry_msgs__msg__Accel
 type CAccel__Sequence = C.geometry_msgs__msg__Accel__Sequence
 
@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	std_msgs_msg "github.com/tiiuae/rclgo/internal/msgs/std_msgs/msg"
@@ -55,6 +57,38 @@ func (t *AccelStamped) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *AccelStamped) Equal(other *AccelStamped) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *AccelStamped) EqualApprox(other *AccelStamped, tol float64) bool {
+	if !t.Header.EqualApprox(&other.Header, tol) {
+		return false
+	}
+	if !t.Accel.EqualApprox(&other.Accel, tol) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *AccelStamped) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *AccelStamped) HashTo(h *msgcmp.Hasher) {
+	t.Header.HashTo(h)
+	t.Accel.HashTo(h)
+}
+
 func (t *AccelStamped) SetDefaults() {
 	t.Header.SetDefaults()
 	t.Accel.SetDefaults()
@@ -64,6 +98,48 @@ func (t *AccelStamped) GetTypeSupport() types.MessageTypeSupport {
 	return AccelStampedTypeSupport
 }
 
+func (t *AccelStamped) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *AccelStamped) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *AccelStamped) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *AccelStamped) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *AccelStamped) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *AccelStamped) EncodeCDR(e *cdr.Encoder) {
+	t.Header.EncodeCDR(e)
+	t.Accel.EncodeCDR(e)
+}
+
+func (t *AccelStamped) DecodeCDR(d *cdr.Decoder) {
+	t.Header.DecodeCDR(d)
+	t.Accel.DecodeCDR(d)
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *AccelStamped) Validate() error {
+	if err := t.Header.Validate(); err != nil {
+		return rclgo.WrapValidationError("header", -1, err)
+	}
+	if err := t.Accel.Validate(); err != nil {
+		return rclgo.WrapValidationError("accel", -1, err)
+	}
+	return nil
+}
+
 // AccelStampedPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type AccelStampedPublisher struct {
@@ -121,6 +197,17 @@ func CloneAccelStampedSlice(dst, src []AccelStamped) {
 	}
 }
 
+// AccelStampedDescriptor describes the fields of AccelStamped. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var AccelStampedDescriptor = &types.MessageDescriptor{
+	TypeName: "geometry_msgs/msg/AccelStamped",
+	Fields: []types.FieldDescriptor{
+		{Name: "header", Type: "std_msgs/msg/Header", GoIndex: 0, Message: std_msgs_msg.HeaderDescriptor},
+		{Name: "accel", Type: "geometry_msgs/msg/Accel", GoIndex: 1, Message: AccelDescriptor},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var AccelStampedTypeSupport types.MessageTypeSupport = _AccelStampedTypeSupport{}
 
@@ -156,6 +243,47 @@ func (t _AccelStampedTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__geometry_msgs__msg__AccelStamped())
 }
 
+func (t _AccelStampedTypeSupport) Descriptor() *types.MessageDescriptor {
+	return AccelStampedDescriptor
+}
+
+func (t _AccelStampedTypeSupport) TypeHash() string {
+	return `RIHS01_ef1df9eabae0a708cc049a061ebcddc4e2a5f745730100ba680e086a9698b165`
+}
+
+func (t _AccelStampedTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "geometry_msgs/msg/AccelStamped", "fields": [{"name": "header", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "std_msgs/msg/Header"}, "default_value": ""}, {"name": "accel", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "geometry_msgs/msg/Accel"}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "builtin_interfaces/msg/Time", "fields": [{"name": "sec", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "nanosec", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "geometry_msgs/msg/Accel", "fields": [{"name": "linear", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "geometry_msgs/msg/Vector3"}, "default_value": ""}, {"name": "angular", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "geometry_msgs/msg/Vector3"}, "default_value": ""}]}, {"type_name": "geometry_msgs/msg/Vector3", "fields": [{"name": "x", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "y", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "z", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "std_msgs/msg/Header", "fields": [{"name": "stamp", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "builtin_interfaces/msg/Time"}, "default_value": ""}, {"name": "frame_id", "type": {"type_id": 17, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}]}`
+}
+
+func (t _AccelStampedTypeSupport) MessageDefinition() string {
+	return `# An accel with reference coordinate frame and timestamp
+std_msgs/Header header
+Accel accel
+================================================================================
+MSG: std_msgs/msg/Header
+# Two-integer timestamp that is expressed as seconds and nanoseconds.
+builtin_interfaces/Time stamp
+# Transform frame with which this data is associated.
+string frame_id
+================================================================================
+MSG: geometry_msgs/msg/Accel
+# This expresses acceleration in free space broken into its linear and angular parts.
+Vector3 linear
+Vector3 angular
+================================================================================
+MSG: builtin_interfaces/msg/Time
+# The seconds component, valid over all int32 values.
+int32 sec
+# The nanoseconds component, valid in the range [0, 10e9).
+uint32 nanosec
+================================================================================
+MSG: geometry_msgs/msg/Vector3
+float64 x
+float64 y
+float64 z
+`
+}
+
 type CAccelStamped = C.geometry_msgs__msg__AccelStamped
 type CAccelStamped__Sequence = C.geometry_msgs__msg__AccelStamped__Sequence
 
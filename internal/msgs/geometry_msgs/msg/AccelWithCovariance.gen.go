@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	primitives "github.com/tiiuae/rclgo/pkg/rclgo/primitives"
@@ -55,6 +57,40 @@ func (t *AccelWithCovariance) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *AccelWithCovariance) Equal(other *AccelWithCovariance) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *AccelWithCovariance) EqualApprox(other *AccelWithCovariance, tol float64) bool {
+	if !t.Accel.EqualApprox(&other.Accel, tol) {
+		return false
+	}
+	if !msgcmp.Float64s(t.Covariance[:], other.Covariance[:], tol) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *AccelWithCovariance) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *AccelWithCovariance) HashTo(h *msgcmp.Hasher) {
+	t.Accel.HashTo(h)
+	for i := range t.Covariance {
+		h.Float64(t.Covariance[i])
+	}
+}
+
 func (t *AccelWithCovariance) SetDefaults() {
 	t.Accel.SetDefaults()
 	t.Covariance = [36]float64{}
@@ -64,6 +100,49 @@ func (t *AccelWithCovariance) GetTypeSupport() types.MessageTypeSupport {
 	return AccelWithCovarianceTypeSupport
 }
 
+func (t *AccelWithCovariance) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *AccelWithCovariance) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *AccelWithCovariance) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *AccelWithCovariance) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *AccelWithCovariance) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *AccelWithCovariance) EncodeCDR(e *cdr.Encoder) {
+	t.Accel.EncodeCDR(e)
+	for i := range t.Covariance {
+		e.Float64(t.Covariance[i])
+	}
+}
+
+func (t *AccelWithCovariance) DecodeCDR(d *cdr.Decoder) {
+	t.Accel.DecodeCDR(d)
+	for i := range t.Covariance {
+		t.Covariance[i] = d.Float64()
+	}
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *AccelWithCovariance) Validate() error {
+	if err := t.Accel.Validate(); err != nil {
+		return rclgo.WrapValidationError("accel", -1, err)
+	}
+	return nil
+}
+
 // AccelWithCovariancePublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type AccelWithCovariancePublisher struct {
@@ -121,6 +200,17 @@ func CloneAccelWithCovarianceSlice(dst, src []AccelWithCovariance) {
 	}
 }
 
+// AccelWithCovarianceDescriptor describes the fields of AccelWithCovariance. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var AccelWithCovarianceDescriptor = &types.MessageDescriptor{
+	TypeName: "geometry_msgs/msg/AccelWithCovariance",
+	Fields: []types.FieldDescriptor{
+		{Name: "accel", Type: "geometry_msgs/msg/Accel", GoIndex: 0, Message: AccelDescriptor},
+		{Name: "covariance", Type: "float64", GoIndex: 1, IsArray: true, ArraySize: 36},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var AccelWithCovarianceTypeSupport types.MessageTypeSupport = _AccelWithCovarianceTypeSupport{}
 
@@ -158,6 +248,35 @@ func (t _AccelWithCovarianceTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__geometry_msgs__msg__AccelWithCovariance())
 }
 
+func (t _AccelWithCovarianceTypeSupport) Descriptor() *types.MessageDescriptor {
+	return AccelWithCovarianceDescriptor
+}
+
+func (t _AccelWithCovarianceTypeSupport) TypeHash() string {
+	return `RIHS01_230d51bd53bc36f260574e73b42941cefe44684753480b6fc330c032c5db5997`
+}
+
+func (t _AccelWithCovarianceTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "geometry_msgs/msg/AccelWithCovariance", "fields": [{"name": "accel", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "geometry_msgs/msg/Accel"}, "default_value": ""}, {"name": "covariance", "type": {"type_id": 59, "capacity": 36, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "geometry_msgs/msg/Accel", "fields": [{"name": "linear", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "geometry_msgs/msg/Vector3"}, "default_value": ""}, {"name": "angular", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "geometry_msgs/msg/Vector3"}, "default_value": ""}]}, {"type_name": "geometry_msgs/msg/Vector3", "fields": [{"name": "x", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "y", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "z", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}]}`
+}
+
+func (t _AccelWithCovarianceTypeSupport) MessageDefinition() string {
+	return `Accel accel
+# Row-major representation of the 6x6 covariance matrixThe orientation parameters use a fixed-axis representation.In order, the parameters are:(x, y, z, rotation about X axis, rotation about Y axis, rotation about Z axis)
+float64[36] covariance
+================================================================================
+MSG: geometry_msgs/msg/Accel
+# This expresses acceleration in free space broken into its linear and angular parts.
+Vector3 linear
+Vector3 angular
+================================================================================
+MSG: geometry_msgs/msg/Vector3
+float64 x
+float64 y
+float64 z
+`
+}
+
 type CAccelWithCovariance = C.geometry_msgs__msg__AccelWithCovariance
 type CAccelWithCovariance__Sequence = C.geometry_msgs__msg__AccelWithCovariance__Sequence
 
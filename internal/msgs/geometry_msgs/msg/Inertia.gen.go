@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	
@@ -66,6 +68,62 @@ func (t *Inertia) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *Inertia) Equal(other *Inertia) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *Inertia) EqualApprox(other *Inertia, tol float64) bool {
+	if !msgcmp.Float64(t.M, other.M, tol) {
+		return false
+	}
+	if !t.Com.EqualApprox(&other.Com, tol) {
+		return false
+	}
+	if !msgcmp.Float64(t.Ixx, other.Ixx, tol) {
+		return false
+	}
+	if !msgcmp.Float64(t.Ixy, other.Ixy, tol) {
+		return false
+	}
+	if !msgcmp.Float64(t.Ixz, other.Ixz, tol) {
+		return false
+	}
+	if !msgcmp.Float64(t.Iyy, other.Iyy, tol) {
+		return false
+	}
+	if !msgcmp.Float64(t.Iyz, other.Iyz, tol) {
+		return false
+	}
+	if !msgcmp.Float64(t.Izz, other.Izz, tol) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *Inertia) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *Inertia) HashTo(h *msgcmp.Hasher) {
+	h.Float64(t.M)
+	t.Com.HashTo(h)
+	h.Float64(t.Ixx)
+	h.Float64(t.Ixy)
+	h.Float64(t.Ixz)
+	h.Float64(t.Iyy)
+	h.Float64(t.Iyz)
+	h.Float64(t.Izz)
+}
+
 func (t *Inertia) SetDefaults() {
 	t.M = 0
 	t.Com.SetDefaults()
@@ -81,6 +139,57 @@ func (t *Inertia) GetTypeSupport() types.MessageTypeSupport {
 	return InertiaTypeSupport
 }
 
+func (t *Inertia) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *Inertia) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *Inertia) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *Inertia) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *Inertia) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *Inertia) EncodeCDR(e *cdr.Encoder) {
+	e.Float64(t.M)
+	t.Com.EncodeCDR(e)
+	e.Float64(t.Ixx)
+	e.Float64(t.Ixy)
+	e.Float64(t.Ixz)
+	e.Float64(t.Iyy)
+	e.Float64(t.Iyz)
+	e.Float64(t.Izz)
+}
+
+func (t *Inertia) DecodeCDR(d *cdr.Decoder) {
+	t.M = d.Float64()
+	t.Com.DecodeCDR(d)
+	t.Ixx = d.Float64()
+	t.Ixy = d.Float64()
+	t.Ixz = d.Float64()
+	t.Iyy = d.Float64()
+	t.Iyz = d.Float64()
+	t.Izz = d.Float64()
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *Inertia) Validate() error {
+	if err := t.Com.Validate(); err != nil {
+		return rclgo.WrapValidationError("com", -1, err)
+	}
+	return nil
+}
+
 // InertiaPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type InertiaPublisher struct {
@@ -138,6 +247,23 @@ func CloneInertiaSlice(dst, src []Inertia) {
 	}
 }
 
+// InertiaDescriptor describes the fields of Inertia. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var InertiaDescriptor = &types.MessageDescriptor{
+	TypeName: "geometry_msgs/msg/Inertia",
+	Fields: []types.FieldDescriptor{
+		{Name: "m", Type: "float64", GoIndex: 0},
+		{Name: "com", Type: "geometry_msgs/msg/Vector3", GoIndex: 1, Message: Vector3Descriptor},
+		{Name: "ixx", Type: "float64", GoIndex: 2},
+		{Name: "ixy", Type: "float64", GoIndex: 3},
+		{Name: "ixz", Type: "float64", GoIndex: 4},
+		{Name: "iyy", Type: "float64", GoIndex: 5},
+		{Name: "iyz", Type: "float64", GoIndex: 6},
+		{Name: "izz", Type: "float64", GoIndex: 7},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var InertiaTypeSupport types.MessageTypeSupport = _InertiaTypeSupport{}
 
@@ -185,6 +311,38 @@ func (t _InertiaTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__geometry_msgs__msg__Inertia())
 }
 
+func (t _InertiaTypeSupport) Descriptor() *types.MessageDescriptor {
+	return InertiaDescriptor
+}
+
+func (t _InertiaTypeSupport) TypeHash() string {
+	return `RIHS01_2ddd5dab5c347825ba2e56c895ddccfd0b8efe53ae931bf67f905529930b4bd7`
+}
+
+func (t _InertiaTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "geometry_msgs/msg/Inertia", "fields": [{"name": "m", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "com", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "geometry_msgs/msg/Vector3"}, "default_value": ""}, {"name": "ixx", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "ixy", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "ixz", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "iyy", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "iyz", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "izz", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "geometry_msgs/msg/Vector3", "fields": [{"name": "x", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "y", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "z", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}]}`
+}
+
+func (t _InertiaTypeSupport) MessageDefinition() string {
+	return `# Mass [kg]
+float64 m
+# Center of mass [m]
+Vector3 com
+# Inertia Tensor [kg-m^2]| ixx ixy ixz |I = | ixy iyy iyz || ixz iyz izz |
+float64 ixx
+float64 ixy
+float64 ixz
+float64 iyy
+float64 iyz
+float64 izz
+================================================================================
+MSG: geometry_msgs/msg/Vector3
+float64 x
+float64 y
+float64 z
+`
+}
+
 type CInertia = C.geometry_msgs__msg__Inertia
 type CInertia__Sequence = C.geometry_msgs__msg__Inertia__Sequence
 
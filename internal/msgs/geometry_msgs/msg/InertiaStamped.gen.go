@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	std_msgs_msg "github.com/tiiuae/rclgo/internal/msgs/std_msgs/msg"
@@ -55,6 +57,38 @@ func (t *InertiaStamped) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *InertiaStamped) Equal(other *InertiaStamped) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *InertiaStamped) EqualApprox(other *InertiaStamped, tol float64) bool {
+	if !t.Header.EqualApprox(&other.Header, tol) {
+		return false
+	}
+	if !t.Inertia.EqualApprox(&other.Inertia, tol) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *InertiaStamped) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *InertiaStamped) HashTo(h *msgcmp.Hasher) {
+	t.Header.HashTo(h)
+	t.Inertia.HashTo(h)
+}
+
 func (t *InertiaStamped) SetDefaults() {
 	t.Header.SetDefaults()
 	t.Inertia.SetDefaults()
@@ -64,6 +98,48 @@ func (t *InertiaStamped) GetTypeSupport() types.MessageTypeSupport {
 	return InertiaStampedTypeSupport
 }
 
+func (t *InertiaStamped) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *InertiaStamped) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *InertiaStamped) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *InertiaStamped) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *InertiaStamped) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *InertiaStamped) EncodeCDR(e *cdr.Encoder) {
+	t.Header.EncodeCDR(e)
+	t.Inertia.EncodeCDR(e)
+}
+
+func (t *InertiaStamped) DecodeCDR(d *cdr.Decoder) {
+	t.Header.DecodeCDR(d)
+	t.Inertia.DecodeCDR(d)
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *InertiaStamped) Validate() error {
+	if err := t.Header.Validate(); err != nil {
+		return rclgo.WrapValidationError("header", -1, err)
+	}
+	if err := t.Inertia.Validate(); err != nil {
+		return rclgo.WrapValidationError("inertia", -1, err)
+	}
+	return nil
+}
+
 // InertiaStampedPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type InertiaStampedPublisher struct {
@@ -121,6 +197,17 @@ func CloneInertiaStampedSlice(dst, src []InertiaStamped) {
 	}
 }
 
+// InertiaStampedDescriptor describes the fields of InertiaStamped. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var InertiaStampedDescriptor = &types.MessageDescriptor{
+	TypeName: "geometry_msgs/msg/InertiaStamped",
+	Fields: []types.FieldDescriptor{
+		{Name: "header", Type: "std_msgs/msg/Header", GoIndex: 0, Message: std_msgs_msg.HeaderDescriptor},
+		{Name: "inertia", Type: "geometry_msgs/msg/Inertia", GoIndex: 1, Message: InertiaDescriptor},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var InertiaStampedTypeSupport types.MessageTypeSupport = _InertiaStampedTypeSupport{}
 
@@ -156,6 +243,54 @@ func (t _InertiaStampedTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__geometry_msgs__msg__InertiaStamped())
 }
 
+func (t _InertiaStampedTypeSupport) Descriptor() *types.MessageDescriptor {
+	return InertiaStampedDescriptor
+}
+
+func (t _InertiaStampedTypeSupport) TypeHash() string {
+	return `RIHS01_766be45976252babf7f9d8ac4ae7c912a7ceccf71035622529f27518b695aa09`
+}
+
+func (t _InertiaStampedTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "geometry_msgs/msg/InertiaStamped", "fields": [{"name": "header", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "std_msgs/msg/Header"}, "default_value": ""}, {"name": "inertia", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "geometry_msgs/msg/Inertia"}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "builtin_interfaces/msg/Time", "fields": [{"name": "sec", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "nanosec", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "geometry_msgs/msg/Inertia", "fields": [{"name": "m", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "com", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "geometry_msgs/msg/Vector3"}, "default_value": ""}, {"name": "ixx", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "ixy", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "ixz", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "iyy", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "iyz", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "izz", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "geometry_msgs/msg/Vector3", "fields": [{"name": "x", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "y", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "z", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "std_msgs/msg/Header", "fields": [{"name": "stamp", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "builtin_interfaces/msg/Time"}, "default_value": ""}, {"name": "frame_id", "type": {"type_id": 17, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}]}`
+}
+
+func (t _InertiaStampedTypeSupport) MessageDefinition() string {
+	return `std_msgs/Header header
+Inertia inertia
+================================================================================
+MSG: std_msgs/msg/Header
+# Two-integer timestamp that is expressed as seconds and nanoseconds.
+builtin_interfaces/Time stamp
+# Transform frame with which this data is associated.
+string frame_id
+================================================================================
+MSG: geometry_msgs/msg/Inertia
+# Mass [kg]
+float64 m
+# Center of mass [m]
+Vector3 com
+# Inertia Tensor [kg-m^2]| ixx ixy ixz |I = | ixy iyy iyz || ixz iyz izz |
+float64 ixx
+float64 ixy
+float64 ixz
+float64 iyy
+float64 iyz
+float64 izz
+================================================================================
+MSG: builtin_interfaces/msg/Time
+# The seconds component, valid over all int32 values.
+int32 sec
+# The nanoseconds component, valid in the range [0, 10e9).
+uint32 nanosec
+================================================================================
+MSG: geometry_msgs/msg/Vector3
+float64 x
+float64 y
+float64 z
+`
+}
+
 type CInertiaStamped = C.geometry_msgs__msg__InertiaStamped
 type CInertiaStamped__Sequence = C.geometry_msgs__msg__InertiaStamped__Sequence
 
@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	
@@ -56,6 +58,42 @@ func (t *Point) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *Point) Equal(other *Point) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *Point) EqualApprox(other *Point, tol float64) bool {
+	if !msgcmp.Float64(t.X, other.X, tol) {
+		return false
+	}
+	if !msgcmp.Float64(t.Y, other.Y, tol) {
+		return false
+	}
+	if !msgcmp.Float64(t.Z, other.Z, tol) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *Point) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *Point) HashTo(h *msgcmp.Hasher) {
+	h.Float64(t.X)
+	h.Float64(t.Y)
+	h.Float64(t.Z)
+}
+
 func (t *Point) SetDefaults() {
 	t.X = 0
 	t.Y = 0
@@ -66,6 +104,44 @@ func (t *Point) GetTypeSupport() types.MessageTypeSupport {
 	return PointTypeSupport
 }
 
+func (t *Point) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *Point) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *Point) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *Point) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *Point) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *Point) EncodeCDR(e *cdr.Encoder) {
+	e.Float64(t.X)
+	e.Float64(t.Y)
+	e.Float64(t.Z)
+}
+
+func (t *Point) DecodeCDR(d *cdr.Decoder) {
+	t.X = d.Float64()
+	t.Y = d.Float64()
+	t.Z = d.Float64()
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *Point) Validate() error {
+	return nil
+}
+
 // PointPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type PointPublisher struct {
@@ -123,6 +199,18 @@ func ClonePointSlice(dst, src []Point) {
 	}
 }
 
+// PointDescriptor describes the fields of Point. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var PointDescriptor = &types.MessageDescriptor{
+	TypeName: "geometry_msgs/msg/Point",
+	Fields: []types.FieldDescriptor{
+		{Name: "x", Type: "float64", GoIndex: 0},
+		{Name: "y", Type: "float64", GoIndex: 1},
+		{Name: "z", Type: "float64", GoIndex: 2},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var PointTypeSupport types.MessageTypeSupport = _PointTypeSupport{}
 
@@ -160,6 +248,26 @@ func (t _PointTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__geometry_msgs__msg__Point())
 }
 
+func (t _PointTypeSupport) Descriptor() *types.MessageDescriptor {
+	return PointDescriptor
+}
+
+func (t _PointTypeSupport) TypeHash() string {
+	return `RIHS01_6963084842a9b04494d6b2941d11444708d892da2f4b09843b9c43f42a7f6881`
+}
+
+func (t _PointTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "geometry_msgs/msg/Point", "fields": [{"name": "x", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "y", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "z", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": []}`
+}
+
+func (t _PointTypeSupport) MessageDefinition() string {
+	return `# This contains the position of a point in free space
+float64 x
+float64 y
+float64 z
+`
+}
+
 type CPoint = C.geometry_msgs__msg__Point
 type CPoint__Sequence = C.geometry_msgs__msg__Point__Sequence
 
@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	
@@ -56,6 +58,42 @@ func (t *Point32) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *Point32) Equal(other *Point32) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *Point32) EqualApprox(other *Point32, tol float64) bool {
+	if !msgcmp.Float32(t.X, other.X, tol) {
+		return false
+	}
+	if !msgcmp.Float32(t.Y, other.Y, tol) {
+		return false
+	}
+	if !msgcmp.Float32(t.Z, other.Z, tol) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *Point32) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *Point32) HashTo(h *msgcmp.Hasher) {
+	h.Float32(t.X)
+	h.Float32(t.Y)
+	h.Float32(t.Z)
+}
+
 func (t *Point32) SetDefaults() {
 	t.X = 0
 	t.Y = 0
@@ -66,6 +104,44 @@ func (t *Point32) GetTypeSupport() types.MessageTypeSupport {
 	return Point32TypeSupport
 }
 
+func (t *Point32) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *Point32) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *Point32) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *Point32) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *Point32) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *Point32) EncodeCDR(e *cdr.Encoder) {
+	e.Float32(t.X)
+	e.Float32(t.Y)
+	e.Float32(t.Z)
+}
+
+func (t *Point32) DecodeCDR(d *cdr.Decoder) {
+	t.X = d.Float32()
+	t.Y = d.Float32()
+	t.Z = d.Float32()
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *Point32) Validate() error {
+	return nil
+}
+
 // Point32Publisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type Point32Publisher struct {
@@ -123,6 +199,18 @@ func ClonePoint32Slice(dst, src []Point32) {
 	}
 }
 
+// Point32Descriptor describes the fields of Point32. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var Point32Descriptor = &types.MessageDescriptor{
+	TypeName: "geometry_msgs/msg/Point32",
+	Fields: []types.FieldDescriptor{
+		{Name: "x", Type: "float32", GoIndex: 0},
+		{Name: "y", Type: "float32", GoIndex: 1},
+		{Name: "z", Type: "float32", GoIndex: 2},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var Point32TypeSupport types.MessageTypeSupport = _Point32TypeSupport{}
 
@@ -160,6 +248,25 @@ func (t _Point32TypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__geometry_msgs__msg__Point32())
 }
 
+func (t _Point32TypeSupport) Descriptor() *types.MessageDescriptor {
+	return Point32Descriptor
+}
+
+func (t _Point32TypeSupport) TypeHash() string {
+	return `RIHS01_2fc4db7cae16a4582c79a56b66173a8d48d52c7dc520ddc55a0d4bcf2a4bfdbc`
+}
+
+func (t _Point32TypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "geometry_msgs/msg/Point32", "fields": [{"name": "x", "type": {"type_id": 10, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "y", "type": {"type_id": 10, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "z", "type": {"type_id": 10, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": []}`
+}
+
+func (t _Point32TypeSupport) MessageDefinition() string {
+	return `float32 x
+float32 y
+float32 z
+`
+}
+
 type CPoint32 = C.geometry_msgs__msg__Point32
 type CPoint32__Sequence = C.geometry_msgs__msg__Point32__Sequence
 
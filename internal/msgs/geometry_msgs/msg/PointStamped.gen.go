@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	std_msgs_msg "github.com/tiiuae/rclgo/internal/msgs/std_msgs/msg"
@@ -55,6 +57,38 @@ func (t *PointStamped) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *PointStamped) Equal(other *PointStamped) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *PointStamped) EqualApprox(other *PointStamped, tol float64) bool {
+	if !t.Header.EqualApprox(&other.Header, tol) {
+		return false
+	}
+	if !t.Point.EqualApprox(&other.Point, tol) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *PointStamped) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *PointStamped) HashTo(h *msgcmp.Hasher) {
+	t.Header.HashTo(h)
+	t.Point.HashTo(h)
+}
+
 func (t *PointStamped) SetDefaults() {
 	t.Header.SetDefaults()
 	t.Point.SetDefaults()
@@ -64,6 +98,48 @@ func (t *PointStamped) GetTypeSupport() types.MessageTypeSupport {
 	return PointStampedTypeSupport
 }
 
+func (t *PointStamped) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *PointStamped) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *PointStamped) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *PointStamped) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *PointStamped) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *PointStamped) EncodeCDR(e *cdr.Encoder) {
+	t.Header.EncodeCDR(e)
+	t.Point.EncodeCDR(e)
+}
+
+func (t *PointStamped) DecodeCDR(d *cdr.Decoder) {
+	t.Header.DecodeCDR(d)
+	t.Point.DecodeCDR(d)
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *PointStamped) Validate() error {
+	if err := t.Header.Validate(); err != nil {
+		return rclgo.WrapValidationError("header", -1, err)
+	}
+	if err := t.Point.Validate(); err != nil {
+		return rclgo.WrapValidationError("point", -1, err)
+	}
+	return nil
+}
+
 // PointStampedPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type PointStampedPublisher struct {
@@ -121,6 +197,17 @@ func ClonePointStampedSlice(dst, src []PointStamped) {
 	}
 }
 
+// PointStampedDescriptor describes the fields of PointStamped. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var PointStampedDescriptor = &types.MessageDescriptor{
+	TypeName: "geometry_msgs/msg/PointStamped",
+	Fields: []types.FieldDescriptor{
+		{Name: "header", Type: "std_msgs/msg/Header", GoIndex: 0, Message: std_msgs_msg.HeaderDescriptor},
+		{Name: "point", Type: "geometry_msgs/msg/Point", GoIndex: 1, Message: PointDescriptor},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var PointStampedTypeSupport types.MessageTypeSupport = _PointStampedTypeSupport{}
 
@@ -156,6 +243,42 @@ func (t _PointStampedTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__geometry_msgs__msg__PointStamped())
 }
 
+func (t _PointStampedTypeSupport) Descriptor() *types.MessageDescriptor {
+	return PointStampedDescriptor
+}
+
+func (t _PointStampedTypeSupport) TypeHash() string {
+	return `RIHS01_4c0296af86e01e562e9e0405d138a01537247580076c58ea38d7923ac1045897`
+}
+
+func (t _PointStampedTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "geometry_msgs/msg/PointStamped", "fields": [{"name": "header", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "std_msgs/msg/Header"}, "default_value": ""}, {"name": "point", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "geometry_msgs/msg/Point"}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "builtin_interfaces/msg/Time", "fields": [{"name": "sec", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "nanosec", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "geometry_msgs/msg/Point", "fields": [{"name": "x", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "y", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "z", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "std_msgs/msg/Header", "fields": [{"name": "stamp", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "builtin_interfaces/msg/Time"}, "default_value": ""}, {"name": "frame_id", "type": {"type_id": 17, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}]}`
+}
+
+func (t _PointStampedTypeSupport) MessageDefinition() string {
+	return `std_msgs/Header header
+Point point
+================================================================================
+MSG: std_msgs/msg/Header
+# Two-integer timestamp that is expressed as seconds and nanoseconds.
+builtin_interfaces/Time stamp
+# Transform frame with which this data is associated.
+string frame_id
+================================================================================
+MSG: geometry_msgs/msg/Point
+# This contains the position of a point in free space
+float64 x
+float64 y
+float64 z
+================================================================================
+MSG: builtin_interfaces/msg/Time
+# The seconds component, valid over all int32 values.
+int32 sec
+# The nanoseconds component, valid in the range [0, 10e9).
+uint32 nanosec
+`
+}
+
 type CPointStamped = C.geometry_msgs__msg__PointStamped
 type CPointStamped__Sequence = C.geometry_msgs__msg__PointStamped__Sequence
 
@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	
@@ -55,6 +57,42 @@ func (t *Polygon) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *Polygon) Equal(other *Polygon) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *Polygon) EqualApprox(other *Polygon, tol float64) bool {
+	if len(t.Points) != len(other.Points) {
+		return false
+	}
+	for i := range t.Points {
+		if !t.Points[i].EqualApprox(&other.Points[i], tol) {
+			return false
+		}
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *Polygon) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *Polygon) HashTo(h *msgcmp.Hasher) {
+	h.Len(len(t.Points))
+	for i := range t.Points {
+		t.Points[i].HashTo(h)
+	}
+}
+
 func (t *Polygon) SetDefaults() {
 	t.Points = nil
 }
@@ -63,6 +101,51 @@ func (t *Polygon) GetTypeSupport() types.MessageTypeSupport {
 	return PolygonTypeSupport
 }
 
+func (t *Polygon) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *Polygon) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *Polygon) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *Polygon) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *Polygon) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *Polygon) EncodeCDR(e *cdr.Encoder) {
+	e.SequenceLength(len(t.Points), 0)
+	for i := range t.Points {
+		t.Points[i].EncodeCDR(e)
+	}
+}
+
+func (t *Polygon) DecodeCDR(d *cdr.Decoder) {
+	t.Points = cdr.MakeSlice[Point32](d, 0)
+	for i := range t.Points {
+		t.Points[i].DecodeCDR(d)
+	}
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *Polygon) Validate() error {
+	for i := range t.Points {
+		if err := t.Points[i].Validate(); err != nil {
+			return rclgo.WrapValidationError("points", i, err)
+		}
+	}
+	return nil
+}
+
 // PolygonPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type PolygonPublisher struct {
@@ -120,6 +203,16 @@ func ClonePolygonSlice(dst, src []Polygon) {
 	}
 }
 
+// PolygonDescriptor describes the fields of Polygon. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var PolygonDescriptor = &types.MessageDescriptor{
+	TypeName: "geometry_msgs/msg/Polygon",
+	Fields: []types.FieldDescriptor{
+		{Name: "points", Type: "geometry_msgs/msg/Point32", GoIndex: 0, IsArray: true, Message: Point32Descriptor},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var PolygonTypeSupport types.MessageTypeSupport = _PolygonTypeSupport{}
 
@@ -153,6 +246,28 @@ func (t _PolygonTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__geometry_msgs__msg__Polygon())
 }
 
+func (t _PolygonTypeSupport) Descriptor() *types.MessageDescriptor {
+	return PolygonDescriptor
+}
+
+func (t _PolygonTypeSupport) TypeHash() string {
+	return `RIHS01_3782f9f0bf044964d692d6c017d705e37611afb1f0bf6a9dee248a7dda0f784a`
+}
+
+func (t _PolygonTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "geometry_msgs/msg/Polygon", "fields": [{"name": "points", "type": {"type_id": 145, "capacity": 0, "string_capacity": 0, "nested_type_name": "geometry_msgs/msg/Point32"}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "geometry_msgs/msg/Point32", "fields": [{"name": "x", "type": {"type_id": 10, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "y", "type": {"type_id": 10, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "z", "type": {"type_id": 10, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}]}`
+}
+
+func (t _PolygonTypeSupport) MessageDefinition() string {
+	return `Point32[] points
+================================================================================
+MSG: geometry_msgs/msg/Point32
+float32 x
+float32 y
+float32 z
+`
+}
+
 type CPolygon = C.geometry_msgs__msg__Polygon
 type CPolygon__Sequence = C.geometry_msgs__msg__Polygon__Sequence
 
@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	std_msgs_msg "github.com/tiiuae/rclgo/internal/msgs/std_msgs/msg"
@@ -55,6 +57,38 @@ func (t *PolygonStamped) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *PolygonStamped) Equal(other *PolygonStamped) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *PolygonStamped) EqualApprox(other *PolygonStamped, tol float64) bool {
+	if !t.Header.EqualApprox(&other.Header, tol) {
+		return false
+	}
+	if !t.Polygon.EqualApprox(&other.Polygon, tol) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *PolygonStamped) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *PolygonStamped) HashTo(h *msgcmp.Hasher) {
+	t.Header.HashTo(h)
+	t.Polygon.HashTo(h)
+}
+
 func (t *PolygonStamped) SetDefaults() {
 	t.Header.SetDefaults()
 	t.Polygon.SetDefaults()
@@ -64,6 +98,48 @@ func (t *PolygonStamped) GetTypeSupport() types.MessageTypeSupport {
 	return PolygonStampedTypeSupport
 }
 
+func (t *PolygonStamped) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *PolygonStamped) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *PolygonStamped) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *PolygonStamped) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *PolygonStamped) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *PolygonStamped) EncodeCDR(e *cdr.Encoder) {
+	t.Header.EncodeCDR(e)
+	t.Polygon.EncodeCDR(e)
+}
+
+func (t *PolygonStamped) DecodeCDR(d *cdr.Decoder) {
+	t.Header.DecodeCDR(d)
+	t.Polygon.DecodeCDR(d)
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *PolygonStamped) Validate() error {
+	if err := t.Header.Validate(); err != nil {
+		return rclgo.WrapValidationError("header", -1, err)
+	}
+	if err := t.Polygon.Validate(); err != nil {
+		return rclgo.WrapValidationError("polygon", -1, err)
+	}
+	return nil
+}
+
 // PolygonStampedPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type PolygonStampedPublisher struct {
@@ -121,6 +197,17 @@ func ClonePolygonStampedSlice(dst, src []PolygonStamped) {
 	}
 }
 
+// PolygonStampedDescriptor describes the fields of PolygonStamped. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var PolygonStampedDescriptor = &types.MessageDescriptor{
+	TypeName: "geometry_msgs/msg/PolygonStamped",
+	Fields: []types.FieldDescriptor{
+		{Name: "header", Type: "std_msgs/msg/Header", GoIndex: 0, Message: std_msgs_msg.HeaderDescriptor},
+		{Name: "polygon", Type: "geometry_msgs/msg/Polygon", GoIndex: 1, Message: PolygonDescriptor},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var PolygonStampedTypeSupport types.MessageTypeSupport = _PolygonStampedTypeSupport{}
 
@@ -156,6 +243,44 @@ func (t _PolygonStampedTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__geometry_msgs__msg__PolygonStamped())
 }
 
+func (t _PolygonStampedTypeSupport) Descriptor() *types.MessageDescriptor {
+	return PolygonStampedDescriptor
+}
+
+func (t _PolygonStampedTypeSupport) TypeHash() string {
+	return `RIHS01_b7cf07932f1523d4b4088075945c1a0141f7cd21da87cc940fc61652e9138b46`
+}
+
+func (t _PolygonStampedTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "geometry_msgs/msg/PolygonStamped", "fields": [{"name": "header", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "std_msgs/msg/Header"}, "default_value": ""}, {"name": "polygon", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "geometry_msgs/msg/Polygon"}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "builtin_interfaces/msg/Time", "fields": [{"name": "sec", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "nanosec", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "geometry_msgs/msg/Point32", "fields": [{"name": "x", "type": {"type_id": 10, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "y", "type": {"type_id": 10, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "z", "type": {"type_id": 10, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "geometry_msgs/msg/Polygon", "fields": [{"name": "points", "type": {"type_id": 145, "capacity": 0, "string_capacity": 0, "nested_type_name": "geometry_msgs/msg/Point32"}, "default_value": ""}]}, {"type_name": "std_msgs/msg/Header", "fields": [{"name": "stamp", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "builtin_interfaces/msg/Time"}, "default_value": ""}, {"name": "frame_id", "type": {"type_id": 17, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}]}`
+}
+
+func (t _PolygonStampedTypeSupport) MessageDefinition() string {
+	return `std_msgs/Header header
+Polygon polygon
+================================================================================
+MSG: std_msgs/msg/Header
+# Two-integer timestamp that is expressed as seconds and nanoseconds.
+builtin_interfaces/Time stamp
+# Transform frame with which this data is associated.
+string frame_id
+================================================================================
+MSG: geometry_msgs/msg/Polygon
+Point32[] points
+================================================================================
+MSG: builtin_interfaces/msg/Time
+# The seconds component, valid over all int32 values.
+int32 sec
+# The nanoseconds component, valid in the range [0, 10e9).
+uint32 nanosec
+================================================================================
+MSG: geometry_msgs/msg/Point32
+float32 x
+float32 y
+float32 z
+`
+}
+
 type CPolygonStamped = C.geometry_msgs__msg__PolygonStamped
 type CPolygonStamped__Sequence = C.geometry_msgs__msg__PolygonStamped__Sequence
 
@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	
@@ -54,6 +56,38 @@ func (t *Pose) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *Pose) Equal(other *Pose) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *Pose) EqualApprox(other *Pose, tol float64) bool {
+	if !t.Position.EqualApprox(&other.Position, tol) {
+		return false
+	}
+	if !t.Orientation.EqualApprox(&other.Orientation, tol) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *Pose) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *Pose) HashTo(h *msgcmp.Hasher) {
+	t.Position.HashTo(h)
+	t.Orientation.HashTo(h)
+}
+
 func (t *Pose) SetDefaults() {
 	t.Position.SetDefaults()
 	t.Orientation.SetDefaults()
@@ -63,6 +97,48 @@ func (t *Pose) GetTypeSupport() types.MessageTypeSupport {
 	return PoseTypeSupport
 }
 
+func (t *Pose) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *Pose) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *Pose) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *Pose) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *Pose) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *Pose) EncodeCDR(e *cdr.Encoder) {
+	t.Position.EncodeCDR(e)
+	t.Orientation.EncodeCDR(e)
+}
+
+func (t *Pose) DecodeCDR(d *cdr.Decoder) {
+	t.Position.DecodeCDR(d)
+	t.Orientation.DecodeCDR(d)
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *Pose) Validate() error {
+	if err := t.Position.Validate(); err != nil {
+		return rclgo.WrapValidationError("position", -1, err)
+	}
+	if err := t.Orientation.Validate(); err != nil {
+		return rclgo.WrapValidationError("orientation", -1, err)
+	}
+	return nil
+}
+
 // PosePublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type PosePublisher struct {
@@ -120,6 +196,17 @@ func ClonePoseSlice(dst, src []Pose) {
 	}
 }
 
+// PoseDescriptor describes the fields of Pose. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var PoseDescriptor = &types.MessageDescriptor{
+	TypeName: "geometry_msgs/msg/Pose",
+	Fields: []types.FieldDescriptor{
+		{Name: "position", Type: "geometry_msgs/msg/Point", GoIndex: 0, Message: PointDescriptor},
+		{Name: "orientation", Type: "geometry_msgs/msg/Quaternion", GoIndex: 1, Message: QuaternionDescriptor},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var PoseTypeSupport types.MessageTypeSupport = _PoseTypeSupport{}
 
@@ -155,6 +242,36 @@ func (t _PoseTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__geometry_msgs__msg__Pose())
 }
 
+func (t _PoseTypeSupport) Descriptor() *types.MessageDescriptor {
+	return PoseDescriptor
+}
+
+func (t _PoseTypeSupport) TypeHash() string {
+	return `RIHS01_d501954e9476cea2996984e812054b68026ae0bfae789d9a10b23daf35cc90fa`
+}
+
+func (t _PoseTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "geometry_msgs/msg/Pose", "fields": [{"name": "position", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "geometry_msgs/msg/Point"}, "default_value": ""}, {"name": "orientation", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "geometry_msgs/msg/Quaternion"}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "geometry_msgs/msg/Point", "fields": [{"name": "x", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "y", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "z", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "geometry_msgs/msg/Quaternion", "fields": [{"name": "x", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "0"}, {"name": "y", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "0"}, {"name": "z", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "0"}, {"name": "w", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "1"}]}]}`
+}
+
+func (t _PoseTypeSupport) MessageDefinition() string {
+	return `Point position
+Quaternion orientation
+================================================================================
+MSG: geometry_msgs/msg/Point
+# This contains the position of a point in free space
+float64 x
+float64 y
+float64 z
+================================================================================
+MSG: geometry_msgs/msg/Quaternion
+float64 x 0
+float64 y 0
+float64 z 0
+float64 w 1
+`
+}
+
 type CPose = C.geometry_msgs__msg__Pose
 type CPose__Sequence = C.geometry_msgs__msg__Pose__Sequence
 
@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	
@@ -56,6 +58,42 @@ func (t *Pose2D) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *Pose2D) Equal(other *Pose2D) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *Pose2D) EqualApprox(other *Pose2D, tol float64) bool {
+	if !msgcmp.Float64(t.X, other.X, tol) {
+		return false
+	}
+	if !msgcmp.Float64(t.Y, other.Y, tol) {
+		return false
+	}
+	if !msgcmp.Float64(t.Theta, other.Theta, tol) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *Pose2D) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *Pose2D) HashTo(h *msgcmp.Hasher) {
+	h.Float64(t.X)
+	h.Float64(t.Y)
+	h.Float64(t.Theta)
+}
+
 func (t *Pose2D) SetDefaults() {
 	t.X = 0
 	t.Y = 0
@@ -66,6 +104,44 @@ func (t *Pose2D) GetTypeSupport() types.MessageTypeSupport {
 	return Pose2DTypeSupport
 }
 
+func (t *Pose2D) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *Pose2D) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *Pose2D) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *Pose2D) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *Pose2D) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *Pose2D) EncodeCDR(e *cdr.Encoder) {
+	e.Float64(t.X)
+	e.Float64(t.Y)
+	e.Float64(t.Theta)
+}
+
+func (t *Pose2D) DecodeCDR(d *cdr.Decoder) {
+	t.X = d.Float64()
+	t.Y = d.Float64()
+	t.Theta = d.Float64()
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *Pose2D) Validate() error {
+	return nil
+}
+
 // Pose2DPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type Pose2DPublisher struct {
@@ -123,6 +199,18 @@ func ClonePose2DSlice(dst, src []Pose2D) {
 	}
 }
 
+// Pose2DDescriptor describes the fields of Pose2D. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var Pose2DDescriptor = &types.MessageDescriptor{
+	TypeName: "geometry_msgs/msg/Pose2D",
+	Fields: []types.FieldDescriptor{
+		{Name: "x", Type: "float64", GoIndex: 0},
+		{Name: "y", Type: "float64", GoIndex: 1},
+		{Name: "theta", Type: "float64", GoIndex: 2},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var Pose2DTypeSupport types.MessageTypeSupport = _Pose2DTypeSupport{}
 
@@ -160,6 +248,25 @@ func (t _Pose2DTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__geometry_msgs__msg__Pose2D())
 }
 
+func (t _Pose2DTypeSupport) Descriptor() *types.MessageDescriptor {
+	return Pose2DDescriptor
+}
+
+func (t _Pose2DTypeSupport) TypeHash() string {
+	return `RIHS01_d68efa5b46e70f7b16ca23085474fdac5a44b638783ec42f661da64da4724ccc`
+}
+
+func (t _Pose2DTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "geometry_msgs/msg/Pose2D", "fields": [{"name": "x", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "y", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "theta", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": []}`
+}
+
+func (t _Pose2DTypeSupport) MessageDefinition() string {
+	return `float64 x
+float64 y
+float64 theta
+`
+}
+
 type CPose2D = C.geometry_msgs__msg__Pose2D
 type CPose2D__Sequence = C.geometry_msgs__msg__Pose2D__Sequence
 
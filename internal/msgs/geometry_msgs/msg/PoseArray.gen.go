@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	std_msgs_msg "github.com/tiiuae/rclgo/internal/msgs/std_msgs/msg"
@@ -58,6 +60,46 @@ func (t *PoseArray) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *PoseArray) Equal(other *PoseArray) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *PoseArray) EqualApprox(other *PoseArray, tol float64) bool {
+	if !t.Header.EqualApprox(&other.Header, tol) {
+		return false
+	}
+	if len(t.Poses) != len(other.Poses) {
+		return false
+	}
+	for i := range t.Poses {
+		if !t.Poses[i].EqualApprox(&other.Poses[i], tol) {
+			return false
+		}
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *PoseArray) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *PoseArray) HashTo(h *msgcmp.Hasher) {
+	t.Header.HashTo(h)
+	h.Len(len(t.Poses))
+	for i := range t.Poses {
+		t.Poses[i].HashTo(h)
+	}
+}
+
 func (t *PoseArray) SetDefaults() {
 	t.Header.SetDefaults()
 	t.Poses = nil
@@ -67,6 +109,56 @@ func (t *PoseArray) GetTypeSupport() types.MessageTypeSupport {
 	return PoseArrayTypeSupport
 }
 
+func (t *PoseArray) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *PoseArray) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *PoseArray) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *PoseArray) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *PoseArray) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *PoseArray) EncodeCDR(e *cdr.Encoder) {
+	t.Header.EncodeCDR(e)
+	e.SequenceLength(len(t.Poses), 0)
+	for i := range t.Poses {
+		t.Poses[i].EncodeCDR(e)
+	}
+}
+
+func (t *PoseArray) DecodeCDR(d *cdr.Decoder) {
+	t.Header.DecodeCDR(d)
+	t.Poses = cdr.MakeSlice[Pose](d, 0)
+	for i := range t.Poses {
+		t.Poses[i].DecodeCDR(d)
+	}
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *PoseArray) Validate() error {
+	if err := t.Header.Validate(); err != nil {
+		return rclgo.WrapValidationError("header", -1, err)
+	}
+	for i := range t.Poses {
+		if err := t.Poses[i].Validate(); err != nil {
+			return rclgo.WrapValidationError("poses", i, err)
+		}
+	}
+	return nil
+}
+
 // PoseArrayPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type PoseArrayPublisher struct {
@@ -124,6 +216,17 @@ func ClonePoseArraySlice(dst, src []PoseArray) {
 	}
 }
 
+// PoseArrayDescriptor describes the fields of PoseArray. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var PoseArrayDescriptor = &types.MessageDescriptor{
+	TypeName: "geometry_msgs/msg/PoseArray",
+	Fields: []types.FieldDescriptor{
+		{Name: "header", Type: "std_msgs/msg/Header", GoIndex: 0, Message: std_msgs_msg.HeaderDescriptor},
+		{Name: "poses", Type: "geometry_msgs/msg/Pose", GoIndex: 1, IsArray: true, Message: PoseDescriptor},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var PoseArrayTypeSupport types.MessageTypeSupport = _PoseArrayTypeSupport{}
 
@@ -159,6 +262,52 @@ func (t _PoseArrayTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__geometry_msgs__msg__PoseArray())
 }
 
+func (t _PoseArrayTypeSupport) Descriptor() *types.MessageDescriptor {
+	return PoseArrayDescriptor
+}
+
+func (t _PoseArrayTypeSupport) TypeHash() string {
+	return `RIHS01_af0cc36d190e104d546d168d6b39df04fa4b4ccecf59cb4c9ed328d3d5004aa0`
+}
+
+func (t _PoseArrayTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "geometry_msgs/msg/PoseArray", "fields": [{"name": "header", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "std_msgs/msg/Header"}, "default_value": ""}, {"name": "poses", "type": {"type_id": 145, "capacity": 0, "string_capacity": 0, "nested_type_name": "geometry_msgs/msg/Pose"}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "builtin_interfaces/msg/Time", "fields": [{"name": "sec", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "nanosec", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "geometry_msgs/msg/Point", "fields": [{"name": "x", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "y", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "z", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "geometry_msgs/msg/Pose", "fields": [{"name": "position", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "geometry_msgs/msg/Point"}, "default_value": ""}, {"name": "orientation", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "geometry_msgs/msg/Quaternion"}, "default_value": ""}]}, {"type_name": "geometry_msgs/msg/Quaternion", "fields": [{"name": "x", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "0"}, {"name": "y", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "0"}, {"name": "z", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "0"}, {"name": "w", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "1"}]}, {"type_name": "std_msgs/msg/Header", "fields": [{"name": "stamp", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "builtin_interfaces/msg/Time"}, "default_value": ""}, {"name": "frame_id", "type": {"type_id": 17, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}]}`
+}
+
+func (t _PoseArrayTypeSupport) MessageDefinition() string {
+	return `std_msgs/Header header
+Pose[] poses
+================================================================================
+MSG: std_msgs/msg/Header
+# Two-integer timestamp that is expressed as seconds and nanoseconds.
+builtin_interfaces/Time stamp
+# Transform frame with which this data is associated.
+string frame_id
+================================================================================
+MSG: geometry_msgs/msg/Pose
+Point position
+Quaternion orientation
+================================================================================
+MSG: builtin_interfaces/msg/Time
+# The seconds component, valid over all int32 values.
+int32 sec
+# The nanoseconds component, valid in the range [0, 10e9).
+uint32 nanosec
+================================================================================
+MSG: geometry_msgs/msg/Point
+# This contains the position of a point in free space
+float64 x
+float64 y
+float64 z
+================================================================================
+MSG: geometry_msgs/msg/Quaternion
+float64 x 0
+float64 y 0
+float64 z 0
+float64 w 1
+`
+}
+
 type CPoseArray = C.geometry_msgs__msg__PoseArray
 type CPoseArray__Sequence = C.geometry_msgs__msg__PoseArray__Sequence
 
@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	primitives "github.com/tiiuae/rclgo/pkg/rclgo/primitives"
@@ -55,6 +57,40 @@ func (t *PoseWithCovariance) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *PoseWithCovariance) Equal(other *PoseWithCovariance) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *PoseWithCovariance) EqualApprox(other *PoseWithCovariance, tol float64) bool {
+	if !t.Pose.EqualApprox(&other.Pose, tol) {
+		return false
+	}
+	if !msgcmp.Float64s(t.Covariance[:], other.Covariance[:], tol) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *PoseWithCovariance) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *PoseWithCovariance) HashTo(h *msgcmp.Hasher) {
+	t.Pose.HashTo(h)
+	for i := range t.Covariance {
+		h.Float64(t.Covariance[i])
+	}
+}
+
 func (t *PoseWithCovariance) SetDefaults() {
 	t.Pose.SetDefaults()
 	t.Covariance = [36]float64{}
@@ -64,6 +100,49 @@ func (t *PoseWithCovariance) GetTypeSupport() types.MessageTypeSupport {
 	return PoseWithCovarianceTypeSupport
 }
 
+func (t *PoseWithCovariance) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *PoseWithCovariance) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *PoseWithCovariance) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *PoseWithCovariance) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *PoseWithCovariance) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *PoseWithCovariance) EncodeCDR(e *cdr.Encoder) {
+	t.Pose.EncodeCDR(e)
+	for i := range t.Covariance {
+		e.Float64(t.Covariance[i])
+	}
+}
+
+func (t *PoseWithCovariance) DecodeCDR(d *cdr.Decoder) {
+	t.Pose.DecodeCDR(d)
+	for i := range t.Covariance {
+		t.Covariance[i] = d.Float64()
+	}
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *PoseWithCovariance) Validate() error {
+	if err := t.Pose.Validate(); err != nil {
+		return rclgo.WrapValidationError("pose", -1, err)
+	}
+	return nil
+}
+
 // PoseWithCovariancePublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type PoseWithCovariancePublisher struct {
@@ -121,6 +200,17 @@ func ClonePoseWithCovarianceSlice(dst, src []PoseWithCovariance) {
 	}
 }
 
+// PoseWithCovarianceDescriptor describes the fields of PoseWithCovariance. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var PoseWithCovarianceDescriptor = &types.MessageDescriptor{
+	TypeName: "geometry_msgs/msg/PoseWithCovariance",
+	Fields: []types.FieldDescriptor{
+		{Name: "pose", Type: "geometry_msgs/msg/Pose", GoIndex: 0, Message: PoseDescriptor},
+		{Name: "covariance", Type: "float64", GoIndex: 1, IsArray: true, ArraySize: 36},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var PoseWithCovarianceTypeSupport types.MessageTypeSupport = _PoseWithCovarianceTypeSupport{}
 
@@ -158,6 +248,41 @@ func (t _PoseWithCovarianceTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__geometry_msgs__msg__PoseWithCovariance())
 }
 
+func (t _PoseWithCovarianceTypeSupport) Descriptor() *types.MessageDescriptor {
+	return PoseWithCovarianceDescriptor
+}
+
+func (t _PoseWithCovarianceTypeSupport) TypeHash() string {
+	return `RIHS01_9a7c0fd234b7f45c6098745ecccd773ca1085670e64107135397aee31c02e1bb`
+}
+
+func (t _PoseWithCovarianceTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "geometry_msgs/msg/PoseWithCovariance", "fields": [{"name": "pose", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "geometry_msgs/msg/Pose"}, "default_value": ""}, {"name": "covariance", "type": {"type_id": 59, "capacity": 36, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "geometry_msgs/msg/Point", "fields": [{"name": "x", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "y", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "z", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "geometry_msgs/msg/Pose", "fields": [{"name": "position", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "geometry_msgs/msg/Point"}, "default_value": ""}, {"name": "orientation", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "geometry_msgs/msg/Quaternion"}, "default_value": ""}]}, {"type_name": "geometry_msgs/msg/Quaternion", "fields": [{"name": "x", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "0"}, {"name": "y", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "0"}, {"name": "z", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "0"}, {"name": "w", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "1"}]}]}`
+}
+
+func (t _PoseWithCovarianceTypeSupport) MessageDefinition() string {
+	return `Pose pose
+# Row-major representation of the 6x6 covariance matrixThe orientation parameters use a fixed-axis representation.In order, the parameters are:(x, y, z, rotation about X axis, rotation about Y axis, rotation about Z axis)
+float64[36] covariance
+================================================================================
+MSG: geometry_msgs/msg/Pose
+Point position
+Quaternion orientation
+================================================================================
+MSG: geometry_msgs/msg/Point
+# This contains the position of a point in free space
+float64 x
+float64 y
+float64 z
+================================================================================
+MSG: geometry_msgs/msg/Quaternion
+float64 x 0
+float64 y 0
+float64 z 0
+float64 w 1
+`
+}
+
 type CPoseWithCovariance = C.geometry_msgs__msg__PoseWithCovariance
 type CPoseWithCovariance__Sequence = C.geometry_msgs__msg__PoseWithCovariance__Sequence
 
@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	std_msgs_msg "github.com/tiiuae/rclgo/internal/msgs/std_msgs/msg"
@@ -55,6 +57,38 @@ func (t *PoseWithCovarianceStamped) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *PoseWithCovarianceStamped) Equal(other *PoseWithCovarianceStamped) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *PoseWithCovarianceStamped) EqualApprox(other *PoseWithCovarianceStamped, tol float64) bool {
+	if !t.Header.EqualApprox(&other.Header, tol) {
+		return false
+	}
+	if !t.Pose.EqualApprox(&other.Pose, tol) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *PoseWithCovarianceStamped) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *PoseWithCovarianceStamped) HashTo(h *msgcmp.Hasher) {
+	t.Header.HashTo(h)
+	t.Pose.HashTo(h)
+}
+
 func (t *PoseWithCovarianceStamped) SetDefaults() {
 	t.Header.SetDefaults()
 	t.Pose.SetDefaults()
@@ -64,6 +98,48 @@ func (t *PoseWithCovarianceStamped) GetTypeSupport() types.MessageTypeSupport {
 	return PoseWithCovarianceStampedTypeSupport
 }
 
+func (t *PoseWithCovarianceStamped) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *PoseWithCovarianceStamped) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *PoseWithCovarianceStamped) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *PoseWithCovarianceStamped) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *PoseWithCovarianceStamped) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *PoseWithCovarianceStamped) EncodeCDR(e *cdr.Encoder) {
+	t.Header.EncodeCDR(e)
+	t.Pose.EncodeCDR(e)
+}
+
+func (t *PoseWithCovarianceStamped) DecodeCDR(d *cdr.Decoder) {
+	t.Header.DecodeCDR(d)
+	t.Pose.DecodeCDR(d)
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *PoseWithCovarianceStamped) Validate() error {
+	if err := t.Header.Validate(); err != nil {
+		return rclgo.WrapValidationError("header", -1, err)
+	}
+	if err := t.Pose.Validate(); err != nil {
+		return rclgo.WrapValidationError("pose", -1, err)
+	}
+	return nil
+}
+
 // PoseWithCovarianceStampedPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type PoseWithCovarianceStampedPublisher struct {
@@ -121,6 +197,17 @@ func ClonePoseWithCovarianceStampedSlice(dst, src []PoseWithCovarianceStamped) {
 	}
 }
 
+// PoseWithCovarianceStampedDescriptor describes the fields of PoseWithCovarianceStamped. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var PoseWithCovarianceStampedDescriptor = &types.MessageDescriptor{
+	TypeName: "geometry_msgs/msg/PoseWithCovarianceStamped",
+	Fields: []types.FieldDescriptor{
+		{Name: "header", Type: "std_msgs/msg/Header", GoIndex: 0, Message: std_msgs_msg.HeaderDescriptor},
+		{Name: "pose", Type: "geometry_msgs/msg/PoseWithCovariance", GoIndex: 1, Message: PoseWithCovarianceDescriptor},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var PoseWithCovarianceStampedTypeSupport types.MessageTypeSupport = _PoseWithCovarianceStampedTypeSupport{}
 
@@ -156,6 +243,57 @@ func (t _PoseWithCovarianceStampedTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__geometry_msgs__msg__PoseWithCovarianceStamped())
 }
 
+func (t _PoseWithCovarianceStampedTypeSupport) Descriptor() *types.MessageDescriptor {
+	return PoseWithCovarianceStampedDescriptor
+}
+
+func (t _PoseWithCovarianceStampedTypeSupport) TypeHash() string {
+	return `RIHS01_26432f9803e43727d3c8f668d1fdb3c630f548af631e2f4e31382371bfea3b6e`
+}
+
+func (t _PoseWithCovarianceStampedTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "geometry_msgs/msg/PoseWithCovarianceStamped", "fields": [{"name": "header", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "std_msgs/msg/Header"}, "default_value": ""}, {"name": "pose", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "geometry_msgs/msg/PoseWithCovariance"}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "builtin_interfaces/msg/Time", "fields": [{"name": "sec", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "nanosec", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "geometry_msgs/msg/Point", "fields": [{"name": "x", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "y", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "z", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "geometry_msgs/msg/Pose", "fields": [{"name": "position", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "geometry_msgs/msg/Point"}, "default_value": ""}, {"name": "orientation", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "geometry_msgs/msg/Quaternion"}, "default_value": ""}]}, {"type_name": "geometry_msgs/msg/PoseWithCovariance", "fields": [{"name": "pose", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "geometry_msgs/msg/Pose"}, "default_value": ""}, {"name": "covariance", "type": {"type_id": 59, "capacity": 36, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "geometry_msgs/msg/Quaternion", "fields": [{"name": "x", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "0"}, {"name": "y", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "0"}, {"name": "z", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "0"}, {"name": "w", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "1"}]}, {"type_name": "std_msgs/msg/Header", "fields": [{"name": "stamp", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "builtin_interfaces/msg/Time"}, "default_value": ""}, {"name": "frame_id", "type": {"type_id": 17, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}]}`
+}
+
+func (t _PoseWithCovarianceStampedTypeSupport) MessageDefinition() string {
+	return `std_msgs/Header header
+PoseWithCovariance pose
+================================================================================
+MSG: std_msgs/msg/Header
+# Two-integer timestamp that is expressed as seconds and nanoseconds.
+builtin_interfaces/Time stamp
+# Transform frame with which this data is associated.
+string frame_id
+================================================================================
+MSG: geometry_msgs/msg/PoseWithCovariance
+Pose pose
+# Row-major representation of the 6x6 covariance matrixThe orientation parameters use a fixed-axis representation.In order, the parameters are:(x, y, z, rotation about X axis, rotation about Y axis, rotation about Z axis)
+float64[36] covariance
+================================================================================
+MSG: builtin_interfaces/msg/Time
+# The seconds component, valid over all int32 values.
+int32 sec
+# The nanoseconds component, valid in the range [0, 10e9).
+uint32 nanosec
+================================================================================
+MSG: geometry_msgs/msg/Pose
+Point position
+Quaternion orientation
+================================================================================
+MSG: geometry_msgs/msg/Point
+# This contains the position of a point in free space
+float64 x
+float64 y
+float64 z
+================================================================================
+MSG: geometry_msgs/msg/Quaternion
+float64 x 0
+float64 y 0
+float64 z 0
+float64 w 1
+`
+}
+
 type CPoseWithCovarianceStamped = C.geometry_msgs__msg__PoseWithCovarianceStamped
 type CPoseWithCovarianceStamped__Sequence = C.geometry_msgs__msg__PoseWithCovarianceStamped__Sequence
 
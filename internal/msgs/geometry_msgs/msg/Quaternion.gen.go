@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	
@@ -58,6 +60,46 @@ func (t *Quaternion) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *Quaternion) Equal(other *Quaternion) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *Quaternion) EqualApprox(other *Quaternion, tol float64) bool {
+	if !msgcmp.Float64(t.X, other.X, tol) {
+		return false
+	}
+	if !msgcmp.Float64(t.Y, other.Y, tol) {
+		return false
+	}
+	if !msgcmp.Float64(t.Z, other.Z, tol) {
+		return false
+	}
+	if !msgcmp.Float64(t.W, other.W, tol) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *Quaternion) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *Quaternion) HashTo(h *msgcmp.Hasher) {
+	h.Float64(t.X)
+	h.Float64(t.Y)
+	h.Float64(t.Z)
+	h.Float64(t.W)
+}
+
 func (t *Quaternion) SetDefaults() {
 	t.X = 0
 	t.Y = 0
@@ -69,6 +111,46 @@ func (t *Quaternion) GetTypeSupport() types.MessageTypeSupport {
 	return QuaternionTypeSupport
 }
 
+func (t *Quaternion) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *Quaternion) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *Quaternion) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *Quaternion) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *Quaternion) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *Quaternion) EncodeCDR(e *cdr.Encoder) {
+	e.Float64(t.X)
+	e.Float64(t.Y)
+	e.Float64(t.Z)
+	e.Float64(t.W)
+}
+
+func (t *Quaternion) DecodeCDR(d *cdr.Decoder) {
+	t.X = d.Float64()
+	t.Y = d.Float64()
+	t.Z = d.Float64()
+	t.W = d.Float64()
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *Quaternion) Validate() error {
+	return nil
+}
+
 // QuaternionPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type QuaternionPublisher struct {
@@ -126,6 +208,19 @@ func CloneQuaternionSlice(dst, src []Quaternion) {
 	}
 }
 
+// QuaternionDescriptor describes the fields of Quaternion. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var QuaternionDescriptor = &types.MessageDescriptor{
+	TypeName: "geometry_msgs/msg/Quaternion",
+	Fields: []types.FieldDescriptor{
+		{Name: "x", Type: "float64", GoIndex: 0},
+		{Name: "y", Type: "float64", GoIndex: 1},
+		{Name: "z", Type: "float64", GoIndex: 2},
+		{Name: "w", Type: "float64", GoIndex: 3},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var QuaternionTypeSupport types.MessageTypeSupport = _QuaternionTypeSupport{}
 
@@ -165,6 +260,26 @@ func (t _QuaternionTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__geometry_msgs__msg__Quaternion())
 }
 
+func (t _QuaternionTypeSupport) Descriptor() *types.MessageDescriptor {
+	return QuaternionDescriptor
+}
+
+func (t _QuaternionTypeSupport) TypeHash() string {
+	return `RIHS01_8a765f66778c8ff7c8ab94afcc590a2ed5325a1d9a076ffff38fbce36f458684`
+}
+
+func (t _QuaternionTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "geometry_msgs/msg/Quaternion", "fields": [{"name": "x", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "0"}, {"name": "y", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "0"}, {"name": "z", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "0"}, {"name": "w", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "1"}]}, "referenced_type_descriptions": []}`
+}
+
+func (t _QuaternionTypeSupport) MessageDefinition() string {
+	return `float64 x 0
+float64 y 0
+float64 z 0
+float64 w 1
+`
+}
+
 type CQuaternion = C.geometry_msgs__msg__Quaternion
 type CQuaternion__Sequence = C.geometry_msgs__msg__Quaternion__Sequence
 
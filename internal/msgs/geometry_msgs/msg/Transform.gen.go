@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	
@@ -54,6 +56,38 @@ func (t *Transform) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *Transform) Equal(other *Transform) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *Transform) EqualApprox(other *Transform, tol float64) bool {
+	if !t.Translation.EqualApprox(&other.Translation, tol) {
+		return false
+	}
+	if !t.Rotation.EqualApprox(&other.Rotation, tol) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *Transform) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *Transform) HashTo(h *msgcmp.Hasher) {
+	t.Translation.HashTo(h)
+	t.Rotation.HashTo(h)
+}
+
 func (t *Transform) SetDefaults() {
 	t.Translation.SetDefaults()
 	t.Rotation.SetDefaults()
@@ -63,6 +97,48 @@ func (t *Transform) GetTypeSupport() types.MessageTypeSupport {
 	return TransformTypeSupport
 }
 
+func (t *Transform) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *Transform) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *Transform) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *Transform) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *Transform) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *Transform) EncodeCDR(e *cdr.Encoder) {
+	t.Translation.EncodeCDR(e)
+	t.Rotation.EncodeCDR(e)
+}
+
+func (t *Transform) DecodeCDR(d *cdr.Decoder) {
+	t.Translation.DecodeCDR(d)
+	t.Rotation.DecodeCDR(d)
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *Transform) Validate() error {
+	if err := t.Translation.Validate(); err != nil {
+		return rclgo.WrapValidationError("translation", -1, err)
+	}
+	if err := t.Rotation.Validate(); err != nil {
+		return rclgo.WrapValidationError("rotation", -1, err)
+	}
+	return nil
+}
+
 // TransformPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type TransformPublisher struct {
@@ -120,6 +196,17 @@ func CloneTransformSlice(dst, src []Transform) {
 	}
 }
 
+// TransformDescriptor describes the fields of Transform. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var TransformDescriptor = &types.MessageDescriptor{
+	TypeName: "geometry_msgs/msg/Transform",
+	Fields: []types.FieldDescriptor{
+		{Name: "translation", Type: "geometry_msgs/msg/Vector3", GoIndex: 0, Message: Vector3Descriptor},
+		{Name: "rotation", Type: "geometry_msgs/msg/Quaternion", GoIndex: 1, Message: QuaternionDescriptor},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var TransformTypeSupport types.MessageTypeSupport = _TransformTypeSupport{}
 
@@ -155,6 +242,35 @@ func (t _TransformTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__geometry_msgs__msg__Transform())
 }
 
+func (t _TransformTypeSupport) Descriptor() *types.MessageDescriptor {
+	return TransformDescriptor
+}
+
+func (t _TransformTypeSupport) TypeHash() string {
+	return `RIHS01_beb83fbe698636351461f6f35d1abb20010c43d55374d81bd041f1ba2581fddc`
+}
+
+func (t _TransformTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "geometry_msgs/msg/Transform", "fields": [{"name": "translation", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "geometry_msgs/msg/Vector3"}, "default_value": ""}, {"name": "rotation", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "geometry_msgs/msg/Quaternion"}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "geometry_msgs/msg/Quaternion", "fields": [{"name": "x", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "0"}, {"name": "y", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "0"}, {"name": "z", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "0"}, {"name": "w", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "1"}]}, {"type_name": "geometry_msgs/msg/Vector3", "fields": [{"name": "x", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "y", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "z", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}]}`
+}
+
+func (t _TransformTypeSupport) MessageDefinition() string {
+	return `Vector3 translation
+Quaternion rotation
+================================================================================
+MSG: geometry_msgs/msg/Vector3
+float64 x
+float64 y
+float64 z
+================================================================================
+MSG: geometry_msgs/msg/Quaternion
+float64 x 0
+float64 y 0
+float64 z 0
+float64 w 1
+`
+}
+
 type CTransform = C.geometry_msgs__msg__Transform
 type CTransform__Sequence = C.geometry_msgs__msg__Transform__Sequence
 
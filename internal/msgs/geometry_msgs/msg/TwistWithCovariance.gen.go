@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	primitives "github.com/tiiuae/rclgo/pkg/rclgo/primitives"
@@ -55,6 +57,40 @@ func (t *TwistWithCovariance) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *TwistWithCovariance) Equal(other *TwistWithCovariance) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *TwistWithCovariance) EqualApprox(other *TwistWithCovariance, tol float64) bool {
+	if !t.Twist.EqualApprox(&other.Twist, tol) {
+		return false
+	}
+	if !msgcmp.Float64s(t.Covariance[:], other.Covariance[:], tol) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *TwistWithCovariance) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *TwistWithCovariance) HashTo(h *msgcmp.Hasher) {
+	t.Twist.HashTo(h)
+	for i := range t.Covariance {
+		h.Float64(t.Covariance[i])
+	}
+}
+
 func (t *TwistWithCovariance) SetDefaults() {
 	t.Twist.SetDefaults()
 	t.Covariance = [36]float64{}
@@ -64,6 +100,49 @@ func (t *TwistWithCovariance) GetTypeSupport() types.MessageTypeSupport {
 	return TwistWithCovarianceTypeSupport
 }
 
+func (t *TwistWithCovariance) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *TwistWithCovariance) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *TwistWithCovariance) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *TwistWithCovariance) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *TwistWithCovariance) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *TwistWithCovariance) EncodeCDR(e *cdr.Encoder) {
+	t.Twist.EncodeCDR(e)
+	for i := range t.Covariance {
+		e.Float64(t.Covariance[i])
+	}
+}
+
+func (t *TwistWithCovariance) DecodeCDR(d *cdr.Decoder) {
+	t.Twist.DecodeCDR(d)
+	for i := range t.Covariance {
+		t.Covariance[i] = d.Float64()
+	}
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *TwistWithCovariance) Validate() error {
+	if err := t.Twist.Validate(); err != nil {
+		return rclgo.WrapValidationError("twist", -1, err)
+	}
+	return nil
+}
+
 // TwistWithCovariancePublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type TwistWithCovariancePublisher struct {
@@ -121,6 +200,17 @@ func CloneTwistWithCovarianceSlice(dst, src []TwistWithCovariance) {
 	}
 }
 
+// TwistWithCovarianceDescriptor describes the fields of TwistWithCovariance. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var TwistWithCovarianceDescriptor = &types.MessageDescriptor{
+	TypeName: "geometry_msgs/msg/TwistWithCovariance",
+	Fields: []types.FieldDescriptor{
+		{Name: "twist", Type: "geometry_msgs/msg/Twist", GoIndex: 0, Message: TwistDescriptor},
+		{Name: "covariance", Type: "float64", GoIndex: 1, IsArray: true, ArraySize: 36},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var TwistWithCovarianceTypeSupport types.MessageTypeSupport = _TwistWithCovarianceTypeSupport{}
 
@@ -158,6 +248,34 @@ func (t _TwistWithCovarianceTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__geometry_msgs__msg__TwistWithCovariance())
 }
 
+func (t _TwistWithCovarianceTypeSupport) Descriptor() *types.MessageDescriptor {
+	return TwistWithCovarianceDescriptor
+}
+
+func (t _TwistWithCovarianceTypeSupport) TypeHash() string {
+	return `RIHS01_49f574f033f095d8b6cd1beaca5ca7925e296e84af1716d16c89d38b059c8c18`
+}
+
+func (t _TwistWithCovarianceTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "geometry_msgs/msg/TwistWithCovariance", "fields": [{"name": "twist", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "geometry_msgs/msg/Twist"}, "default_value": ""}, {"name": "covariance", "type": {"type_id": 59, "capacity": 36, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "geometry_msgs/msg/Twist", "fields": [{"name": "linear", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "geometry_msgs/msg/Vector3"}, "default_value": ""}, {"name": "angular", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "geometry_msgs/msg/Vector3"}, "default_value": ""}]}, {"type_name": "geometry_msgs/msg/Vector3", "fields": [{"name": "x", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "y", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "z", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}]}`
+}
+
+func (t _TwistWithCovarianceTypeSupport) MessageDefinition() string {
+	return `Twist twist
+# Row-major representation of the 6x6 covariance matrixThe orientation parameters use a fixed-axis representation.In order, the parameters are:(x, y, z, rotation about X axis, rotation about Y axis, rotation about Z axis)
+float64[36] covariance
+================================================================================
+MSG: geometry_msgs/msg/Twist
+Vector3 linear
+Vector3 angular
+================================================================================
+MSG: geometry_msgs/msg/Vector3
+float64 x
+float64 y
+float64 z
+`
+}
+
 type CTwistWithCovariance = C.geometry_msgs__msg__TwistWithCovariance
 type CTwistWithCovariance__Sequence = C.geometry_msgs__msg__TwistWithCovariance__Sequence
 
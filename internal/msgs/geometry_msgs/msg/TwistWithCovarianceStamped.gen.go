@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	std_msgs_msg "github.com/tiiuae/rclgo/internal/msgs/std_msgs/msg"
@@ -55,6 +57,38 @@ func (t *TwistWithCovarianceStamped) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *TwistWithCovarianceStamped) Equal(other *TwistWithCovarianceStamped) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *TwistWithCovarianceStamped) EqualApprox(other *TwistWithCovarianceStamped, tol float64) bool {
+	if !t.Header.EqualApprox(&other.Header, tol) {
+		return false
+	}
+	if !t.Twist.EqualApprox(&other.Twist, tol) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *TwistWithCovarianceStamped) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *TwistWithCovarianceStamped) HashTo(h *msgcmp.Hasher) {
+	t.Header.HashTo(h)
+	t.Twist.HashTo(h)
+}
+
 func (t *TwistWithCovarianceStamped) SetDefaults() {
 	t.Header.SetDefaults()
 	t.Twist.SetDefaults()
@@ -64,6 +98,48 @@ func (t *TwistWithCovarianceStamped) GetTypeSupport() types.MessageTypeSupport {
 	return TwistWithCovarianceStampedTypeSupport
 }
 
+func (t *TwistWithCovarianceStamped) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *TwistWithCovarianceStamped) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *TwistWithCovarianceStamped) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *TwistWithCovarianceStamped) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *TwistWithCovarianceStamped) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *TwistWithCovarianceStamped) EncodeCDR(e *cdr.Encoder) {
+	t.Header.EncodeCDR(e)
+	t.Twist.EncodeCDR(e)
+}
+
+func (t *TwistWithCovarianceStamped) DecodeCDR(d *cdr.Decoder) {
+	t.Header.DecodeCDR(d)
+	t.Twist.DecodeCDR(d)
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *TwistWithCovarianceStamped) Validate() error {
+	if err := t.Header.Validate(); err != nil {
+		return rclgo.WrapValidationError("header", -1, err)
+	}
+	if err := t.Twist.Validate(); err != nil {
+		return rclgo.WrapValidationError("twist", -1, err)
+	}
+	return nil
+}
+
 // TwistWithCovarianceStampedPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type TwistWithCovarianceStampedPublisher struct {
@@ -121,6 +197,17 @@ func CloneTwistWithCovarianceStampedSlice(dst, src []TwistWithCovarianceStamped)
 	}
 }
 
+// TwistWithCovarianceStampedDescriptor describes the fields of TwistWithCovarianceStamped. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var TwistWithCovarianceStampedDescriptor = &types.MessageDescriptor{
+	TypeName: "geometry_msgs/msg/TwistWithCovarianceStamped",
+	Fields: []types.FieldDescriptor{
+		{Name: "header", Type: "std_msgs/msg/Header", GoIndex: 0, Message: std_msgs_msg.HeaderDescriptor},
+		{Name: "twist", Type: "geometry_msgs/msg/TwistWithCovariance", GoIndex: 1, Message: TwistWithCovarianceDescriptor},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var TwistWithCovarianceStampedTypeSupport types.MessageTypeSupport = _TwistWithCovarianceStampedTypeSupport{}
 
@@ -156,6 +243,50 @@ func (t _TwistWithCovarianceStampedTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__geometry_msgs__msg__TwistWithCovarianceStamped())
 }
 
+func (t _TwistWithCovarianceStampedTypeSupport) Descriptor() *types.MessageDescriptor {
+	return TwistWithCovarianceStampedDescriptor
+}
+
+func (t _TwistWithCovarianceStampedTypeSupport) TypeHash() string {
+	return `RIHS01_77b67434531e6529b7a0091357b186b6ebdb17fd9ffd3e0c7ce9d3fb11a44563`
+}
+
+func (t _TwistWithCovarianceStampedTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "geometry_msgs/msg/TwistWithCovarianceStamped", "fields": [{"name": "header", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "std_msgs/msg/Header"}, "default_value": ""}, {"name": "twist", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "geometry_msgs/msg/TwistWithCovariance"}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "builtin_interfaces/msg/Time", "fields": [{"name": "sec", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "nanosec", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "geometry_msgs/msg/Twist", "fields": [{"name": "linear", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "geometry_msgs/msg/Vector3"}, "default_value": ""}, {"name": "angular", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "geometry_msgs/msg/Vector3"}, "default_value": ""}]}, {"type_name": "geometry_msgs/msg/TwistWithCovariance", "fields": [{"name": "twist", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "geometry_msgs/msg/Twist"}, "default_value": ""}, {"name": "covariance", "type": {"type_id": 59, "capacity": 36, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "geometry_msgs/msg/Vector3", "fields": [{"name": "x", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "y", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "z", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "std_msgs/msg/Header", "fields": [{"name": "stamp", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "builtin_interfaces/msg/Time"}, "default_value": ""}, {"name": "frame_id", "type": {"type_id": 17, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}]}`
+}
+
+func (t _TwistWithCovarianceStampedTypeSupport) MessageDefinition() string {
+	return `std_msgs/Header header
+TwistWithCovariance twist
+================================================================================
+MSG: std_msgs/msg/Header
+# Two-integer timestamp that is expressed as seconds and nanoseconds.
+builtin_interfaces/Time stamp
+# Transform frame with which this data is associated.
+string frame_id
+================================================================================
+MSG: geometry_msgs/msg/TwistWithCovariance
+Twist twist
+# Row-major representation of the 6x6 covariance matrixThe orientation parameters use a fixed-axis representation.In order, the parameters are:(x, y, z, rotation about X axis, rotation about Y axis, rotation about Z axis)
+float64[36] covariance
+================================================================================
+MSG: builtin_interfaces/msg/Time
+# The seconds component, valid over all int32 values.
+int32 sec
+# The nanoseconds component, valid in the range [0, 10e9).
+uint32 nanosec
+================================================================================
+MSG: geometry_msgs/msg/Twist
+Vector3 linear
+Vector3 angular
+================================================================================
+MSG: geometry_msgs/msg/Vector3
+float64 x
+float64 y
+float64 z
+`
+}
+
 type CTwistWithCovarianceStamped = C.geometry_msgs__msg__TwistWithCovarianceStamped
 type CTwistWithCovarianceStamped__Sequence = C.geometry_msgs__msg__TwistWithCovarianceStamped__Sequence
 
@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	
@@ -56,6 +58,42 @@ func (t *Vector3) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *Vector3) Equal(other *Vector3) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *Vector3) EqualApprox(other *Vector3, tol float64) bool {
+	if !msgcmp.Float64(t.X, other.X, tol) {
+		return false
+	}
+	if !msgcmp.Float64(t.Y, other.Y, tol) {
+		return false
+	}
+	if !msgcmp.Float64(t.Z, other.Z, tol) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *Vector3) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *Vector3) HashTo(h *msgcmp.Hasher) {
+	h.Float64(t.X)
+	h.Float64(t.Y)
+	h.Float64(t.Z)
+}
+
 func (t *Vector3) SetDefaults() {
 	t.X = 0
 	t.Y = 0
@@ -66,6 +104,44 @@ func (t *Vector3) GetTypeSupport() types.MessageTypeSupport {
 	return Vector3TypeSupport
 }
 
+func (t *Vector3) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *Vector3) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *Vector3) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *Vector3) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *Vector3) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *Vector3) EncodeCDR(e *cdr.Encoder) {
+	e.Float64(t.X)
+	e.Float64(t.Y)
+	e.Float64(t.Z)
+}
+
+func (t *Vector3) DecodeCDR(d *cdr.Decoder) {
+	t.X = d.Float64()
+	t.Y = d.Float64()
+	t.Z = d.Float64()
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *Vector3) Validate() error {
+	return nil
+}
+
 // Vector3Publisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type Vector3Publisher struct {
@@ -123,6 +199,18 @@ func CloneVector3Slice(dst, src []Vector3) {
 	}
 }
 
+// Vector3Descriptor describes the fields of Vector3. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var Vector3Descriptor = &types.MessageDescriptor{
+	TypeName: "geometry_msgs/msg/Vector3",
+	Fields: []types.FieldDescriptor{
+		{Name: "x", Type: "float64", GoIndex: 0},
+		{Name: "y", Type: "float64", GoIndex: 1},
+		{Name: "z", Type: "float64", GoIndex: 2},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var Vector3TypeSupport types.MessageTypeSupport = _Vector3TypeSupport{}
 
@@ -160,6 +248,25 @@ func (t _Vector3TypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__geometry_msgs__msg__Vector3())
 }
 
+func (t _Vector3TypeSupport) Descriptor() *types.MessageDescriptor {
+	return Vector3Descriptor
+}
+
+func (t _Vector3TypeSupport) TypeHash() string {
+	return `RIHS01_cc12fe83e4c02719f1ce8070bfd14aecd40f75a96696a67a2a1f37f7dbb0765d`
+}
+
+func (t _Vector3TypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "geometry_msgs/msg/Vector3", "fields": [{"name": "x", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "y", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "z", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": []}`
+}
+
+func (t _Vector3TypeSupport) MessageDefinition() string {
+	return `float64 x
+float64 y
+float64 z
+`
+}
+
 type CVector3 = C.geometry_msgs__msg__Vector3
 type CVector3__Sequence = C.geometry_msgs__msg__Vector3__Sequence
 
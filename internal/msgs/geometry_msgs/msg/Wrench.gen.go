@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	
@@ -54,6 +56,38 @@ func (t *Wrench) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *Wrench) Equal(other *Wrench) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *Wrench) EqualApprox(other *Wrench, tol float64) bool {
+	if !t.Force.EqualApprox(&other.Force, tol) {
+		return false
+	}
+	if !t.Torque.EqualApprox(&other.Torque, tol) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *Wrench) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *Wrench) HashTo(h *msgcmp.Hasher) {
+	t.Force.HashTo(h)
+	t.Torque.HashTo(h)
+}
+
 func (t *Wrench) SetDefaults() {
 	t.Force.SetDefaults()
 	t.Torque.SetDefaults()
@@ -63,6 +97,48 @@ func (t *Wrench) GetTypeSupport() types.MessageTypeSupport {
 	return WrenchTypeSupport
 }
 
+func (t *Wrench) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *Wrench) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *Wrench) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *Wrench) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *Wrench) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *Wrench) EncodeCDR(e *cdr.Encoder) {
+	t.Force.EncodeCDR(e)
+	t.Torque.EncodeCDR(e)
+}
+
+func (t *Wrench) DecodeCDR(d *cdr.Decoder) {
+	t.Force.DecodeCDR(d)
+	t.Torque.DecodeCDR(d)
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *Wrench) Validate() error {
+	if err := t.Force.Validate(); err != nil {
+		return rclgo.WrapValidationError("force", -1, err)
+	}
+	if err := t.Torque.Validate(); err != nil {
+		return rclgo.WrapValidationError("torque", -1, err)
+	}
+	return nil
+}
+
 // WrenchPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type WrenchPublisher struct {
@@ -120,6 +196,17 @@ func CloneWrenchSlice(dst, src []Wrench) {
 	}
 }
 
+// WrenchDescriptor describes the fields of Wrench. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var WrenchDescriptor = &types.MessageDescriptor{
+	TypeName: "geometry_msgs/msg/Wrench",
+	Fields: []types.FieldDescriptor{
+		{Name: "force", Type: "geometry_msgs/msg/Vector3", GoIndex: 0, Message: Vector3Descriptor},
+		{Name: "torque", Type: "geometry_msgs/msg/Vector3", GoIndex: 1, Message: Vector3Descriptor},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var WrenchTypeSupport types.MessageTypeSupport = _WrenchTypeSupport{}
 
@@ -155,6 +242,29 @@ func (t _WrenchTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__geometry_msgs__msg__Wrench())
 }
 
+func (t _WrenchTypeSupport) Descriptor() *types.MessageDescriptor {
+	return WrenchDescriptor
+}
+
+func (t _WrenchTypeSupport) TypeHash() string {
+	return `RIHS01_018e8519d57c16adbe97c9fe1460ef21fec7e31bc541de3d653a35895677ce52`
+}
+
+func (t _WrenchTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "geometry_msgs/msg/Wrench", "fields": [{"name": "force", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "geometry_msgs/msg/Vector3"}, "default_value": ""}, {"name": "torque", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "geometry_msgs/msg/Vector3"}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "geometry_msgs/msg/Vector3", "fields": [{"name": "x", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "y", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "z", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}]}`
+}
+
+func (t _WrenchTypeSupport) MessageDefinition() string {
+	return `Vector3 force
+Vector3 torque
+================================================================================
+MSG: geometry_msgs/msg/Vector3
+float64 x
+float64 y
+float64 z
+`
+}
+
 type CWrench = C.geometry_msgs__msg__Wrench
 type CWrench__Sequence = C.geometry_msgs__msg__Wrench__Sequence
 
@@ -14,10 +14,13 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	std_msgs_msg "github.com/tiiuae/rclgo/internal/msgs/std_msgs/msg"
 	primitives "github.com/tiiuae/rclgo/pkg/rclgo/primitives"
+	 "strconv"
 	
 )
 /*
@@ -56,6 +59,144 @@ const (
 	BatteryState_POWER_SUPPLY_TECHNOLOGY_LIMN uint8 = 6
 )
 
+// BatteryState_PowerSupplyStatus is the type of the BatteryState_POWER_SUPPLY_STATUS_* constants.
+type BatteryState_PowerSupplyStatus uint8
+
+// BatteryState_PowerSupplyStatusValues contains the distinct values of the
+// BatteryState_POWER_SUPPLY_STATUS_* constants.
+var BatteryState_PowerSupplyStatusValues = []BatteryState_PowerSupplyStatus{
+	BatteryState_PowerSupplyStatus(BatteryState_POWER_SUPPLY_STATUS_UNKNOWN),
+	BatteryState_PowerSupplyStatus(BatteryState_POWER_SUPPLY_STATUS_CHARGING),
+	BatteryState_PowerSupplyStatus(BatteryState_POWER_SUPPLY_STATUS_DISCHARGING),
+	BatteryState_PowerSupplyStatus(BatteryState_POWER_SUPPLY_STATUS_NOT_CHARGING),
+	BatteryState_PowerSupplyStatus(BatteryState_POWER_SUPPLY_STATUS_FULL),
+}
+
+// String returns the ROS name of the constant whose value is v.
+func (v BatteryState_PowerSupplyStatus) String() string {
+	switch v {
+	case BatteryState_PowerSupplyStatus(BatteryState_POWER_SUPPLY_STATUS_UNKNOWN):
+		return "POWER_SUPPLY_STATUS_UNKNOWN"
+	case BatteryState_PowerSupplyStatus(BatteryState_POWER_SUPPLY_STATUS_CHARGING):
+		return "POWER_SUPPLY_STATUS_CHARGING"
+	case BatteryState_PowerSupplyStatus(BatteryState_POWER_SUPPLY_STATUS_DISCHARGING):
+		return "POWER_SUPPLY_STATUS_DISCHARGING"
+	case BatteryState_PowerSupplyStatus(BatteryState_POWER_SUPPLY_STATUS_NOT_CHARGING):
+		return "POWER_SUPPLY_STATUS_NOT_CHARGING"
+	case BatteryState_PowerSupplyStatus(BatteryState_POWER_SUPPLY_STATUS_FULL):
+		return "POWER_SUPPLY_STATUS_FULL"
+	}
+	return "BatteryState_PowerSupplyStatus(" + strconv.FormatUint(uint64(v), 10) + ")"
+}
+
+// IsValid reports whether v is the value of one of the
+// BatteryState_POWER_SUPPLY_STATUS_* constants.
+func (v BatteryState_PowerSupplyStatus) IsValid() bool {
+	switch v {
+	case BatteryState_PowerSupplyStatus(BatteryState_POWER_SUPPLY_STATUS_UNKNOWN), BatteryState_PowerSupplyStatus(BatteryState_POWER_SUPPLY_STATUS_CHARGING), BatteryState_PowerSupplyStatus(BatteryState_POWER_SUPPLY_STATUS_DISCHARGING), BatteryState_PowerSupplyStatus(BatteryState_POWER_SUPPLY_STATUS_NOT_CHARGING), BatteryState_PowerSupplyStatus(BatteryState_POWER_SUPPLY_STATUS_FULL):
+		return true
+	}
+	return false
+}
+
+// BatteryState_PowerSupplyHealth is the type of the BatteryState_POWER_SUPPLY_HEALTH_* constants.
+type BatteryState_PowerSupplyHealth uint8
+
+// BatteryState_PowerSupplyHealthValues contains the distinct values of the
+// BatteryState_POWER_SUPPLY_HEALTH_* constants.
+var BatteryState_PowerSupplyHealthValues = []BatteryState_PowerSupplyHealth{
+	BatteryState_PowerSupplyHealth(BatteryState_POWER_SUPPLY_HEALTH_UNKNOWN),
+	BatteryState_PowerSupplyHealth(BatteryState_POWER_SUPPLY_HEALTH_GOOD),
+	BatteryState_PowerSupplyHealth(BatteryState_POWER_SUPPLY_HEALTH_OVERHEAT),
+	BatteryState_PowerSupplyHealth(BatteryState_POWER_SUPPLY_HEALTH_DEAD),
+	BatteryState_PowerSupplyHealth(BatteryState_POWER_SUPPLY_HEALTH_OVERVOLTAGE),
+	BatteryState_PowerSupplyHealth(BatteryState_POWER_SUPPLY_HEALTH_UNSPEC_FAILURE),
+	BatteryState_PowerSupplyHealth(BatteryState_POWER_SUPPLY_HEALTH_COLD),
+	BatteryState_PowerSupplyHealth(BatteryState_POWER_SUPPLY_HEALTH_WATCHDOG_TIMER_EXPIRE),
+	BatteryState_PowerSupplyHealth(BatteryState_POWER_SUPPLY_HEALTH_SAFETY_TIMER_EXPIRE),
+}
+
+// String returns the ROS name of the constant whose value is v.
+func (v BatteryState_PowerSupplyHealth) String() string {
+	switch v {
+	case BatteryState_PowerSupplyHealth(BatteryState_POWER_SUPPLY_HEALTH_UNKNOWN):
+		return "POWER_SUPPLY_HEALTH_UNKNOWN"
+	case BatteryState_PowerSupplyHealth(BatteryState_POWER_SUPPLY_HEALTH_GOOD):
+		return "POWER_SUPPLY_HEALTH_GOOD"
+	case BatteryState_PowerSupplyHealth(BatteryState_POWER_SUPPLY_HEALTH_OVERHEAT):
+		return "POWER_SUPPLY_HEALTH_OVERHEAT"
+	case BatteryState_PowerSupplyHealth(BatteryState_POWER_SUPPLY_HEALTH_DEAD):
+		return "POWER_SUPPLY_HEALTH_DEAD"
+	case BatteryState_PowerSupplyHealth(BatteryState_POWER_SUPPLY_HEALTH_OVERVOLTAGE):
+		return "POWER_SUPPLY_HEALTH_OVERVOLTAGE"
+	case BatteryState_PowerSupplyHealth(BatteryState_POWER_SUPPLY_HEALTH_UNSPEC_FAILURE):
+		return "POWER_SUPPLY_HEALTH_UNSPEC_FAILURE"
+	case BatteryState_PowerSupplyHealth(BatteryState_POWER_SUPPLY_HEALTH_COLD):
+		return "POWER_SUPPLY_HEALTH_COLD"
+	case BatteryState_PowerSupplyHealth(BatteryState_POWER_SUPPLY_HEALTH_WATCHDOG_TIMER_EXPIRE):
+		return "POWER_SUPPLY_HEALTH_WATCHDOG_TIMER_EXPIRE"
+	case BatteryState_PowerSupplyHealth(BatteryState_POWER_SUPPLY_HEALTH_SAFETY_TIMER_EXPIRE):
+		return "POWER_SUPPLY_HEALTH_SAFETY_TIMER_EXPIRE"
+	}
+	return "BatteryState_PowerSupplyHealth(" + strconv.FormatUint(uint64(v), 10) + ")"
+}
+
+// IsValid reports whether v is the value of one of the
+// BatteryState_POWER_SUPPLY_HEALTH_* constants.
+func (v BatteryState_PowerSupplyHealth) IsValid() bool {
+	switch v {
+	case BatteryState_PowerSupplyHealth(BatteryState_POWER_SUPPLY_HEALTH_UNKNOWN), BatteryState_PowerSupplyHealth(BatteryState_POWER_SUPPLY_HEALTH_GOOD), BatteryState_PowerSupplyHealth(BatteryState_POWER_SUPPLY_HEALTH_OVERHEAT), BatteryState_PowerSupplyHealth(BatteryState_POWER_SUPPLY_HEALTH_DEAD), BatteryState_PowerSupplyHealth(BatteryState_POWER_SUPPLY_HEALTH_OVERVOLTAGE), BatteryState_PowerSupplyHealth(BatteryState_POWER_SUPPLY_HEALTH_UNSPEC_FAILURE), BatteryState_PowerSupplyHealth(BatteryState_POWER_SUPPLY_HEALTH_COLD), BatteryState_PowerSupplyHealth(BatteryState_POWER_SUPPLY_HEALTH_WATCHDOG_TIMER_EXPIRE), BatteryState_PowerSupplyHealth(BatteryState_POWER_SUPPLY_HEALTH_SAFETY_TIMER_EXPIRE):
+		return true
+	}
+	return false
+}
+
+// BatteryState_PowerSupplyTechnology is the type of the BatteryState_POWER_SUPPLY_TECHNOLOGY_* constants.
+type BatteryState_PowerSupplyTechnology uint8
+
+// BatteryState_PowerSupplyTechnologyValues contains the distinct values of the
+// BatteryState_POWER_SUPPLY_TECHNOLOGY_* constants.
+var BatteryState_PowerSupplyTechnologyValues = []BatteryState_PowerSupplyTechnology{
+	BatteryState_PowerSupplyTechnology(BatteryState_POWER_SUPPLY_TECHNOLOGY_UNKNOWN),
+	BatteryState_PowerSupplyTechnology(BatteryState_POWER_SUPPLY_TECHNOLOGY_NIMH),
+	BatteryState_PowerSupplyTechnology(BatteryState_POWER_SUPPLY_TECHNOLOGY_LION),
+	BatteryState_PowerSupplyTechnology(BatteryState_POWER_SUPPLY_TECHNOLOGY_LIPO),
+	BatteryState_PowerSupplyTechnology(BatteryState_POWER_SUPPLY_TECHNOLOGY_LIFE),
+	BatteryState_PowerSupplyTechnology(BatteryState_POWER_SUPPLY_TECHNOLOGY_NICD),
+	BatteryState_PowerSupplyTechnology(BatteryState_POWER_SUPPLY_TECHNOLOGY_LIMN),
+}
+
+// String returns the ROS name of the constant whose value is v.
+func (v BatteryState_PowerSupplyTechnology) String() string {
+	switch v {
+	case BatteryState_PowerSupplyTechnology(BatteryState_POWER_SUPPLY_TECHNOLOGY_UNKNOWN):
+		return "POWER_SUPPLY_TECHNOLOGY_UNKNOWN"
+	case BatteryState_PowerSupplyTechnology(BatteryState_POWER_SUPPLY_TECHNOLOGY_NIMH):
+		return "POWER_SUPPLY_TECHNOLOGY_NIMH"
+	case BatteryState_PowerSupplyTechnology(BatteryState_POWER_SUPPLY_TECHNOLOGY_LION):
+		return "POWER_SUPPLY_TECHNOLOGY_LION"
+	case BatteryState_PowerSupplyTechnology(BatteryState_POWER_SUPPLY_TECHNOLOGY_LIPO):
+		return "POWER_SUPPLY_TECHNOLOGY_LIPO"
+	case BatteryState_PowerSupplyTechnology(BatteryState_POWER_SUPPLY_TECHNOLOGY_LIFE):
+		return "POWER_SUPPLY_TECHNOLOGY_LIFE"
+	case BatteryState_PowerSupplyTechnology(BatteryState_POWER_SUPPLY_TECHNOLOGY_NICD):
+		return "POWER_SUPPLY_TECHNOLOGY_NICD"
+	case BatteryState_PowerSupplyTechnology(BatteryState_POWER_SUPPLY_TECHNOLOGY_LIMN):
+		return "POWER_SUPPLY_TECHNOLOGY_LIMN"
+	}
+	return "BatteryState_PowerSupplyTechnology(" + strconv.FormatUint(uint64(v), 10) + ")"
+}
+
+// IsValid reports whether v is the value of one of the
+// BatteryState_POWER_SUPPLY_TECHNOLOGY_* constants.
+func (v BatteryState_PowerSupplyTechnology) IsValid() bool {
+	switch v {
+	case BatteryState_PowerSupplyTechnology(BatteryState_POWER_SUPPLY_TECHNOLOGY_UNKNOWN), BatteryState_PowerSupplyTechnology(BatteryState_POWER_SUPPLY_TECHNOLOGY_NIMH), BatteryState_PowerSupplyTechnology(BatteryState_POWER_SUPPLY_TECHNOLOGY_LION), BatteryState_PowerSupplyTechnology(BatteryState_POWER_SUPPLY_TECHNOLOGY_LIPO), BatteryState_PowerSupplyTechnology(BatteryState_POWER_SUPPLY_TECHNOLOGY_LIFE), BatteryState_PowerSupplyTechnology(BatteryState_POWER_SUPPLY_TECHNOLOGY_NICD), BatteryState_PowerSupplyTechnology(BatteryState_POWER_SUPPLY_TECHNOLOGY_LIMN):
+		return true
+	}
+	return false
+}
+
 type BatteryState struct {
 	Header std_msgs_msg.Header `yaml:"header"`
 	Voltage float32 `yaml:"voltage"`// Voltage in Volts (Mandatory)
@@ -113,6 +254,100 @@ func (t *BatteryState) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *BatteryState) Equal(other *BatteryState) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *BatteryState) EqualApprox(other *BatteryState, tol float64) bool {
+	if !t.Header.EqualApprox(&other.Header, tol) {
+		return false
+	}
+	if !msgcmp.Float32(t.Voltage, other.Voltage, tol) {
+		return false
+	}
+	if !msgcmp.Float32(t.Temperature, other.Temperature, tol) {
+		return false
+	}
+	if !msgcmp.Float32(t.Current, other.Current, tol) {
+		return false
+	}
+	if !msgcmp.Float32(t.Charge, other.Charge, tol) {
+		return false
+	}
+	if !msgcmp.Float32(t.Capacity, other.Capacity, tol) {
+		return false
+	}
+	if !msgcmp.Float32(t.DesignCapacity, other.DesignCapacity, tol) {
+		return false
+	}
+	if !msgcmp.Float32(t.Percentage, other.Percentage, tol) {
+		return false
+	}
+	if t.PowerSupplyStatus != other.PowerSupplyStatus {
+		return false
+	}
+	if t.PowerSupplyHealth != other.PowerSupplyHealth {
+		return false
+	}
+	if t.PowerSupplyTechnology != other.PowerSupplyTechnology {
+		return false
+	}
+	if t.Present != other.Present {
+		return false
+	}
+	if !msgcmp.Float32s(t.CellVoltage, other.CellVoltage, tol) {
+		return false
+	}
+	if !msgcmp.Float32s(t.CellTemperature, other.CellTemperature, tol) {
+		return false
+	}
+	if t.Location != other.Location {
+		return false
+	}
+	if t.SerialNumber != other.SerialNumber {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *BatteryState) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *BatteryState) HashTo(h *msgcmp.Hasher) {
+	t.Header.HashTo(h)
+	h.Float32(t.Voltage)
+	h.Float32(t.Temperature)
+	h.Float32(t.Current)
+	h.Float32(t.Charge)
+	h.Float32(t.Capacity)
+	h.Float32(t.DesignCapacity)
+	h.Float32(t.Percentage)
+	h.Uint8(t.PowerSupplyStatus)
+	h.Uint8(t.PowerSupplyHealth)
+	h.Uint8(t.PowerSupplyTechnology)
+	h.Bool(t.Present)
+	h.Len(len(t.CellVoltage))
+	for i := range t.CellVoltage {
+		h.Float32(t.CellVoltage[i])
+	}
+	h.Len(len(t.CellTemperature))
+	for i := range t.CellTemperature {
+		h.Float32(t.CellTemperature[i])
+	}
+	h.String(t.Location)
+	h.String(t.SerialNumber)
+}
+
 func (t *BatteryState) SetDefaults() {
 	t.Header.SetDefaults()
 	t.Voltage = 0
@@ -136,6 +371,85 @@ func (t *BatteryState) GetTypeSupport() types.MessageTypeSupport {
 	return BatteryStateTypeSupport
 }
 
+func (t *BatteryState) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *BatteryState) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *BatteryState) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *BatteryState) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *BatteryState) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *BatteryState) EncodeCDR(e *cdr.Encoder) {
+	t.Header.EncodeCDR(e)
+	e.Float32(t.Voltage)
+	e.Float32(t.Temperature)
+	e.Float32(t.Current)
+	e.Float32(t.Charge)
+	e.Float32(t.Capacity)
+	e.Float32(t.DesignCapacity)
+	e.Float32(t.Percentage)
+	e.Uint8(t.PowerSupplyStatus)
+	e.Uint8(t.PowerSupplyHealth)
+	e.Uint8(t.PowerSupplyTechnology)
+	e.Bool(t.Present)
+	e.SequenceLength(len(t.CellVoltage), 0)
+	for i := range t.CellVoltage {
+		e.Float32(t.CellVoltage[i])
+	}
+	e.SequenceLength(len(t.CellTemperature), 0)
+	for i := range t.CellTemperature {
+		e.Float32(t.CellTemperature[i])
+	}
+	e.String(t.Location)
+	e.String(t.SerialNumber)
+}
+
+func (t *BatteryState) DecodeCDR(d *cdr.Decoder) {
+	t.Header.DecodeCDR(d)
+	t.Voltage = d.Float32()
+	t.Temperature = d.Float32()
+	t.Current = d.Float32()
+	t.Charge = d.Float32()
+	t.Capacity = d.Float32()
+	t.DesignCapacity = d.Float32()
+	t.Percentage = d.Float32()
+	t.PowerSupplyStatus = d.Uint8()
+	t.PowerSupplyHealth = d.Uint8()
+	t.PowerSupplyTechnology = d.Uint8()
+	t.Present = d.Bool()
+	t.CellVoltage = cdr.MakeSlice[float32](d, 0)
+	for i := range t.CellVoltage {
+		t.CellVoltage[i] = d.Float32()
+	}
+	t.CellTemperature = cdr.MakeSlice[float32](d, 0)
+	for i := range t.CellTemperature {
+		t.CellTemperature[i] = d.Float32()
+	}
+	t.Location = d.String()
+	t.SerialNumber = d.String()
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *BatteryState) Validate() error {
+	if err := t.Header.Validate(); err != nil {
+		return rclgo.WrapValidationError("header", -1, err)
+	}
+	return nil
+}
+
 // BatteryStatePublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type BatteryStatePublisher struct {
@@ -193,6 +507,31 @@ func CloneBatteryStateSlice(dst, src []BatteryState) {
 	}
 }
 
+// BatteryStateDescriptor describes the fields of BatteryState. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var BatteryStateDescriptor = &types.MessageDescriptor{
+	TypeName: "sensor_msgs/msg/BatteryState",
+	Fields: []types.FieldDescriptor{
+		{Name: "header", Type: "std_msgs/msg/Header", GoIndex: 0, Message: std_msgs_msg.HeaderDescriptor},
+		{Name: "voltage", Type: "float32", GoIndex: 1},
+		{Name: "temperature", Type: "float32", GoIndex: 2},
+		{Name: "current", Type: "float32", GoIndex: 3},
+		{Name: "charge", Type: "float32", GoIndex: 4},
+		{Name: "capacity", Type: "float32", GoIndex: 5},
+		{Name: "design_capacity", Type: "float32", GoIndex: 6},
+		{Name: "percentage", Type: "float32", GoIndex: 7},
+		{Name: "power_supply_status", Type: "uint8", GoIndex: 8},
+		{Name: "power_supply_health", Type: "uint8", GoIndex: 9},
+		{Name: "power_supply_technology", Type: "uint8", GoIndex: 10},
+		{Name: "present", Type: "bool", GoIndex: 11},
+		{Name: "cell_voltage", Type: "float32", GoIndex: 12, IsArray: true},
+		{Name: "cell_temperature", Type: "float32", GoIndex: 13, IsArray: true},
+		{Name: "location", Type: "string", GoIndex: 14},
+		{Name: "serial_number", Type: "string", GoIndex: 15},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var BatteryStateTypeSupport types.MessageTypeSupport = _BatteryStateTypeSupport{}
 
@@ -256,6 +595,90 @@ func (t _BatteryStateTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__sensor_msgs__msg__BatteryState())
 }
 
+func (t _BatteryStateTypeSupport) Descriptor() *types.MessageDescriptor {
+	return BatteryStateDescriptor
+}
+
+func (t _BatteryStateTypeSupport) TypeHash() string {
+	return `RIHS01_4bee5dfce981c98faa6828b868307a0a73f992ed0789f374ee96c8f840e69741`
+}
+
+func (t _BatteryStateTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "sensor_msgs/msg/BatteryState", "fields": [{"name": "header", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "std_msgs/msg/Header"}, "default_value": ""}, {"name": "voltage", "type": {"type_id": 10, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "temperature", "type": {"type_id": 10, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "current", "type": {"type_id": 10, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "charge", "type": {"type_id": 10, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "capacity", "type": {"type_id": 10, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "design_capacity", "type": {"type_id": 10, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "percentage", "type": {"type_id": 10, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "power_supply_status", "type": {"type_id": 3, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "power_supply_health", "type": {"type_id": 3, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "power_supply_technology", "type": {"type_id": 3, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "present", "type": {"type_id": 15, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "cell_voltage", "type": {"type_id": 154, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "cell_temperature", "type": {"type_id": 154, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "location", "type": {"type_id": 17, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "serial_number", "type": {"type_id": 17, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "builtin_interfaces/msg/Time", "fields": [{"name": "sec", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "nanosec", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "std_msgs/msg/Header", "fields": [{"name": "stamp", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "builtin_interfaces/msg/Time"}, "default_value": ""}, {"name": "frame_id", "type": {"type_id": 17, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}]}`
+}
+
+func (t _BatteryStateTypeSupport) MessageDefinition() string {
+	return `# Power supply status constants
+uint8 POWER_SUPPLY_STATUS_UNKNOWN=0
+uint8 POWER_SUPPLY_STATUS_CHARGING=1
+uint8 POWER_SUPPLY_STATUS_DISCHARGING=2
+uint8 POWER_SUPPLY_STATUS_NOT_CHARGING=3
+uint8 POWER_SUPPLY_STATUS_FULL=4
+# Power supply health constants
+uint8 POWER_SUPPLY_HEALTH_UNKNOWN=0
+uint8 POWER_SUPPLY_HEALTH_GOOD=1
+uint8 POWER_SUPPLY_HEALTH_OVERHEAT=2
+uint8 POWER_SUPPLY_HEALTH_DEAD=3
+uint8 POWER_SUPPLY_HEALTH_OVERVOLTAGE=4
+uint8 POWER_SUPPLY_HEALTH_UNSPEC_FAILURE=5
+uint8 POWER_SUPPLY_HEALTH_COLD=6
+uint8 POWER_SUPPLY_HEALTH_WATCHDOG_TIMER_EXPIRE=7
+uint8 POWER_SUPPLY_HEALTH_SAFETY_TIMER_EXPIRE=8
+# Power supply technology (chemistry) constants
+uint8 POWER_SUPPLY_TECHNOLOGY_UNKNOWN=0
+uint8 POWER_SUPPLY_TECHNOLOGY_NIMH=1
+uint8 POWER_SUPPLY_TECHNOLOGY_LION=2
+uint8 POWER_SUPPLY_TECHNOLOGY_LIPO=3
+uint8 POWER_SUPPLY_TECHNOLOGY_LIFE=4
+uint8 POWER_SUPPLY_TECHNOLOGY_NICD=5
+uint8 POWER_SUPPLY_TECHNOLOGY_LIMN=6
+
+std_msgs/Header header
+# Voltage in Volts (Mandatory)
+float32 voltage
+# Temperature in Degrees Celsius (If unmeasured NaN)
+float32 temperature
+# Negative when discharging (A)  (If unmeasured NaN)
+float32 current
+# Current charge in Ah  (If unmeasured NaN)
+float32 charge
+# Capacity in Ah (last full capacity)  (If unmeasured NaN)
+float32 capacity
+# Capacity in Ah (design capacity)  (If unmeasured NaN)
+float32 design_capacity
+# Charge percentage on 0 to 1 range  (If unmeasured NaN)
+float32 percentage
+# The charging status as reported. Values defined above
+uint8 power_supply_status
+# The battery health metric. Values defined above
+uint8 power_supply_health
+# The battery chemistry. Values defined above
+uint8 power_supply_technology
+# True if the battery is present
+bool present
+# An array of individual cell voltages for each cell in the pack
+float32[] cell_voltage
+# An array of individual cell temperatures for each cell in the pack. If individual voltages unknown but number of cells known set each to NaN
+float32[] cell_temperature
+# The location into which the battery is inserted. (slot number or plug). If individual temperatures unknown but number of cells known set each to NaN
+string location
+# The best approximation of the battery serial number
+string serial_number
+================================================================================
+MSG: std_msgs/msg/Header
+# Two-integer timestamp that is expressed as seconds and nanoseconds.
+builtin_interfaces/Time stamp
+# Transform frame with which this data is associated.
+string frame_id
+================================================================================
+MSG: builtin_interfaces/msg/Time
+# The seconds component, valid over all int32 values.
+int32 sec
+# The nanoseconds component, valid in the range [0, 10e9).
+uint32 nanosec
+`
+}
+
 type CBatteryState = C.sensor_msgs__msg__BatteryState
 type CBatteryState__Sequence = C.sensor_msgs__msg__BatteryState__Sequence
 
@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	std_msgs_msg "github.com/tiiuae/rclgo/internal/msgs/std_msgs/msg"
@@ -77,6 +79,83 @@ func (t *CameraInfo) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *CameraInfo) Equal(other *CameraInfo) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *CameraInfo) EqualApprox(other *CameraInfo, tol float64) bool {
+	if !t.Header.EqualApprox(&other.Header, tol) {
+		return false
+	}
+	if t.Height != other.Height {
+		return false
+	}
+	if t.Width != other.Width {
+		return false
+	}
+	if t.DistortionModel != other.DistortionModel {
+		return false
+	}
+	if !msgcmp.Float64s(t.D, other.D, tol) {
+		return false
+	}
+	if !msgcmp.Float64s(t.K[:], other.K[:], tol) {
+		return false
+	}
+	if !msgcmp.Float64s(t.R[:], other.R[:], tol) {
+		return false
+	}
+	if !msgcmp.Float64s(t.P[:], other.P[:], tol) {
+		return false
+	}
+	if t.BinningX != other.BinningX {
+		return false
+	}
+	if t.BinningY != other.BinningY {
+		return false
+	}
+	if !t.Roi.EqualApprox(&other.Roi, tol) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *CameraInfo) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *CameraInfo) HashTo(h *msgcmp.Hasher) {
+	t.Header.HashTo(h)
+	h.Uint32(t.Height)
+	h.Uint32(t.Width)
+	h.String(t.DistortionModel)
+	h.Len(len(t.D))
+	for i := range t.D {
+		h.Float64(t.D[i])
+	}
+	for i := range t.K {
+		h.Float64(t.K[i])
+	}
+	for i := range t.R {
+		h.Float64(t.R[i])
+	}
+	for i := range t.P {
+		h.Float64(t.P[i])
+	}
+	h.Uint32(t.BinningX)
+	h.Uint32(t.BinningY)
+	t.Roi.HashTo(h)
+}
+
 func (t *CameraInfo) SetDefaults() {
 	t.Header.SetDefaults()
 	t.Height = 0
@@ -95,6 +174,84 @@ func (t *CameraInfo) GetTypeSupport() types.MessageTypeSupport {
 	return CameraInfoTypeSupport
 }
 
+func (t *CameraInfo) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *CameraInfo) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *CameraInfo) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *CameraInfo) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *CameraInfo) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *CameraInfo) EncodeCDR(e *cdr.Encoder) {
+	t.Header.EncodeCDR(e)
+	e.Uint32(t.Height)
+	e.Uint32(t.Width)
+	e.String(t.DistortionModel)
+	e.SequenceLength(len(t.D), 0)
+	for i := range t.D {
+		e.Float64(t.D[i])
+	}
+	for i := range t.K {
+		e.Float64(t.K[i])
+	}
+	for i := range t.R {
+		e.Float64(t.R[i])
+	}
+	for i := range t.P {
+		e.Float64(t.P[i])
+	}
+	e.Uint32(t.BinningX)
+	e.Uint32(t.BinningY)
+	t.Roi.EncodeCDR(e)
+}
+
+func (t *CameraInfo) DecodeCDR(d *cdr.Decoder) {
+	t.Header.DecodeCDR(d)
+	t.Height = d.Uint32()
+	t.Width = d.Uint32()
+	t.DistortionModel = d.String()
+	t.D = cdr.MakeSlice[float64](d, 0)
+	for i := range t.D {
+		t.D[i] = d.Float64()
+	}
+	for i := range t.K {
+		t.K[i] = d.Float64()
+	}
+	for i := range t.R {
+		t.R[i] = d.Float64()
+	}
+	for i := range t.P {
+		t.P[i] = d.Float64()
+	}
+	t.BinningX = d.Uint32()
+	t.BinningY = d.Uint32()
+	t.Roi.DecodeCDR(d)
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *CameraInfo) Validate() error {
+	if err := t.Header.Validate(); err != nil {
+		return rclgo.WrapValidationError("header", -1, err)
+	}
+	if err := t.Roi.Validate(); err != nil {
+		return rclgo.WrapValidationError("roi", -1, err)
+	}
+	return nil
+}
+
 // CameraInfoPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type CameraInfoPublisher struct {
@@ -152,6 +309,26 @@ func CloneCameraInfoSlice(dst, src []CameraInfo) {
 	}
 }
 
+// CameraInfoDescriptor describes the fields of CameraInfo. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var CameraInfoDescriptor = &types.MessageDescriptor{
+	TypeName: "sensor_msgs/msg/CameraInfo",
+	Fields: []types.FieldDescriptor{
+		{Name: "header", Type: "std_msgs/msg/Header", GoIndex: 0, Message: std_msgs_msg.HeaderDescriptor},
+		{Name: "height", Type: "uint32", GoIndex: 1},
+		{Name: "width", Type: "uint32", GoIndex: 2},
+		{Name: "distortion_model", Type: "string", GoIndex: 3},
+		{Name: "d", Type: "float64", GoIndex: 4, IsArray: true},
+		{Name: "k", Type: "float64", GoIndex: 5, IsArray: true, ArraySize: 9},
+		{Name: "r", Type: "float64", GoIndex: 6, IsArray: true, ArraySize: 9},
+		{Name: "p", Type: "float64", GoIndex: 7, IsArray: true, ArraySize: 12},
+		{Name: "binning_x", Type: "uint32", GoIndex: 8},
+		{Name: "binning_y", Type: "uint32", GoIndex: 9},
+		{Name: "roi", Type: "sensor_msgs/msg/RegionOfInterest", GoIndex: 10, Message: RegionOfInterestDescriptor},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var CameraInfoTypeSupport types.MessageTypeSupport = _CameraInfoTypeSupport{}
 
@@ -211,6 +388,66 @@ func (t _CameraInfoTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__sensor_msgs__msg__CameraInfo())
 }
 
+func (t _CameraInfoTypeSupport) Descriptor() *types.MessageDescriptor {
+	return CameraInfoDescriptor
+}
+
+func (t _CameraInfoTypeSupport) TypeHash() string {
+	return `RIHS01_b3dfd68ff46c9d56c80fd3bd4ed22c7a4ddce8c8348f2f59c299e73118e7e275`
+}
+
+func (t _CameraInfoTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "sensor_msgs/msg/CameraInfo", "fields": [{"name": "header", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "std_msgs/msg/Header"}, "default_value": ""}, {"name": "height", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "width", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "distortion_model", "type": {"type_id": 17, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "d", "type": {"type_id": 155, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "k", "type": {"type_id": 59, "capacity": 9, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "r", "type": {"type_id": 59, "capacity": 9, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "p", "type": {"type_id": 59, "capacity": 12, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "binning_x", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "binning_y", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "roi", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "sensor_msgs/msg/RegionOfInterest"}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "builtin_interfaces/msg/Time", "fields": [{"name": "sec", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "nanosec", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "sensor_msgs/msg/RegionOfInterest", "fields": [{"name": "x_offset", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "y_offset", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "height", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "width", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "do_rectify", "type": {"type_id": 15, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "std_msgs/msg/Header", "fields": [{"name": "stamp", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "builtin_interfaces/msg/Time"}, "default_value": ""}, {"name": "frame_id", "type": {"type_id": 17, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}]}`
+}
+
+func (t _CameraInfoTypeSupport) MessageDefinition() string {
+	return `# Header timestamp should be acquisition time of image. Time of image acquisition, camera coordinate frame ID
+std_msgs/Header header
+# The image dimensions with which the camera was calibrated.Normally this will be the full camera resolution in pixels.
+uint32 height
+uint32 width
+# The distortion model used. Supported models are listed insensor_msgs/distortion_models.hpp. For most cameras, "plumb_bob" - asimple model of radial and tangential distortion - is sufficent.
+string distortion_model
+# The distortion parameters, size depending on the distortion model.For "plumb_bob", the 5 parameters are: (k1, k2, t1, t2, k3).
+float64[] d
+# 3x3 row-major matrix. Intrinsic camera matrix for the raw (distorted) images.[fx  0 cx]K = [ 0 fy cy][ 0  0  1]Projects 3D points in the camera coordinate frame to 2D pixelcoordinates using the focal lengths (fx, fy) and principal point(cx, cy).
+float64[9] k
+# 3x3 row-major matrix. Rectification matrix (stereo cameras only)A rotation matrix aligning the camera coordinate system to the idealstereo image plane so that epipolar lines in both stereo images areparallel.
+float64[9] r
+# 3x4 row-major matrix. Projection/camera matrix[fx'  0  cx' Tx]P = [ 0  fy' cy' Ty][ 0   0   1   0]By convention, this matrix specifies the intrinsic (camera) matrixof the processed (rectified) image. That is, the left 3x3 portionis the normal camera intrinsic matrix for the rectified image.It projects 3D points in the camera coordinate frame to 2D pixelcoordinates using the focal lengths (fx', fy') and principal point(cx', cy') - these may differ from the values in K.For monocular cameras, Tx = Ty = 0. Normally, monocular cameras willalso have R = the identity and P[1:3,1:3] = K.For a stereo pair, the fourth column [Tx Ty 0]' is related to theposition of the optical center of the second camera in the firstcamera's frame. We assume Tz = 0 so both cameras are in the samestereo image plane. The first camera always has Tx = Ty = 0. Forthe right (second) camera of a horizontal stereo pair, Ty = 0 andTx = -fx' * B, where B is the baseline between the cameras.Given a 3D point [X Y Z]', the projection (x, y) of the point ontothe rectified image is given by:[u v w]' = P * [X Y Z 1]'x = u / wy = v / wThis holds for both images of a stereo pair.
+float64[12] p
+# Binning refers here to any camera setting which combines rectangularneighborhoods of pixels into larger "super-pixels." It reduces theresolution of the output image to(width / binning_x) x (height / binning_y).The default values binning_x = binning_y = 0 is considered the sameas binning_x = binning_y = 1 (no subsampling).
+uint32 binning_x
+uint32 binning_y
+# Region of interest (subwindow of full camera resolution), given infull resolution (unbinned) image coordinates. A particular ROIalways denotes the same window of pixels on the camera sensor,regardless of binning settings.The default setting of roi (all values 0) is considered the same asfull resolution (roi.width = width, roi.height = height).
+RegionOfInterest roi
+================================================================================
+MSG: std_msgs/msg/Header
+# Two-integer timestamp that is expressed as seconds and nanoseconds.
+builtin_interfaces/Time stamp
+# Transform frame with which this data is associated.
+string frame_id
+================================================================================
+MSG: sensor_msgs/msg/RegionOfInterest
+# Leftmost pixel of the ROI
+uint32 x_offset
+# Topmost pixel of the ROI. (0 if the ROI includes the left edge of the image)
+uint32 y_offset
+# Height of ROI. (0 if the ROI includes the top edge of the image)
+uint32 height
+# Width of ROI
+uint32 width
+# True if a distinct rectified ROI should be calculated from the "raw"ROI in this message. Typically this should be False if the full imageis captured (ROI not used), and True if a subwindow is captured (ROIused).
+bool do_rectify
+================================================================================
+MSG: builtin_interfaces/msg/Time
+# The seconds component, valid over all int32 values.
+int32 sec
+# The nanoseconds component, valid in the range [0, 10e9).
+uint32 nanosec
+`
+}
+
 type CCameraInfo = C.sensor_msgs__msg__CameraInfo
 type CCameraInfo__Sequence = C.sensor_msgs__msg__CameraInfo__Sequence
 
@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	primitives "github.com/tiiuae/rclgo/pkg/rclgo/primitives"
@@ -58,6 +60,41 @@ func (t *ChannelFloat32) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *ChannelFloat32) Equal(other *ChannelFloat32) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *ChannelFloat32) EqualApprox(other *ChannelFloat32, tol float64) bool {
+	if t.Name != other.Name {
+		return false
+	}
+	if !msgcmp.Float32s(t.Values, other.Values, tol) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *ChannelFloat32) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *ChannelFloat32) HashTo(h *msgcmp.Hasher) {
+	h.String(t.Name)
+	h.Len(len(t.Values))
+	for i := range t.Values {
+		h.Float32(t.Values[i])
+	}
+}
+
 func (t *ChannelFloat32) SetDefaults() {
 	t.Name = ""
 	t.Values = nil
@@ -67,6 +104,48 @@ func (t *ChannelFloat32) GetTypeSupport() types.MessageTypeSupport {
 	return ChannelFloat32TypeSupport
 }
 
+func (t *ChannelFloat32) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *ChannelFloat32) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *ChannelFloat32) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *ChannelFloat32) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *ChannelFloat32) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *ChannelFloat32) EncodeCDR(e *cdr.Encoder) {
+	e.String(t.Name)
+	e.SequenceLength(len(t.Values), 0)
+	for i := range t.Values {
+		e.Float32(t.Values[i])
+	}
+}
+
+func (t *ChannelFloat32) DecodeCDR(d *cdr.Decoder) {
+	t.Name = d.String()
+	t.Values = cdr.MakeSlice[float32](d, 0)
+	for i := range t.Values {
+		t.Values[i] = d.Float32()
+	}
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *ChannelFloat32) Validate() error {
+	return nil
+}
+
 // ChannelFloat32Publisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type ChannelFloat32Publisher struct {
@@ -124,6 +203,17 @@ func CloneChannelFloat32Slice(dst, src []ChannelFloat32) {
 	}
 }
 
+// ChannelFloat32Descriptor describes the fields of ChannelFloat32. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var ChannelFloat32Descriptor = &types.MessageDescriptor{
+	TypeName: "sensor_msgs/msg/ChannelFloat32",
+	Fields: []types.FieldDescriptor{
+		{Name: "name", Type: "string", GoIndex: 0},
+		{Name: "values", Type: "float32", GoIndex: 1, IsArray: true},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var ChannelFloat32TypeSupport types.MessageTypeSupport = _ChannelFloat32TypeSupport{}
 
@@ -159,6 +249,26 @@ func (t _ChannelFloat32TypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__sensor_msgs__msg__ChannelFloat32())
 }
 
+func (t _ChannelFloat32TypeSupport) Descriptor() *types.MessageDescriptor {
+	return ChannelFloat32Descriptor
+}
+
+func (t _ChannelFloat32TypeSupport) TypeHash() string {
+	return `RIHS01_92665437ddf39346f4ba39ee32e648390605b633cc077d40f4bd4d7b58af6cd4`
+}
+
+func (t _ChannelFloat32TypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "sensor_msgs/msg/ChannelFloat32", "fields": [{"name": "name", "type": {"type_id": 17, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "values", "type": {"type_id": 154, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": []}`
+}
+
+func (t _ChannelFloat32TypeSupport) MessageDefinition() string {
+	return `# The channel name should give semantics of the channel (e.g."intensity" instead of "value").
+string name
+# The values array should be 1-1 with the elements of the associatedPointCloud.
+float32[] values
+`
+}
+
 type CChannelFloat32 = C.sensor_msgs__msg__ChannelFloat32
 type CChannelFloat32__Sequence = C.sensor_msgs__msg__ChannelFloat32__Sequence
 
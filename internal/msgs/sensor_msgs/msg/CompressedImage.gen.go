@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	std_msgs_msg "github.com/tiiuae/rclgo/internal/msgs/std_msgs/msg"
@@ -61,6 +63,45 @@ func (t *CompressedImage) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *CompressedImage) Equal(other *CompressedImage) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *CompressedImage) EqualApprox(other *CompressedImage, tol float64) bool {
+	if !t.Header.EqualApprox(&other.Header, tol) {
+		return false
+	}
+	if t.Format != other.Format {
+		return false
+	}
+	if !msgcmp.Slices(t.Data, other.Data) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *CompressedImage) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *CompressedImage) HashTo(h *msgcmp.Hasher) {
+	t.Header.HashTo(h)
+	h.String(t.Format)
+	h.Len(len(t.Data))
+	for i := range t.Data {
+		h.Uint8(t.Data[i])
+	}
+}
+
 func (t *CompressedImage) SetDefaults() {
 	t.Header.SetDefaults()
 	t.Format = ""
@@ -71,6 +112,49 @@ func (t *CompressedImage) GetTypeSupport() types.MessageTypeSupport {
 	return CompressedImageTypeSupport
 }
 
+func (t *CompressedImage) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *CompressedImage) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *CompressedImage) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *CompressedImage) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *CompressedImage) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *CompressedImage) EncodeCDR(e *cdr.Encoder) {
+	t.Header.EncodeCDR(e)
+	e.String(t.Format)
+	e.SequenceLength(len(t.Data), 0)
+	e.Uint8s(t.Data[:])
+}
+
+func (t *CompressedImage) DecodeCDR(d *cdr.Decoder) {
+	t.Header.DecodeCDR(d)
+	t.Format = d.String()
+	t.Data = cdr.MakeSlice[uint8](d, 0)
+	d.Uint8s(t.Data[:])
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *CompressedImage) Validate() error {
+	if err := t.Header.Validate(); err != nil {
+		return rclgo.WrapValidationError("header", -1, err)
+	}
+	return nil
+}
+
 // CompressedImagePublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type CompressedImagePublisher struct {
@@ -128,6 +212,18 @@ func CloneCompressedImageSlice(dst, src []CompressedImage) {
 	}
 }
 
+// CompressedImageDescriptor describes the fields of CompressedImage. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var CompressedImageDescriptor = &types.MessageDescriptor{
+	TypeName: "sensor_msgs/msg/CompressedImage",
+	Fields: []types.FieldDescriptor{
+		{Name: "header", Type: "std_msgs/msg/Header", GoIndex: 0, Message: std_msgs_msg.HeaderDescriptor},
+		{Name: "format", Type: "string", GoIndex: 1},
+		{Name: "data", Type: "uint8", GoIndex: 2, IsArray: true},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var CompressedImageTypeSupport types.MessageTypeSupport = _CompressedImageTypeSupport{}
 
@@ -165,6 +261,40 @@ func (t _CompressedImageTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__sensor_msgs__msg__CompressedImage())
 }
 
+func (t _CompressedImageTypeSupport) Descriptor() *types.MessageDescriptor {
+	return CompressedImageDescriptor
+}
+
+func (t _CompressedImageTypeSupport) TypeHash() string {
+	return `RIHS01_15640771531571185e2efc8a100baf923961a4d15d5569652e6cb6691e8e371a`
+}
+
+func (t _CompressedImageTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "sensor_msgs/msg/CompressedImage", "fields": [{"name": "header", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "std_msgs/msg/Header"}, "default_value": ""}, {"name": "format", "type": {"type_id": 17, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "data", "type": {"type_id": 147, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "builtin_interfaces/msg/Time", "fields": [{"name": "sec", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "nanosec", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "std_msgs/msg/Header", "fields": [{"name": "stamp", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "builtin_interfaces/msg/Time"}, "default_value": ""}, {"name": "frame_id", "type": {"type_id": 17, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}]}`
+}
+
+func (t _CompressedImageTypeSupport) MessageDefinition() string {
+	return `# Header timestamp should be acquisition time of image
+std_msgs/Header header
+# Specifies the format of the data
+string format
+# Compressed image buffer
+uint8[] data
+================================================================================
+MSG: std_msgs/msg/Header
+# Two-integer timestamp that is expressed as seconds and nanoseconds.
+builtin_interfaces/Time stamp
+# Transform frame with which this data is associated.
+string frame_id
+================================================================================
+MSG: builtin_interfaces/msg/Time
+# The seconds component, valid over all int32 values.
+int32 sec
+# The nanoseconds component, valid in the range [0, 10e9).
+uint32 nanosec
+`
+}
+
 type CCompressedImage = C.sensor_msgs__msg__CompressedImage
 type CCompressedImage__Sequence = C.sensor_msgs__msg__CompressedImage__Sequence
 
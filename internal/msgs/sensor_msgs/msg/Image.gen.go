@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	std_msgs_msg "github.com/tiiuae/rclgo/internal/msgs/std_msgs/msg"
@@ -69,6 +71,61 @@ func (t *Image) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *Image) Equal(other *Image) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *Image) EqualApprox(other *Image, tol float64) bool {
+	if !t.Header.EqualApprox(&other.Header, tol) {
+		return false
+	}
+	if t.Height != other.Height {
+		return false
+	}
+	if t.Width != other.Width {
+		return false
+	}
+	if t.Encoding != other.Encoding {
+		return false
+	}
+	if t.IsBigendian != other.IsBigendian {
+		return false
+	}
+	if t.Step != other.Step {
+		return false
+	}
+	if !msgcmp.Slices(t.Data, other.Data) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *Image) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *Image) HashTo(h *msgcmp.Hasher) {
+	t.Header.HashTo(h)
+	h.Uint32(t.Height)
+	h.Uint32(t.Width)
+	h.String(t.Encoding)
+	h.Uint8(t.IsBigendian)
+	h.Uint32(t.Step)
+	h.Len(len(t.Data))
+	for i := range t.Data {
+		h.Uint8(t.Data[i])
+	}
+}
+
 func (t *Image) SetDefaults() {
 	t.Header.SetDefaults()
 	t.Height = 0
@@ -83,6 +140,57 @@ func (t *Image) GetTypeSupport() types.MessageTypeSupport {
 	return ImageTypeSupport
 }
 
+func (t *Image) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *Image) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *Image) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *Image) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *Image) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *Image) EncodeCDR(e *cdr.Encoder) {
+	t.Header.EncodeCDR(e)
+	e.Uint32(t.Height)
+	e.Uint32(t.Width)
+	e.String(t.Encoding)
+	e.Uint8(t.IsBigendian)
+	e.Uint32(t.Step)
+	e.SequenceLength(len(t.Data), 0)
+	e.Uint8s(t.Data[:])
+}
+
+func (t *Image) DecodeCDR(d *cdr.Decoder) {
+	t.Header.DecodeCDR(d)
+	t.Height = d.Uint32()
+	t.Width = d.Uint32()
+	t.Encoding = d.String()
+	t.IsBigendian = d.Uint8()
+	t.Step = d.Uint32()
+	t.Data = cdr.MakeSlice[uint8](d, 0)
+	d.Uint8s(t.Data[:])
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *Image) Validate() error {
+	if err := t.Header.Validate(); err != nil {
+		return rclgo.WrapValidationError("header", -1, err)
+	}
+	return nil
+}
+
 // ImagePublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type ImagePublisher struct {
@@ -140,6 +248,22 @@ func CloneImageSlice(dst, src []Image) {
 	}
 }
 
+// ImageDescriptor describes the fields of Image. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var ImageDescriptor = &types.MessageDescriptor{
+	TypeName: "sensor_msgs/msg/Image",
+	Fields: []types.FieldDescriptor{
+		{Name: "header", Type: "std_msgs/msg/Header", GoIndex: 0, Message: std_msgs_msg.HeaderDescriptor},
+		{Name: "height", Type: "uint32", GoIndex: 1},
+		{Name: "width", Type: "uint32", GoIndex: 2},
+		{Name: "encoding", Type: "string", GoIndex: 3},
+		{Name: "is_bigendian", Type: "uint8", GoIndex: 4},
+		{Name: "step", Type: "uint32", GoIndex: 5},
+		{Name: "data", Type: "uint8", GoIndex: 6, IsArray: true},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var ImageTypeSupport types.MessageTypeSupport = _ImageTypeSupport{}
 
@@ -185,6 +309,48 @@ func (t _ImageTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__sensor_msgs__msg__Image())
 }
 
+func (t _ImageTypeSupport) Descriptor() *types.MessageDescriptor {
+	return ImageDescriptor
+}
+
+func (t _ImageTypeSupport) TypeHash() string {
+	return `RIHS01_d31d41a9a4c4bc8eae9be757b0beed306564f7526c88ea6a4588fb9582527d47`
+}
+
+func (t _ImageTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "sensor_msgs/msg/Image", "fields": [{"name": "header", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "std_msgs/msg/Header"}, "default_value": ""}, {"name": "height", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "width", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "encoding", "type": {"type_id": 17, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "is_bigendian", "type": {"type_id": 3, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "step", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "data", "type": {"type_id": 147, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "builtin_interfaces/msg/Time", "fields": [{"name": "sec", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "nanosec", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "std_msgs/msg/Header", "fields": [{"name": "stamp", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "builtin_interfaces/msg/Time"}, "default_value": ""}, {"name": "frame_id", "type": {"type_id": 17, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}]}`
+}
+
+func (t _ImageTypeSupport) MessageDefinition() string {
+	return `# Header timestamp should be acquisition time of image
+std_msgs/Header header
+# image height, that is, number of rows
+uint32 height
+# image width, that is, number of columns
+uint32 width
+# Encoding of pixels -- channel meaning, ordering, size
+string encoding
+# is this data bigendian?
+uint8 is_bigendian
+# Full row length in bytes
+uint32 step
+# actual matrix data, size is (step * rows)
+uint8[] data
+================================================================================
+MSG: std_msgs/msg/Header
+# Two-integer timestamp that is expressed as seconds and nanoseconds.
+builtin_interfaces/Time stamp
+# Transform frame with which this data is associated.
+string frame_id
+================================================================================
+MSG: builtin_interfaces/msg/Time
+# The seconds component, valid over all int32 values.
+int32 sec
+# The nanoseconds component, valid in the range [0, 10e9).
+uint32 nanosec
+`
+}
+
 type CImage = C.sensor_msgs__msg__Image
 type CImage__Sequence = C.sensor_msgs__msg__Image__Sequence
 
@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	geometry_msgs_msg "github.com/tiiuae/rclgo/internal/msgs/geometry_msgs/msg"
@@ -67,6 +69,64 @@ func (t *Imu) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *Imu) Equal(other *Imu) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *Imu) EqualApprox(other *Imu, tol float64) bool {
+	if !t.Header.EqualApprox(&other.Header, tol) {
+		return false
+	}
+	if !t.Orientation.EqualApprox(&other.Orientation, tol) {
+		return false
+	}
+	if !msgcmp.Float64s(t.OrientationCovariance[:], other.OrientationCovariance[:], tol) {
+		return false
+	}
+	if !t.AngularVelocity.EqualApprox(&other.AngularVelocity, tol) {
+		return false
+	}
+	if !msgcmp.Float64s(t.AngularVelocityCovariance[:], other.AngularVelocityCovariance[:], tol) {
+		return false
+	}
+	if !t.LinearAcceleration.EqualApprox(&other.LinearAcceleration, tol) {
+		return false
+	}
+	if !msgcmp.Float64s(t.LinearAccelerationCovariance[:], other.LinearAccelerationCovariance[:], tol) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *Imu) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *Imu) HashTo(h *msgcmp.Hasher) {
+	t.Header.HashTo(h)
+	t.Orientation.HashTo(h)
+	for i := range t.OrientationCovariance {
+		h.Float64(t.OrientationCovariance[i])
+	}
+	t.AngularVelocity.HashTo(h)
+	for i := range t.AngularVelocityCovariance {
+		h.Float64(t.AngularVelocityCovariance[i])
+	}
+	t.LinearAcceleration.HashTo(h)
+	for i := range t.LinearAccelerationCovariance {
+		h.Float64(t.LinearAccelerationCovariance[i])
+	}
+}
+
 func (t *Imu) SetDefaults() {
 	t.Header.SetDefaults()
 	t.Orientation.SetDefaults()
@@ -81,6 +141,76 @@ func (t *Imu) GetTypeSupport() types.MessageTypeSupport {
 	return ImuTypeSupport
 }
 
+func (t *Imu) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *Imu) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *Imu) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *Imu) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *Imu) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *Imu) EncodeCDR(e *cdr.Encoder) {
+	t.Header.EncodeCDR(e)
+	t.Orientation.EncodeCDR(e)
+	for i := range t.OrientationCovariance {
+		e.Float64(t.OrientationCovariance[i])
+	}
+	t.AngularVelocity.EncodeCDR(e)
+	for i := range t.AngularVelocityCovariance {
+		e.Float64(t.AngularVelocityCovariance[i])
+	}
+	t.LinearAcceleration.EncodeCDR(e)
+	for i := range t.LinearAccelerationCovariance {
+		e.Float64(t.LinearAccelerationCovariance[i])
+	}
+}
+
+func (t *Imu) DecodeCDR(d *cdr.Decoder) {
+	t.Header.DecodeCDR(d)
+	t.Orientation.DecodeCDR(d)
+	for i := range t.OrientationCovariance {
+		t.OrientationCovariance[i] = d.Float64()
+	}
+	t.AngularVelocity.DecodeCDR(d)
+	for i := range t.AngularVelocityCovariance {
+		t.AngularVelocityCovariance[i] = d.Float64()
+	}
+	t.LinearAcceleration.DecodeCDR(d)
+	for i := range t.LinearAccelerationCovariance {
+		t.LinearAccelerationCovariance[i] = d.Float64()
+	}
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *Imu) Validate() error {
+	if err := t.Header.Validate(); err != nil {
+		return rclgo.WrapValidationError("header", -1, err)
+	}
+	if err := t.Orientation.Validate(); err != nil {
+		return rclgo.WrapValidationError("orientation", -1, err)
+	}
+	if err := t.AngularVelocity.Validate(); err != nil {
+		return rclgo.WrapValidationError("angular_velocity", -1, err)
+	}
+	if err := t.LinearAcceleration.Validate(); err != nil {
+		return rclgo.WrapValidationError("linear_acceleration", -1, err)
+	}
+	return nil
+}
+
 // ImuPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type ImuPublisher struct {
@@ -138,6 +268,22 @@ func CloneImuSlice(dst, src []Imu) {
 	}
 }
 
+// ImuDescriptor describes the fields of Imu. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var ImuDescriptor = &types.MessageDescriptor{
+	TypeName: "sensor_msgs/msg/Imu",
+	Fields: []types.FieldDescriptor{
+		{Name: "header", Type: "std_msgs/msg/Header", GoIndex: 0, Message: std_msgs_msg.HeaderDescriptor},
+		{Name: "orientation", Type: "geometry_msgs/msg/Quaternion", GoIndex: 1, Message: geometry_msgs_msg.QuaternionDescriptor},
+		{Name: "orientation_covariance", Type: "float64", GoIndex: 2, IsArray: true, ArraySize: 9},
+		{Name: "angular_velocity", Type: "geometry_msgs/msg/Vector3", GoIndex: 3, Message: geometry_msgs_msg.Vector3Descriptor},
+		{Name: "angular_velocity_covariance", Type: "float64", GoIndex: 4, IsArray: true, ArraySize: 9},
+		{Name: "linear_acceleration", Type: "geometry_msgs/msg/Vector3", GoIndex: 5, Message: geometry_msgs_msg.Vector3Descriptor},
+		{Name: "linear_acceleration_covariance", Type: "float64", GoIndex: 6, IsArray: true, ArraySize: 9},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var ImuTypeSupport types.MessageTypeSupport = _ImuTypeSupport{}
 
@@ -189,6 +335,55 @@ func (t _ImuTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__sensor_msgs__msg__Imu())
 }
 
+func (t _ImuTypeSupport) Descriptor() *types.MessageDescriptor {
+	return ImuDescriptor
+}
+
+func (t _ImuTypeSupport) TypeHash() string {
+	return `RIHS01_7d9a00ff131080897a5ec7e26e315954b8eae3353c3f995c55faf71574000b5b`
+}
+
+func (t _ImuTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "sensor_msgs/msg/Imu", "fields": [{"name": "header", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "std_msgs/msg/Header"}, "default_value": ""}, {"name": "orientation", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "geometry_msgs/msg/Quaternion"}, "default_value": ""}, {"name": "orientation_covariance", "type": {"type_id": 59, "capacity": 9, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "angular_velocity", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "geometry_msgs/msg/Vector3"}, "default_value": ""}, {"name": "angular_velocity_covariance", "type": {"type_id": 59, "capacity": 9, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "linear_acceleration", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "geometry_msgs/msg/Vector3"}, "default_value": ""}, {"name": "linear_acceleration_covariance", "type": {"type_id": 59, "capacity": 9, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "builtin_interfaces/msg/Time", "fields": [{"name": "sec", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "nanosec", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "geometry_msgs/msg/Quaternion", "fields": [{"name": "x", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "0"}, {"name": "y", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "0"}, {"name": "z", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "0"}, {"name": "w", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "1"}]}, {"type_name": "geometry_msgs/msg/Vector3", "fields": [{"name": "x", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "y", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "z", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "std_msgs/msg/Header", "fields": [{"name": "stamp", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "builtin_interfaces/msg/Time"}, "default_value": ""}, {"name": "frame_id", "type": {"type_id": 17, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}]}`
+}
+
+func (t _ImuTypeSupport) MessageDefinition() string {
+	return `std_msgs/Header header
+geometry_msgs/Quaternion orientation
+# Row major about x, y, z axes
+float64[9] orientation_covariance
+geometry_msgs/Vector3 angular_velocity
+# Row major about x, y, z axes
+float64[9] angular_velocity_covariance
+geometry_msgs/Vector3 linear_acceleration
+# Row major x, y z
+float64[9] linear_acceleration_covariance
+================================================================================
+MSG: std_msgs/msg/Header
+# Two-integer timestamp that is expressed as seconds and nanoseconds.
+builtin_interfaces/Time stamp
+# Transform frame with which this data is associated.
+string frame_id
+================================================================================
+MSG: geometry_msgs/msg/Quaternion
+float64 x 0
+float64 y 0
+float64 z 0
+float64 w 1
+================================================================================
+MSG: geometry_msgs/msg/Vector3
+float64 x
+float64 y
+float64 z
+================================================================================
+MSG: builtin_interfaces/msg/Time
+# The seconds component, valid over all int32 values.
+int32 sec
+# The nanoseconds component, valid in the range [0, 10e9).
+uint32 nanosec
+`
+}
+
 type CImu = C.sensor_msgs__msg__Imu
 type CImu__Sequence = C.sensor_msgs__msg__Imu__Sequence
 
@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	std_msgs_msg "github.com/tiiuae/rclgo/internal/msgs/std_msgs/msg"
@@ -74,6 +76,62 @@ func (t *JointState) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *JointState) Equal(other *JointState) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *JointState) EqualApprox(other *JointState, tol float64) bool {
+	if !t.Header.EqualApprox(&other.Header, tol) {
+		return false
+	}
+	if !msgcmp.Slices(t.Name, other.Name) {
+		return false
+	}
+	if !msgcmp.Float64s(t.Position, other.Position, tol) {
+		return false
+	}
+	if !msgcmp.Float64s(t.Velocity, other.Velocity, tol) {
+		return false
+	}
+	if !msgcmp.Float64s(t.Effort, other.Effort, tol) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *JointState) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *JointState) HashTo(h *msgcmp.Hasher) {
+	t.Header.HashTo(h)
+	h.Len(len(t.Name))
+	for i := range t.Name {
+		h.String(t.Name[i])
+	}
+	h.Len(len(t.Position))
+	for i := range t.Position {
+		h.Float64(t.Position[i])
+	}
+	h.Len(len(t.Velocity))
+	for i := range t.Velocity {
+		h.Float64(t.Velocity[i])
+	}
+	h.Len(len(t.Effort))
+	for i := range t.Effort {
+		h.Float64(t.Effort[i])
+	}
+}
+
 func (t *JointState) SetDefaults() {
 	t.Header.SetDefaults()
 	t.Name = nil
@@ -86,6 +144,75 @@ func (t *JointState) GetTypeSupport() types.MessageTypeSupport {
 	return JointStateTypeSupport
 }
 
+func (t *JointState) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *JointState) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *JointState) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *JointState) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *JointState) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *JointState) EncodeCDR(e *cdr.Encoder) {
+	t.Header.EncodeCDR(e)
+	e.SequenceLength(len(t.Name), 0)
+	for i := range t.Name {
+		e.String(t.Name[i])
+	}
+	e.SequenceLength(len(t.Position), 0)
+	for i := range t.Position {
+		e.Float64(t.Position[i])
+	}
+	e.SequenceLength(len(t.Velocity), 0)
+	for i := range t.Velocity {
+		e.Float64(t.Velocity[i])
+	}
+	e.SequenceLength(len(t.Effort), 0)
+	for i := range t.Effort {
+		e.Float64(t.Effort[i])
+	}
+}
+
+func (t *JointState) DecodeCDR(d *cdr.Decoder) {
+	t.Header.DecodeCDR(d)
+	t.Name = cdr.MakeSlice[string](d, 0)
+	for i := range t.Name {
+		t.Name[i] = d.String()
+	}
+	t.Position = cdr.MakeSlice[float64](d, 0)
+	for i := range t.Position {
+		t.Position[i] = d.Float64()
+	}
+	t.Velocity = cdr.MakeSlice[float64](d, 0)
+	for i := range t.Velocity {
+		t.Velocity[i] = d.Float64()
+	}
+	t.Effort = cdr.MakeSlice[float64](d, 0)
+	for i := range t.Effort {
+		t.Effort[i] = d.Float64()
+	}
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *JointState) Validate() error {
+	if err := t.Header.Validate(); err != nil {
+		return rclgo.WrapValidationError("header", -1, err)
+	}
+	return nil
+}
+
 // JointStatePublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type JointStatePublisher struct {
@@ -143,6 +270,20 @@ func CloneJointStateSlice(dst, src []JointState) {
 	}
 }
 
+// JointStateDescriptor describes the fields of JointState. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var JointStateDescriptor = &types.MessageDescriptor{
+	TypeName: "sensor_msgs/msg/JointState",
+	Fields: []types.FieldDescriptor{
+		{Name: "header", Type: "std_msgs/msg/Header", GoIndex: 0, Message: std_msgs_msg.HeaderDescriptor},
+		{Name: "name", Type: "string", GoIndex: 1, IsArray: true},
+		{Name: "position", Type: "float64", GoIndex: 2, IsArray: true},
+		{Name: "velocity", Type: "float64", GoIndex: 3, IsArray: true},
+		{Name: "effort", Type: "float64", GoIndex: 4, IsArray: true},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var JointStateTypeSupport types.MessageTypeSupport = _JointStateTypeSupport{}
 
@@ -184,6 +325,39 @@ func (t _JointStateTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__sensor_msgs__msg__JointState())
 }
 
+func (t _JointStateTypeSupport) Descriptor() *types.MessageDescriptor {
+	return JointStateDescriptor
+}
+
+func (t _JointStateTypeSupport) TypeHash() string {
+	return `RIHS01_a13ee3a330e346c9d87b5aa18d24e11690752bd33a0350f11c5882bc9179260e`
+}
+
+func (t _JointStateTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "sensor_msgs/msg/JointState", "fields": [{"name": "header", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "std_msgs/msg/Header"}, "default_value": ""}, {"name": "name", "type": {"type_id": 161, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "position", "type": {"type_id": 155, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "velocity", "type": {"type_id": 155, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "effort", "type": {"type_id": 155, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "builtin_interfaces/msg/Time", "fields": [{"name": "sec", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "nanosec", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "std_msgs/msg/Header", "fields": [{"name": "stamp", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "builtin_interfaces/msg/Time"}, "default_value": ""}, {"name": "frame_id", "type": {"type_id": 17, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}]}`
+}
+
+func (t _JointStateTypeSupport) MessageDefinition() string {
+	return `std_msgs/Header header
+string[] name
+float64[] position
+float64[] velocity
+float64[] effort
+================================================================================
+MSG: std_msgs/msg/Header
+# Two-integer timestamp that is expressed as seconds and nanoseconds.
+builtin_interfaces/Time stamp
+# Transform frame with which this data is associated.
+string frame_id
+================================================================================
+MSG: builtin_interfaces/msg/Time
+# The seconds component, valid over all int32 values.
+int32 sec
+# The nanoseconds component, valid in the range [0, 10e9).
+uint32 nanosec
+`
+}
+
 type CJointState = C.sensor_msgs__msg__JointState
 type CJointState__Sequence = C.sensor_msgs__msg__JointState__Sequence
 
@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	std_msgs_msg "github.com/tiiuae/rclgo/internal/msgs/std_msgs/msg"
@@ -64,6 +66,48 @@ func (t *Joy) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *Joy) Equal(other *Joy) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *Joy) EqualApprox(other *Joy, tol float64) bool {
+	if !t.Header.EqualApprox(&other.Header, tol) {
+		return false
+	}
+	if !msgcmp.Float32s(t.Axes, other.Axes, tol) {
+		return false
+	}
+	if !msgcmp.Slices(t.Buttons, other.Buttons) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *Joy) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *Joy) HashTo(h *msgcmp.Hasher) {
+	t.Header.HashTo(h)
+	h.Len(len(t.Axes))
+	for i := range t.Axes {
+		h.Float32(t.Axes[i])
+	}
+	h.Len(len(t.Buttons))
+	for i := range t.Buttons {
+		h.Int32(t.Buttons[i])
+	}
+}
+
 func (t *Joy) SetDefaults() {
 	t.Header.SetDefaults()
 	t.Axes = nil
@@ -74,6 +118,59 @@ func (t *Joy) GetTypeSupport() types.MessageTypeSupport {
 	return JoyTypeSupport
 }
 
+func (t *Joy) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *Joy) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *Joy) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *Joy) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *Joy) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *Joy) EncodeCDR(e *cdr.Encoder) {
+	t.Header.EncodeCDR(e)
+	e.SequenceLength(len(t.Axes), 0)
+	for i := range t.Axes {
+		e.Float32(t.Axes[i])
+	}
+	e.SequenceLength(len(t.Buttons), 0)
+	for i := range t.Buttons {
+		e.Int32(t.Buttons[i])
+	}
+}
+
+func (t *Joy) DecodeCDR(d *cdr.Decoder) {
+	t.Header.DecodeCDR(d)
+	t.Axes = cdr.MakeSlice[float32](d, 0)
+	for i := range t.Axes {
+		t.Axes[i] = d.Float32()
+	}
+	t.Buttons = cdr.MakeSlice[int32](d, 0)
+	for i := range t.Buttons {
+		t.Buttons[i] = d.Int32()
+	}
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *Joy) Validate() error {
+	if err := t.Header.Validate(); err != nil {
+		return rclgo.WrapValidationError("header", -1, err)
+	}
+	return nil
+}
+
 // JoyPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type JoyPublisher struct {
@@ -131,6 +228,18 @@ func CloneJoySlice(dst, src []Joy) {
 	}
 }
 
+// JoyDescriptor describes the fields of Joy. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var JoyDescriptor = &types.MessageDescriptor{
+	TypeName: "sensor_msgs/msg/Joy",
+	Fields: []types.FieldDescriptor{
+		{Name: "header", Type: "std_msgs/msg/Header", GoIndex: 0, Message: std_msgs_msg.HeaderDescriptor},
+		{Name: "axes", Type: "float32", GoIndex: 1, IsArray: true},
+		{Name: "buttons", Type: "int32", GoIndex: 2, IsArray: true},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var JoyTypeSupport types.MessageTypeSupport = _JoyTypeSupport{}
 
@@ -168,6 +277,40 @@ func (t _JoyTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__sensor_msgs__msg__Joy())
 }
 
+func (t _JoyTypeSupport) Descriptor() *types.MessageDescriptor {
+	return JoyDescriptor
+}
+
+func (t _JoyTypeSupport) TypeHash() string {
+	return `RIHS01_0d356c79cad3401e35ffeb75a96a96e08be3ef896b8b83841d73e890989372c5`
+}
+
+func (t _JoyTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "sensor_msgs/msg/Joy", "fields": [{"name": "header", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "std_msgs/msg/Header"}, "default_value": ""}, {"name": "axes", "type": {"type_id": 154, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "buttons", "type": {"type_id": 150, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "builtin_interfaces/msg/Time", "fields": [{"name": "sec", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "nanosec", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "std_msgs/msg/Header", "fields": [{"name": "stamp", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "builtin_interfaces/msg/Time"}, "default_value": ""}, {"name": "frame_id", "type": {"type_id": 17, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}]}`
+}
+
+func (t _JoyTypeSupport) MessageDefinition() string {
+	return `# The timestamp is the time at which data is received from the joystick.
+std_msgs/Header header
+# The axes measurements from a joystick.
+float32[] axes
+# The buttons measurements from a joystick.
+int32[] buttons
+================================================================================
+MSG: std_msgs/msg/Header
+# Two-integer timestamp that is expressed as seconds and nanoseconds.
+builtin_interfaces/Time stamp
+# Transform frame with which this data is associated.
+string frame_id
+================================================================================
+MSG: builtin_interfaces/msg/Time
+# The seconds component, valid over all int32 values.
+int32 sec
+# The nanoseconds component, valid in the range [0, 10e9).
+uint32 nanosec
+`
+}
+
 type CJoy = C.sensor_msgs__msg__Joy
 type CJoy__Sequence = C.sensor_msgs__msg__Joy__Sequence
 
@@ -14,8 +14,11 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
+	 "strconv"
 	
 )
 /*
@@ -36,6 +39,40 @@ const (
 	JoyFeedback_TYPE_BUZZER uint8 = 2
 )
 
+// JoyFeedback_Type is the type of the JoyFeedback_TYPE_* constants.
+type JoyFeedback_Type uint8
+
+// JoyFeedback_TypeValues contains the distinct values of the
+// JoyFeedback_TYPE_* constants.
+var JoyFeedback_TypeValues = []JoyFeedback_Type{
+	JoyFeedback_Type(JoyFeedback_TYPE_LED),
+	JoyFeedback_Type(JoyFeedback_TYPE_RUMBLE),
+	JoyFeedback_Type(JoyFeedback_TYPE_BUZZER),
+}
+
+// String returns the ROS name of the constant whose value is v.
+func (v JoyFeedback_Type) String() string {
+	switch v {
+	case JoyFeedback_Type(JoyFeedback_TYPE_LED):
+		return "TYPE_LED"
+	case JoyFeedback_Type(JoyFeedback_TYPE_RUMBLE):
+		return "TYPE_RUMBLE"
+	case JoyFeedback_Type(JoyFeedback_TYPE_BUZZER):
+		return "TYPE_BUZZER"
+	}
+	return "JoyFeedback_Type(" + strconv.FormatUint(uint64(v), 10) + ")"
+}
+
+// IsValid reports whether v is the value of one of the
+// JoyFeedback_TYPE_* constants.
+func (v JoyFeedback_Type) IsValid() bool {
+	switch v {
+	case JoyFeedback_Type(JoyFeedback_TYPE_LED), JoyFeedback_Type(JoyFeedback_TYPE_RUMBLE), JoyFeedback_Type(JoyFeedback_TYPE_BUZZER):
+		return true
+	}
+	return false
+}
+
 type JoyFeedback struct {
 	Type uint8 `yaml:"type"`
 	Id uint8 `yaml:"id"`// This will hold an id number for each type of each feedback.Example, the first led would be id=0, the second would be id=1
@@ -61,6 +98,42 @@ func (t *JoyFeedback) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *JoyFeedback) Equal(other *JoyFeedback) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *JoyFeedback) EqualApprox(other *JoyFeedback, tol float64) bool {
+	if t.Type != other.Type {
+		return false
+	}
+	if t.Id != other.Id {
+		return false
+	}
+	if !msgcmp.Float32(t.Intensity, other.Intensity, tol) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *JoyFeedback) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *JoyFeedback) HashTo(h *msgcmp.Hasher) {
+	h.Uint8(t.Type)
+	h.Uint8(t.Id)
+	h.Float32(t.Intensity)
+}
+
 func (t *JoyFeedback) SetDefaults() {
 	t.Type = 0
 	t.Id = 0
@@ -71,6 +144,44 @@ func (t *JoyFeedback) GetTypeSupport() types.MessageTypeSupport {
 	return JoyFeedbackTypeSupport
 }
 
+func (t *JoyFeedback) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *JoyFeedback) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *JoyFeedback) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *JoyFeedback) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *JoyFeedback) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *JoyFeedback) EncodeCDR(e *cdr.Encoder) {
+	e.Uint8(t.Type)
+	e.Uint8(t.Id)
+	e.Float32(t.Intensity)
+}
+
+func (t *JoyFeedback) DecodeCDR(d *cdr.Decoder) {
+	t.Type = d.Uint8()
+	t.Id = d.Uint8()
+	t.Intensity = d.Float32()
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *JoyFeedback) Validate() error {
+	return nil
+}
+
 // JoyFeedbackPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type JoyFeedbackPublisher struct {
@@ -128,6 +239,18 @@ func CloneJoyFeedbackSlice(dst, src []JoyFeedback) {
 	}
 }
 
+// JoyFeedbackDescriptor describes the fields of JoyFeedback. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var JoyFeedbackDescriptor = &types.MessageDescriptor{
+	TypeName: "sensor_msgs/msg/JoyFeedback",
+	Fields: []types.FieldDescriptor{
+		{Name: "type", Type: "uint8", GoIndex: 0},
+		{Name: "id", Type: "uint8", GoIndex: 1},
+		{Name: "intensity", Type: "float32", GoIndex: 2},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var JoyFeedbackTypeSupport types.MessageTypeSupport = _JoyFeedbackTypeSupport{}
 
@@ -165,6 +288,32 @@ func (t _JoyFeedbackTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__sensor_msgs__msg__JoyFeedback())
 }
 
+func (t _JoyFeedbackTypeSupport) Descriptor() *types.MessageDescriptor {
+	return JoyFeedbackDescriptor
+}
+
+func (t _JoyFeedbackTypeSupport) TypeHash() string {
+	return `RIHS01_231dd362f71d6fc08272770d07120ad5fe5874ce2dbac70109b28986834290cd`
+}
+
+func (t _JoyFeedbackTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "sensor_msgs/msg/JoyFeedback", "fields": [{"name": "type", "type": {"type_id": 3, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "id", "type": {"type_id": 3, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "intensity", "type": {"type_id": 10, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": []}`
+}
+
+func (t _JoyFeedbackTypeSupport) MessageDefinition() string {
+	return `# Declare of the type of feedback
+uint8 TYPE_LED=0
+uint8 TYPE_RUMBLE=1
+uint8 TYPE_BUZZER=2
+
+uint8 type
+# This will hold an id number for each type of each feedback.Example, the first led would be id=0, the second would be id=1
+uint8 id
+# Intensity of the feedback, from 0.0 to 1.0, inclusive.  If device isactually binary, driver should treat 0<=x<0.5 as off, 0.5<=x<=1 as on.
+float32 intensity
+`
+}
+
 type CJoyFeedback = C.sensor_msgs__msg__JoyFeedback
 type CJoyFeedback__Sequence = C.sensor_msgs__msg__JoyFeedback__Sequence
 
@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	
@@ -55,6 +57,42 @@ func (t *JoyFeedbackArray) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *JoyFeedbackArray) Equal(other *JoyFeedbackArray) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *JoyFeedbackArray) EqualApprox(other *JoyFeedbackArray, tol float64) bool {
+	if len(t.Array) != len(other.Array) {
+		return false
+	}
+	for i := range t.Array {
+		if !t.Array[i].EqualApprox(&other.Array[i], tol) {
+			return false
+		}
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *JoyFeedbackArray) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *JoyFeedbackArray) HashTo(h *msgcmp.Hasher) {
+	h.Len(len(t.Array))
+	for i := range t.Array {
+		t.Array[i].HashTo(h)
+	}
+}
+
 func (t *JoyFeedbackArray) SetDefaults() {
 	t.Array = nil
 }
@@ -63,6 +101,51 @@ func (t *JoyFeedbackArray) GetTypeSupport() types.MessageTypeSupport {
 	return JoyFeedbackArrayTypeSupport
 }
 
+func (t *JoyFeedbackArray) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *JoyFeedbackArray) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *JoyFeedbackArray) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *JoyFeedbackArray) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *JoyFeedbackArray) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *JoyFeedbackArray) EncodeCDR(e *cdr.Encoder) {
+	e.SequenceLength(len(t.Array), 0)
+	for i := range t.Array {
+		t.Array[i].EncodeCDR(e)
+	}
+}
+
+func (t *JoyFeedbackArray) DecodeCDR(d *cdr.Decoder) {
+	t.Array = cdr.MakeSlice[JoyFeedback](d, 0)
+	for i := range t.Array {
+		t.Array[i].DecodeCDR(d)
+	}
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *JoyFeedbackArray) Validate() error {
+	for i := range t.Array {
+		if err := t.Array[i].Validate(); err != nil {
+			return rclgo.WrapValidationError("array", i, err)
+		}
+	}
+	return nil
+}
+
 // JoyFeedbackArrayPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type JoyFeedbackArrayPublisher struct {
@@ -120,6 +203,16 @@ func CloneJoyFeedbackArraySlice(dst, src []JoyFeedbackArray) {
 	}
 }
 
+// JoyFeedbackArrayDescriptor describes the fields of JoyFeedbackArray. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var JoyFeedbackArrayDescriptor = &types.MessageDescriptor{
+	TypeName: "sensor_msgs/msg/JoyFeedbackArray",
+	Fields: []types.FieldDescriptor{
+		{Name: "array", Type: "sensor_msgs/msg/JoyFeedback", GoIndex: 0, IsArray: true, Message: JoyFeedbackDescriptor},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var JoyFeedbackArrayTypeSupport types.MessageTypeSupport = _JoyFeedbackArrayTypeSupport{}
 
@@ -153,6 +246,36 @@ func (t _JoyFeedbackArrayTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__sensor_msgs__msg__JoyFeedbackArray())
 }
 
+func (t _JoyFeedbackArrayTypeSupport) Descriptor() *types.MessageDescriptor {
+	return JoyFeedbackArrayDescriptor
+}
+
+func (t _JoyFeedbackArrayTypeSupport) TypeHash() string {
+	return `RIHS01_3287c32e1b688cae04555e465443df3cca7dae76ee4ebf85c4658d585037bcaa`
+}
+
+func (t _JoyFeedbackArrayTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "sensor_msgs/msg/JoyFeedbackArray", "fields": [{"name": "array", "type": {"type_id": 145, "capacity": 0, "string_capacity": 0, "nested_type_name": "sensor_msgs/msg/JoyFeedback"}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "sensor_msgs/msg/JoyFeedback", "fields": [{"name": "type", "type": {"type_id": 3, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "id", "type": {"type_id": 3, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "intensity", "type": {"type_id": 10, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}]}`
+}
+
+func (t _JoyFeedbackArrayTypeSupport) MessageDefinition() string {
+	return `# This message publishes values for multiple feedback at once.
+JoyFeedback[] array
+================================================================================
+MSG: sensor_msgs/msg/JoyFeedback
+# Declare of the type of feedback
+uint8 TYPE_LED=0
+uint8 TYPE_RUMBLE=1
+uint8 TYPE_BUZZER=2
+
+uint8 type
+# This will hold an id number for each type of each feedback.Example, the first led would be id=0, the second would be id=1
+uint8 id
+# Intensity of the feedback, from 0.0 to 1.0, inclusive.  If device isactually binary, driver should treat 0<=x<0.5 as off, 0.5<=x<=1 as on.
+float32 intensity
+`
+}
+
 type CJoyFeedbackArray = C.sensor_msgs__msg__JoyFeedbackArray
 type CJoyFeedbackArray__Sequence = C.sensor_msgs__msg__JoyFeedbackArray__Sequence
 
@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	primitives "github.com/tiiuae/rclgo/pkg/rclgo/primitives"
@@ -56,6 +58,37 @@ func (t *LaserEcho) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *LaserEcho) Equal(other *LaserEcho) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *LaserEcho) EqualApprox(other *LaserEcho, tol float64) bool {
+	if !msgcmp.Float32s(t.Echoes, other.Echoes, tol) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *LaserEcho) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *LaserEcho) HashTo(h *msgcmp.Hasher) {
+	h.Len(len(t.Echoes))
+	for i := range t.Echoes {
+		h.Float32(t.Echoes[i])
+	}
+}
+
 func (t *LaserEcho) SetDefaults() {
 	t.Echoes = nil
 }
@@ -64,6 +97,46 @@ func (t *LaserEcho) GetTypeSupport() types.MessageTypeSupport {
 	return LaserEchoTypeSupport
 }
 
+func (t *LaserEcho) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *LaserEcho) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *LaserEcho) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *LaserEcho) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *LaserEcho) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *LaserEcho) EncodeCDR(e *cdr.Encoder) {
+	e.SequenceLength(len(t.Echoes), 0)
+	for i := range t.Echoes {
+		e.Float32(t.Echoes[i])
+	}
+}
+
+func (t *LaserEcho) DecodeCDR(d *cdr.Decoder) {
+	t.Echoes = cdr.MakeSlice[float32](d, 0)
+	for i := range t.Echoes {
+		t.Echoes[i] = d.Float32()
+	}
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *LaserEcho) Validate() error {
+	return nil
+}
+
 // LaserEchoPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type LaserEchoPublisher struct {
@@ -121,6 +194,16 @@ func CloneLaserEchoSlice(dst, src []LaserEcho) {
 	}
 }
 
+// LaserEchoDescriptor describes the fields of LaserEcho. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var LaserEchoDescriptor = &types.MessageDescriptor{
+	TypeName: "sensor_msgs/msg/LaserEcho",
+	Fields: []types.FieldDescriptor{
+		{Name: "echoes", Type: "float32", GoIndex: 0, IsArray: true},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var LaserEchoTypeSupport types.MessageTypeSupport = _LaserEchoTypeSupport{}
 
@@ -154,6 +237,24 @@ func (t _LaserEchoTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__sensor_msgs__msg__LaserEcho())
 }
 
+func (t _LaserEchoTypeSupport) Descriptor() *types.MessageDescriptor {
+	return LaserEchoDescriptor
+}
+
+func (t _LaserEchoTypeSupport) TypeHash() string {
+	return `RIHS01_0fbc05a0db7d37fe52c0f0375356db55da0046f7ef5bd27ca6b34bd0582bc952`
+}
+
+func (t _LaserEchoTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "sensor_msgs/msg/LaserEcho", "fields": [{"name": "echoes", "type": {"type_id": 154, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": []}`
+}
+
+func (t _LaserEchoTypeSupport) MessageDefinition() string {
+	return `# Multiple values of ranges or intensities.
+float32[] echoes
+`
+}
+
 type CLaserEcho = C.sensor_msgs__msg__LaserEcho
 type CLaserEcho__Sequence = C.sensor_msgs__msg__LaserEcho__Sequence
 
@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	std_msgs_msg "github.com/tiiuae/rclgo/internal/msgs/std_msgs/msg"
@@ -78,6 +80,76 @@ func (t *LaserScan) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *LaserScan) Equal(other *LaserScan) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *LaserScan) EqualApprox(other *LaserScan, tol float64) bool {
+	if !t.Header.EqualApprox(&other.Header, tol) {
+		return false
+	}
+	if !msgcmp.Float32(t.AngleMin, other.AngleMin, tol) {
+		return false
+	}
+	if !msgcmp.Float32(t.AngleMax, other.AngleMax, tol) {
+		return false
+	}
+	if !msgcmp.Float32(t.AngleIncrement, other.AngleIncrement, tol) {
+		return false
+	}
+	if !msgcmp.Float32(t.TimeIncrement, other.TimeIncrement, tol) {
+		return false
+	}
+	if !msgcmp.Float32(t.ScanTime, other.ScanTime, tol) {
+		return false
+	}
+	if !msgcmp.Float32(t.RangeMin, other.RangeMin, tol) {
+		return false
+	}
+	if !msgcmp.Float32(t.RangeMax, other.RangeMax, tol) {
+		return false
+	}
+	if !msgcmp.Float32s(t.Ranges, other.Ranges, tol) {
+		return false
+	}
+	if !msgcmp.Float32s(t.Intensities, other.Intensities, tol) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *LaserScan) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *LaserScan) HashTo(h *msgcmp.Hasher) {
+	t.Header.HashTo(h)
+	h.Float32(t.AngleMin)
+	h.Float32(t.AngleMax)
+	h.Float32(t.AngleIncrement)
+	h.Float32(t.TimeIncrement)
+	h.Float32(t.ScanTime)
+	h.Float32(t.RangeMin)
+	h.Float32(t.RangeMax)
+	h.Len(len(t.Ranges))
+	for i := range t.Ranges {
+		h.Float32(t.Ranges[i])
+	}
+	h.Len(len(t.Intensities))
+	for i := range t.Intensities {
+		h.Float32(t.Intensities[i])
+	}
+}
+
 func (t *LaserScan) SetDefaults() {
 	t.Header.SetDefaults()
 	t.AngleMin = 0
@@ -95,6 +167,73 @@ func (t *LaserScan) GetTypeSupport() types.MessageTypeSupport {
 	return LaserScanTypeSupport
 }
 
+func (t *LaserScan) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *LaserScan) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *LaserScan) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *LaserScan) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *LaserScan) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *LaserScan) EncodeCDR(e *cdr.Encoder) {
+	t.Header.EncodeCDR(e)
+	e.Float32(t.AngleMin)
+	e.Float32(t.AngleMax)
+	e.Float32(t.AngleIncrement)
+	e.Float32(t.TimeIncrement)
+	e.Float32(t.ScanTime)
+	e.Float32(t.RangeMin)
+	e.Float32(t.RangeMax)
+	e.SequenceLength(len(t.Ranges), 0)
+	for i := range t.Ranges {
+		e.Float32(t.Ranges[i])
+	}
+	e.SequenceLength(len(t.Intensities), 0)
+	for i := range t.Intensities {
+		e.Float32(t.Intensities[i])
+	}
+}
+
+func (t *LaserScan) DecodeCDR(d *cdr.Decoder) {
+	t.Header.DecodeCDR(d)
+	t.AngleMin = d.Float32()
+	t.AngleMax = d.Float32()
+	t.AngleIncrement = d.Float32()
+	t.TimeIncrement = d.Float32()
+	t.ScanTime = d.Float32()
+	t.RangeMin = d.Float32()
+	t.RangeMax = d.Float32()
+	t.Ranges = cdr.MakeSlice[float32](d, 0)
+	for i := range t.Ranges {
+		t.Ranges[i] = d.Float32()
+	}
+	t.Intensities = cdr.MakeSlice[float32](d, 0)
+	for i := range t.Intensities {
+		t.Intensities[i] = d.Float32()
+	}
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *LaserScan) Validate() error {
+	if err := t.Header.Validate(); err != nil {
+		return rclgo.WrapValidationError("header", -1, err)
+	}
+	return nil
+}
+
 // LaserScanPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type LaserScanPublisher struct {
@@ -152,6 +291,25 @@ func CloneLaserScanSlice(dst, src []LaserScan) {
 	}
 }
 
+// LaserScanDescriptor describes the fields of LaserScan. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var LaserScanDescriptor = &types.MessageDescriptor{
+	TypeName: "sensor_msgs/msg/LaserScan",
+	Fields: []types.FieldDescriptor{
+		{Name: "header", Type: "std_msgs/msg/Header", GoIndex: 0, Message: std_msgs_msg.HeaderDescriptor},
+		{Name: "angle_min", Type: "float32", GoIndex: 1},
+		{Name: "angle_max", Type: "float32", GoIndex: 2},
+		{Name: "angle_increment", Type: "float32", GoIndex: 3},
+		{Name: "time_increment", Type: "float32", GoIndex: 4},
+		{Name: "scan_time", Type: "float32", GoIndex: 5},
+		{Name: "range_min", Type: "float32", GoIndex: 6},
+		{Name: "range_max", Type: "float32", GoIndex: 7},
+		{Name: "ranges", Type: "float32", GoIndex: 8, IsArray: true},
+		{Name: "intensities", Type: "float32", GoIndex: 9, IsArray: true},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var LaserScanTypeSupport types.MessageTypeSupport = _LaserScanTypeSupport{}
 
@@ -203,6 +361,54 @@ func (t _LaserScanTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__sensor_msgs__msg__LaserScan())
 }
 
+func (t _LaserScanTypeSupport) Descriptor() *types.MessageDescriptor {
+	return LaserScanDescriptor
+}
+
+func (t _LaserScanTypeSupport) TypeHash() string {
+	return `RIHS01_64c191398013af96509d518dac71d5164f9382553fce5c1f8cca5be7924bd828`
+}
+
+func (t _LaserScanTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "sensor_msgs/msg/LaserScan", "fields": [{"name": "header", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "std_msgs/msg/Header"}, "default_value": ""}, {"name": "angle_min", "type": {"type_id": 10, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "angle_max", "type": {"type_id": 10, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "angle_increment", "type": {"type_id": 10, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "time_increment", "type": {"type_id": 10, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "scan_time", "type": {"type_id": 10, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "range_min", "type": {"type_id": 10, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "range_max", "type": {"type_id": 10, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "ranges", "type": {"type_id": 154, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "intensities", "type": {"type_id": 154, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "builtin_interfaces/msg/Time", "fields": [{"name": "sec", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "nanosec", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "std_msgs/msg/Header", "fields": [{"name": "stamp", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "builtin_interfaces/msg/Time"}, "default_value": ""}, {"name": "frame_id", "type": {"type_id": 17, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}]}`
+}
+
+func (t _LaserScanTypeSupport) MessageDefinition() string {
+	return `# timestamp in the header is the acquisition time of
+std_msgs/Header header
+# start angle of the scan [rad]
+float32 angle_min
+# end angle of the scan [rad]
+float32 angle_max
+# angular distance between measurements [rad]
+float32 angle_increment
+# time between measurements [seconds] - if your scanner
+float32 time_increment
+# time between scans [seconds]. is moving, this will be used in interpolating positionof 3d points
+float32 scan_time
+# minimum range value [m]
+float32 range_min
+# maximum range value [m]
+float32 range_max
+# range data [m]
+float32[] ranges
+# intensity data [device-specific units].  If your. (Note: values < range_min or > range_max should be discarded)
+float32[] intensities
+================================================================================
+MSG: std_msgs/msg/Header
+# Two-integer timestamp that is expressed as seconds and nanoseconds.
+builtin_interfaces/Time stamp
+# Transform frame with which this data is associated.
+string frame_id
+================================================================================
+MSG: builtin_interfaces/msg/Time
+# The seconds component, valid over all int32 values.
+int32 sec
+# The nanoseconds component, valid in the range [0, 10e9).
+uint32 nanosec
+`
+}
+
 type CLaserScan = C.sensor_msgs__msg__LaserScan
 type CLaserScan__Sequence = C.sensor_msgs__msg__LaserScan__Sequence
 
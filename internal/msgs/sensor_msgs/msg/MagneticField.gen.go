@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	geometry_msgs_msg "github.com/tiiuae/rclgo/internal/msgs/geometry_msgs/msg"
@@ -59,6 +61,44 @@ func (t *MagneticField) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *MagneticField) Equal(other *MagneticField) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *MagneticField) EqualApprox(other *MagneticField, tol float64) bool {
+	if !t.Header.EqualApprox(&other.Header, tol) {
+		return false
+	}
+	if !t.MagneticField.EqualApprox(&other.MagneticField, tol) {
+		return false
+	}
+	if !msgcmp.Float64s(t.MagneticFieldCovariance[:], other.MagneticFieldCovariance[:], tol) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *MagneticField) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *MagneticField) HashTo(h *msgcmp.Hasher) {
+	t.Header.HashTo(h)
+	t.MagneticField.HashTo(h)
+	for i := range t.MagneticFieldCovariance {
+		h.Float64(t.MagneticFieldCovariance[i])
+	}
+}
+
 func (t *MagneticField) SetDefaults() {
 	t.Header.SetDefaults()
 	t.MagneticField.SetDefaults()
@@ -69,6 +109,54 @@ func (t *MagneticField) GetTypeSupport() types.MessageTypeSupport {
 	return MagneticFieldTypeSupport
 }
 
+func (t *MagneticField) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *MagneticField) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *MagneticField) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *MagneticField) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *MagneticField) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *MagneticField) EncodeCDR(e *cdr.Encoder) {
+	t.Header.EncodeCDR(e)
+	t.MagneticField.EncodeCDR(e)
+	for i := range t.MagneticFieldCovariance {
+		e.Float64(t.MagneticFieldCovariance[i])
+	}
+}
+
+func (t *MagneticField) DecodeCDR(d *cdr.Decoder) {
+	t.Header.DecodeCDR(d)
+	t.MagneticField.DecodeCDR(d)
+	for i := range t.MagneticFieldCovariance {
+		t.MagneticFieldCovariance[i] = d.Float64()
+	}
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *MagneticField) Validate() error {
+	if err := t.Header.Validate(); err != nil {
+		return rclgo.WrapValidationError("header", -1, err)
+	}
+	if err := t.MagneticField.Validate(); err != nil {
+		return rclgo.WrapValidationError("magnetic_field", -1, err)
+	}
+	return nil
+}
+
 // MagneticFieldPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type MagneticFieldPublisher struct {
@@ -126,6 +214,18 @@ func CloneMagneticFieldSlice(dst, src []MagneticField) {
 	}
 }
 
+// MagneticFieldDescriptor describes the fields of MagneticField. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var MagneticFieldDescriptor = &types.MessageDescriptor{
+	TypeName: "sensor_msgs/msg/MagneticField",
+	Fields: []types.FieldDescriptor{
+		{Name: "header", Type: "std_msgs/msg/Header", GoIndex: 0, Message: std_msgs_msg.HeaderDescriptor},
+		{Name: "magnetic_field", Type: "geometry_msgs/msg/Vector3", GoIndex: 1, Message: geometry_msgs_msg.Vector3Descriptor},
+		{Name: "magnetic_field_covariance", Type: "float64", GoIndex: 2, IsArray: true, ArraySize: 9},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var MagneticFieldTypeSupport types.MessageTypeSupport = _MagneticFieldTypeSupport{}
 
@@ -165,6 +265,45 @@ func (t _MagneticFieldTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__sensor_msgs__msg__MagneticField())
 }
 
+func (t _MagneticFieldTypeSupport) Descriptor() *types.MessageDescriptor {
+	return MagneticFieldDescriptor
+}
+
+func (t _MagneticFieldTypeSupport) TypeHash() string {
+	return `RIHS01_e80f32f56a20486c9923008fc1a1db07bbb273cbbf6a5b3bfa00835ee00e4dff`
+}
+
+func (t _MagneticFieldTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "sensor_msgs/msg/MagneticField", "fields": [{"name": "header", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "std_msgs/msg/Header"}, "default_value": ""}, {"name": "magnetic_field", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "geometry_msgs/msg/Vector3"}, "default_value": ""}, {"name": "magnetic_field_covariance", "type": {"type_id": 59, "capacity": 9, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "builtin_interfaces/msg/Time", "fields": [{"name": "sec", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "nanosec", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "geometry_msgs/msg/Vector3", "fields": [{"name": "x", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "y", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "z", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "std_msgs/msg/Header", "fields": [{"name": "stamp", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "builtin_interfaces/msg/Time"}, "default_value": ""}, {"name": "frame_id", "type": {"type_id": 17, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}]}`
+}
+
+func (t _MagneticFieldTypeSupport) MessageDefinition() string {
+	return `# timestamp is the time the
+std_msgs/Header header
+# x, y, and z components of the
+geometry_msgs/Vector3 magnetic_field
+# Row major about x, y, z axes
+float64[9] magnetic_field_covariance
+================================================================================
+MSG: std_msgs/msg/Header
+# Two-integer timestamp that is expressed as seconds and nanoseconds.
+builtin_interfaces/Time stamp
+# Transform frame with which this data is associated.
+string frame_id
+================================================================================
+MSG: geometry_msgs/msg/Vector3
+float64 x
+float64 y
+float64 z
+================================================================================
+MSG: builtin_interfaces/msg/Time
+# The seconds component, valid over all int32 values.
+int32 sec
+# The nanoseconds component, valid in the range [0, 10e9).
+uint32 nanosec
+`
+}
+
 type CMagneticField = C.sensor_msgs__msg__MagneticField
 type CMagneticField__Sequence = C.sensor_msgs__msg__MagneticField__Sequence
 
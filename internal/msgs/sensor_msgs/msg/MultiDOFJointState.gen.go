@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	geometry_msgs_msg "github.com/tiiuae/rclgo/internal/msgs/geometry_msgs/msg"
@@ -75,6 +77,77 @@ func (t *MultiDOFJointState) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *MultiDOFJointState) Equal(other *MultiDOFJointState) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *MultiDOFJointState) EqualApprox(other *MultiDOFJointState, tol float64) bool {
+	if !t.Header.EqualApprox(&other.Header, tol) {
+		return false
+	}
+	if !msgcmp.Slices(t.JointNames, other.JointNames) {
+		return false
+	}
+	if len(t.Transforms) != len(other.Transforms) {
+		return false
+	}
+	for i := range t.Transforms {
+		if !t.Transforms[i].EqualApprox(&other.Transforms[i], tol) {
+			return false
+		}
+	}
+	if len(t.Twist) != len(other.Twist) {
+		return false
+	}
+	for i := range t.Twist {
+		if !t.Twist[i].EqualApprox(&other.Twist[i], tol) {
+			return false
+		}
+	}
+	if len(t.Wrench) != len(other.Wrench) {
+		return false
+	}
+	for i := range t.Wrench {
+		if !t.Wrench[i].EqualApprox(&other.Wrench[i], tol) {
+			return false
+		}
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *MultiDOFJointState) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *MultiDOFJointState) HashTo(h *msgcmp.Hasher) {
+	t.Header.HashTo(h)
+	h.Len(len(t.JointNames))
+	for i := range t.JointNames {
+		h.String(t.JointNames[i])
+	}
+	h.Len(len(t.Transforms))
+	for i := range t.Transforms {
+		t.Transforms[i].HashTo(h)
+	}
+	h.Len(len(t.Twist))
+	for i := range t.Twist {
+		t.Twist[i].HashTo(h)
+	}
+	h.Len(len(t.Wrench))
+	for i := range t.Wrench {
+		t.Wrench[i].HashTo(h)
+	}
+}
+
 func (t *MultiDOFJointState) SetDefaults() {
 	t.Header.SetDefaults()
 	t.JointNames = nil
@@ -87,6 +160,90 @@ func (t *MultiDOFJointState) GetTypeSupport() types.MessageTypeSupport {
 	return MultiDOFJointStateTypeSupport
 }
 
+func (t *MultiDOFJointState) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *MultiDOFJointState) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *MultiDOFJointState) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *MultiDOFJointState) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *MultiDOFJointState) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *MultiDOFJointState) EncodeCDR(e *cdr.Encoder) {
+	t.Header.EncodeCDR(e)
+	e.SequenceLength(len(t.JointNames), 0)
+	for i := range t.JointNames {
+		e.String(t.JointNames[i])
+	}
+	e.SequenceLength(len(t.Transforms), 0)
+	for i := range t.Transforms {
+		t.Transforms[i].EncodeCDR(e)
+	}
+	e.SequenceLength(len(t.Twist), 0)
+	for i := range t.Twist {
+		t.Twist[i].EncodeCDR(e)
+	}
+	e.SequenceLength(len(t.Wrench), 0)
+	for i := range t.Wrench {
+		t.Wrench[i].EncodeCDR(e)
+	}
+}
+
+func (t *MultiDOFJointState) DecodeCDR(d *cdr.Decoder) {
+	t.Header.DecodeCDR(d)
+	t.JointNames = cdr.MakeSlice[string](d, 0)
+	for i := range t.JointNames {
+		t.JointNames[i] = d.String()
+	}
+	t.Transforms = cdr.MakeSlice[geometry_msgs_msg.Transform](d, 0)
+	for i := range t.Transforms {
+		t.Transforms[i].DecodeCDR(d)
+	}
+	t.Twist = cdr.MakeSlice[geometry_msgs_msg.Twist](d, 0)
+	for i := range t.Twist {
+		t.Twist[i].DecodeCDR(d)
+	}
+	t.Wrench = cdr.MakeSlice[geometry_msgs_msg.Wrench](d, 0)
+	for i := range t.Wrench {
+		t.Wrench[i].DecodeCDR(d)
+	}
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *MultiDOFJointState) Validate() error {
+	if err := t.Header.Validate(); err != nil {
+		return rclgo.WrapValidationError("header", -1, err)
+	}
+	for i := range t.Transforms {
+		if err := t.Transforms[i].Validate(); err != nil {
+			return rclgo.WrapValidationError("transforms", i, err)
+		}
+	}
+	for i := range t.Twist {
+		if err := t.Twist[i].Validate(); err != nil {
+			return rclgo.WrapValidationError("twist", i, err)
+		}
+	}
+	for i := range t.Wrench {
+		if err := t.Wrench[i].Validate(); err != nil {
+			return rclgo.WrapValidationError("wrench", i, err)
+		}
+	}
+	return nil
+}
+
 // MultiDOFJointStatePublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type MultiDOFJointStatePublisher struct {
@@ -144,6 +301,20 @@ func CloneMultiDOFJointStateSlice(dst, src []MultiDOFJointState) {
 	}
 }
 
+// MultiDOFJointStateDescriptor describes the fields of MultiDOFJointState. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var MultiDOFJointStateDescriptor = &types.MessageDescriptor{
+	TypeName: "sensor_msgs/msg/MultiDOFJointState",
+	Fields: []types.FieldDescriptor{
+		{Name: "header", Type: "std_msgs/msg/Header", GoIndex: 0, Message: std_msgs_msg.HeaderDescriptor},
+		{Name: "joint_names", Type: "string", GoIndex: 1, IsArray: true},
+		{Name: "transforms", Type: "geometry_msgs/msg/Transform", GoIndex: 2, IsArray: true, Message: geometry_msgs_msg.TransformDescriptor},
+		{Name: "twist", Type: "geometry_msgs/msg/Twist", GoIndex: 3, IsArray: true, Message: geometry_msgs_msg.TwistDescriptor},
+		{Name: "wrench", Type: "geometry_msgs/msg/Wrench", GoIndex: 4, IsArray: true, Message: geometry_msgs_msg.WrenchDescriptor},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var MultiDOFJointStateTypeSupport types.MessageTypeSupport = _MultiDOFJointStateTypeSupport{}
 
@@ -185,6 +356,62 @@ func (t _MultiDOFJointStateTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__sensor_msgs__msg__MultiDOFJointState())
 }
 
+func (t _MultiDOFJointStateTypeSupport) Descriptor() *types.MessageDescriptor {
+	return MultiDOFJointStateDescriptor
+}
+
+func (t _MultiDOFJointStateTypeSupport) TypeHash() string {
+	return `RIHS01_4d4ded702cfba7ff3ec783835c1a1425f75e53939a430ff355d1fee4b3bbc40b`
+}
+
+func (t _MultiDOFJointStateTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "sensor_msgs/msg/MultiDOFJointState", "fields": [{"name": "header", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "std_msgs/msg/Header"}, "default_value": ""}, {"name": "joint_names", "type": {"type_id": 161, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "transforms", "type": {"type_id": 145, "capacity": 0, "string_capacity": 0, "nested_type_name": "geometry_msgs/msg/Transform"}, "default_value": ""}, {"name": "twist", "type": {"type_id": 145, "capacity": 0, "string_capacity": 0, "nested_type_name": "geometry_msgs/msg/Twist"}, "default_value": ""}, {"name": "wrench", "type": {"type_id": 145, "capacity": 0, "string_capacity": 0, "nested_type_name": "geometry_msgs/msg/Wrench"}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "builtin_interfaces/msg/Time", "fields": [{"name": "sec", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "nanosec", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "geometry_msgs/msg/Quaternion", "fields": [{"name": "x", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "0"}, {"name": "y", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "0"}, {"name": "z", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "0"}, {"name": "w", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "1"}]}, {"type_name": "geometry_msgs/msg/Transform", "fields": [{"name": "translation", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "geometry_msgs/msg/Vector3"}, "default_value": ""}, {"name": "rotation", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "geometry_msgs/msg/Quaternion"}, "default_value": ""}]}, {"type_name": "geometry_msgs/msg/Twist", "fields": [{"name": "linear", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "geometry_msgs/msg/Vector3"}, "default_value": ""}, {"name": "angular", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "geometry_msgs/msg/Vector3"}, "default_value": ""}]}, {"type_name": "geometry_msgs/msg/Vector3", "fields": [{"name": "x", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "y", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "z", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "geometry_msgs/msg/Wrench", "fields": [{"name": "force", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "geometry_msgs/msg/Vector3"}, "default_value": ""}, {"name": "torque", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "geometry_msgs/msg/Vector3"}, "default_value": ""}]}, {"type_name": "std_msgs/msg/Header", "fields": [{"name": "stamp", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "builtin_interfaces/msg/Time"}, "default_value": ""}, {"name": "frame_id", "type": {"type_id": 17, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}]}`
+}
+
+func (t _MultiDOFJointStateTypeSupport) MessageDefinition() string {
+	return `std_msgs/Header header
+string[] joint_names
+geometry_msgs/Transform[] transforms
+geometry_msgs/Twist[] twist
+geometry_msgs/Wrench[] wrench
+================================================================================
+MSG: std_msgs/msg/Header
+# Two-integer timestamp that is expressed as seconds and nanoseconds.
+builtin_interfaces/Time stamp
+# Transform frame with which this data is associated.
+string frame_id
+================================================================================
+MSG: geometry_msgs/msg/Transform
+Vector3 translation
+Quaternion rotation
+================================================================================
+MSG: geometry_msgs/msg/Twist
+Vector3 linear
+Vector3 angular
+================================================================================
+MSG: geometry_msgs/msg/Wrench
+Vector3 force
+Vector3 torque
+================================================================================
+MSG: builtin_interfaces/msg/Time
+# The seconds component, valid over all int32 values.
+int32 sec
+# The nanoseconds component, valid in the range [0, 10e9).
+uint32 nanosec
+================================================================================
+MSG: geometry_msgs/msg/Vector3
+float64 x
+float64 y
+float64 z
+================================================================================
+MSG: geometry_msgs/msg/Quaternion
+float64 x 0
+float64 y 0
+float64 z 0
+float64 w 1
+`
+}
+
 type CMultiDOFJointState = C.sensor_msgs__msg__MultiDOFJointState
 type CMultiDOFJointState__Sequence = C.sensor_msgs__msg__MultiDOFJointState__Sequence
 
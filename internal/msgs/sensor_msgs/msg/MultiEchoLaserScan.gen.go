@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	std_msgs_msg "github.com/tiiuae/rclgo/internal/msgs/std_msgs/msg"
@@ -77,6 +79,86 @@ func (t *MultiEchoLaserScan) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *MultiEchoLaserScan) Equal(other *MultiEchoLaserScan) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *MultiEchoLaserScan) EqualApprox(other *MultiEchoLaserScan, tol float64) bool {
+	if !t.Header.EqualApprox(&other.Header, tol) {
+		return false
+	}
+	if !msgcmp.Float32(t.AngleMin, other.AngleMin, tol) {
+		return false
+	}
+	if !msgcmp.Float32(t.AngleMax, other.AngleMax, tol) {
+		return false
+	}
+	if !msgcmp.Float32(t.AngleIncrement, other.AngleIncrement, tol) {
+		return false
+	}
+	if !msgcmp.Float32(t.TimeIncrement, other.TimeIncrement, tol) {
+		return false
+	}
+	if !msgcmp.Float32(t.ScanTime, other.ScanTime, tol) {
+		return false
+	}
+	if !msgcmp.Float32(t.RangeMin, other.RangeMin, tol) {
+		return false
+	}
+	if !msgcmp.Float32(t.RangeMax, other.RangeMax, tol) {
+		return false
+	}
+	if len(t.Ranges) != len(other.Ranges) {
+		return false
+	}
+	for i := range t.Ranges {
+		if !t.Ranges[i].EqualApprox(&other.Ranges[i], tol) {
+			return false
+		}
+	}
+	if len(t.Intensities) != len(other.Intensities) {
+		return false
+	}
+	for i := range t.Intensities {
+		if !t.Intensities[i].EqualApprox(&other.Intensities[i], tol) {
+			return false
+		}
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *MultiEchoLaserScan) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *MultiEchoLaserScan) HashTo(h *msgcmp.Hasher) {
+	t.Header.HashTo(h)
+	h.Float32(t.AngleMin)
+	h.Float32(t.AngleMax)
+	h.Float32(t.AngleIncrement)
+	h.Float32(t.TimeIncrement)
+	h.Float32(t.ScanTime)
+	h.Float32(t.RangeMin)
+	h.Float32(t.RangeMax)
+	h.Len(len(t.Ranges))
+	for i := range t.Ranges {
+		t.Ranges[i].HashTo(h)
+	}
+	h.Len(len(t.Intensities))
+	for i := range t.Intensities {
+		t.Intensities[i].HashTo(h)
+	}
+}
+
 func (t *MultiEchoLaserScan) SetDefaults() {
 	t.Header.SetDefaults()
 	t.AngleMin = 0
@@ -94,6 +176,83 @@ func (t *MultiEchoLaserScan) GetTypeSupport() types.MessageTypeSupport {
 	return MultiEchoLaserScanTypeSupport
 }
 
+func (t *MultiEchoLaserScan) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *MultiEchoLaserScan) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *MultiEchoLaserScan) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *MultiEchoLaserScan) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *MultiEchoLaserScan) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *MultiEchoLaserScan) EncodeCDR(e *cdr.Encoder) {
+	t.Header.EncodeCDR(e)
+	e.Float32(t.AngleMin)
+	e.Float32(t.AngleMax)
+	e.Float32(t.AngleIncrement)
+	e.Float32(t.TimeIncrement)
+	e.Float32(t.ScanTime)
+	e.Float32(t.RangeMin)
+	e.Float32(t.RangeMax)
+	e.SequenceLength(len(t.Ranges), 0)
+	for i := range t.Ranges {
+		t.Ranges[i].EncodeCDR(e)
+	}
+	e.SequenceLength(len(t.Intensities), 0)
+	for i := range t.Intensities {
+		t.Intensities[i].EncodeCDR(e)
+	}
+}
+
+func (t *MultiEchoLaserScan) DecodeCDR(d *cdr.Decoder) {
+	t.Header.DecodeCDR(d)
+	t.AngleMin = d.Float32()
+	t.AngleMax = d.Float32()
+	t.AngleIncrement = d.Float32()
+	t.TimeIncrement = d.Float32()
+	t.ScanTime = d.Float32()
+	t.RangeMin = d.Float32()
+	t.RangeMax = d.Float32()
+	t.Ranges = cdr.MakeSlice[LaserEcho](d, 0)
+	for i := range t.Ranges {
+		t.Ranges[i].DecodeCDR(d)
+	}
+	t.Intensities = cdr.MakeSlice[LaserEcho](d, 0)
+	for i := range t.Intensities {
+		t.Intensities[i].DecodeCDR(d)
+	}
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *MultiEchoLaserScan) Validate() error {
+	if err := t.Header.Validate(); err != nil {
+		return rclgo.WrapValidationError("header", -1, err)
+	}
+	for i := range t.Ranges {
+		if err := t.Ranges[i].Validate(); err != nil {
+			return rclgo.WrapValidationError("ranges", i, err)
+		}
+	}
+	for i := range t.Intensities {
+		if err := t.Intensities[i].Validate(); err != nil {
+			return rclgo.WrapValidationError("intensities", i, err)
+		}
+	}
+	return nil
+}
+
 // MultiEchoLaserScanPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type MultiEchoLaserScanPublisher struct {
@@ -151,6 +310,25 @@ func CloneMultiEchoLaserScanSlice(dst, src []MultiEchoLaserScan) {
 	}
 }
 
+// MultiEchoLaserScanDescriptor describes the fields of MultiEchoLaserScan. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var MultiEchoLaserScanDescriptor = &types.MessageDescriptor{
+	TypeName: "sensor_msgs/msg/MultiEchoLaserScan",
+	Fields: []types.FieldDescriptor{
+		{Name: "header", Type: "std_msgs/msg/Header", GoIndex: 0, Message: std_msgs_msg.HeaderDescriptor},
+		{Name: "angle_min", Type: "float32", GoIndex: 1},
+		{Name: "angle_max", Type: "float32", GoIndex: 2},
+		{Name: "angle_increment", Type: "float32", GoIndex: 3},
+		{Name: "time_increment", Type: "float32", GoIndex: 4},
+		{Name: "scan_time", Type: "float32", GoIndex: 5},
+		{Name: "range_min", Type: "float32", GoIndex: 6},
+		{Name: "range_max", Type: "float32", GoIndex: 7},
+		{Name: "ranges", Type: "sensor_msgs/msg/LaserEcho", GoIndex: 8, IsArray: true, Message: LaserEchoDescriptor},
+		{Name: "intensities", Type: "sensor_msgs/msg/LaserEcho", GoIndex: 9, IsArray: true, Message: LaserEchoDescriptor},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var MultiEchoLaserScanTypeSupport types.MessageTypeSupport = _MultiEchoLaserScanTypeSupport{}
 
@@ -202,6 +380,58 @@ func (t _MultiEchoLaserScanTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__sensor_msgs__msg__MultiEchoLaserScan())
 }
 
+func (t _MultiEchoLaserScanTypeSupport) Descriptor() *types.MessageDescriptor {
+	return MultiEchoLaserScanDescriptor
+}
+
+func (t _MultiEchoLaserScanTypeSupport) TypeHash() string {
+	return `RIHS01_ba5eac341cd5bbb2701527aa4568e8baec172b69cadb9a1945d6f149d087ee48`
+}
+
+func (t _MultiEchoLaserScanTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "sensor_msgs/msg/MultiEchoLaserScan", "fields": [{"name": "header", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "std_msgs/msg/Header"}, "default_value": ""}, {"name": "angle_min", "type": {"type_id": 10, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "angle_max", "type": {"type_id": 10, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "angle_increment", "type": {"type_id": 10, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "time_increment", "type": {"type_id": 10, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "scan_time", "type": {"type_id": 10, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "range_min", "type": {"type_id": 10, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "range_max", "type": {"type_id": 10, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "ranges", "type": {"type_id": 145, "capacity": 0, "string_capacity": 0, "nested_type_name": "sensor_msgs/msg/LaserEcho"}, "default_value": ""}, {"name": "intensities", "type": {"type_id": 145, "capacity": 0, "string_capacity": 0, "nested_type_name": "sensor_msgs/msg/LaserEcho"}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "builtin_interfaces/msg/Time", "fields": [{"name": "sec", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "nanosec", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "sensor_msgs/msg/LaserEcho", "fields": [{"name": "echoes", "type": {"type_id": 154, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "std_msgs/msg/Header", "fields": [{"name": "stamp", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "builtin_interfaces/msg/Time"}, "default_value": ""}, {"name": "frame_id", "type": {"type_id": 17, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}]}`
+}
+
+func (t _MultiEchoLaserScanTypeSupport) MessageDefinition() string {
+	return `# timestamp in the header is the acquisition time of
+std_msgs/Header header
+# start angle of the scan [rad]
+float32 angle_min
+# end angle of the scan [rad]
+float32 angle_max
+# angular distance between measurements [rad]
+float32 angle_increment
+# time between measurements [seconds] - if your scanner
+float32 time_increment
+# time between scans [seconds]. is moving, this will be used in interpolating positionof 3d points
+float32 scan_time
+# minimum range value [m]
+float32 range_min
+# maximum range value [m]
+float32 range_max
+# range data [m]
+LaserEcho[] ranges
+# intensity data [device-specific units].  If your. (Note: NaNs, values < range_min or > range_max should be discarded)+Inf measurements are out of range-Inf measurements are too close to determine exact distance.
+LaserEcho[] intensities
+================================================================================
+MSG: std_msgs/msg/Header
+# Two-integer timestamp that is expressed as seconds and nanoseconds.
+builtin_interfaces/Time stamp
+# Transform frame with which this data is associated.
+string frame_id
+================================================================================
+MSG: sensor_msgs/msg/LaserEcho
+# Multiple values of ranges or intensities.
+float32[] echoes
+================================================================================
+MSG: builtin_interfaces/msg/Time
+# The seconds component, valid over all int32 values.
+int32 sec
+# The nanoseconds component, valid in the range [0, 10e9).
+uint32 nanosec
+`
+}
+
 type CMultiEchoLaserScan = C.sensor_msgs__msg__MultiEchoLaserScan
 type CMultiEchoLaserScan__Sequence = C.sensor_msgs__msg__MultiEchoLaserScan__Sequence
 
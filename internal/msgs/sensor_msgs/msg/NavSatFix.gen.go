@@ -14,10 +14,13 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	std_msgs_msg "github.com/tiiuae/rclgo/internal/msgs/std_msgs/msg"
 	primitives "github.com/tiiuae/rclgo/pkg/rclgo/primitives"
+	 "strconv"
 	
 )
 /*
@@ -39,6 +42,43 @@ const (
 	NavSatFix_COVARIANCE_TYPE_KNOWN uint8 = 3
 )
 
+// NavSatFix_CovarianceType is the type of the NavSatFix_COVARIANCE_TYPE_* constants.
+type NavSatFix_CovarianceType uint8
+
+// NavSatFix_CovarianceTypeValues contains the distinct values of the
+// NavSatFix_COVARIANCE_TYPE_* constants.
+var NavSatFix_CovarianceTypeValues = []NavSatFix_CovarianceType{
+	NavSatFix_CovarianceType(NavSatFix_COVARIANCE_TYPE_UNKNOWN),
+	NavSatFix_CovarianceType(NavSatFix_COVARIANCE_TYPE_APPROXIMATED),
+	NavSatFix_CovarianceType(NavSatFix_COVARIANCE_TYPE_DIAGONAL_KNOWN),
+	NavSatFix_CovarianceType(NavSatFix_COVARIANCE_TYPE_KNOWN),
+}
+
+// String returns the ROS name of the constant whose value is v.
+func (v NavSatFix_CovarianceType) String() string {
+	switch v {
+	case NavSatFix_CovarianceType(NavSatFix_COVARIANCE_TYPE_UNKNOWN):
+		return "COVARIANCE_TYPE_UNKNOWN"
+	case NavSatFix_CovarianceType(NavSatFix_COVARIANCE_TYPE_APPROXIMATED):
+		return "COVARIANCE_TYPE_APPROXIMATED"
+	case NavSatFix_CovarianceType(NavSatFix_COVARIANCE_TYPE_DIAGONAL_KNOWN):
+		return "COVARIANCE_TYPE_DIAGONAL_KNOWN"
+	case NavSatFix_CovarianceType(NavSatFix_COVARIANCE_TYPE_KNOWN):
+		return "COVARIANCE_TYPE_KNOWN"
+	}
+	return "NavSatFix_CovarianceType(" + strconv.FormatUint(uint64(v), 10) + ")"
+}
+
+// IsValid reports whether v is the value of one of the
+// NavSatFix_COVARIANCE_TYPE_* constants.
+func (v NavSatFix_CovarianceType) IsValid() bool {
+	switch v {
+	case NavSatFix_CovarianceType(NavSatFix_COVARIANCE_TYPE_UNKNOWN), NavSatFix_CovarianceType(NavSatFix_COVARIANCE_TYPE_APPROXIMATED), NavSatFix_CovarianceType(NavSatFix_COVARIANCE_TYPE_DIAGONAL_KNOWN), NavSatFix_CovarianceType(NavSatFix_COVARIANCE_TYPE_KNOWN):
+		return true
+	}
+	return false
+}
+
 type NavSatFix struct {
 	Header std_msgs_msg.Header `yaml:"header"`// header.stamp specifies the ROS time for this measurement (thecorresponding satellite time may be reported using thesensor_msgs/TimeReference message).header.frame_id is the frame of reference reported by the satellitereceiver, usually the location of the antenna.  This is aEuclidean frame relative to the vehicle, not a referenceellipsoid.
 	Status NavSatStatus `yaml:"status"`// Satellite fix status information.
@@ -72,6 +112,60 @@ func (t *NavSatFix) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *NavSatFix) Equal(other *NavSatFix) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *NavSatFix) EqualApprox(other *NavSatFix, tol float64) bool {
+	if !t.Header.EqualApprox(&other.Header, tol) {
+		return false
+	}
+	if !t.Status.EqualApprox(&other.Status, tol) {
+		return false
+	}
+	if !msgcmp.Float64(t.Latitude, other.Latitude, tol) {
+		return false
+	}
+	if !msgcmp.Float64(t.Longitude, other.Longitude, tol) {
+		return false
+	}
+	if !msgcmp.Float64(t.Altitude, other.Altitude, tol) {
+		return false
+	}
+	if !msgcmp.Float64s(t.PositionCovariance[:], other.PositionCovariance[:], tol) {
+		return false
+	}
+	if t.PositionCovarianceType != other.PositionCovarianceType {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *NavSatFix) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *NavSatFix) HashTo(h *msgcmp.Hasher) {
+	t.Header.HashTo(h)
+	t.Status.HashTo(h)
+	h.Float64(t.Latitude)
+	h.Float64(t.Longitude)
+	h.Float64(t.Altitude)
+	for i := range t.PositionCovariance {
+		h.Float64(t.PositionCovariance[i])
+	}
+	h.Uint8(t.PositionCovarianceType)
+}
+
 func (t *NavSatFix) SetDefaults() {
 	t.Header.SetDefaults()
 	t.Status.SetDefaults()
@@ -86,6 +180,62 @@ func (t *NavSatFix) GetTypeSupport() types.MessageTypeSupport {
 	return NavSatFixTypeSupport
 }
 
+func (t *NavSatFix) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *NavSatFix) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *NavSatFix) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *NavSatFix) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *NavSatFix) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *NavSatFix) EncodeCDR(e *cdr.Encoder) {
+	t.Header.EncodeCDR(e)
+	t.Status.EncodeCDR(e)
+	e.Float64(t.Latitude)
+	e.Float64(t.Longitude)
+	e.Float64(t.Altitude)
+	for i := range t.PositionCovariance {
+		e.Float64(t.PositionCovariance[i])
+	}
+	e.Uint8(t.PositionCovarianceType)
+}
+
+func (t *NavSatFix) DecodeCDR(d *cdr.Decoder) {
+	t.Header.DecodeCDR(d)
+	t.Status.DecodeCDR(d)
+	t.Latitude = d.Float64()
+	t.Longitude = d.Float64()
+	t.Altitude = d.Float64()
+	for i := range t.PositionCovariance {
+		t.PositionCovariance[i] = d.Float64()
+	}
+	t.PositionCovarianceType = d.Uint8()
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *NavSatFix) Validate() error {
+	if err := t.Header.Validate(); err != nil {
+		return rclgo.WrapValidationError("header", -1, err)
+	}
+	if err := t.Status.Validate(); err != nil {
+		return rclgo.WrapValidationError("status", -1, err)
+	}
+	return nil
+}
+
 // NavSatFixPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type NavSatFixPublisher struct {
@@ -143,6 +293,22 @@ func CloneNavSatFixSlice(dst, src []NavSatFix) {
 	}
 }
 
+// NavSatFixDescriptor describes the fields of NavSatFix. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var NavSatFixDescriptor = &types.MessageDescriptor{
+	TypeName: "sensor_msgs/msg/NavSatFix",
+	Fields: []types.FieldDescriptor{
+		{Name: "header", Type: "std_msgs/msg/Header", GoIndex: 0, Message: std_msgs_msg.HeaderDescriptor},
+		{Name: "status", Type: "sensor_msgs/msg/NavSatStatus", GoIndex: 1, Message: NavSatStatusDescriptor},
+		{Name: "latitude", Type: "float64", GoIndex: 2},
+		{Name: "longitude", Type: "float64", GoIndex: 3},
+		{Name: "altitude", Type: "float64", GoIndex: 4},
+		{Name: "position_covariance", Type: "float64", GoIndex: 5, IsArray: true, ArraySize: 9},
+		{Name: "position_covariance_type", Type: "uint8", GoIndex: 6},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var NavSatFixTypeSupport types.MessageTypeSupport = _NavSatFixTypeSupport{}
 
@@ -190,6 +356,70 @@ func (t _NavSatFixTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__sensor_msgs__msg__NavSatFix())
 }
 
+func (t _NavSatFixTypeSupport) Descriptor() *types.MessageDescriptor {
+	return NavSatFixDescriptor
+}
+
+func (t _NavSatFixTypeSupport) TypeHash() string {
+	return `RIHS01_62223ab3fe210a15976021da7afddc9e200dc9ec75231c1b6a557fc598a65404`
+}
+
+func (t _NavSatFixTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "sensor_msgs/msg/NavSatFix", "fields": [{"name": "header", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "std_msgs/msg/Header"}, "default_value": ""}, {"name": "status", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "sensor_msgs/msg/NavSatStatus"}, "default_value": ""}, {"name": "latitude", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "longitude", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "altitude", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "position_covariance", "type": {"type_id": 59, "capacity": 9, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "position_covariance_type", "type": {"type_id": 3, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "builtin_interfaces/msg/Time", "fields": [{"name": "sec", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "nanosec", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "sensor_msgs/msg/NavSatStatus", "fields": [{"name": "status", "type": {"type_id": 2, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "service", "type": {"type_id": 5, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "std_msgs/msg/Header", "fields": [{"name": "stamp", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "builtin_interfaces/msg/Time"}, "default_value": ""}, {"name": "frame_id", "type": {"type_id": 17, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}]}`
+}
+
+func (t _NavSatFixTypeSupport) MessageDefinition() string {
+	return `uint8 COVARIANCE_TYPE_UNKNOWN=0
+uint8 COVARIANCE_TYPE_APPROXIMATED=1
+uint8 COVARIANCE_TYPE_DIAGONAL_KNOWN=2
+uint8 COVARIANCE_TYPE_KNOWN=3
+
+# header.stamp specifies the ROS time for this measurement (thecorresponding satellite time may be reported using thesensor_msgs/TimeReference message).header.frame_id is the frame of reference reported by the satellitereceiver, usually the location of the antenna.  This is aEuclidean frame relative to the vehicle, not a referenceellipsoid.
+std_msgs/Header header
+# Satellite fix status information.
+NavSatStatus status
+# Latitude [degrees]. Positive is north of equator; negative is south.
+float64 latitude
+# Longitude [degrees]. Positive is east of prime meridian; negative is west.
+float64 longitude
+# Altitude [m]. Positive is above the WGS 84 ellipsoid(quiet NaN if no altitude is available).
+float64 altitude
+# Position covariance [m^2] defined relative to a tangential planethrough the reported position. The components are East, North, andUp (ENU), in row-major order.Beware: this coordinate system exhibits singularities at the poles.
+float64[9] position_covariance
+uint8 position_covariance_type
+================================================================================
+MSG: std_msgs/msg/Header
+# Two-integer timestamp that is expressed as seconds and nanoseconds.
+builtin_interfaces/Time stamp
+# Transform frame with which this data is associated.
+string frame_id
+================================================================================
+MSG: sensor_msgs/msg/NavSatStatus
+# unable to fix position
+int8 STATUS_NO_FIX=-1
+# unaugmented fix
+int8 STATUS_FIX=0
+# with satellite-based augmentation
+int8 STATUS_SBAS_FIX=1
+# with ground-based augmentation
+int8 STATUS_GBAS_FIX=2
+uint16 SERVICE_GPS=1
+uint16 SERVICE_GLONASS=2
+# includes BeiDou.
+uint16 SERVICE_COMPASS=4
+uint16 SERVICE_GALILEO=8
+
+int8 status
+uint16 service
+================================================================================
+MSG: builtin_interfaces/msg/Time
+# The seconds component, valid over all int32 values.
+int32 sec
+# The nanoseconds component, valid in the range [0, 10e9).
+uint32 nanosec
+`
+}
+
 type CNavSatFix = C.sensor_msgs__msg__NavSatFix
 type CNavSatFix__Sequence = C.sensor_msgs__msg__NavSatFix__Sequence
 
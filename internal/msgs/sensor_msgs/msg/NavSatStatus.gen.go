@@ -14,8 +14,11 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
+	 "strconv"
 	
 )
 /*
@@ -41,6 +44,80 @@ const (
 	NavSatStatus_SERVICE_GALILEO uint16 = 8
 )
 
+// NavSatStatus_Status is the type of the NavSatStatus_STATUS_* constants.
+type NavSatStatus_Status int8
+
+// NavSatStatus_StatusValues contains the distinct values of the
+// NavSatStatus_STATUS_* constants.
+var NavSatStatus_StatusValues = []NavSatStatus_Status{
+	NavSatStatus_Status(NavSatStatus_STATUS_NO_FIX),
+	NavSatStatus_Status(NavSatStatus_STATUS_FIX),
+	NavSatStatus_Status(NavSatStatus_STATUS_SBAS_FIX),
+	NavSatStatus_Status(NavSatStatus_STATUS_GBAS_FIX),
+}
+
+// String returns the ROS name of the constant whose value is v.
+func (v NavSatStatus_Status) String() string {
+	switch v {
+	case NavSatStatus_Status(NavSatStatus_STATUS_NO_FIX):
+		return "STATUS_NO_FIX"
+	case NavSatStatus_Status(NavSatStatus_STATUS_FIX):
+		return "STATUS_FIX"
+	case NavSatStatus_Status(NavSatStatus_STATUS_SBAS_FIX):
+		return "STATUS_SBAS_FIX"
+	case NavSatStatus_Status(NavSatStatus_STATUS_GBAS_FIX):
+		return "STATUS_GBAS_FIX"
+	}
+	return "NavSatStatus_Status(" + strconv.FormatInt(int64(v), 10) + ")"
+}
+
+// IsValid reports whether v is the value of one of the
+// NavSatStatus_STATUS_* constants.
+func (v NavSatStatus_Status) IsValid() bool {
+	switch v {
+	case NavSatStatus_Status(NavSatStatus_STATUS_NO_FIX), NavSatStatus_Status(NavSatStatus_STATUS_FIX), NavSatStatus_Status(NavSatStatus_STATUS_SBAS_FIX), NavSatStatus_Status(NavSatStatus_STATUS_GBAS_FIX):
+		return true
+	}
+	return false
+}
+
+// NavSatStatus_Service is the type of the NavSatStatus_SERVICE_* constants.
+type NavSatStatus_Service uint16
+
+// NavSatStatus_ServiceValues contains the distinct values of the
+// NavSatStatus_SERVICE_* constants.
+var NavSatStatus_ServiceValues = []NavSatStatus_Service{
+	NavSatStatus_Service(NavSatStatus_SERVICE_GPS),
+	NavSatStatus_Service(NavSatStatus_SERVICE_GLONASS),
+	NavSatStatus_Service(NavSatStatus_SERVICE_COMPASS),
+	NavSatStatus_Service(NavSatStatus_SERVICE_GALILEO),
+}
+
+// String returns the ROS name of the constant whose value is v.
+func (v NavSatStatus_Service) String() string {
+	switch v {
+	case NavSatStatus_Service(NavSatStatus_SERVICE_GPS):
+		return "SERVICE_GPS"
+	case NavSatStatus_Service(NavSatStatus_SERVICE_GLONASS):
+		return "SERVICE_GLONASS"
+	case NavSatStatus_Service(NavSatStatus_SERVICE_COMPASS):
+		return "SERVICE_COMPASS"
+	case NavSatStatus_Service(NavSatStatus_SERVICE_GALILEO):
+		return "SERVICE_GALILEO"
+	}
+	return "NavSatStatus_Service(" + strconv.FormatUint(uint64(v), 10) + ")"
+}
+
+// IsValid reports whether v is the value of one of the
+// NavSatStatus_SERVICE_* constants.
+func (v NavSatStatus_Service) IsValid() bool {
+	switch v {
+	case NavSatStatus_Service(NavSatStatus_SERVICE_GPS), NavSatStatus_Service(NavSatStatus_SERVICE_GLONASS), NavSatStatus_Service(NavSatStatus_SERVICE_COMPASS), NavSatStatus_Service(NavSatStatus_SERVICE_GALILEO):
+		return true
+	}
+	return false
+}
+
 type NavSatStatus struct {
 	Status int8 `yaml:"status"`
 	Service uint16 `yaml:"service"`
@@ -64,6 +141,38 @@ func (t *NavSatStatus) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *NavSatStatus) Equal(other *NavSatStatus) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *NavSatStatus) EqualApprox(other *NavSatStatus, tol float64) bool {
+	if t.Status != other.Status {
+		return false
+	}
+	if t.Service != other.Service {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *NavSatStatus) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *NavSatStatus) HashTo(h *msgcmp.Hasher) {
+	h.Int8(t.Status)
+	h.Uint16(t.Service)
+}
+
 func (t *NavSatStatus) SetDefaults() {
 	t.Status = 0
 	t.Service = 0
@@ -73,6 +182,42 @@ func (t *NavSatStatus) GetTypeSupport() types.MessageTypeSupport {
 	return NavSatStatusTypeSupport
 }
 
+func (t *NavSatStatus) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *NavSatStatus) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *NavSatStatus) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *NavSatStatus) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *NavSatStatus) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *NavSatStatus) EncodeCDR(e *cdr.Encoder) {
+	e.Int8(t.Status)
+	e.Uint16(t.Service)
+}
+
+func (t *NavSatStatus) DecodeCDR(d *cdr.Decoder) {
+	t.Status = d.Int8()
+	t.Service = d.Uint16()
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *NavSatStatus) Validate() error {
+	return nil
+}
+
 // NavSatStatusPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type NavSatStatusPublisher struct {
@@ -130,6 +275,17 @@ func CloneNavSatStatusSlice(dst, src []NavSatStatus) {
 	}
 }
 
+// NavSatStatusDescriptor describes the fields of NavSatStatus. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var NavSatStatusDescriptor = &types.MessageDescriptor{
+	TypeName: "sensor_msgs/msg/NavSatStatus",
+	Fields: []types.FieldDescriptor{
+		{Name: "status", Type: "int8", GoIndex: 0},
+		{Name: "service", Type: "uint16", GoIndex: 1},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var NavSatStatusTypeSupport types.MessageTypeSupport = _NavSatStatusTypeSupport{}
 
@@ -165,6 +321,38 @@ func (t _NavSatStatusTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__sensor_msgs__msg__NavSatStatus())
 }
 
+func (t _NavSatStatusTypeSupport) Descriptor() *types.MessageDescriptor {
+	return NavSatStatusDescriptor
+}
+
+func (t _NavSatStatusTypeSupport) TypeHash() string {
+	return `RIHS01_d1ed3befa628e09571bd273b888ba1c1fd187c9a5e0006b385d7e5e9095a3204`
+}
+
+func (t _NavSatStatusTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "sensor_msgs/msg/NavSatStatus", "fields": [{"name": "status", "type": {"type_id": 2, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "service", "type": {"type_id": 5, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": []}`
+}
+
+func (t _NavSatStatusTypeSupport) MessageDefinition() string {
+	return `# unable to fix position
+int8 STATUS_NO_FIX=-1
+# unaugmented fix
+int8 STATUS_FIX=0
+# with satellite-based augmentation
+int8 STATUS_SBAS_FIX=1
+# with ground-based augmentation
+int8 STATUS_GBAS_FIX=2
+uint16 SERVICE_GPS=1
+uint16 SERVICE_GLONASS=2
+# includes BeiDou.
+uint16 SERVICE_COMPASS=4
+uint16 SERVICE_GALILEO=8
+
+int8 status
+uint16 service
+`
+}
+
 type CNavSatStatus = C.sensor_msgs__msg__NavSatStatus
 type CNavSatStatus__Sequence = C.sensor_msgs__msg__NavSatStatus__Sequence
 
@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	geometry_msgs_msg "github.com/tiiuae/rclgo/internal/msgs/geometry_msgs/msg"
@@ -64,6 +66,58 @@ func (t *PointCloud) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *PointCloud) Equal(other *PointCloud) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *PointCloud) EqualApprox(other *PointCloud, tol float64) bool {
+	if !t.Header.EqualApprox(&other.Header, tol) {
+		return false
+	}
+	if len(t.Points) != len(other.Points) {
+		return false
+	}
+	for i := range t.Points {
+		if !t.Points[i].EqualApprox(&other.Points[i], tol) {
+			return false
+		}
+	}
+	if len(t.Channels) != len(other.Channels) {
+		return false
+	}
+	for i := range t.Channels {
+		if !t.Channels[i].EqualApprox(&other.Channels[i], tol) {
+			return false
+		}
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *PointCloud) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *PointCloud) HashTo(h *msgcmp.Hasher) {
+	t.Header.HashTo(h)
+	h.Len(len(t.Points))
+	for i := range t.Points {
+		t.Points[i].HashTo(h)
+	}
+	h.Len(len(t.Channels))
+	for i := range t.Channels {
+		t.Channels[i].HashTo(h)
+	}
+}
+
 func (t *PointCloud) SetDefaults() {
 	t.Header.SetDefaults()
 	t.Points = nil
@@ -74,6 +128,69 @@ func (t *PointCloud) GetTypeSupport() types.MessageTypeSupport {
 	return PointCloudTypeSupport
 }
 
+func (t *PointCloud) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *PointCloud) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *PointCloud) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *PointCloud) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *PointCloud) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *PointCloud) EncodeCDR(e *cdr.Encoder) {
+	t.Header.EncodeCDR(e)
+	e.SequenceLength(len(t.Points), 0)
+	for i := range t.Points {
+		t.Points[i].EncodeCDR(e)
+	}
+	e.SequenceLength(len(t.Channels), 0)
+	for i := range t.Channels {
+		t.Channels[i].EncodeCDR(e)
+	}
+}
+
+func (t *PointCloud) DecodeCDR(d *cdr.Decoder) {
+	t.Header.DecodeCDR(d)
+	t.Points = cdr.MakeSlice[geometry_msgs_msg.Point32](d, 0)
+	for i := range t.Points {
+		t.Points[i].DecodeCDR(d)
+	}
+	t.Channels = cdr.MakeSlice[ChannelFloat32](d, 0)
+	for i := range t.Channels {
+		t.Channels[i].DecodeCDR(d)
+	}
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *PointCloud) Validate() error {
+	if err := t.Header.Validate(); err != nil {
+		return rclgo.WrapValidationError("header", -1, err)
+	}
+	for i := range t.Points {
+		if err := t.Points[i].Validate(); err != nil {
+			return rclgo.WrapValidationError("points", i, err)
+		}
+	}
+	for i := range t.Channels {
+		if err := t.Channels[i].Validate(); err != nil {
+			return rclgo.WrapValidationError("channels", i, err)
+		}
+	}
+	return nil
+}
+
 // PointCloudPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type PointCloudPublisher struct {
@@ -131,6 +248,18 @@ func ClonePointCloudSlice(dst, src []PointCloud) {
 	}
 }
 
+// PointCloudDescriptor describes the fields of PointCloud. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var PointCloudDescriptor = &types.MessageDescriptor{
+	TypeName: "sensor_msgs/msg/PointCloud",
+	Fields: []types.FieldDescriptor{
+		{Name: "header", Type: "std_msgs/msg/Header", GoIndex: 0, Message: std_msgs_msg.HeaderDescriptor},
+		{Name: "points", Type: "geometry_msgs/msg/Point32", GoIndex: 1, IsArray: true, Message: geometry_msgs_msg.Point32Descriptor},
+		{Name: "channels", Type: "sensor_msgs/msg/ChannelFloat32", GoIndex: 2, IsArray: true, Message: ChannelFloat32Descriptor},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var PointCloudTypeSupport types.MessageTypeSupport = _PointCloudTypeSupport{}
 
@@ -168,6 +297,51 @@ func (t _PointCloudTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__sensor_msgs__msg__PointCloud())
 }
 
+func (t _PointCloudTypeSupport) Descriptor() *types.MessageDescriptor {
+	return PointCloudDescriptor
+}
+
+func (t _PointCloudTypeSupport) TypeHash() string {
+	return `RIHS01_614593df71d3c2b9bd4604a71b750fd218f0d65c045ea988b713719455a65b3b`
+}
+
+func (t _PointCloudTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "sensor_msgs/msg/PointCloud", "fields": [{"name": "header", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "std_msgs/msg/Header"}, "default_value": ""}, {"name": "points", "type": {"type_id": 145, "capacity": 0, "string_capacity": 0, "nested_type_name": "geometry_msgs/msg/Point32"}, "default_value": ""}, {"name": "channels", "type": {"type_id": 145, "capacity": 0, "string_capacity": 0, "nested_type_name": "sensor_msgs/msg/ChannelFloat32"}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "builtin_interfaces/msg/Time", "fields": [{"name": "sec", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "nanosec", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "geometry_msgs/msg/Point32", "fields": [{"name": "x", "type": {"type_id": 10, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "y", "type": {"type_id": 10, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "z", "type": {"type_id": 10, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "sensor_msgs/msg/ChannelFloat32", "fields": [{"name": "name", "type": {"type_id": 17, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "values", "type": {"type_id": 154, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "std_msgs/msg/Header", "fields": [{"name": "stamp", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "builtin_interfaces/msg/Time"}, "default_value": ""}, {"name": "frame_id", "type": {"type_id": 17, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}]}`
+}
+
+func (t _PointCloudTypeSupport) MessageDefinition() string {
+	return `# Time of sensor data acquisition, coordinate frame ID.
+std_msgs/Header header
+# Array of 3d points. Each Point32 should be interpreted as a 3d pointin the frame given in the header.
+geometry_msgs/Point32[] points
+# Each channel should have the same number of elements as points array,and the data in each channel should correspond 1:1 with each point.Channel names in common practice are listed in ChannelFloat32.msg.
+ChannelFloat32[] channels
+================================================================================
+MSG: std_msgs/msg/Header
+# Two-integer timestamp that is expressed as seconds and nanoseconds.
+builtin_interfaces/Time stamp
+# Transform frame with which this data is associated.
+string frame_id
+================================================================================
+MSG: geometry_msgs/msg/Point32
+float32 x
+float32 y
+float32 z
+================================================================================
+MSG: sensor_msgs/msg/ChannelFloat32
+# The channel name should give semantics of the channel (e.g."intensity" instead of "value").
+string name
+# The values array should be 1-1 with the elements of the associatedPointCloud.
+float32[] values
+================================================================================
+MSG: builtin_interfaces/msg/Time
+# The seconds component, valid over all int32 values.
+int32 sec
+# The nanoseconds component, valid in the range [0, 10e9).
+uint32 nanosec
+`
+}
+
 type CPointCloud = C.sensor_msgs__msg__PointCloud
 type CPointCloud__Sequence = C.sensor_msgs__msg__PointCloud__Sequence
 
@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	std_msgs_msg "github.com/tiiuae/rclgo/internal/msgs/std_msgs/msg"
@@ -76,6 +78,77 @@ func (t *PointCloud2) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *PointCloud2) Equal(other *PointCloud2) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *PointCloud2) EqualApprox(other *PointCloud2, tol float64) bool {
+	if !t.Header.EqualApprox(&other.Header, tol) {
+		return false
+	}
+	if t.Height != other.Height {
+		return false
+	}
+	if t.Width != other.Width {
+		return false
+	}
+	if len(t.Fields) != len(other.Fields) {
+		return false
+	}
+	for i := range t.Fields {
+		if !t.Fields[i].EqualApprox(&other.Fields[i], tol) {
+			return false
+		}
+	}
+	if t.IsBigendian != other.IsBigendian {
+		return false
+	}
+	if t.PointStep != other.PointStep {
+		return false
+	}
+	if t.RowStep != other.RowStep {
+		return false
+	}
+	if !msgcmp.Slices(t.Data, other.Data) {
+		return false
+	}
+	if t.IsDense != other.IsDense {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *PointCloud2) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *PointCloud2) HashTo(h *msgcmp.Hasher) {
+	t.Header.HashTo(h)
+	h.Uint32(t.Height)
+	h.Uint32(t.Width)
+	h.Len(len(t.Fields))
+	for i := range t.Fields {
+		t.Fields[i].HashTo(h)
+	}
+	h.Bool(t.IsBigendian)
+	h.Uint32(t.PointStep)
+	h.Uint32(t.RowStep)
+	h.Len(len(t.Data))
+	for i := range t.Data {
+		h.Uint8(t.Data[i])
+	}
+	h.Bool(t.IsDense)
+}
+
 func (t *PointCloud2) SetDefaults() {
 	t.Header.SetDefaults()
 	t.Height = 0
@@ -92,6 +165,72 @@ func (t *PointCloud2) GetTypeSupport() types.MessageTypeSupport {
 	return PointCloud2TypeSupport
 }
 
+func (t *PointCloud2) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *PointCloud2) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *PointCloud2) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *PointCloud2) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *PointCloud2) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *PointCloud2) EncodeCDR(e *cdr.Encoder) {
+	t.Header.EncodeCDR(e)
+	e.Uint32(t.Height)
+	e.Uint32(t.Width)
+	e.SequenceLength(len(t.Fields), 0)
+	for i := range t.Fields {
+		t.Fields[i].EncodeCDR(e)
+	}
+	e.Bool(t.IsBigendian)
+	e.Uint32(t.PointStep)
+	e.Uint32(t.RowStep)
+	e.SequenceLength(len(t.Data), 0)
+	e.Uint8s(t.Data[:])
+	e.Bool(t.IsDense)
+}
+
+func (t *PointCloud2) DecodeCDR(d *cdr.Decoder) {
+	t.Header.DecodeCDR(d)
+	t.Height = d.Uint32()
+	t.Width = d.Uint32()
+	t.Fields = cdr.MakeSlice[PointField](d, 0)
+	for i := range t.Fields {
+		t.Fields[i].DecodeCDR(d)
+	}
+	t.IsBigendian = d.Bool()
+	t.PointStep = d.Uint32()
+	t.RowStep = d.Uint32()
+	t.Data = cdr.MakeSlice[uint8](d, 0)
+	d.Uint8s(t.Data[:])
+	t.IsDense = d.Bool()
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *PointCloud2) Validate() error {
+	if err := t.Header.Validate(); err != nil {
+		return rclgo.WrapValidationError("header", -1, err)
+	}
+	for i := range t.Fields {
+		if err := t.Fields[i].Validate(); err != nil {
+			return rclgo.WrapValidationError("fields", i, err)
+		}
+	}
+	return nil
+}
+
 // PointCloud2Publisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type PointCloud2Publisher struct {
@@ -149,6 +288,24 @@ func ClonePointCloud2Slice(dst, src []PointCloud2) {
 	}
 }
 
+// PointCloud2Descriptor describes the fields of PointCloud2. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var PointCloud2Descriptor = &types.MessageDescriptor{
+	TypeName: "sensor_msgs/msg/PointCloud2",
+	Fields: []types.FieldDescriptor{
+		{Name: "header", Type: "std_msgs/msg/Header", GoIndex: 0, Message: std_msgs_msg.HeaderDescriptor},
+		{Name: "height", Type: "uint32", GoIndex: 1},
+		{Name: "width", Type: "uint32", GoIndex: 2},
+		{Name: "fields", Type: "sensor_msgs/msg/PointField", GoIndex: 3, IsArray: true, Message: PointFieldDescriptor},
+		{Name: "is_bigendian", Type: "bool", GoIndex: 4},
+		{Name: "point_step", Type: "uint32", GoIndex: 5},
+		{Name: "row_step", Type: "uint32", GoIndex: 6},
+		{Name: "data", Type: "uint8", GoIndex: 7, IsArray: true},
+		{Name: "is_dense", Type: "bool", GoIndex: 8},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var PointCloud2TypeSupport types.MessageTypeSupport = _PointCloud2TypeSupport{}
 
@@ -198,6 +355,71 @@ func (t _PointCloud2TypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__sensor_msgs__msg__PointCloud2())
 }
 
+func (t _PointCloud2TypeSupport) Descriptor() *types.MessageDescriptor {
+	return PointCloud2Descriptor
+}
+
+func (t _PointCloud2TypeSupport) TypeHash() string {
+	return `RIHS01_9198cabf7da3796ae6fe19c4cb3bdd3525492988c70522628af5daa124bae2b5`
+}
+
+func (t _PointCloud2TypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "sensor_msgs/msg/PointCloud2", "fields": [{"name": "header", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "std_msgs/msg/Header"}, "default_value": ""}, {"name": "height", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "width", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "fields", "type": {"type_id": 145, "capacity": 0, "string_capacity": 0, "nested_type_name": "sensor_msgs/msg/PointField"}, "default_value": ""}, {"name": "is_bigendian", "type": {"type_id": 15, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "point_step", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "row_step", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "data", "type": {"type_id": 147, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "is_dense", "type": {"type_id": 15, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "builtin_interfaces/msg/Time", "fields": [{"name": "sec", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "nanosec", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "sensor_msgs/msg/PointField", "fields": [{"name": "name", "type": {"type_id": 17, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "offset", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "datatype", "type": {"type_id": 3, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "count", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "std_msgs/msg/Header", "fields": [{"name": "stamp", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "builtin_interfaces/msg/Time"}, "default_value": ""}, {"name": "frame_id", "type": {"type_id": 17, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}]}`
+}
+
+func (t _PointCloud2TypeSupport) MessageDefinition() string {
+	return `# Time of sensor data acquisition, and the coordinate frame ID (for 3d points).
+std_msgs/Header header
+# 2D structure of the point cloud. If the cloud is unordered, height is1 and width is the length of the point cloud.
+uint32 height
+uint32 width
+# Describes the channels and their layout in the binary data blob.
+PointField[] fields
+# Is this data bigendian?
+bool is_bigendian
+# Length of a point in bytes
+uint32 point_step
+# Length of a row in bytes
+uint32 row_step
+# Actual point data, size is (row_step*height)
+uint8[] data
+# True if there are no invalid points
+bool is_dense
+================================================================================
+MSG: std_msgs/msg/Header
+# Two-integer timestamp that is expressed as seconds and nanoseconds.
+builtin_interfaces/Time stamp
+# Transform frame with which this data is associated.
+string frame_id
+================================================================================
+MSG: sensor_msgs/msg/PointField
+# This message holds the description of one point entry in thePointCloud2 message format.
+uint8 INT8=1
+uint8 UINT8=2
+uint8 INT16=3
+uint8 UINT16=4
+uint8 INT32=5
+uint8 UINT32=6
+uint8 FLOAT32=7
+uint8 FLOAT64=8
+
+# Name of field. Common PointField names are x, y, z, intensity, rgb, rgba
+string name
+# Offset from start of point struct
+uint32 offset
+# Datatype enumeration, see above
+uint8 datatype
+# How many elements in the field
+uint32 count
+================================================================================
+MSG: builtin_interfaces/msg/Time
+# The seconds component, valid over all int32 values.
+int32 sec
+# The nanoseconds component, valid in the range [0, 10e9).
+uint32 nanosec
+`
+}
+
 type CPointCloud2 = C.sensor_msgs__msg__PointCloud2
 type CPointCloud2__Sequence = C.sensor_msgs__msg__PointCloud2__Sequence
 
@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	primitives "github.com/tiiuae/rclgo/pkg/rclgo/primitives"
@@ -69,6 +71,46 @@ func (t *PointField) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *PointField) Equal(other *PointField) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *PointField) EqualApprox(other *PointField, tol float64) bool {
+	if t.Name != other.Name {
+		return false
+	}
+	if t.Offset != other.Offset {
+		return false
+	}
+	if t.Datatype != other.Datatype {
+		return false
+	}
+	if t.Count != other.Count {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *PointField) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *PointField) HashTo(h *msgcmp.Hasher) {
+	h.String(t.Name)
+	h.Uint32(t.Offset)
+	h.Uint8(t.Datatype)
+	h.Uint32(t.Count)
+}
+
 func (t *PointField) SetDefaults() {
 	t.Name = ""
 	t.Offset = 0
@@ -80,6 +122,46 @@ func (t *PointField) GetTypeSupport() types.MessageTypeSupport {
 	return PointFieldTypeSupport
 }
 
+func (t *PointField) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *PointField) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *PointField) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *PointField) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *PointField) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *PointField) EncodeCDR(e *cdr.Encoder) {
+	e.String(t.Name)
+	e.Uint32(t.Offset)
+	e.Uint8(t.Datatype)
+	e.Uint32(t.Count)
+}
+
+func (t *PointField) DecodeCDR(d *cdr.Decoder) {
+	t.Name = d.String()
+	t.Offset = d.Uint32()
+	t.Datatype = d.Uint8()
+	t.Count = d.Uint32()
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *PointField) Validate() error {
+	return nil
+}
+
 // PointFieldPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type PointFieldPublisher struct {
@@ -137,6 +219,19 @@ func ClonePointFieldSlice(dst, src []PointField) {
 	}
 }
 
+// PointFieldDescriptor describes the fields of PointField. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var PointFieldDescriptor = &types.MessageDescriptor{
+	TypeName: "sensor_msgs/msg/PointField",
+	Fields: []types.FieldDescriptor{
+		{Name: "name", Type: "string", GoIndex: 0},
+		{Name: "offset", Type: "uint32", GoIndex: 1},
+		{Name: "datatype", Type: "uint8", GoIndex: 2},
+		{Name: "count", Type: "uint32", GoIndex: 3},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var PointFieldTypeSupport types.MessageTypeSupport = _PointFieldTypeSupport{}
 
@@ -176,6 +271,40 @@ func (t _PointFieldTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__sensor_msgs__msg__PointField())
 }
 
+func (t _PointFieldTypeSupport) Descriptor() *types.MessageDescriptor {
+	return PointFieldDescriptor
+}
+
+func (t _PointFieldTypeSupport) TypeHash() string {
+	return `RIHS01_5c6a4750728c2bcfbbf7037225b20b02d4429634732146b742dee1726637ef01`
+}
+
+func (t _PointFieldTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "sensor_msgs/msg/PointField", "fields": [{"name": "name", "type": {"type_id": 17, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "offset", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "datatype", "type": {"type_id": 3, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "count", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": []}`
+}
+
+func (t _PointFieldTypeSupport) MessageDefinition() string {
+	return `# This message holds the description of one point entry in thePointCloud2 message format.
+uint8 INT8=1
+uint8 UINT8=2
+uint8 INT16=3
+uint8 UINT16=4
+uint8 INT32=5
+uint8 UINT32=6
+uint8 FLOAT32=7
+uint8 FLOAT64=8
+
+# Name of field. Common PointField names are x, y, z, intensity, rgb, rgba
+string name
+# Offset from start of point struct
+uint32 offset
+# Datatype enumeration, see above
+uint8 datatype
+# How many elements in the field
+uint32 count
+`
+}
+
 type CPointField = C.sensor_msgs__msg__PointField
 type CPointField__Sequence = C.sensor_msgs__msg__PointField__Sequence
 
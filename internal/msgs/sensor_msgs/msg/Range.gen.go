@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	std_msgs_msg "github.com/tiiuae/rclgo/internal/msgs/std_msgs/msg"
@@ -67,6 +69,54 @@ func (t *Range) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *Range) Equal(other *Range) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *Range) EqualApprox(other *Range, tol float64) bool {
+	if !t.Header.EqualApprox(&other.Header, tol) {
+		return false
+	}
+	if t.RadiationType != other.RadiationType {
+		return false
+	}
+	if !msgcmp.Float32(t.FieldOfView, other.FieldOfView, tol) {
+		return false
+	}
+	if !msgcmp.Float32(t.MinRange, other.MinRange, tol) {
+		return false
+	}
+	if !msgcmp.Float32(t.MaxRange, other.MaxRange, tol) {
+		return false
+	}
+	if !msgcmp.Float32(t.Range, other.Range, tol) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *Range) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *Range) HashTo(h *msgcmp.Hasher) {
+	t.Header.HashTo(h)
+	h.Uint8(t.RadiationType)
+	h.Float32(t.FieldOfView)
+	h.Float32(t.MinRange)
+	h.Float32(t.MaxRange)
+	h.Float32(t.Range)
+}
+
 func (t *Range) SetDefaults() {
 	t.Header.SetDefaults()
 	t.RadiationType = 0
@@ -80,6 +130,53 @@ func (t *Range) GetTypeSupport() types.MessageTypeSupport {
 	return RangeTypeSupport
 }
 
+func (t *Range) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *Range) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *Range) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *Range) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *Range) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *Range) EncodeCDR(e *cdr.Encoder) {
+	t.Header.EncodeCDR(e)
+	e.Uint8(t.RadiationType)
+	e.Float32(t.FieldOfView)
+	e.Float32(t.MinRange)
+	e.Float32(t.MaxRange)
+	e.Float32(t.Range)
+}
+
+func (t *Range) DecodeCDR(d *cdr.Decoder) {
+	t.Header.DecodeCDR(d)
+	t.RadiationType = d.Uint8()
+	t.FieldOfView = d.Float32()
+	t.MinRange = d.Float32()
+	t.MaxRange = d.Float32()
+	t.Range = d.Float32()
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *Range) Validate() error {
+	if err := t.Header.Validate(); err != nil {
+		return rclgo.WrapValidationError("header", -1, err)
+	}
+	return nil
+}
+
 // RangePublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type RangePublisher struct {
@@ -137,6 +234,21 @@ func CloneRangeSlice(dst, src []Range) {
 	}
 }
 
+// RangeDescriptor describes the fields of Range. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var RangeDescriptor = &types.MessageDescriptor{
+	TypeName: "sensor_msgs/msg/Range",
+	Fields: []types.FieldDescriptor{
+		{Name: "header", Type: "std_msgs/msg/Header", GoIndex: 0, Message: std_msgs_msg.HeaderDescriptor},
+		{Name: "radiation_type", Type: "uint8", GoIndex: 1},
+		{Name: "field_of_view", Type: "float32", GoIndex: 2},
+		{Name: "min_range", Type: "float32", GoIndex: 3},
+		{Name: "max_range", Type: "float32", GoIndex: 4},
+		{Name: "range", Type: "float32", GoIndex: 5},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var RangeTypeSupport types.MessageTypeSupport = _RangeTypeSupport{}
 
@@ -180,6 +292,50 @@ func (t _RangeTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__sensor_msgs__msg__Range())
 }
 
+func (t _RangeTypeSupport) Descriptor() *types.MessageDescriptor {
+	return RangeDescriptor
+}
+
+func (t _RangeTypeSupport) TypeHash() string {
+	return `RIHS01_9430b1915b94d4268ff903679e8ecd09b6a67d331bd028738ec1eeb592891ebd`
+}
+
+func (t _RangeTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "sensor_msgs/msg/Range", "fields": [{"name": "header", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "std_msgs/msg/Header"}, "default_value": ""}, {"name": "radiation_type", "type": {"type_id": 3, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "field_of_view", "type": {"type_id": 10, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "min_range", "type": {"type_id": 10, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "max_range", "type": {"type_id": 10, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "range", "type": {"type_id": 10, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "builtin_interfaces/msg/Time", "fields": [{"name": "sec", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "nanosec", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "std_msgs/msg/Header", "fields": [{"name": "stamp", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "builtin_interfaces/msg/Time"}, "default_value": ""}, {"name": "frame_id", "type": {"type_id": 17, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}]}`
+}
+
+func (t _RangeTypeSupport) MessageDefinition() string {
+	return `# Radiation type enumsIf you want a value added to this list, send an email to the ros-users list
+uint8 ULTRASOUND=0
+uint8 INFRARED=1
+
+# timestamp in the header is the time the ranger
+std_msgs/Header header
+# the type of radiation used by the sensor
+uint8 radiation_type
+# the size of the arc that the distance reading is
+float32 field_of_view
+# minimum range value [m]
+float32 min_range
+# maximum range value [m]
+float32 max_range
+# range data [m]
+float32 range
+================================================================================
+MSG: std_msgs/msg/Header
+# Two-integer timestamp that is expressed as seconds and nanoseconds.
+builtin_interfaces/Time stamp
+# Transform frame with which this data is associated.
+string frame_id
+================================================================================
+MSG: builtin_interfaces/msg/Time
+# The seconds component, valid over all int32 values.
+int32 sec
+# The nanoseconds component, valid in the range [0, 10e9).
+uint32 nanosec
+`
+}
+
 type CRange = C.sensor_msgs__msg__Range
 type CRange__Sequence = C.sensor_msgs__msg__Range__Sequence
 
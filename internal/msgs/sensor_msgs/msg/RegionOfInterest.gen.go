@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	
@@ -60,6 +62,50 @@ func (t *RegionOfInterest) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *RegionOfInterest) Equal(other *RegionOfInterest) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *RegionOfInterest) EqualApprox(other *RegionOfInterest, tol float64) bool {
+	if t.XOffset != other.XOffset {
+		return false
+	}
+	if t.YOffset != other.YOffset {
+		return false
+	}
+	if t.Height != other.Height {
+		return false
+	}
+	if t.Width != other.Width {
+		return false
+	}
+	if t.DoRectify != other.DoRectify {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *RegionOfInterest) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *RegionOfInterest) HashTo(h *msgcmp.Hasher) {
+	h.Uint32(t.XOffset)
+	h.Uint32(t.YOffset)
+	h.Uint32(t.Height)
+	h.Uint32(t.Width)
+	h.Bool(t.DoRectify)
+}
+
 func (t *RegionOfInterest) SetDefaults() {
 	t.XOffset = 0
 	t.YOffset = 0
@@ -72,6 +118,48 @@ func (t *RegionOfInterest) GetTypeSupport() types.MessageTypeSupport {
 	return RegionOfInterestTypeSupport
 }
 
+func (t *RegionOfInterest) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *RegionOfInterest) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *RegionOfInterest) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *RegionOfInterest) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *RegionOfInterest) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *RegionOfInterest) EncodeCDR(e *cdr.Encoder) {
+	e.Uint32(t.XOffset)
+	e.Uint32(t.YOffset)
+	e.Uint32(t.Height)
+	e.Uint32(t.Width)
+	e.Bool(t.DoRectify)
+}
+
+func (t *RegionOfInterest) DecodeCDR(d *cdr.Decoder) {
+	t.XOffset = d.Uint32()
+	t.YOffset = d.Uint32()
+	t.Height = d.Uint32()
+	t.Width = d.Uint32()
+	t.DoRectify = d.Bool()
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *RegionOfInterest) Validate() error {
+	return nil
+}
+
 // RegionOfInterestPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type RegionOfInterestPublisher struct {
@@ -129,6 +217,20 @@ func CloneRegionOfInterestSlice(dst, src []RegionOfInterest) {
 	}
 }
 
+// RegionOfInterestDescriptor describes the fields of RegionOfInterest. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var RegionOfInterestDescriptor = &types.MessageDescriptor{
+	TypeName: "sensor_msgs/msg/RegionOfInterest",
+	Fields: []types.FieldDescriptor{
+		{Name: "x_offset", Type: "uint32", GoIndex: 0},
+		{Name: "y_offset", Type: "uint32", GoIndex: 1},
+		{Name: "height", Type: "uint32", GoIndex: 2},
+		{Name: "width", Type: "uint32", GoIndex: 3},
+		{Name: "do_rectify", Type: "bool", GoIndex: 4},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var RegionOfInterestTypeSupport types.MessageTypeSupport = _RegionOfInterestTypeSupport{}
 
@@ -170,6 +272,32 @@ func (t _RegionOfInterestTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__sensor_msgs__msg__RegionOfInterest())
 }
 
+func (t _RegionOfInterestTypeSupport) Descriptor() *types.MessageDescriptor {
+	return RegionOfInterestDescriptor
+}
+
+func (t _RegionOfInterestTypeSupport) TypeHash() string {
+	return `RIHS01_ad16bcba5f9131dcdba6fbded19f726f5440e3c513b4fb586dd3027eeed8abb1`
+}
+
+func (t _RegionOfInterestTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "sensor_msgs/msg/RegionOfInterest", "fields": [{"name": "x_offset", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "y_offset", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "height", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "width", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "do_rectify", "type": {"type_id": 15, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": []}`
+}
+
+func (t _RegionOfInterestTypeSupport) MessageDefinition() string {
+	return `# Leftmost pixel of the ROI
+uint32 x_offset
+# Topmost pixel of the ROI. (0 if the ROI includes the left edge of the image)
+uint32 y_offset
+# Height of ROI. (0 if the ROI includes the top edge of the image)
+uint32 height
+# Width of ROI
+uint32 width
+# True if a distinct rectified ROI should be calculated from the "raw"ROI in this message. Typically this should be False if the full imageis captured (ROI not used), and True if a subwindow is captured (ROIused).
+bool do_rectify
+`
+}
+
 type CRegionOfInterest = C.sensor_msgs__msg__RegionOfInterest
 type CRegionOfInterest__Sequence = C.sensor_msgs__msg__RegionOfInterest__Sequence
 
@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	std_msgs_msg "github.com/tiiuae/rclgo/internal/msgs/std_msgs/msg"
@@ -57,6 +59,42 @@ func (t *RelativeHumidity) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *RelativeHumidity) Equal(other *RelativeHumidity) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *RelativeHumidity) EqualApprox(other *RelativeHumidity, tol float64) bool {
+	if !t.Header.EqualApprox(&other.Header, tol) {
+		return false
+	}
+	if !msgcmp.Float64(t.RelativeHumidity, other.RelativeHumidity, tol) {
+		return false
+	}
+	if !msgcmp.Float64(t.Variance, other.Variance, tol) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *RelativeHumidity) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *RelativeHumidity) HashTo(h *msgcmp.Hasher) {
+	t.Header.HashTo(h)
+	h.Float64(t.RelativeHumidity)
+	h.Float64(t.Variance)
+}
+
 func (t *RelativeHumidity) SetDefaults() {
 	t.Header.SetDefaults()
 	t.RelativeHumidity = 0
@@ -67,6 +105,47 @@ func (t *RelativeHumidity) GetTypeSupport() types.MessageTypeSupport {
 	return RelativeHumidityTypeSupport
 }
 
+func (t *RelativeHumidity) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *RelativeHumidity) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *RelativeHumidity) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *RelativeHumidity) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *RelativeHumidity) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *RelativeHumidity) EncodeCDR(e *cdr.Encoder) {
+	t.Header.EncodeCDR(e)
+	e.Float64(t.RelativeHumidity)
+	e.Float64(t.Variance)
+}
+
+func (t *RelativeHumidity) DecodeCDR(d *cdr.Decoder) {
+	t.Header.DecodeCDR(d)
+	t.RelativeHumidity = d.Float64()
+	t.Variance = d.Float64()
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *RelativeHumidity) Validate() error {
+	if err := t.Header.Validate(); err != nil {
+		return rclgo.WrapValidationError("header", -1, err)
+	}
+	return nil
+}
+
 // RelativeHumidityPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type RelativeHumidityPublisher struct {
@@ -124,6 +203,18 @@ func CloneRelativeHumiditySlice(dst, src []RelativeHumidity) {
 	}
 }
 
+// RelativeHumidityDescriptor describes the fields of RelativeHumidity. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var RelativeHumidityDescriptor = &types.MessageDescriptor{
+	TypeName: "sensor_msgs/msg/RelativeHumidity",
+	Fields: []types.FieldDescriptor{
+		{Name: "header", Type: "std_msgs/msg/Header", GoIndex: 0, Message: std_msgs_msg.HeaderDescriptor},
+		{Name: "relative_humidity", Type: "float64", GoIndex: 1},
+		{Name: "variance", Type: "float64", GoIndex: 2},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var RelativeHumidityTypeSupport types.MessageTypeSupport = _RelativeHumidityTypeSupport{}
 
@@ -161,6 +252,40 @@ func (t _RelativeHumidityTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__sensor_msgs__msg__RelativeHumidity())
 }
 
+func (t _RelativeHumidityTypeSupport) Descriptor() *types.MessageDescriptor {
+	return RelativeHumidityDescriptor
+}
+
+func (t _RelativeHumidityTypeSupport) TypeHash() string {
+	return `RIHS01_8687c99b4fb393cb2e545e407b5ea7fd0b5d8960bcd849a0f86c544740138839`
+}
+
+func (t _RelativeHumidityTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "sensor_msgs/msg/RelativeHumidity", "fields": [{"name": "header", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "std_msgs/msg/Header"}, "default_value": ""}, {"name": "relative_humidity", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "variance", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "builtin_interfaces/msg/Time", "fields": [{"name": "sec", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "nanosec", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "std_msgs/msg/Header", "fields": [{"name": "stamp", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "builtin_interfaces/msg/Time"}, "default_value": ""}, {"name": "frame_id", "type": {"type_id": 17, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}]}`
+}
+
+func (t _RelativeHumidityTypeSupport) MessageDefinition() string {
+	return `# timestamp of the measurement
+std_msgs/Header header
+# Expression of the relative humidity
+float64 relative_humidity
+# 0 is interpreted as variance unknown
+float64 variance
+================================================================================
+MSG: std_msgs/msg/Header
+# Two-integer timestamp that is expressed as seconds and nanoseconds.
+builtin_interfaces/Time stamp
+# Transform frame with which this data is associated.
+string frame_id
+================================================================================
+MSG: builtin_interfaces/msg/Time
+# The seconds component, valid over all int32 values.
+int32 sec
+# The nanoseconds component, valid in the range [0, 10e9).
+uint32 nanosec
+`
+}
+
 type CRelativeHumidity = C.sensor_msgs__msg__RelativeHumidity
 type CRelativeHumidity__Sequence = C.sensor_msgs__msg__RelativeHumidity__Sequence
 
@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	std_msgs_msg "github.com/tiiuae/rclgo/internal/msgs/std_msgs/msg"
@@ -57,6 +59,42 @@ func (t *Temperature) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *Temperature) Equal(other *Temperature) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *Temperature) EqualApprox(other *Temperature, tol float64) bool {
+	if !t.Header.EqualApprox(&other.Header, tol) {
+		return false
+	}
+	if !msgcmp.Float64(t.Temperature, other.Temperature, tol) {
+		return false
+	}
+	if !msgcmp.Float64(t.Variance, other.Variance, tol) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *Temperature) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *Temperature) HashTo(h *msgcmp.Hasher) {
+	t.Header.HashTo(h)
+	h.Float64(t.Temperature)
+	h.Float64(t.Variance)
+}
+
 func (t *Temperature) SetDefaults() {
 	t.Header.SetDefaults()
 	t.Temperature = 0
@@ -67,6 +105,47 @@ func (t *Temperature) GetTypeSupport() types.MessageTypeSupport {
 	return TemperatureTypeSupport
 }
 
+func (t *Temperature) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *Temperature) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *Temperature) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *Temperature) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *Temperature) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *Temperature) EncodeCDR(e *cdr.Encoder) {
+	t.Header.EncodeCDR(e)
+	e.Float64(t.Temperature)
+	e.Float64(t.Variance)
+}
+
+func (t *Temperature) DecodeCDR(d *cdr.Decoder) {
+	t.Header.DecodeCDR(d)
+	t.Temperature = d.Float64()
+	t.Variance = d.Float64()
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *Temperature) Validate() error {
+	if err := t.Header.Validate(); err != nil {
+		return rclgo.WrapValidationError("header", -1, err)
+	}
+	return nil
+}
+
 // TemperaturePublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type TemperaturePublisher struct {
@@ -124,6 +203,18 @@ func CloneTemperatureSlice(dst, src []Temperature) {
 	}
 }
 
+// TemperatureDescriptor describes the fields of Temperature. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var TemperatureDescriptor = &types.MessageDescriptor{
+	TypeName: "sensor_msgs/msg/Temperature",
+	Fields: []types.FieldDescriptor{
+		{Name: "header", Type: "std_msgs/msg/Header", GoIndex: 0, Message: std_msgs_msg.HeaderDescriptor},
+		{Name: "temperature", Type: "float64", GoIndex: 1},
+		{Name: "variance", Type: "float64", GoIndex: 2},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var TemperatureTypeSupport types.MessageTypeSupport = _TemperatureTypeSupport{}
 
@@ -161,6 +252,40 @@ func (t _TemperatureTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__sensor_msgs__msg__Temperature())
 }
 
+func (t _TemperatureTypeSupport) Descriptor() *types.MessageDescriptor {
+	return TemperatureDescriptor
+}
+
+func (t _TemperatureTypeSupport) TypeHash() string {
+	return `RIHS01_72514a14126ab9f8a9abec974c78e5610a367b59db5da355ff1fb982d5bad4b8`
+}
+
+func (t _TemperatureTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "sensor_msgs/msg/Temperature", "fields": [{"name": "header", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "std_msgs/msg/Header"}, "default_value": ""}, {"name": "temperature", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "variance", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "builtin_interfaces/msg/Time", "fields": [{"name": "sec", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "nanosec", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "std_msgs/msg/Header", "fields": [{"name": "stamp", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "builtin_interfaces/msg/Time"}, "default_value": ""}, {"name": "frame_id", "type": {"type_id": 17, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}]}`
+}
+
+func (t _TemperatureTypeSupport) MessageDefinition() string {
+	return `# timestamp is the time the temperature was measured
+std_msgs/Header header
+# Measurement of the Temperature in Degrees Celsius.
+float64 temperature
+# 0 is interpreted as variance unknown.
+float64 variance
+================================================================================
+MSG: std_msgs/msg/Header
+# Two-integer timestamp that is expressed as seconds and nanoseconds.
+builtin_interfaces/Time stamp
+# Transform frame with which this data is associated.
+string frame_id
+================================================================================
+MSG: builtin_interfaces/msg/Time
+# The seconds component, valid over all int32 values.
+int32 sec
+# The nanoseconds component, valid in the range [0, 10e9).
+uint32 nanosec
+`
+}
+
 type CTemperature = C.sensor_msgs__msg__Temperature
 type CTemperature__Sequence = C.sensor_msgs__msg__Temperature__Sequence
 
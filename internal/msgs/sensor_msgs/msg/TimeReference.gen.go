@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	builtin_interfaces_msg "github.com/tiiuae/rclgo/internal/msgs/builtin_interfaces/msg"
@@ -59,6 +61,42 @@ func (t *TimeReference) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *TimeReference) Equal(other *TimeReference) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *TimeReference) EqualApprox(other *TimeReference, tol float64) bool {
+	if !t.Header.EqualApprox(&other.Header, tol) {
+		return false
+	}
+	if !t.TimeRef.EqualApprox(&other.TimeRef, tol) {
+		return false
+	}
+	if t.Source != other.Source {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *TimeReference) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *TimeReference) HashTo(h *msgcmp.Hasher) {
+	t.Header.HashTo(h)
+	t.TimeRef.HashTo(h)
+	h.String(t.Source)
+}
+
 func (t *TimeReference) SetDefaults() {
 	t.Header.SetDefaults()
 	t.TimeRef.SetDefaults()
@@ -69,6 +107,50 @@ func (t *TimeReference) GetTypeSupport() types.MessageTypeSupport {
 	return TimeReferenceTypeSupport
 }
 
+func (t *TimeReference) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *TimeReference) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *TimeReference) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *TimeReference) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *TimeReference) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *TimeReference) EncodeCDR(e *cdr.Encoder) {
+	t.Header.EncodeCDR(e)
+	t.TimeRef.EncodeCDR(e)
+	e.String(t.Source)
+}
+
+func (t *TimeReference) DecodeCDR(d *cdr.Decoder) {
+	t.Header.DecodeCDR(d)
+	t.TimeRef.DecodeCDR(d)
+	t.Source = d.String()
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *TimeReference) Validate() error {
+	if err := t.Header.Validate(); err != nil {
+		return rclgo.WrapValidationError("header", -1, err)
+	}
+	if err := t.TimeRef.Validate(); err != nil {
+		return rclgo.WrapValidationError("time_ref", -1, err)
+	}
+	return nil
+}
+
 // TimeReferencePublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type TimeReferencePublisher struct {
@@ -126,6 +208,18 @@ func CloneTimeReferenceSlice(dst, src []TimeReference) {
 	}
 }
 
+// TimeReferenceDescriptor describes the fields of TimeReference. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var TimeReferenceDescriptor = &types.MessageDescriptor{
+	TypeName: "sensor_msgs/msg/TimeReference",
+	Fields: []types.FieldDescriptor{
+		{Name: "header", Type: "std_msgs/msg/Header", GoIndex: 0, Message: std_msgs_msg.HeaderDescriptor},
+		{Name: "time_ref", Type: "builtin_interfaces/msg/Time", GoIndex: 1, Message: builtin_interfaces_msg.TimeDescriptor},
+		{Name: "source", Type: "string", GoIndex: 2},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var TimeReferenceTypeSupport types.MessageTypeSupport = _TimeReferenceTypeSupport{}
 
@@ -163,6 +257,40 @@ func (t _TimeReferenceTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__sensor_msgs__msg__TimeReference())
 }
 
+func (t _TimeReferenceTypeSupport) Descriptor() *types.MessageDescriptor {
+	return TimeReferenceDescriptor
+}
+
+func (t _TimeReferenceTypeSupport) TypeHash() string {
+	return `RIHS01_dd66e84cf40bbb5d5a40472e6ecf2675a031334d4c426abdb2ad41801a8efc99`
+}
+
+func (t _TimeReferenceTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "sensor_msgs/msg/TimeReference", "fields": [{"name": "header", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "std_msgs/msg/Header"}, "default_value": ""}, {"name": "time_ref", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "builtin_interfaces/msg/Time"}, "default_value": ""}, {"name": "source", "type": {"type_id": 17, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "builtin_interfaces/msg/Time", "fields": [{"name": "sec", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "nanosec", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "std_msgs/msg/Header", "fields": [{"name": "stamp", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "builtin_interfaces/msg/Time"}, "default_value": ""}, {"name": "frame_id", "type": {"type_id": 17, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}]}`
+}
+
+func (t _TimeReferenceTypeSupport) MessageDefinition() string {
+	return `# stamp is system time for which measurement was valid
+std_msgs/Header header
+# corresponding time from this external source
+builtin_interfaces/Time time_ref
+# (optional) name of time source
+string source
+================================================================================
+MSG: std_msgs/msg/Header
+# Two-integer timestamp that is expressed as seconds and nanoseconds.
+builtin_interfaces/Time stamp
+# Transform frame with which this data is associated.
+string frame_id
+================================================================================
+MSG: builtin_interfaces/msg/Time
+# The seconds component, valid over all int32 values.
+int32 sec
+# The nanoseconds component, valid in the range [0, 10e9).
+uint32 nanosec
+`
+}
+
 type CTimeReference = C.sensor_msgs__msg__TimeReference
 type CTimeReference__Sequence = C.sensor_msgs__msg__TimeReference__Sequence
 
@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	sensor_msgs_msg "github.com/tiiuae/rclgo/internal/msgs/sensor_msgs/msg"
@@ -53,6 +55,34 @@ func (t *SetCameraInfo_Request) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *SetCameraInfo_Request) Equal(other *SetCameraInfo_Request) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *SetCameraInfo_Request) EqualApprox(other *SetCameraInfo_Request, tol float64) bool {
+	if !t.CameraInfo.EqualApprox(&other.CameraInfo, tol) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *SetCameraInfo_Request) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *SetCameraInfo_Request) HashTo(h *msgcmp.Hasher) {
+	t.CameraInfo.HashTo(h)
+}
+
 func (t *SetCameraInfo_Request) SetDefaults() {
 	t.CameraInfo.SetDefaults()
 }
@@ -61,6 +91,43 @@ func (t *SetCameraInfo_Request) GetTypeSupport() types.MessageTypeSupport {
 	return SetCameraInfo_RequestTypeSupport
 }
 
+func (t *SetCameraInfo_Request) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *SetCameraInfo_Request) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *SetCameraInfo_Request) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *SetCameraInfo_Request) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *SetCameraInfo_Request) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *SetCameraInfo_Request) EncodeCDR(e *cdr.Encoder) {
+	t.CameraInfo.EncodeCDR(e)
+}
+
+func (t *SetCameraInfo_Request) DecodeCDR(d *cdr.Decoder) {
+	t.CameraInfo.DecodeCDR(d)
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *SetCameraInfo_Request) Validate() error {
+	if err := t.CameraInfo.Validate(); err != nil {
+		return rclgo.WrapValidationError("camera_info", -1, err)
+	}
+	return nil
+}
+
 // SetCameraInfo_RequestPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type SetCameraInfo_RequestPublisher struct {
@@ -118,6 +185,16 @@ func CloneSetCameraInfo_RequestSlice(dst, src []SetCameraInfo_Request) {
 	}
 }
 
+// SetCameraInfo_RequestDescriptor describes the fields of SetCameraInfo_Request. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var SetCameraInfo_RequestDescriptor = &types.MessageDescriptor{
+	TypeName: "sensor_msgs/srv/SetCameraInfo_Request",
+	Fields: []types.FieldDescriptor{
+		{Name: "camera_info", Type: "sensor_msgs/msg/CameraInfo", GoIndex: 0, Message: sensor_msgs_msg.CameraInfoDescriptor},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var SetCameraInfo_RequestTypeSupport types.MessageTypeSupport = _SetCameraInfo_RequestTypeSupport{}
 
@@ -151,6 +228,70 @@ func (t _SetCameraInfo_RequestTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__sensor_msgs__srv__SetCameraInfo_Request())
 }
 
+func (t _SetCameraInfo_RequestTypeSupport) Descriptor() *types.MessageDescriptor {
+	return SetCameraInfo_RequestDescriptor
+}
+
+func (t _SetCameraInfo_RequestTypeSupport) TypeHash() string {
+	return `RIHS01_27dc5497730fdb6930e66cb879c210e31a83022acc5bbc999f24f55286029f87`
+}
+
+func (t _SetCameraInfo_RequestTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "sensor_msgs/srv/SetCameraInfo_Request", "fields": [{"name": "camera_info", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "sensor_msgs/msg/CameraInfo"}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "builtin_interfaces/msg/Time", "fields": [{"name": "sec", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "nanosec", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "sensor_msgs/msg/CameraInfo", "fields": [{"name": "header", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "std_msgs/msg/Header"}, "default_value": ""}, {"name": "height", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "width", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "distortion_model", "type": {"type_id": 17, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "d", "type": {"type_id": 155, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "k", "type": {"type_id": 59, "capacity": 9, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "r", "type": {"type_id": 59, "capacity": 9, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "p", "type": {"type_id": 59, "capacity": 12, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "binning_x", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "binning_y", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "roi", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "sensor_msgs/msg/RegionOfInterest"}, "default_value": ""}]}, {"type_name": "sensor_msgs/msg/RegionOfInterest", "fields": [{"name": "x_offset", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "y_offset", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "height", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "width", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "do_rectify", "type": {"type_id": 15, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "std_msgs/msg/Header", "fields": [{"name": "stamp", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "builtin_interfaces/msg/Time"}, "default_value": ""}, {"name": "frame_id", "type": {"type_id": 17, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}]}`
+}
+
+func (t _SetCameraInfo_RequestTypeSupport) MessageDefinition() string {
+	return `# The camera_info to store
+CameraInfo camera_info
+================================================================================
+MSG: sensor_msgs/msg/CameraInfo
+# Header timestamp should be acquisition time of image. Time of image acquisition, camera coordinate frame ID
+std_msgs/Header header
+# The image dimensions with which the camera was calibrated.Normally this will be the full camera resolution in pixels.
+uint32 height
+uint32 width
+# The distortion model used. Supported models are listed insensor_msgs/distortion_models.hpp. For most cameras, "plumb_bob" - asimple model of radial and tangential distortion - is sufficent.
+string distortion_model
+# The distortion parameters, size depending on the distortion model.For "plumb_bob", the 5 parameters are: (k1, k2, t1, t2, k3).
+float64[] d
+# 3x3 row-major matrix. Intrinsic camera matrix for the raw (distorted) images.[fx  0 cx]K = [ 0 fy cy][ 0  0  1]Projects 3D points in the camera coordinate frame to 2D pixelcoordinates using the focal lengths (fx, fy) and principal point(cx, cy).
+float64[9] k
+# 3x3 row-major matrix. Rectification matrix (stereo cameras only)A rotation matrix aligning the camera coordinate system to the idealstereo image plane so that epipolar lines in both stereo images areparallel.
+float64[9] r
+# 3x4 row-major matrix. Projection/camera matrix[fx'  0  cx' Tx]P = [ 0  fy' cy' Ty][ 0   0   1   0]By convention, this matrix specifies the intrinsic (camera) matrixof the processed (rectified) image. That is, the left 3x3 portionis the normal camera intrinsic matrix for the rectified image.It projects 3D points in the camera coordinate frame to 2D pixelcoordinates using the focal lengths (fx', fy') and principal point(cx', cy') - these may differ from the values in K.For monocular cameras, Tx = Ty = 0. Normally, monocular cameras willalso have R = the identity and P[1:3,1:3] = K.For a stereo pair, the fourth column [Tx Ty 0]' is related to theposition of the optical center of the second camera in the firstcamera's frame. We assume Tz = 0 so both cameras are in the samestereo image plane. The first camera always has Tx = Ty = 0. Forthe right (second) camera of a horizontal stereo pair, Ty = 0 andTx = -fx' * B, where B is the baseline between the cameras.Given a 3D point [X Y Z]', the projection (x, y) of the point ontothe rectified image is given by:[u v w]' = P * [X Y Z 1]'x = u / wy = v / wThis holds for both images of a stereo pair.
+float64[12] p
+# Binning refers here to any camera setting which combines rectangularneighborhoods of pixels into larger "super-pixels." It reduces theresolution of the output image to(width / binning_x) x (height / binning_y).The default values binning_x = binning_y = 0 is considered the sameas binning_x = binning_y = 1 (no subsampling).
+uint32 binning_x
+uint32 binning_y
+# Region of interest (subwindow of full camera resolution), given infull resolution (unbinned) image coordinates. A particular ROIalways denotes the same window of pixels on the camera sensor,regardless of binning settings.The default setting of roi (all values 0) is considered the same asfull resolution (roi.width = width, roi.height = height).
+RegionOfInterest roi
+================================================================================
+MSG: std_msgs/msg/Header
+# Two-integer timestamp that is expressed as seconds and nanoseconds.
+builtin_interfaces/Time stamp
+# Transform frame with which this data is associated.
+string frame_id
+================================================================================
+MSG: sensor_msgs/msg/RegionOfInterest
+# Leftmost pixel of the ROI
+uint32 x_offset
+# Topmost pixel of the ROI. (0 if the ROI includes the left edge of the image)
+uint32 y_offset
+# Height of ROI. (0 if the ROI includes the top edge of the image)
+uint32 height
+# Width of ROI
+uint32 width
+# True if a distinct rectified ROI should be calculated from the "raw"ROI in this message. Typically this should be False if the full imageis captured (ROI not used), and True if a subwindow is captured (ROIused).
+bool do_rectify
+================================================================================
+MSG: builtin_interfaces/msg/Time
+# The seconds component, valid over all int32 values.
+int32 sec
+# The nanoseconds component, valid in the range [0, 10e9).
+uint32 nanosec
+`
+}
+
 type CSetCameraInfo_Request = C.sensor_msgs__srv__SetCameraInfo_Request
 type CSetCameraInfo_Request__Sequence = C.sensor_msgs__srv__SetCameraInfo_Request__Sequence
 
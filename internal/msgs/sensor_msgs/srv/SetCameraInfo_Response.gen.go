@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	primitives "github.com/tiiuae/rclgo/pkg/rclgo/primitives"
@@ -55,6 +57,38 @@ func (t *SetCameraInfo_Response) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *SetCameraInfo_Response) Equal(other *SetCameraInfo_Response) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *SetCameraInfo_Response) EqualApprox(other *SetCameraInfo_Response, tol float64) bool {
+	if t.Success != other.Success {
+		return false
+	}
+	if t.StatusMessage != other.StatusMessage {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *SetCameraInfo_Response) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *SetCameraInfo_Response) HashTo(h *msgcmp.Hasher) {
+	h.Bool(t.Success)
+	h.String(t.StatusMessage)
+}
+
 func (t *SetCameraInfo_Response) SetDefaults() {
 	t.Success = false
 	t.StatusMessage = ""
@@ -64,6 +98,42 @@ func (t *SetCameraInfo_Response) GetTypeSupport() types.MessageTypeSupport {
 	return SetCameraInfo_ResponseTypeSupport
 }
 
+func (t *SetCameraInfo_Response) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *SetCameraInfo_Response) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *SetCameraInfo_Response) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *SetCameraInfo_Response) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *SetCameraInfo_Response) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *SetCameraInfo_Response) EncodeCDR(e *cdr.Encoder) {
+	e.Bool(t.Success)
+	e.String(t.StatusMessage)
+}
+
+func (t *SetCameraInfo_Response) DecodeCDR(d *cdr.Decoder) {
+	t.Success = d.Bool()
+	t.StatusMessage = d.String()
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *SetCameraInfo_Response) Validate() error {
+	return nil
+}
+
 // SetCameraInfo_ResponsePublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type SetCameraInfo_ResponsePublisher struct {
@@ -121,6 +191,17 @@ func CloneSetCameraInfo_ResponseSlice(dst, src []SetCameraInfo_Response) {
 	}
 }
 
+// SetCameraInfo_ResponseDescriptor describes the fields of SetCameraInfo_Response. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var SetCameraInfo_ResponseDescriptor = &types.MessageDescriptor{
+	TypeName: "sensor_msgs/srv/SetCameraInfo_Response",
+	Fields: []types.FieldDescriptor{
+		{Name: "success", Type: "bool", GoIndex: 0},
+		{Name: "status_message", Type: "string", GoIndex: 1},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var SetCameraInfo_ResponseTypeSupport types.MessageTypeSupport = _SetCameraInfo_ResponseTypeSupport{}
 
@@ -156,6 +237,26 @@ func (t _SetCameraInfo_ResponseTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__sensor_msgs__srv__SetCameraInfo_Response())
 }
 
+func (t _SetCameraInfo_ResponseTypeSupport) Descriptor() *types.MessageDescriptor {
+	return SetCameraInfo_ResponseDescriptor
+}
+
+func (t _SetCameraInfo_ResponseTypeSupport) TypeHash() string {
+	return `RIHS01_4db6d7df20cdcdc05430945e76fa58e1eab1285c15f31d26fad76e2c1b10299c`
+}
+
+func (t _SetCameraInfo_ResponseTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "sensor_msgs/srv/SetCameraInfo_Response", "fields": [{"name": "success", "type": {"type_id": 15, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "status_message", "type": {"type_id": 17, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": []}`
+}
+
+func (t _SetCameraInfo_ResponseTypeSupport) MessageDefinition() string {
+	return `# True if the call succeeded
+bool success
+# Used to give details about success
+string status_message
+`
+}
+
 type CSetCameraInfo_Response = C.sensor_msgs__srv__SetCameraInfo_Response
 type CSetCameraInfo_Response__Sequence = C.sensor_msgs__srv__SetCameraInfo_Response__Sequence
 
@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	
@@ -52,6 +54,34 @@ func (t *Byte) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *Byte) Equal(other *Byte) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *Byte) EqualApprox(other *Byte, tol float64) bool {
+	if t.Data != other.Data {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *Byte) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *Byte) HashTo(h *msgcmp.Hasher) {
+	h.Uint8(t.Data)
+}
+
 func (t *Byte) SetDefaults() {
 	t.Data = 0
 }
@@ -60,6 +90,40 @@ func (t *Byte) GetTypeSupport() types.MessageTypeSupport {
 	return ByteTypeSupport
 }
 
+func (t *Byte) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *Byte) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *Byte) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *Byte) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *Byte) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *Byte) EncodeCDR(e *cdr.Encoder) {
+	e.Uint8(t.Data)
+}
+
+func (t *Byte) DecodeCDR(d *cdr.Decoder) {
+	t.Data = d.Uint8()
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *Byte) Validate() error {
+	return nil
+}
+
 // BytePublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type BytePublisher struct {
@@ -117,6 +181,16 @@ func CloneByteSlice(dst, src []Byte) {
 	}
 }
 
+// ByteDescriptor describes the fields of Byte. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var ByteDescriptor = &types.MessageDescriptor{
+	TypeName: "std_msgs/msg/Byte",
+	Fields: []types.FieldDescriptor{
+		{Name: "data", Type: "byte", GoIndex: 0},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var ByteTypeSupport types.MessageTypeSupport = _ByteTypeSupport{}
 
@@ -150,6 +224,23 @@ func (t _ByteTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__std_msgs__msg__Byte())
 }
 
+func (t _ByteTypeSupport) Descriptor() *types.MessageDescriptor {
+	return ByteDescriptor
+}
+
+func (t _ByteTypeSupport) TypeHash() string {
+	return `RIHS01_41e1a3345f73fe93ede006da826a6ee274af23dd4653976ff249b0f44e3e798f`
+}
+
+func (t _ByteTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "std_msgs/msg/Byte", "fields": [{"name": "data", "type": {"type_id": 16, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": []}`
+}
+
+func (t _ByteTypeSupport) MessageDefinition() string {
+	return `byte data
+`
+}
+
 type CByte = C.std_msgs__msg__Byte
 type CByte__Sequence = C.std_msgs__msg__Byte__Sequence
 
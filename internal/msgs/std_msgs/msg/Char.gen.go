@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	
@@ -52,6 +54,34 @@ func (t *Char) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *Char) Equal(other *Char) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *Char) EqualApprox(other *Char, tol float64) bool {
+	if t.Data != other.Data {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *Char) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *Char) HashTo(h *msgcmp.Hasher) {
+	h.Uint8(t.Data)
+}
+
 func (t *Char) SetDefaults() {
 	t.Data = 0
 }
@@ -60,6 +90,40 @@ func (t *Char) GetTypeSupport() types.MessageTypeSupport {
 	return CharTypeSupport
 }
 
+func (t *Char) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *Char) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *Char) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *Char) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *Char) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *Char) EncodeCDR(e *cdr.Encoder) {
+	e.Uint8(t.Data)
+}
+
+func (t *Char) DecodeCDR(d *cdr.Decoder) {
+	t.Data = d.Uint8()
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *Char) Validate() error {
+	return nil
+}
+
 // CharPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type CharPublisher struct {
@@ -117,6 +181,16 @@ func CloneCharSlice(dst, src []Char) {
 	}
 }
 
+// CharDescriptor describes the fields of Char. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var CharDescriptor = &types.MessageDescriptor{
+	TypeName: "std_msgs/msg/Char",
+	Fields: []types.FieldDescriptor{
+		{Name: "data", Type: "char", GoIndex: 0},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var CharTypeSupport types.MessageTypeSupport = _CharTypeSupport{}
 
@@ -150,6 +224,23 @@ func (t _CharTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__std_msgs__msg__Char())
 }
 
+func (t _CharTypeSupport) Descriptor() *types.MessageDescriptor {
+	return CharDescriptor
+}
+
+func (t _CharTypeSupport) TypeHash() string {
+	return `RIHS01_3ad2d04dd29ba19d04b16659afa3ccaedd691914b02a64e82e252f2fa6a586a9`
+}
+
+func (t _CharTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "std_msgs/msg/Char", "fields": [{"name": "data", "type": {"type_id": 3, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": []}`
+}
+
+func (t _CharTypeSupport) MessageDefinition() string {
+	return `char data
+`
+}
+
 type CChar = C.std_msgs__msg__Char
 type CChar__Sequence = C.std_msgs__msg__Char__Sequence
 
@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	
@@ -58,6 +60,46 @@ func (t *ColorRGBA) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *ColorRGBA) Equal(other *ColorRGBA) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *ColorRGBA) EqualApprox(other *ColorRGBA, tol float64) bool {
+	if !msgcmp.Float32(t.R, other.R, tol) {
+		return false
+	}
+	if !msgcmp.Float32(t.G, other.G, tol) {
+		return false
+	}
+	if !msgcmp.Float32(t.B, other.B, tol) {
+		return false
+	}
+	if !msgcmp.Float32(t.A, other.A, tol) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *ColorRGBA) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *ColorRGBA) HashTo(h *msgcmp.Hasher) {
+	h.Float32(t.R)
+	h.Float32(t.G)
+	h.Float32(t.B)
+	h.Float32(t.A)
+}
+
 func (t *ColorRGBA) SetDefaults() {
 	t.R = 0
 	t.G = 0
@@ -69,6 +111,46 @@ func (t *ColorRGBA) GetTypeSupport() types.MessageTypeSupport {
 	return ColorRGBATypeSupport
 }
 
+func (t *ColorRGBA) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *ColorRGBA) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *ColorRGBA) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *ColorRGBA) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *ColorRGBA) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *ColorRGBA) EncodeCDR(e *cdr.Encoder) {
+	e.Float32(t.R)
+	e.Float32(t.G)
+	e.Float32(t.B)
+	e.Float32(t.A)
+}
+
+func (t *ColorRGBA) DecodeCDR(d *cdr.Decoder) {
+	t.R = d.Float32()
+	t.G = d.Float32()
+	t.B = d.Float32()
+	t.A = d.Float32()
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *ColorRGBA) Validate() error {
+	return nil
+}
+
 // ColorRGBAPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type ColorRGBAPublisher struct {
@@ -126,6 +208,19 @@ func CloneColorRGBASlice(dst, src []ColorRGBA) {
 	}
 }
 
+// ColorRGBADescriptor describes the fields of ColorRGBA. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var ColorRGBADescriptor = &types.MessageDescriptor{
+	TypeName: "std_msgs/msg/ColorRGBA",
+	Fields: []types.FieldDescriptor{
+		{Name: "r", Type: "float32", GoIndex: 0},
+		{Name: "g", Type: "float32", GoIndex: 1},
+		{Name: "b", Type: "float32", GoIndex: 2},
+		{Name: "a", Type: "float32", GoIndex: 3},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var ColorRGBATypeSupport types.MessageTypeSupport = _ColorRGBATypeSupport{}
 
@@ -165,6 +260,26 @@ func (t _ColorRGBATypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__std_msgs__msg__ColorRGBA())
 }
 
+func (t _ColorRGBATypeSupport) Descriptor() *types.MessageDescriptor {
+	return ColorRGBADescriptor
+}
+
+func (t _ColorRGBATypeSupport) TypeHash() string {
+	return `RIHS01_77a7a5b9ae477306097665106e0413ba74440245b1f3d0c6d6405fe5c7813fe8`
+}
+
+func (t _ColorRGBATypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "std_msgs/msg/ColorRGBA", "fields": [{"name": "r", "type": {"type_id": 10, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "g", "type": {"type_id": 10, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "b", "type": {"type_id": 10, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "a", "type": {"type_id": 10, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": []}`
+}
+
+func (t _ColorRGBATypeSupport) MessageDefinition() string {
+	return `float32 r
+float32 g
+float32 b
+float32 a
+`
+}
+
 type CColorRGBA = C.std_msgs__msg__ColorRGBA
 type CColorRGBA__Sequence = C.std_msgs__msg__ColorRGBA__Sequence
 
@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	
@@ -50,6 +52,30 @@ func (t *Empty) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *Empty) Equal(other *Empty) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *Empty) EqualApprox(other *Empty, tol float64) bool {
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *Empty) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *Empty) HashTo(h *msgcmp.Hasher) {
+}
+
 func (t *Empty) SetDefaults() {
 }
 
@@ -57,6 +83,40 @@ func (t *Empty) GetTypeSupport() types.MessageTypeSupport {
 	return EmptyTypeSupport
 }
 
+func (t *Empty) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *Empty) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *Empty) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *Empty) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *Empty) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *Empty) EncodeCDR(e *cdr.Encoder) {
+	e.Uint8(0)
+}
+
+func (t *Empty) DecodeCDR(d *cdr.Decoder) {
+	d.Uint8()
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *Empty) Validate() error {
+	return nil
+}
+
 // EmptyPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type EmptyPublisher struct {
@@ -114,6 +174,13 @@ func CloneEmptySlice(dst, src []Empty) {
 	}
 }
 
+// EmptyDescriptor describes the fields of Empty. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var EmptyDescriptor = &types.MessageDescriptor{
+	TypeName: "std_msgs/msg/Empty",
+}
+
 // Modifying this variable is undefined behavior.
 var EmptyTypeSupport types.MessageTypeSupport = _EmptyTypeSupport{}
 
@@ -143,6 +210,22 @@ func (t _EmptyTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__std_msgs__msg__Empty())
 }
 
+func (t _EmptyTypeSupport) Descriptor() *types.MessageDescriptor {
+	return EmptyDescriptor
+}
+
+func (t _EmptyTypeSupport) TypeHash() string {
+	return `RIHS01_20b625256f32d5dbc0d04fee44f43c41e51c70d3502f84b4a08e7a9c26a96312`
+}
+
+func (t _EmptyTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "std_msgs/msg/Empty", "fields": [{"name": "structure_needs_at_least_one_member", "type": {"type_id": 3, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": []}`
+}
+
+func (t _EmptyTypeSupport) MessageDefinition() string {
+	return ``
+}
+
 type CEmpty = C.std_msgs__msg__Empty
 type CEmpty__Sequence = C.std_msgs__msg__Empty__Sequence
 
@@ -14,10 +14,13 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	builtin_interfaces_msg "github.com/tiiuae/rclgo/internal/msgs/builtin_interfaces/msg"
 	primitives "github.com/tiiuae/rclgo/pkg/rclgo/primitives"
+	 "time"
 	
 )
 /*
@@ -56,6 +59,38 @@ func (t *Header) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *Header) Equal(other *Header) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *Header) EqualApprox(other *Header, tol float64) bool {
+	if !t.Stamp.EqualApprox(&other.Stamp, tol) {
+		return false
+	}
+	if t.FrameId != other.FrameId {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *Header) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *Header) HashTo(h *msgcmp.Hasher) {
+	t.Stamp.HashTo(h)
+	h.String(t.FrameId)
+}
+
 func (t *Header) SetDefaults() {
 	t.Stamp.SetDefaults()
 	t.FrameId = ""
@@ -65,6 +100,65 @@ func (t *Header) GetTypeSupport() types.MessageTypeSupport {
 	return HeaderTypeSupport
 }
 
+func (t *Header) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *Header) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *Header) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *Header) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *Header) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *Header) EncodeCDR(e *cdr.Encoder) {
+	t.Stamp.EncodeCDR(e)
+	e.String(t.FrameId)
+}
+
+func (t *Header) DecodeCDR(d *cdr.Decoder) {
+	t.Stamp.DecodeCDR(d)
+	t.FrameId = d.String()
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *Header) Validate() error {
+	if err := t.Stamp.Validate(); err != nil {
+		return rclgo.WrapValidationError("stamp", -1, err)
+	}
+	return nil
+}
+
+// StampTime returns the stamp of t as a time.Time.
+func (t *Header) StampTime() time.Time {
+	return t.Stamp.ToTime()
+}
+
+// SetStamp sets the stamp of t to tm.
+func (t *Header) SetStamp(tm time.Time) {
+	t.Stamp.FromTime(tm)
+}
+
+// StampNow sets the stamp of t to the current time of clock.
+func (t *Header) StampNow(clock *rclgo.Clock) error {
+	now, err := clock.Now()
+	if err != nil {
+		return err
+	}
+	t.SetStamp(now)
+	return nil
+}
+
 // HeaderPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type HeaderPublisher struct {
@@ -122,6 +216,17 @@ func CloneHeaderSlice(dst, src []Header) {
 	}
 }
 
+// HeaderDescriptor describes the fields of Header. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var HeaderDescriptor = &types.MessageDescriptor{
+	TypeName: "std_msgs/msg/Header",
+	Fields: []types.FieldDescriptor{
+		{Name: "stamp", Type: "builtin_interfaces/msg/Time", GoIndex: 0, Message: builtin_interfaces_msg.TimeDescriptor},
+		{Name: "frame_id", Type: "string", GoIndex: 1},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var HeaderTypeSupport types.MessageTypeSupport = _HeaderTypeSupport{}
 
@@ -157,6 +262,32 @@ func (t _HeaderTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__std_msgs__msg__Header())
 }
 
+func (t _HeaderTypeSupport) Descriptor() *types.MessageDescriptor {
+	return HeaderDescriptor
+}
+
+func (t _HeaderTypeSupport) TypeHash() string {
+	return `RIHS01_f49fb3ae2cf070f793645ff749683ac6b06203e41c891e17701b1cb597ce6a01`
+}
+
+func (t _HeaderTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "std_msgs/msg/Header", "fields": [{"name": "stamp", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "builtin_interfaces/msg/Time"}, "default_value": ""}, {"name": "frame_id", "type": {"type_id": 17, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "builtin_interfaces/msg/Time", "fields": [{"name": "sec", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "nanosec", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}]}`
+}
+
+func (t _HeaderTypeSupport) MessageDefinition() string {
+	return `# Two-integer timestamp that is expressed as seconds and nanoseconds.
+builtin_interfaces/Time stamp
+# Transform frame with which this data is associated.
+string frame_id
+================================================================================
+MSG: builtin_interfaces/msg/Time
+# The seconds component, valid over all int32 values.
+int32 sec
+# The nanoseconds component, valid in the range [0, 10e9).
+uint32 nanosec
+`
+}
+
 type CHeader = C.std_msgs__msg__Header
 type CHeader__Sequence = C.std_msgs__msg__Header__Sequence
 
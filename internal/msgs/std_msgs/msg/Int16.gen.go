@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	
@@ -52,6 +54,34 @@ func (t *Int16) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *Int16) Equal(other *Int16) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *Int16) EqualApprox(other *Int16, tol float64) bool {
+	if t.Data != other.Data {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *Int16) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *Int16) HashTo(h *msgcmp.Hasher) {
+	h.Int16(t.Data)
+}
+
 func (t *Int16) SetDefaults() {
 	t.Data = 0
 }
@@ -60,6 +90,40 @@ func (t *Int16) GetTypeSupport() types.MessageTypeSupport {
 	return Int16TypeSupport
 }
 
+func (t *Int16) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *Int16) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *Int16) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *Int16) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *Int16) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *Int16) EncodeCDR(e *cdr.Encoder) {
+	e.Int16(t.Data)
+}
+
+func (t *Int16) DecodeCDR(d *cdr.Decoder) {
+	t.Data = d.Int16()
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *Int16) Validate() error {
+	return nil
+}
+
 // Int16Publisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type Int16Publisher struct {
@@ -117,6 +181,16 @@ func CloneInt16Slice(dst, src []Int16) {
 	}
 }
 
+// Int16Descriptor describes the fields of Int16. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var Int16Descriptor = &types.MessageDescriptor{
+	TypeName: "std_msgs/msg/Int16",
+	Fields: []types.FieldDescriptor{
+		{Name: "data", Type: "int16", GoIndex: 0},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var Int16TypeSupport types.MessageTypeSupport = _Int16TypeSupport{}
 
@@ -150,6 +224,23 @@ func (t _Int16TypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__std_msgs__msg__Int16())
 }
 
+func (t _Int16TypeSupport) Descriptor() *types.MessageDescriptor {
+	return Int16Descriptor
+}
+
+func (t _Int16TypeSupport) TypeHash() string {
+	return `RIHS01_1dcc3464e47c288a55f943a389d337cdb06804de3f5cd7a266b0de718eee17e5`
+}
+
+func (t _Int16TypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "std_msgs/msg/Int16", "fields": [{"name": "data", "type": {"type_id": 4, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": []}`
+}
+
+func (t _Int16TypeSupport) MessageDefinition() string {
+	return `int16 data
+`
+}
+
 type CInt16 = C.std_msgs__msg__Int16
 type CInt16__Sequence = C.std_msgs__msg__Int16__Sequence
 
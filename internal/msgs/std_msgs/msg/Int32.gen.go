@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	
@@ -52,6 +54,34 @@ func (t *Int32) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *Int32) Equal(other *Int32) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *Int32) EqualApprox(other *Int32, tol float64) bool {
+	if t.Data != other.Data {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *Int32) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *Int32) HashTo(h *msgcmp.Hasher) {
+	h.Int32(t.Data)
+}
+
 func (t *Int32) SetDefaults() {
 	t.Data = 0
 }
@@ -60,6 +90,40 @@ func (t *Int32) GetTypeSupport() types.MessageTypeSupport {
 	return Int32TypeSupport
 }
 
+func (t *Int32) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *Int32) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *Int32) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *Int32) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *Int32) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *Int32) EncodeCDR(e *cdr.Encoder) {
+	e.Int32(t.Data)
+}
+
+func (t *Int32) DecodeCDR(d *cdr.Decoder) {
+	t.Data = d.Int32()
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *Int32) Validate() error {
+	return nil
+}
+
 // Int32Publisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type Int32Publisher struct {
@@ -117,6 +181,16 @@ func CloneInt32Slice(dst, src []Int32) {
 	}
 }
 
+// Int32Descriptor describes the fields of Int32. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var Int32Descriptor = &types.MessageDescriptor{
+	TypeName: "std_msgs/msg/Int32",
+	Fields: []types.FieldDescriptor{
+		{Name: "data", Type: "int32", GoIndex: 0},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var Int32TypeSupport types.MessageTypeSupport = _Int32TypeSupport{}
 
@@ -150,6 +224,23 @@ func (t _Int32TypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__std_msgs__msg__Int32())
 }
 
+func (t _Int32TypeSupport) Descriptor() *types.MessageDescriptor {
+	return Int32Descriptor
+}
+
+func (t _Int32TypeSupport) TypeHash() string {
+	return `RIHS01_b6578ded3c58c626cfe8d1a6fb6e04f706f97e9f03d2727c9ff4e74b1cef0deb`
+}
+
+func (t _Int32TypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "std_msgs/msg/Int32", "fields": [{"name": "data", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": []}`
+}
+
+func (t _Int32TypeSupport) MessageDefinition() string {
+	return `int32 data
+`
+}
+
 type CInt32 = C.std_msgs__msg__Int32
 type CInt32__Sequence = C.std_msgs__msg__Int32__Sequence
 
@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	
@@ -52,6 +54,34 @@ func (t *Int8) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *Int8) Equal(other *Int8) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *Int8) EqualApprox(other *Int8, tol float64) bool {
+	if t.Data != other.Data {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *Int8) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *Int8) HashTo(h *msgcmp.Hasher) {
+	h.Int8(t.Data)
+}
+
 func (t *Int8) SetDefaults() {
 	t.Data = 0
 }
@@ -60,6 +90,40 @@ func (t *Int8) GetTypeSupport() types.MessageTypeSupport {
 	return Int8TypeSupport
 }
 
+func (t *Int8) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *Int8) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *Int8) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *Int8) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *Int8) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *Int8) EncodeCDR(e *cdr.Encoder) {
+	e.Int8(t.Data)
+}
+
+func (t *Int8) DecodeCDR(d *cdr.Decoder) {
+	t.Data = d.Int8()
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *Int8) Validate() error {
+	return nil
+}
+
 // Int8Publisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type Int8Publisher struct {
@@ -117,6 +181,16 @@ func CloneInt8Slice(dst, src []Int8) {
 	}
 }
 
+// Int8Descriptor describes the fields of Int8. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var Int8Descriptor = &types.MessageDescriptor{
+	TypeName: "std_msgs/msg/Int8",
+	Fields: []types.FieldDescriptor{
+		{Name: "data", Type: "int8", GoIndex: 0},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var Int8TypeSupport types.MessageTypeSupport = _Int8TypeSupport{}
 
@@ -150,6 +224,23 @@ func (t _Int8TypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__std_msgs__msg__Int8())
 }
 
+func (t _Int8TypeSupport) Descriptor() *types.MessageDescriptor {
+	return Int8Descriptor
+}
+
+func (t _Int8TypeSupport) TypeHash() string {
+	return `RIHS01_26525065a403d972cb672f0777e333f0c799ad444ae5fcd79e43d1e73bd0f440`
+}
+
+func (t _Int8TypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "std_msgs/msg/Int8", "fields": [{"name": "data", "type": {"type_id": 2, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": []}`
+}
+
+func (t _Int8TypeSupport) MessageDefinition() string {
+	return `int8 data
+`
+}
+
 type CInt8 = C.std_msgs__msg__Int8
 type CInt8__Sequence = C.std_msgs__msg__Int8__Sequence
 
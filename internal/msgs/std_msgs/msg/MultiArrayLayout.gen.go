@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	
@@ -57,6 +59,46 @@ func (t *MultiArrayLayout) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *MultiArrayLayout) Equal(other *MultiArrayLayout) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *MultiArrayLayout) EqualApprox(other *MultiArrayLayout, tol float64) bool {
+	if len(t.Dim) != len(other.Dim) {
+		return false
+	}
+	for i := range t.Dim {
+		if !t.Dim[i].EqualApprox(&other.Dim[i], tol) {
+			return false
+		}
+	}
+	if t.DataOffset != other.DataOffset {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *MultiArrayLayout) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *MultiArrayLayout) HashTo(h *msgcmp.Hasher) {
+	h.Len(len(t.Dim))
+	for i := range t.Dim {
+		t.Dim[i].HashTo(h)
+	}
+	h.Uint32(t.DataOffset)
+}
+
 func (t *MultiArrayLayout) SetDefaults() {
 	t.Dim = nil
 	t.DataOffset = 0
@@ -66,6 +108,53 @@ func (t *MultiArrayLayout) GetTypeSupport() types.MessageTypeSupport {
 	return MultiArrayLayoutTypeSupport
 }
 
+func (t *MultiArrayLayout) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *MultiArrayLayout) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *MultiArrayLayout) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *MultiArrayLayout) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *MultiArrayLayout) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *MultiArrayLayout) EncodeCDR(e *cdr.Encoder) {
+	e.SequenceLength(len(t.Dim), 0)
+	for i := range t.Dim {
+		t.Dim[i].EncodeCDR(e)
+	}
+	e.Uint32(t.DataOffset)
+}
+
+func (t *MultiArrayLayout) DecodeCDR(d *cdr.Decoder) {
+	t.Dim = cdr.MakeSlice[MultiArrayDimension](d, 0)
+	for i := range t.Dim {
+		t.Dim[i].DecodeCDR(d)
+	}
+	t.DataOffset = d.Uint32()
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *MultiArrayLayout) Validate() error {
+	for i := range t.Dim {
+		if err := t.Dim[i].Validate(); err != nil {
+			return rclgo.WrapValidationError("dim", i, err)
+		}
+	}
+	return nil
+}
+
 // MultiArrayLayoutPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type MultiArrayLayoutPublisher struct {
@@ -123,6 +212,17 @@ func CloneMultiArrayLayoutSlice(dst, src []MultiArrayLayout) {
 	}
 }
 
+// MultiArrayLayoutDescriptor describes the fields of MultiArrayLayout. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var MultiArrayLayoutDescriptor = &types.MessageDescriptor{
+	TypeName: "std_msgs/msg/MultiArrayLayout",
+	Fields: []types.FieldDescriptor{
+		{Name: "dim", Type: "std_msgs/msg/MultiArrayDimension", GoIndex: 0, IsArray: true, Message: MultiArrayDimensionDescriptor},
+		{Name: "data_offset", Type: "uint32", GoIndex: 1},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var MultiArrayLayoutTypeSupport types.MessageTypeSupport = _MultiArrayLayoutTypeSupport{}
 
@@ -158,6 +258,34 @@ func (t _MultiArrayLayoutTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__std_msgs__msg__MultiArrayLayout())
 }
 
+func (t _MultiArrayLayoutTypeSupport) Descriptor() *types.MessageDescriptor {
+	return MultiArrayLayoutDescriptor
+}
+
+func (t _MultiArrayLayoutTypeSupport) TypeHash() string {
+	return `RIHS01_4c66e6f78e740ac103a94cf63259f968e48c617e7699e829b63c21a5cb50dac6`
+}
+
+func (t _MultiArrayLayoutTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "std_msgs/msg/MultiArrayLayout", "fields": [{"name": "dim", "type": {"type_id": 145, "capacity": 0, "string_capacity": 0, "nested_type_name": "std_msgs/msg/MultiArrayDimension"}, "default_value": ""}, {"name": "data_offset", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "std_msgs/msg/MultiArrayDimension", "fields": [{"name": "label", "type": {"type_id": 17, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "size", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "stride", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}]}`
+}
+
+func (t _MultiArrayLayoutTypeSupport) MessageDefinition() string {
+	return `# Array of dimension properties
+MultiArrayDimension[] dim
+# padding bytes at front of data
+uint32 data_offset
+================================================================================
+MSG: std_msgs/msg/MultiArrayDimension
+# label of given dimension
+string label
+# size of given dimension (in type units)
+uint32 size
+# stride of given dimension
+uint32 stride
+`
+}
+
 type CMultiArrayLayout = C.std_msgs__msg__MultiArrayLayout
 type CMultiArrayLayout__Sequence = C.std_msgs__msg__MultiArrayLayout__Sequence
 
@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	
@@ -52,6 +54,34 @@ func (t *UInt32) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *UInt32) Equal(other *UInt32) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *UInt32) EqualApprox(other *UInt32, tol float64) bool {
+	if t.Data != other.Data {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *UInt32) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *UInt32) HashTo(h *msgcmp.Hasher) {
+	h.Uint32(t.Data)
+}
+
 func (t *UInt32) SetDefaults() {
 	t.Data = 0
 }
@@ -60,6 +90,40 @@ func (t *UInt32) GetTypeSupport() types.MessageTypeSupport {
 	return UInt32TypeSupport
 }
 
+func (t *UInt32) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *UInt32) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *UInt32) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *UInt32) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *UInt32) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *UInt32) EncodeCDR(e *cdr.Encoder) {
+	e.Uint32(t.Data)
+}
+
+func (t *UInt32) DecodeCDR(d *cdr.Decoder) {
+	t.Data = d.Uint32()
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *UInt32) Validate() error {
+	return nil
+}
+
 // UInt32Publisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type UInt32Publisher struct {
@@ -117,6 +181,16 @@ func CloneUInt32Slice(dst, src []UInt32) {
 	}
 }
 
+// UInt32Descriptor describes the fields of UInt32. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var UInt32Descriptor = &types.MessageDescriptor{
+	TypeName: "std_msgs/msg/UInt32",
+	Fields: []types.FieldDescriptor{
+		{Name: "data", Type: "uint32", GoIndex: 0},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var UInt32TypeSupport types.MessageTypeSupport = _UInt32TypeSupport{}
 
@@ -150,6 +224,23 @@ func (t _UInt32TypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__std_msgs__msg__UInt32())
 }
 
+func (t _UInt32TypeSupport) Descriptor() *types.MessageDescriptor {
+	return UInt32Descriptor
+}
+
+func (t _UInt32TypeSupport) TypeHash() string {
+	return `RIHS01_a5c874829b752bc5fa190024b0ad76f578cc278271e855c7d02a818b3516fb4a`
+}
+
+func (t _UInt32TypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "std_msgs/msg/UInt32", "fields": [{"name": "data", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": []}`
+}
+
+func (t _UInt32TypeSupport) MessageDefinition() string {
+	return `uint32 data
+`
+}
+
 type CUInt32 = C.std_msgs__msg__UInt32
 type CUInt32__Sequence = C.std_msgs__msg__UInt32__Sequence
 
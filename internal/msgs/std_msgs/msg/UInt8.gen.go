@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	
@@ -52,6 +54,34 @@ func (t *UInt8) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *UInt8) Equal(other *UInt8) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *UInt8) EqualApprox(other *UInt8, tol float64) bool {
+	if t.Data != other.Data {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *UInt8) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *UInt8) HashTo(h *msgcmp.Hasher) {
+	h.Uint8(t.Data)
+}
+
 func (t *UInt8) SetDefaults() {
 	t.Data = 0
 }
@@ -60,6 +90,40 @@ func (t *UInt8) GetTypeSupport() types.MessageTypeSupport {
 	return UInt8TypeSupport
 }
 
+func (t *UInt8) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *UInt8) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *UInt8) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *UInt8) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *UInt8) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *UInt8) EncodeCDR(e *cdr.Encoder) {
+	e.Uint8(t.Data)
+}
+
+func (t *UInt8) DecodeCDR(d *cdr.Decoder) {
+	t.Data = d.Uint8()
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *UInt8) Validate() error {
+	return nil
+}
+
 // UInt8Publisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type UInt8Publisher struct {
@@ -117,6 +181,16 @@ func CloneUInt8Slice(dst, src []UInt8) {
 	}
 }
 
+// UInt8Descriptor describes the fields of UInt8. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var UInt8Descriptor = &types.MessageDescriptor{
+	TypeName: "std_msgs/msg/UInt8",
+	Fields: []types.FieldDescriptor{
+		{Name: "data", Type: "uint8", GoIndex: 0},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var UInt8TypeSupport types.MessageTypeSupport = _UInt8TypeSupport{}
 
@@ -150,6 +224,23 @@ func (t _UInt8TypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__std_msgs__msg__UInt8())
 }
 
+func (t _UInt8TypeSupport) Descriptor() *types.MessageDescriptor {
+	return UInt8Descriptor
+}
+
+func (t _UInt8TypeSupport) TypeHash() string {
+	return `RIHS01_6138bd83d8c3569cb80a667db03cfc1629f529fee79d944c39c34e352e72f010`
+}
+
+func (t _UInt8TypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "std_msgs/msg/UInt8", "fields": [{"name": "data", "type": {"type_id": 3, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": []}`
+}
+
+func (t _UInt8TypeSupport) MessageDefinition() string {
+	return `uint8 data
+`
+}
+
 type CUInt8 = C.std_msgs__msg__UInt8
 type CUInt8__Sequence = C.std_msgs__msg__UInt8__Sequence
 
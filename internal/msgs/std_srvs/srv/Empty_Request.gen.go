@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	
@@ -50,6 +52,30 @@ func (t *Empty_Request) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *Empty_Request) Equal(other *Empty_Request) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *Empty_Request) EqualApprox(other *Empty_Request, tol float64) bool {
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *Empty_Request) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *Empty_Request) HashTo(h *msgcmp.Hasher) {
+}
+
 func (t *Empty_Request) SetDefaults() {
 }
 
@@ -57,6 +83,40 @@ func (t *Empty_Request) GetTypeSupport() types.MessageTypeSupport {
 	return Empty_RequestTypeSupport
 }
 
+func (t *Empty_Request) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *Empty_Request) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *Empty_Request) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *Empty_Request) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *Empty_Request) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *Empty_Request) EncodeCDR(e *cdr.Encoder) {
+	e.Uint8(0)
+}
+
+func (t *Empty_Request) DecodeCDR(d *cdr.Decoder) {
+	d.Uint8()
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *Empty_Request) Validate() error {
+	return nil
+}
+
 // Empty_RequestPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type Empty_RequestPublisher struct {
@@ -114,6 +174,13 @@ func CloneEmpty_RequestSlice(dst, src []Empty_Request) {
 	}
 }
 
+// Empty_RequestDescriptor describes the fields of Empty_Request. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var Empty_RequestDescriptor = &types.MessageDescriptor{
+	TypeName: "std_srvs/srv/Empty_Request",
+}
+
 // Modifying this variable is undefined behavior.
 var Empty_RequestTypeSupport types.MessageTypeSupport = _Empty_RequestTypeSupport{}
 
@@ -143,6 +210,22 @@ func (t _Empty_RequestTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__std_srvs__srv__Empty_Request())
 }
 
+func (t _Empty_RequestTypeSupport) Descriptor() *types.MessageDescriptor {
+	return Empty_RequestDescriptor
+}
+
+func (t _Empty_RequestTypeSupport) TypeHash() string {
+	return `RIHS01_458eaf200a3f63d9cc53d19436edebc0c140ef4c93ca75d5bd4ce19fd7759e35`
+}
+
+func (t _Empty_RequestTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "std_srvs/srv/Empty_Request", "fields": [{"name": "structure_needs_at_least_one_member", "type": {"type_id": 3, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": []}`
+}
+
+func (t _Empty_RequestTypeSupport) MessageDefinition() string {
+	return ``
+}
+
 type CEmpty_Request = C.std_srvs__srv__Empty_Request
 type CEmpty_Request__Sequence = C.std_srvs__srv__Empty_Request__Sequence
 
@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	
@@ -52,6 +54,34 @@ func (t *SetBool_Request) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *SetBool_Request) Equal(other *SetBool_Request) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *SetBool_Request) EqualApprox(other *SetBool_Request, tol float64) bool {
+	if t.Data != other.Data {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *SetBool_Request) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *SetBool_Request) HashTo(h *msgcmp.Hasher) {
+	h.Bool(t.Data)
+}
+
 func (t *SetBool_Request) SetDefaults() {
 	t.Data = false
 }
@@ -60,6 +90,40 @@ func (t *SetBool_Request) GetTypeSupport() types.MessageTypeSupport {
 	return SetBool_RequestTypeSupport
 }
 
+func (t *SetBool_Request) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *SetBool_Request) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *SetBool_Request) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *SetBool_Request) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *SetBool_Request) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *SetBool_Request) EncodeCDR(e *cdr.Encoder) {
+	e.Bool(t.Data)
+}
+
+func (t *SetBool_Request) DecodeCDR(d *cdr.Decoder) {
+	t.Data = d.Bool()
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *SetBool_Request) Validate() error {
+	return nil
+}
+
 // SetBool_RequestPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type SetBool_RequestPublisher struct {
@@ -117,6 +181,16 @@ func CloneSetBool_RequestSlice(dst, src []SetBool_Request) {
 	}
 }
 
+// SetBool_RequestDescriptor describes the fields of SetBool_Request. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var SetBool_RequestDescriptor = &types.MessageDescriptor{
+	TypeName: "std_srvs/srv/SetBool_Request",
+	Fields: []types.FieldDescriptor{
+		{Name: "data", Type: "bool", GoIndex: 0},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var SetBool_RequestTypeSupport types.MessageTypeSupport = _SetBool_RequestTypeSupport{}
 
@@ -150,6 +224,23 @@ func (t _SetBool_RequestTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__std_srvs__srv__SetBool_Request())
 }
 
+func (t _SetBool_RequestTypeSupport) Descriptor() *types.MessageDescriptor {
+	return SetBool_RequestDescriptor
+}
+
+func (t _SetBool_RequestTypeSupport) TypeHash() string {
+	return `RIHS01_c62fbb99d94e1b25e8ef9e109f9581956bb1b3361a45a4e5810c36a90d29932e`
+}
+
+func (t _SetBool_RequestTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "std_srvs/srv/SetBool_Request", "fields": [{"name": "data", "type": {"type_id": 15, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": []}`
+}
+
+func (t _SetBool_RequestTypeSupport) MessageDefinition() string {
+	return `# e.g. for hardware enabling / disabling
+bool data`
+}
+
 type CSetBool_Request = C.std_srvs__srv__SetBool_Request
 type CSetBool_Request__Sequence = C.std_srvs__srv__SetBool_Request__Sequence
 
@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	
@@ -50,6 +52,30 @@ func (t *Trigger_Request) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *Trigger_Request) Equal(other *Trigger_Request) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *Trigger_Request) EqualApprox(other *Trigger_Request, tol float64) bool {
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *Trigger_Request) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *Trigger_Request) HashTo(h *msgcmp.Hasher) {
+}
+
 func (t *Trigger_Request) SetDefaults() {
 }
 
@@ -57,6 +83,40 @@ func (t *Trigger_Request) GetTypeSupport() types.MessageTypeSupport {
 	return Trigger_RequestTypeSupport
 }
 
+func (t *Trigger_Request) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *Trigger_Request) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *Trigger_Request) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *Trigger_Request) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *Trigger_Request) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *Trigger_Request) EncodeCDR(e *cdr.Encoder) {
+	e.Uint8(0)
+}
+
+func (t *Trigger_Request) DecodeCDR(d *cdr.Decoder) {
+	d.Uint8()
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *Trigger_Request) Validate() error {
+	return nil
+}
+
 // Trigger_RequestPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type Trigger_RequestPublisher struct {
@@ -114,6 +174,13 @@ func CloneTrigger_RequestSlice(dst, src []Trigger_Request) {
 	}
 }
 
+// Trigger_RequestDescriptor describes the fields of Trigger_Request. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var Trigger_RequestDescriptor = &types.MessageDescriptor{
+	TypeName: "std_srvs/srv/Trigger_Request",
+}
+
 // Modifying this variable is undefined behavior.
 var Trigger_RequestTypeSupport types.MessageTypeSupport = _Trigger_RequestTypeSupport{}
 
@@ -143,6 +210,22 @@ func (t _Trigger_RequestTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__std_srvs__srv__Trigger_Request())
 }
 
+func (t _Trigger_RequestTypeSupport) Descriptor() *types.MessageDescriptor {
+	return Trigger_RequestDescriptor
+}
+
+func (t _Trigger_RequestTypeSupport) TypeHash() string {
+	return `RIHS01_d010825374ce8918e72bfd826c82603e60f45419e932ea976f807b74a863a199`
+}
+
+func (t _Trigger_RequestTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "std_srvs/srv/Trigger_Request", "fields": [{"name": "structure_needs_at_least_one_member", "type": {"type_id": 3, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": []}`
+}
+
+func (t _Trigger_RequestTypeSupport) MessageDefinition() string {
+	return ``
+}
+
 type CTrigger_Request = C.std_srvs__srv__Trigger_Request
 type CTrigger_Request__Sequence = C.std_srvs__srv__Trigger_Request__Sequence
 
@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	
@@ -52,6 +54,34 @@ func (t *Fibonacci_Goal) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *Fibonacci_Goal) Equal(other *Fibonacci_Goal) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *Fibonacci_Goal) EqualApprox(other *Fibonacci_Goal, tol float64) bool {
+	if t.Order != other.Order {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *Fibonacci_Goal) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *Fibonacci_Goal) HashTo(h *msgcmp.Hasher) {
+	h.Int32(t.Order)
+}
+
 func (t *Fibonacci_Goal) SetDefaults() {
 	t.Order = 0
 }
@@ -60,6 +90,40 @@ func (t *Fibonacci_Goal) GetTypeSupport() types.MessageTypeSupport {
 	return Fibonacci_GoalTypeSupport
 }
 
+func (t *Fibonacci_Goal) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *Fibonacci_Goal) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *Fibonacci_Goal) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *Fibonacci_Goal) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *Fibonacci_Goal) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *Fibonacci_Goal) EncodeCDR(e *cdr.Encoder) {
+	e.Int32(t.Order)
+}
+
+func (t *Fibonacci_Goal) DecodeCDR(d *cdr.Decoder) {
+	t.Order = d.Int32()
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *Fibonacci_Goal) Validate() error {
+	return nil
+}
+
 // Fibonacci_GoalPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type Fibonacci_GoalPublisher struct {
@@ -117,6 +181,16 @@ func CloneFibonacci_GoalSlice(dst, src []Fibonacci_Goal) {
 	}
 }
 
+// Fibonacci_GoalDescriptor describes the fields of Fibonacci_Goal. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var Fibonacci_GoalDescriptor = &types.MessageDescriptor{
+	TypeName: "test_msgs/action/Fibonacci_Goal",
+	Fields: []types.FieldDescriptor{
+		{Name: "order", Type: "int32", GoIndex: 0},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var Fibonacci_GoalTypeSupport types.MessageTypeSupport = _Fibonacci_GoalTypeSupport{}
 
@@ -150,6 +224,23 @@ func (t _Fibonacci_GoalTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__test_msgs__action__Fibonacci_Goal())
 }
 
+func (t _Fibonacci_GoalTypeSupport) Descriptor() *types.MessageDescriptor {
+	return Fibonacci_GoalDescriptor
+}
+
+func (t _Fibonacci_GoalTypeSupport) TypeHash() string {
+	return `RIHS01_e58ef5a8f4f039ab522a740823547c460b1d5aed8031c8b7825d33d345d90df8`
+}
+
+func (t _Fibonacci_GoalTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "test_msgs/action/Fibonacci_Goal", "fields": [{"name": "order", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": []}`
+}
+
+func (t _Fibonacci_GoalTypeSupport) MessageDefinition() string {
+	return `# goal definition
+int32 order`
+}
+
 type CFibonacci_Goal = C.test_msgs__action__Fibonacci_Goal
 type CFibonacci_Goal__Sequence = C.test_msgs__action__Fibonacci_Goal__Sequence
 
@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	primitives "github.com/tiiuae/rclgo/pkg/rclgo/primitives"
@@ -56,6 +58,37 @@ func (t *Fibonacci_Result) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *Fibonacci_Result) Equal(other *Fibonacci_Result) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *Fibonacci_Result) EqualApprox(other *Fibonacci_Result, tol float64) bool {
+	if !msgcmp.Slices(t.Sequence, other.Sequence) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *Fibonacci_Result) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *Fibonacci_Result) HashTo(h *msgcmp.Hasher) {
+	h.Len(len(t.Sequence))
+	for i := range t.Sequence {
+		h.Int32(t.Sequence[i])
+	}
+}
+
 func (t *Fibonacci_Result) SetDefaults() {
 	t.Sequence = nil
 }
@@ -64,6 +97,46 @@ func (t *Fibonacci_Result) GetTypeSupport() types.MessageTypeSupport {
 	return Fibonacci_ResultTypeSupport
 }
 
+func (t *Fibonacci_Result) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *Fibonacci_Result) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *Fibonacci_Result) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *Fibonacci_Result) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *Fibonacci_Result) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *Fibonacci_Result) EncodeCDR(e *cdr.Encoder) {
+	e.SequenceLength(len(t.Sequence), 0)
+	for i := range t.Sequence {
+		e.Int32(t.Sequence[i])
+	}
+}
+
+func (t *Fibonacci_Result) DecodeCDR(d *cdr.Decoder) {
+	t.Sequence = cdr.MakeSlice[int32](d, 0)
+	for i := range t.Sequence {
+		t.Sequence[i] = d.Int32()
+	}
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *Fibonacci_Result) Validate() error {
+	return nil
+}
+
 // Fibonacci_ResultPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type Fibonacci_ResultPublisher struct {
@@ -121,6 +194,16 @@ func CloneFibonacci_ResultSlice(dst, src []Fibonacci_Result) {
 	}
 }
 
+// Fibonacci_ResultDescriptor describes the fields of Fibonacci_Result. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var Fibonacci_ResultDescriptor = &types.MessageDescriptor{
+	TypeName: "test_msgs/action/Fibonacci_Result",
+	Fields: []types.FieldDescriptor{
+		{Name: "sequence", Type: "int32", GoIndex: 0, IsArray: true},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var Fibonacci_ResultTypeSupport types.MessageTypeSupport = _Fibonacci_ResultTypeSupport{}
 
@@ -154,6 +237,23 @@ func (t _Fibonacci_ResultTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__test_msgs__action__Fibonacci_Result())
 }
 
+func (t _Fibonacci_ResultTypeSupport) Descriptor() *types.MessageDescriptor {
+	return Fibonacci_ResultDescriptor
+}
+
+func (t _Fibonacci_ResultTypeSupport) TypeHash() string {
+	return `RIHS01_0774b095467813c6904d475498b5486b7ebd4cda6a4569f97e8de8c862689049`
+}
+
+func (t _Fibonacci_ResultTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "test_msgs/action/Fibonacci_Result", "fields": [{"name": "sequence", "type": {"type_id": 150, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": []}`
+}
+
+func (t _Fibonacci_ResultTypeSupport) MessageDefinition() string {
+	return `# result definition
+int32[] sequence`
+}
+
 type CFibonacci_Result = C.test_msgs__action__Fibonacci_Result
 type CFibonacci_Result__Sequence = C.test_msgs__action__Fibonacci_Result__Sequence
 
@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	unique_identifier_msgs_msg "github.com/tiiuae/rclgo/internal/msgs/unique_identifier_msgs/msg"
@@ -55,6 +57,38 @@ func (t *Fibonacci_SendGoal_Request) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *Fibonacci_SendGoal_Request) Equal(other *Fibonacci_SendGoal_Request) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *Fibonacci_SendGoal_Request) EqualApprox(other *Fibonacci_SendGoal_Request, tol float64) bool {
+	if !t.GoalID.EqualApprox(&other.GoalID, tol) {
+		return false
+	}
+	if !t.Goal.EqualApprox(&other.Goal, tol) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *Fibonacci_SendGoal_Request) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *Fibonacci_SendGoal_Request) HashTo(h *msgcmp.Hasher) {
+	t.GoalID.HashTo(h)
+	t.Goal.HashTo(h)
+}
+
 func (t *Fibonacci_SendGoal_Request) SetDefaults() {
 	t.GoalID.SetDefaults()
 	t.Goal.SetDefaults()
@@ -63,6 +97,48 @@ func (t *Fibonacci_SendGoal_Request) SetDefaults() {
 func (t *Fibonacci_SendGoal_Request) GetTypeSupport() types.MessageTypeSupport {
 	return Fibonacci_SendGoal_RequestTypeSupport
 }
+
+func (t *Fibonacci_SendGoal_Request) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *Fibonacci_SendGoal_Request) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *Fibonacci_SendGoal_Request) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *Fibonacci_SendGoal_Request) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *Fibonacci_SendGoal_Request) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *Fibonacci_SendGoal_Request) EncodeCDR(e *cdr.Encoder) {
+	t.GoalID.EncodeCDR(e)
+	t.Goal.EncodeCDR(e)
+}
+
+func (t *Fibonacci_SendGoal_Request) DecodeCDR(d *cdr.Decoder) {
+	t.GoalID.DecodeCDR(d)
+	t.Goal.DecodeCDR(d)
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *Fibonacci_SendGoal_Request) Validate() error {
+	if err := t.GoalID.Validate(); err != nil {
+		return rclgo.WrapValidationError("goal_id", -1, err)
+	}
+	if err := t.Goal.Validate(); err != nil {
+		return rclgo.WrapValidationError("goal", -1, err)
+	}
+	return nil
+}
 func (t *Fibonacci_SendGoal_Request) GetGoalID() *types.GoalID {
 	return (*types.GoalID)(&t.GoalID.Uuid)
 }
@@ -135,6 +211,17 @@ func CloneFibonacci_SendGoal_RequestSlice(dst, src []Fibonacci_SendGoal_Request)
 	}
 }
 
+// Fibonacci_SendGoal_RequestDescriptor describes the fields of Fibonacci_SendGoal_Request. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var Fibonacci_SendGoal_RequestDescriptor = &types.MessageDescriptor{
+	TypeName: "test_msgs/action/Fibonacci_SendGoal_Request",
+	Fields: []types.FieldDescriptor{
+		{Name: "goal_id", Type: "unique_identifier_msgs/msg/UUID", GoIndex: 0, Message: unique_identifier_msgs_msg.UUIDDescriptor},
+		{Name: "goal", Type: "test_msgs/action/Fibonacci_Goal", GoIndex: 1, Message: Fibonacci_GoalDescriptor},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var Fibonacci_SendGoal_RequestTypeSupport types.MessageTypeSupport = _Fibonacci_SendGoal_RequestTypeSupport{}
 
@@ -170,6 +257,30 @@ func (t _Fibonacci_SendGoal_RequestTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__test_msgs__action__Fibonacci_SendGoal_Request())
 }
 
+func (t _Fibonacci_SendGoal_RequestTypeSupport) Descriptor() *types.MessageDescriptor {
+	return Fibonacci_SendGoal_RequestDescriptor
+}
+
+func (t _Fibonacci_SendGoal_RequestTypeSupport) TypeHash() string {
+	return `RIHS01_dfe75c73dc2c090cbc8fd8c45039bfa279d16aa6aadd348f812c6adad26e669e`
+}
+
+func (t _Fibonacci_SendGoal_RequestTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "test_msgs/action/Fibonacci_SendGoal_Request", "fields": [{"name": "goal_id", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "unique_identifier_msgs/msg/UUID"}, "default_value": ""}, {"name": "goal", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "test_msgs/action/Fibonacci_Goal"}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "test_msgs/action/Fibonacci_Goal", "fields": [{"name": "order", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "unique_identifier_msgs/msg/UUID", "fields": [{"name": "uuid", "type": {"type_id": 51, "capacity": 16, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}]}`
+}
+
+func (t _Fibonacci_SendGoal_RequestTypeSupport) MessageDefinition() string {
+	return `unique_identifier_msgs/UUID goal_id
+test_msgs/Fibonacci_Goal goal
+================================================================================
+MSG: unique_identifier_msgs/msg/UUID
+uint8[16] uuid
+================================================================================
+MSG: test_msgs/action/Fibonacci_Goal
+# goal definition
+int32 order`
+}
+
 type CFibonacci_SendGoal_Request = C.test_msgs__action__Fibonacci_SendGoal_Request
 type CFibonacci_SendGoal_Request__Sequence = C.test_msgs__action__Fibonacci_SendGoal_Request__Sequence
 
@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	builtin_interfaces_msg "github.com/tiiuae/rclgo/internal/msgs/builtin_interfaces/msg"
@@ -55,6 +57,38 @@ func (t *Fibonacci_SendGoal_Response) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *Fibonacci_SendGoal_Response) Equal(other *Fibonacci_SendGoal_Response) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *Fibonacci_SendGoal_Response) EqualApprox(other *Fibonacci_SendGoal_Response, tol float64) bool {
+	if t.Accepted != other.Accepted {
+		return false
+	}
+	if !t.Stamp.EqualApprox(&other.Stamp, tol) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *Fibonacci_SendGoal_Response) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *Fibonacci_SendGoal_Response) HashTo(h *msgcmp.Hasher) {
+	h.Bool(t.Accepted)
+	t.Stamp.HashTo(h)
+}
+
 func (t *Fibonacci_SendGoal_Response) SetDefaults() {
 	t.Accepted = false
 	t.Stamp.SetDefaults()
@@ -63,6 +97,45 @@ func (t *Fibonacci_SendGoal_Response) SetDefaults() {
 func (t *Fibonacci_SendGoal_Response) GetTypeSupport() types.MessageTypeSupport {
 	return Fibonacci_SendGoal_ResponseTypeSupport
 }
+
+func (t *Fibonacci_SendGoal_Response) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *Fibonacci_SendGoal_Response) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *Fibonacci_SendGoal_Response) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *Fibonacci_SendGoal_Response) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *Fibonacci_SendGoal_Response) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *Fibonacci_SendGoal_Response) EncodeCDR(e *cdr.Encoder) {
+	e.Bool(t.Accepted)
+	t.Stamp.EncodeCDR(e)
+}
+
+func (t *Fibonacci_SendGoal_Response) DecodeCDR(d *cdr.Decoder) {
+	t.Accepted = d.Bool()
+	t.Stamp.DecodeCDR(d)
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *Fibonacci_SendGoal_Response) Validate() error {
+	if err := t.Stamp.Validate(); err != nil {
+		return rclgo.WrapValidationError("stamp", -1, err)
+	}
+	return nil
+}
 func (t *Fibonacci_SendGoal_Response) GetGoalAccepted() bool {
 	return t.Accepted
 }
@@ -124,6 +197,17 @@ func CloneFibonacci_SendGoal_ResponseSlice(dst, src []Fibonacci_SendGoal_Respons
 	}
 }
 
+// Fibonacci_SendGoal_ResponseDescriptor describes the fields of Fibonacci_SendGoal_Response. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var Fibonacci_SendGoal_ResponseDescriptor = &types.MessageDescriptor{
+	TypeName: "test_msgs/action/Fibonacci_SendGoal_Response",
+	Fields: []types.FieldDescriptor{
+		{Name: "accepted", Type: "bool", GoIndex: 0},
+		{Name: "stamp", Type: "builtin_interfaces/msg/Time", GoIndex: 1, Message: builtin_interfaces_msg.TimeDescriptor},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var Fibonacci_SendGoal_ResponseTypeSupport types.MessageTypeSupport = _Fibonacci_SendGoal_ResponseTypeSupport{}
 
@@ -159,6 +243,30 @@ func (t _Fibonacci_SendGoal_ResponseTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__test_msgs__action__Fibonacci_SendGoal_Response())
 }
 
+func (t _Fibonacci_SendGoal_ResponseTypeSupport) Descriptor() *types.MessageDescriptor {
+	return Fibonacci_SendGoal_ResponseDescriptor
+}
+
+func (t _Fibonacci_SendGoal_ResponseTypeSupport) TypeHash() string {
+	return `RIHS01_1e7e84f7edf1fb020d9e51c7842bceef4cbdbd847f354125f3227cf4f314a683`
+}
+
+func (t _Fibonacci_SendGoal_ResponseTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "test_msgs/action/Fibonacci_SendGoal_Response", "fields": [{"name": "accepted", "type": {"type_id": 15, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "stamp", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "builtin_interfaces/msg/Time"}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "builtin_interfaces/msg/Time", "fields": [{"name": "sec", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "nanosec", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}]}`
+}
+
+func (t _Fibonacci_SendGoal_ResponseTypeSupport) MessageDefinition() string {
+	return `bool accepted
+builtin_interfaces/Time stamp
+================================================================================
+MSG: builtin_interfaces/msg/Time
+# The seconds component, valid over all int32 values.
+int32 sec
+# The nanoseconds component, valid in the range [0, 10e9).
+uint32 nanosec
+`
+}
+
 type CFibonacci_SendGoal_Response = C.test_msgs__action__Fibonacci_SendGoal_Response
 type CFibonacci_SendGoal_Response__Sequence = C.test_msgs__action__Fibonacci_SendGoal_Response__Sequence
 
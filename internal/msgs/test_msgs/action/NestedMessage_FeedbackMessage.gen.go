@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	unique_identifier_msgs_msg "github.com/tiiuae/rclgo/internal/msgs/unique_identifier_msgs/msg"
@@ -55,6 +57,38 @@ func (t *NestedMessage_FeedbackMessage) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *NestedMessage_FeedbackMessage) Equal(other *NestedMessage_FeedbackMessage) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *NestedMessage_FeedbackMessage) EqualApprox(other *NestedMessage_FeedbackMessage, tol float64) bool {
+	if !t.GoalID.EqualApprox(&other.GoalID, tol) {
+		return false
+	}
+	if !t.Feedback.EqualApprox(&other.Feedback, tol) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *NestedMessage_FeedbackMessage) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *NestedMessage_FeedbackMessage) HashTo(h *msgcmp.Hasher) {
+	t.GoalID.HashTo(h)
+	t.Feedback.HashTo(h)
+}
+
 func (t *NestedMessage_FeedbackMessage) SetDefaults() {
 	t.GoalID.SetDefaults()
 	t.Feedback.SetDefaults()
@@ -63,6 +97,48 @@ func (t *NestedMessage_FeedbackMessage) SetDefaults() {
 func (t *NestedMessage_FeedbackMessage) GetTypeSupport() types.MessageTypeSupport {
 	return NestedMessage_FeedbackMessageTypeSupport
 }
+
+func (t *NestedMessage_FeedbackMessage) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *NestedMessage_FeedbackMessage) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *NestedMessage_FeedbackMessage) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *NestedMessage_FeedbackMessage) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *NestedMessage_FeedbackMessage) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *NestedMessage_FeedbackMessage) EncodeCDR(e *cdr.Encoder) {
+	t.GoalID.EncodeCDR(e)
+	t.Feedback.EncodeCDR(e)
+}
+
+func (t *NestedMessage_FeedbackMessage) DecodeCDR(d *cdr.Decoder) {
+	t.GoalID.DecodeCDR(d)
+	t.Feedback.DecodeCDR(d)
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *NestedMessage_FeedbackMessage) Validate() error {
+	if err := t.GoalID.Validate(); err != nil {
+		return rclgo.WrapValidationError("goal_id", -1, err)
+	}
+	if err := t.Feedback.Validate(); err != nil {
+		return rclgo.WrapValidationError("feedback", -1, err)
+	}
+	return nil
+}
 func (t *NestedMessage_FeedbackMessage) GetGoalID() *types.GoalID {
 	return (*types.GoalID)(&t.GoalID.Uuid)
 }
@@ -128,6 +204,17 @@ func CloneNestedMessage_FeedbackMessageSlice(dst, src []NestedMessage_FeedbackMe
 	}
 }
 
+// NestedMessage_FeedbackMessageDescriptor describes the fields of NestedMessage_FeedbackMessage. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var NestedMessage_FeedbackMessageDescriptor = &types.MessageDescriptor{
+	TypeName: "test_msgs/action/NestedMessage_FeedbackMessage",
+	Fields: []types.FieldDescriptor{
+		{Name: "goal_id", Type: "unique_identifier_msgs/msg/UUID", GoIndex: 0, Message: unique_identifier_msgs_msg.UUIDDescriptor},
+		{Name: "feedback", Type: "test_msgs/action/NestedMessage_Feedback", GoIndex: 1, Message: NestedMessage_FeedbackDescriptor},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var NestedMessage_FeedbackMessageTypeSupport types.MessageTypeSupport = _NestedMessage_FeedbackMessageTypeSupport{}
 
@@ -163,6 +250,64 @@ func (t _NestedMessage_FeedbackMessageTypeSupport) TypeSupport() unsafe.Pointer
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__test_msgs__action__NestedMessage_FeedbackMessage())
 }
 
+func (t _NestedMessage_FeedbackMessageTypeSupport) Descriptor() *types.MessageDescriptor {
+	return NestedMessage_FeedbackMessageDescriptor
+}
+
+func (t _NestedMessage_FeedbackMessageTypeSupport) TypeHash() string {
+	return `RIHS01_72ee5828e8083f3c42a56bc5d99fd9e7d5c86389850fc006f254ecdcaecb7d03`
+}
+
+func (t _NestedMessage_FeedbackMessageTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "test_msgs/action/NestedMessage_FeedbackMessage", "fields": [{"name": "goal_id", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "unique_identifier_msgs/msg/UUID"}, "default_value": ""}, {"name": "feedback", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "test_msgs/action/NestedMessage_Feedback"}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "builtin_interfaces/msg/Duration", "fields": [{"name": "sec", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "nanosec", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "builtin_interfaces/msg/Time", "fields": [{"name": "sec", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "nanosec", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "test_msgs/action/NestedMessage_Feedback", "fields": [{"name": "nested_field_no_pkg", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "test_msgs/msg/Builtins"}, "default_value": ""}, {"name": "nested_field", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "test_msgs/msg/BasicTypes"}, "default_value": ""}, {"name": "nested_different_pkg", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "builtin_interfaces/msg/Time"}, "default_value": ""}]}, {"type_name": "test_msgs/msg/BasicTypes", "fields": [{"name": "bool_value", "type": {"type_id": 15, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "byte_value", "type": {"type_id": 16, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "char_value", "type": {"type_id": 3, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "float32_value", "type": {"type_id": 10, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "float64_value", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "int8_value", "type": {"type_id": 2, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "uint8_value", "type": {"type_id": 3, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "int16_value", "type": {"type_id": 4, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "uint16_value", "type": {"type_id": 5, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "int32_value", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "uint32_value", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "int64_value", "type": {"type_id": 8, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "uint64_value", "type": {"type_id": 9, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "test_msgs/msg/Builtins", "fields": [{"name": "duration_value", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "builtin_interfaces/msg/Duration"}, "default_value": ""}, {"name": "time_value", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "builtin_interfaces/msg/Time"}, "default_value": ""}]}, {"type_name": "unique_identifier_msgs/msg/UUID", "fields": [{"name": "uuid", "type": {"type_id": 51, "capacity": 16, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}]}`
+}
+
+func (t _NestedMessage_FeedbackMessageTypeSupport) MessageDefinition() string {
+	return `unique_identifier_msgs/UUID goal_id
+test_msgs/NestedMessage_Feedback feedback
+================================================================================
+MSG: unique_identifier_msgs/msg/UUID
+uint8[16] uuid
+================================================================================
+MSG: test_msgs/action/NestedMessage_Feedback
+# feedback
+Builtins nested_field_no_pkg
+BasicTypes nested_field
+builtin_interfaces/Time nested_different_pkg
+================================================================================
+MSG: test_msgs/msg/Builtins
+builtin_interfaces/Duration duration_value
+builtin_interfaces/Time time_value
+================================================================================
+MSG: test_msgs/msg/BasicTypes
+bool bool_value
+byte byte_value
+char char_value
+float32 float32_value
+float64 float64_value
+int8 int8_value
+uint8 uint8_value
+int16 int16_value
+uint16 uint16_value
+int32 int32_value
+uint32 uint32_value
+int64 int64_value
+uint64 uint64_value
+================================================================================
+MSG: builtin_interfaces/msg/Time
+# The seconds component, valid over all int32 values.
+int32 sec
+# The nanoseconds component, valid in the range [0, 10e9).
+uint32 nanosec
+================================================================================
+MSG: builtin_interfaces/msg/Duration
+# Seconds component, range is valid over any possible int32 value.
+int32 sec
+# Nanoseconds component in the range of [0, 10e9).
+uint32 nanosec
+`
+}
+
 type CNestedMessage_FeedbackMessage = C.test_msgs__action__NestedMessage_FeedbackMessage
 type CNestedMessage_FeedbackMessage__Sequence = C.test_msgs__action__NestedMessage_FeedbackMessage__Sequence
 
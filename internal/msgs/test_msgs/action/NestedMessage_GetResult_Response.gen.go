@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	
@@ -54,6 +56,38 @@ func (t *NestedMessage_GetResult_Response) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *NestedMessage_GetResult_Response) Equal(other *NestedMessage_GetResult_Response) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *NestedMessage_GetResult_Response) EqualApprox(other *NestedMessage_GetResult_Response, tol float64) bool {
+	if t.Status != other.Status {
+		return false
+	}
+	if !t.Result.EqualApprox(&other.Result, tol) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *NestedMessage_GetResult_Response) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *NestedMessage_GetResult_Response) HashTo(h *msgcmp.Hasher) {
+	h.Int8(t.Status)
+	t.Result.HashTo(h)
+}
+
 func (t *NestedMessage_GetResult_Response) SetDefaults() {
 	t.Status = 0
 	t.Result.SetDefaults()
@@ -63,6 +97,45 @@ func (t *NestedMessage_GetResult_Response) GetTypeSupport() types.MessageTypeSup
 	return NestedMessage_GetResult_ResponseTypeSupport
 }
 
+func (t *NestedMessage_GetResult_Response) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *NestedMessage_GetResult_Response) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *NestedMessage_GetResult_Response) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *NestedMessage_GetResult_Response) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *NestedMessage_GetResult_Response) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *NestedMessage_GetResult_Response) EncodeCDR(e *cdr.Encoder) {
+	e.Int8(t.Status)
+	t.Result.EncodeCDR(e)
+}
+
+func (t *NestedMessage_GetResult_Response) DecodeCDR(d *cdr.Decoder) {
+	t.Status = d.Int8()
+	t.Result.DecodeCDR(d)
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *NestedMessage_GetResult_Response) Validate() error {
+	if err := t.Result.Validate(); err != nil {
+		return rclgo.WrapValidationError("result", -1, err)
+	}
+	return nil
+}
+
 // NestedMessage_GetResult_ResponsePublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type NestedMessage_GetResult_ResponsePublisher struct {
@@ -120,6 +193,17 @@ func CloneNestedMessage_GetResult_ResponseSlice(dst, src []NestedMessage_GetResu
 	}
 }
 
+// NestedMessage_GetResult_ResponseDescriptor describes the fields of NestedMessage_GetResult_Response. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var NestedMessage_GetResult_ResponseDescriptor = &types.MessageDescriptor{
+	TypeName: "test_msgs/action/NestedMessage_GetResult_Response",
+	Fields: []types.FieldDescriptor{
+		{Name: "status", Type: "int8", GoIndex: 0},
+		{Name: "result", Type: "test_msgs/action/NestedMessage_Result", GoIndex: 1, Message: NestedMessage_ResultDescriptor},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var NestedMessage_GetResult_ResponseTypeSupport types.MessageTypeSupport = _NestedMessage_GetResult_ResponseTypeSupport{}
 
@@ -155,6 +239,61 @@ func (t _NestedMessage_GetResult_ResponseTypeSupport) TypeSupport() unsafe.Point
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__test_msgs__action__NestedMessage_GetResult_Response())
 }
 
+func (t _NestedMessage_GetResult_ResponseTypeSupport) Descriptor() *types.MessageDescriptor {
+	return NestedMessage_GetResult_ResponseDescriptor
+}
+
+func (t _NestedMessage_GetResult_ResponseTypeSupport) TypeHash() string {
+	return `RIHS01_663ca16d674f9c9557884d2cfa74b51e3d4720a8fd305d87d89369a087791c80`
+}
+
+func (t _NestedMessage_GetResult_ResponseTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "test_msgs/action/NestedMessage_GetResult_Response", "fields": [{"name": "status", "type": {"type_id": 2, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "result", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "test_msgs/action/NestedMessage_Result"}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "builtin_interfaces/msg/Duration", "fields": [{"name": "sec", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "nanosec", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "builtin_interfaces/msg/Time", "fields": [{"name": "sec", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "nanosec", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "test_msgs/action/NestedMessage_Result", "fields": [{"name": "nested_field_no_pkg", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "test_msgs/msg/Builtins"}, "default_value": ""}, {"name": "nested_field", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "test_msgs/msg/BasicTypes"}, "default_value": ""}, {"name": "nested_different_pkg", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "builtin_interfaces/msg/Time"}, "default_value": ""}]}, {"type_name": "test_msgs/msg/BasicTypes", "fields": [{"name": "bool_value", "type": {"type_id": 15, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "byte_value", "type": {"type_id": 16, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "char_value", "type": {"type_id": 3, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "float32_value", "type": {"type_id": 10, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "float64_value", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "int8_value", "type": {"type_id": 2, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "uint8_value", "type": {"type_id": 3, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "int16_value", "type": {"type_id": 4, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "uint16_value", "type": {"type_id": 5, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "int32_value", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "uint32_value", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "int64_value", "type": {"type_id": 8, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "uint64_value", "type": {"type_id": 9, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "test_msgs/msg/Builtins", "fields": [{"name": "duration_value", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "builtin_interfaces/msg/Duration"}, "default_value": ""}, {"name": "time_value", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "builtin_interfaces/msg/Time"}, "default_value": ""}]}]}`
+}
+
+func (t _NestedMessage_GetResult_ResponseTypeSupport) MessageDefinition() string {
+	return `int8 status
+test_msgs/NestedMessage_Result result
+================================================================================
+MSG: test_msgs/action/NestedMessage_Result
+# result definition
+Builtins nested_field_no_pkg
+BasicTypes nested_field
+builtin_interfaces/Time nested_different_pkg
+================================================================================
+MSG: test_msgs/msg/Builtins
+builtin_interfaces/Duration duration_value
+builtin_interfaces/Time time_value
+================================================================================
+MSG: test_msgs/msg/BasicTypes
+bool bool_value
+byte byte_value
+char char_value
+float32 float32_value
+float64 float64_value
+int8 int8_value
+uint8 uint8_value
+int16 int16_value
+uint16 uint16_value
+int32 int32_value
+uint32 uint32_value
+int64 int64_value
+uint64 uint64_value
+================================================================================
+MSG: builtin_interfaces/msg/Time
+# The seconds component, valid over all int32 values.
+int32 sec
+# The nanoseconds component, valid in the range [0, 10e9).
+uint32 nanosec
+================================================================================
+MSG: builtin_interfaces/msg/Duration
+# Seconds component, range is valid over any possible int32 value.
+int32 sec
+# Nanoseconds component in the range of [0, 10e9).
+uint32 nanosec
+`
+}
+
 type CNestedMessage_GetResult_Response = C.test_msgs__action__NestedMessage_GetResult_Response
 type CNestedMessage_GetResult_Response__Sequence = C.test_msgs__action__NestedMessage_GetResult_Response__Sequence
 
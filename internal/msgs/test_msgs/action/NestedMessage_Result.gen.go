@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	builtin_interfaces_msg "github.com/tiiuae/rclgo/internal/msgs/builtin_interfaces/msg"
@@ -58,6 +60,42 @@ func (t *NestedMessage_Result) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *NestedMessage_Result) Equal(other *NestedMessage_Result) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *NestedMessage_Result) EqualApprox(other *NestedMessage_Result, tol float64) bool {
+	if !t.NestedFieldNoPkg.EqualApprox(&other.NestedFieldNoPkg, tol) {
+		return false
+	}
+	if !t.NestedField.EqualApprox(&other.NestedField, tol) {
+		return false
+	}
+	if !t.NestedDifferentPkg.EqualApprox(&other.NestedDifferentPkg, tol) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *NestedMessage_Result) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *NestedMessage_Result) HashTo(h *msgcmp.Hasher) {
+	t.NestedFieldNoPkg.HashTo(h)
+	t.NestedField.HashTo(h)
+	t.NestedDifferentPkg.HashTo(h)
+}
+
 func (t *NestedMessage_Result) SetDefaults() {
 	t.NestedFieldNoPkg.SetDefaults()
 	t.NestedField.SetDefaults()
@@ -68,6 +106,53 @@ func (t *NestedMessage_Result) GetTypeSupport() types.MessageTypeSupport {
 	return NestedMessage_ResultTypeSupport
 }
 
+func (t *NestedMessage_Result) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *NestedMessage_Result) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *NestedMessage_Result) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *NestedMessage_Result) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *NestedMessage_Result) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *NestedMessage_Result) EncodeCDR(e *cdr.Encoder) {
+	t.NestedFieldNoPkg.EncodeCDR(e)
+	t.NestedField.EncodeCDR(e)
+	t.NestedDifferentPkg.EncodeCDR(e)
+}
+
+func (t *NestedMessage_Result) DecodeCDR(d *cdr.Decoder) {
+	t.NestedFieldNoPkg.DecodeCDR(d)
+	t.NestedField.DecodeCDR(d)
+	t.NestedDifferentPkg.DecodeCDR(d)
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *NestedMessage_Result) Validate() error {
+	if err := t.NestedFieldNoPkg.Validate(); err != nil {
+		return rclgo.WrapValidationError("nested_field_no_pkg", -1, err)
+	}
+	if err := t.NestedField.Validate(); err != nil {
+		return rclgo.WrapValidationError("nested_field", -1, err)
+	}
+	if err := t.NestedDifferentPkg.Validate(); err != nil {
+		return rclgo.WrapValidationError("nested_different_pkg", -1, err)
+	}
+	return nil
+}
+
 // NestedMessage_ResultPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type NestedMessage_ResultPublisher struct {
@@ -125,6 +210,18 @@ func CloneNestedMessage_ResultSlice(dst, src []NestedMessage_Result) {
 	}
 }
 
+// NestedMessage_ResultDescriptor describes the fields of NestedMessage_Result. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var NestedMessage_ResultDescriptor = &types.MessageDescriptor{
+	TypeName: "test_msgs/action/NestedMessage_Result",
+	Fields: []types.FieldDescriptor{
+		{Name: "nested_field_no_pkg", Type: "test_msgs/msg/Builtins", GoIndex: 0, Message: test_msgs_msg.BuiltinsDescriptor},
+		{Name: "nested_field", Type: "test_msgs/msg/BasicTypes", GoIndex: 1, Message: test_msgs_msg.BasicTypesDescriptor},
+		{Name: "nested_different_pkg", Type: "builtin_interfaces/msg/Time", GoIndex: 2, Message: builtin_interfaces_msg.TimeDescriptor},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var NestedMessage_ResultTypeSupport types.MessageTypeSupport = _NestedMessage_ResultTypeSupport{}
 
@@ -162,6 +259,57 @@ func (t _NestedMessage_ResultTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__test_msgs__action__NestedMessage_Result())
 }
 
+func (t _NestedMessage_ResultTypeSupport) Descriptor() *types.MessageDescriptor {
+	return NestedMessage_ResultDescriptor
+}
+
+func (t _NestedMessage_ResultTypeSupport) TypeHash() string {
+	return `RIHS01_c3356da873cd9b6f11cafaa25d6a9269a68751b937715cee506c3dbc051cdcfd`
+}
+
+func (t _NestedMessage_ResultTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "test_msgs/action/NestedMessage_Result", "fields": [{"name": "nested_field_no_pkg", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "test_msgs/msg/Builtins"}, "default_value": ""}, {"name": "nested_field", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "test_msgs/msg/BasicTypes"}, "default_value": ""}, {"name": "nested_different_pkg", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "builtin_interfaces/msg/Time"}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "builtin_interfaces/msg/Duration", "fields": [{"name": "sec", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "nanosec", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "builtin_interfaces/msg/Time", "fields": [{"name": "sec", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "nanosec", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "test_msgs/msg/BasicTypes", "fields": [{"name": "bool_value", "type": {"type_id": 15, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "byte_value", "type": {"type_id": 16, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "char_value", "type": {"type_id": 3, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "float32_value", "type": {"type_id": 10, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "float64_value", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "int8_value", "type": {"type_id": 2, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "uint8_value", "type": {"type_id": 3, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "int16_value", "type": {"type_id": 4, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "uint16_value", "type": {"type_id": 5, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "int32_value", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "uint32_value", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "int64_value", "type": {"type_id": 8, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "uint64_value", "type": {"type_id": 9, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "test_msgs/msg/Builtins", "fields": [{"name": "duration_value", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "builtin_interfaces/msg/Duration"}, "default_value": ""}, {"name": "time_value", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "builtin_interfaces/msg/Time"}, "default_value": ""}]}]}`
+}
+
+func (t _NestedMessage_ResultTypeSupport) MessageDefinition() string {
+	return `# result definition
+Builtins nested_field_no_pkg
+BasicTypes nested_field
+builtin_interfaces/Time nested_different_pkg
+================================================================================
+MSG: test_msgs/msg/Builtins
+builtin_interfaces/Duration duration_value
+builtin_interfaces/Time time_value
+================================================================================
+MSG: test_msgs/msg/BasicTypes
+bool bool_value
+byte byte_value
+char char_value
+float32 float32_value
+float64 float64_value
+int8 int8_value
+uint8 uint8_value
+int16 int16_value
+uint16 uint16_value
+int32 int32_value
+uint32 uint32_value
+int64 int64_value
+uint64 uint64_value
+================================================================================
+MSG: builtin_interfaces/msg/Time
+# The seconds component, valid over all int32 values.
+int32 sec
+# The nanoseconds component, valid in the range [0, 10e9).
+uint32 nanosec
+================================================================================
+MSG: builtin_interfaces/msg/Duration
+# Seconds component, range is valid over any possible int32 value.
+int32 sec
+# Nanoseconds component in the range of [0, 10e9).
+uint32 nanosec
+`
+}
+
 type CNestedMessage_Result = C.test_msgs__action__NestedMessage_Result
 type CNestedMessage_Result__Sequence = C.test_msgs__action__NestedMessage_Result__Sequence
 
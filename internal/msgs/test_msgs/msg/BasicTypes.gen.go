@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	
@@ -76,6 +78,82 @@ func (t *BasicTypes) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *BasicTypes) Equal(other *BasicTypes) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *BasicTypes) EqualApprox(other *BasicTypes, tol float64) bool {
+	if t.BoolValue != other.BoolValue {
+		return false
+	}
+	if t.ByteValue != other.ByteValue {
+		return false
+	}
+	if t.CharValue != other.CharValue {
+		return false
+	}
+	if !msgcmp.Float32(t.Float32Value, other.Float32Value, tol) {
+		return false
+	}
+	if !msgcmp.Float64(t.Float64Value, other.Float64Value, tol) {
+		return false
+	}
+	if t.Int8Value != other.Int8Value {
+		return false
+	}
+	if t.Uint8Value != other.Uint8Value {
+		return false
+	}
+	if t.Int16Value != other.Int16Value {
+		return false
+	}
+	if t.Uint16Value != other.Uint16Value {
+		return false
+	}
+	if t.Int32Value != other.Int32Value {
+		return false
+	}
+	if t.Uint32Value != other.Uint32Value {
+		return false
+	}
+	if t.Int64Value != other.Int64Value {
+		return false
+	}
+	if t.Uint64Value != other.Uint64Value {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *BasicTypes) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *BasicTypes) HashTo(h *msgcmp.Hasher) {
+	h.Bool(t.BoolValue)
+	h.Uint8(t.ByteValue)
+	h.Uint8(t.CharValue)
+	h.Float32(t.Float32Value)
+	h.Float64(t.Float64Value)
+	h.Int8(t.Int8Value)
+	h.Uint8(t.Uint8Value)
+	h.Int16(t.Int16Value)
+	h.Uint16(t.Uint16Value)
+	h.Int32(t.Int32Value)
+	h.Uint32(t.Uint32Value)
+	h.Int64(t.Int64Value)
+	h.Uint64(t.Uint64Value)
+}
+
 func (t *BasicTypes) SetDefaults() {
 	t.BoolValue = false
 	t.ByteValue = 0
@@ -96,6 +174,64 @@ func (t *BasicTypes) GetTypeSupport() types.MessageTypeSupport {
 	return BasicTypesTypeSupport
 }
 
+func (t *BasicTypes) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *BasicTypes) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *BasicTypes) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *BasicTypes) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *BasicTypes) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *BasicTypes) EncodeCDR(e *cdr.Encoder) {
+	e.Bool(t.BoolValue)
+	e.Uint8(t.ByteValue)
+	e.Uint8(t.CharValue)
+	e.Float32(t.Float32Value)
+	e.Float64(t.Float64Value)
+	e.Int8(t.Int8Value)
+	e.Uint8(t.Uint8Value)
+	e.Int16(t.Int16Value)
+	e.Uint16(t.Uint16Value)
+	e.Int32(t.Int32Value)
+	e.Uint32(t.Uint32Value)
+	e.Int64(t.Int64Value)
+	e.Uint64(t.Uint64Value)
+}
+
+func (t *BasicTypes) DecodeCDR(d *cdr.Decoder) {
+	t.BoolValue = d.Bool()
+	t.ByteValue = d.Uint8()
+	t.CharValue = d.Uint8()
+	t.Float32Value = d.Float32()
+	t.Float64Value = d.Float64()
+	t.Int8Value = d.Int8()
+	t.Uint8Value = d.Uint8()
+	t.Int16Value = d.Int16()
+	t.Uint16Value = d.Uint16()
+	t.Int32Value = d.Int32()
+	t.Uint32Value = d.Uint32()
+	t.Int64Value = d.Int64()
+	t.Uint64Value = d.Uint64()
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *BasicTypes) Validate() error {
+	return nil
+}
+
 // BasicTypesPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type BasicTypesPublisher struct {
@@ -153,6 +289,28 @@ func CloneBasicTypesSlice(dst, src []BasicTypes) {
 	}
 }
 
+// BasicTypesDescriptor describes the fields of BasicTypes. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var BasicTypesDescriptor = &types.MessageDescriptor{
+	TypeName: "test_msgs/msg/BasicTypes",
+	Fields: []types.FieldDescriptor{
+		{Name: "bool_value", Type: "bool", GoIndex: 0},
+		{Name: "byte_value", Type: "byte", GoIndex: 1},
+		{Name: "char_value", Type: "char", GoIndex: 2},
+		{Name: "float32_value", Type: "float32", GoIndex: 3},
+		{Name: "float64_value", Type: "float64", GoIndex: 4},
+		{Name: "int8_value", Type: "int8", GoIndex: 5},
+		{Name: "uint8_value", Type: "uint8", GoIndex: 6},
+		{Name: "int16_value", Type: "int16", GoIndex: 7},
+		{Name: "uint16_value", Type: "uint16", GoIndex: 8},
+		{Name: "int32_value", Type: "int32", GoIndex: 9},
+		{Name: "uint32_value", Type: "uint32", GoIndex: 10},
+		{Name: "int64_value", Type: "int64", GoIndex: 11},
+		{Name: "uint64_value", Type: "uint64", GoIndex: 12},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var BasicTypesTypeSupport types.MessageTypeSupport = _BasicTypesTypeSupport{}
 
@@ -210,6 +368,35 @@ func (t _BasicTypesTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__test_msgs__msg__BasicTypes())
 }
 
+func (t _BasicTypesTypeSupport) Descriptor() *types.MessageDescriptor {
+	return BasicTypesDescriptor
+}
+
+func (t _BasicTypesTypeSupport) TypeHash() string {
+	return `RIHS01_7c300afd4e796798d49bdd6cdaa0fa87fa0ed2ba3217d977e1faa87070d797ab`
+}
+
+func (t _BasicTypesTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "test_msgs/msg/BasicTypes", "fields": [{"name": "bool_value", "type": {"type_id": 15, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "byte_value", "type": {"type_id": 16, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "char_value", "type": {"type_id": 3, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "float32_value", "type": {"type_id": 10, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "float64_value", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "int8_value", "type": {"type_id": 2, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "uint8_value", "type": {"type_id": 3, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "int16_value", "type": {"type_id": 4, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "uint16_value", "type": {"type_id": 5, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "int32_value", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "uint32_value", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "int64_value", "type": {"type_id": 8, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "uint64_value", "type": {"type_id": 9, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": []}`
+}
+
+func (t _BasicTypesTypeSupport) MessageDefinition() string {
+	return `bool bool_value
+byte byte_value
+char char_value
+float32 float32_value
+float64 float64_value
+int8 int8_value
+uint8 uint8_value
+int16 int16_value
+uint16 uint16_value
+int32 int32_value
+uint32 uint32_value
+int64 int64_value
+uint64 uint64_value
+`
+}
+
 type CBasicTypes = C.test_msgs__msg__BasicTypes
 type CBasicTypes__Sequence = C.test_msgs__msg__BasicTypes__Sequence
 
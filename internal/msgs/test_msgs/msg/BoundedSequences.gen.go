@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	primitives "github.com/tiiuae/rclgo/pkg/rclgo/primitives"
@@ -208,6 +210,266 @@ func (t *BoundedSequences) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *BoundedSequences) Equal(other *BoundedSequences) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *BoundedSequences) EqualApprox(other *BoundedSequences, tol float64) bool {
+	if !msgcmp.Slices(t.BoolValues, other.BoolValues) {
+		return false
+	}
+	if !msgcmp.Slices(t.ByteValues, other.ByteValues) {
+		return false
+	}
+	if !msgcmp.Slices(t.CharValues, other.CharValues) {
+		return false
+	}
+	if !msgcmp.Float32s(t.Float32Values, other.Float32Values, tol) {
+		return false
+	}
+	if !msgcmp.Float64s(t.Float64Values, other.Float64Values, tol) {
+		return false
+	}
+	if !msgcmp.Slices(t.Int8Values, other.Int8Values) {
+		return false
+	}
+	if !msgcmp.Slices(t.Uint8Values, other.Uint8Values) {
+		return false
+	}
+	if !msgcmp.Slices(t.Int16Values, other.Int16Values) {
+		return false
+	}
+	if !msgcmp.Slices(t.Uint16Values, other.Uint16Values) {
+		return false
+	}
+	if !msgcmp.Slices(t.Int32Values, other.Int32Values) {
+		return false
+	}
+	if !msgcmp.Slices(t.Uint32Values, other.Uint32Values) {
+		return false
+	}
+	if !msgcmp.Slices(t.Int64Values, other.Int64Values) {
+		return false
+	}
+	if !msgcmp.Slices(t.Uint64Values, other.Uint64Values) {
+		return false
+	}
+	if !msgcmp.Slices(t.StringValues, other.StringValues) {
+		return false
+	}
+	if len(t.BasicTypesValues) != len(other.BasicTypesValues) {
+		return false
+	}
+	for i := range t.BasicTypesValues {
+		if !t.BasicTypesValues[i].EqualApprox(&other.BasicTypesValues[i], tol) {
+			return false
+		}
+	}
+	if len(t.ConstantsValues) != len(other.ConstantsValues) {
+		return false
+	}
+	for i := range t.ConstantsValues {
+		if !t.ConstantsValues[i].EqualApprox(&other.ConstantsValues[i], tol) {
+			return false
+		}
+	}
+	if len(t.DefaultsValues) != len(other.DefaultsValues) {
+		return false
+	}
+	for i := range t.DefaultsValues {
+		if !t.DefaultsValues[i].EqualApprox(&other.DefaultsValues[i], tol) {
+			return false
+		}
+	}
+	if !msgcmp.Slices(t.BoolValuesDefault, other.BoolValuesDefault) {
+		return false
+	}
+	if !msgcmp.Slices(t.ByteValuesDefault, other.ByteValuesDefault) {
+		return false
+	}
+	if !msgcmp.Slices(t.CharValuesDefault, other.CharValuesDefault) {
+		return false
+	}
+	if !msgcmp.Float32s(t.Float32ValuesDefault, other.Float32ValuesDefault, tol) {
+		return false
+	}
+	if !msgcmp.Float64s(t.Float64ValuesDefault, other.Float64ValuesDefault, tol) {
+		return false
+	}
+	if !msgcmp.Slices(t.Int8ValuesDefault, other.Int8ValuesDefault) {
+		return false
+	}
+	if !msgcmp.Slices(t.Uint8ValuesDefault, other.Uint8ValuesDefault) {
+		return false
+	}
+	if !msgcmp.Slices(t.Int16ValuesDefault, other.Int16ValuesDefault) {
+		return false
+	}
+	if !msgcmp.Slices(t.Uint16ValuesDefault, other.Uint16ValuesDefault) {
+		return false
+	}
+	if !msgcmp.Slices(t.Int32ValuesDefault, other.Int32ValuesDefault) {
+		return false
+	}
+	if !msgcmp.Slices(t.Uint32ValuesDefault, other.Uint32ValuesDefault) {
+		return false
+	}
+	if !msgcmp.Slices(t.Int64ValuesDefault, other.Int64ValuesDefault) {
+		return false
+	}
+	if !msgcmp.Slices(t.Uint64ValuesDefault, other.Uint64ValuesDefault) {
+		return false
+	}
+	if !msgcmp.Slices(t.StringValuesDefault, other.StringValuesDefault) {
+		return false
+	}
+	if t.AlignmentCheck != other.AlignmentCheck {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *BoundedSequences) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *BoundedSequences) HashTo(h *msgcmp.Hasher) {
+	h.Len(len(t.BoolValues))
+	for i := range t.BoolValues {
+		h.Bool(t.BoolValues[i])
+	}
+	h.Len(len(t.ByteValues))
+	for i := range t.ByteValues {
+		h.Uint8(t.ByteValues[i])
+	}
+	h.Len(len(t.CharValues))
+	for i := range t.CharValues {
+		h.Uint8(t.CharValues[i])
+	}
+	h.Len(len(t.Float32Values))
+	for i := range t.Float32Values {
+		h.Float32(t.Float32Values[i])
+	}
+	h.Len(len(t.Float64Values))
+	for i := range t.Float64Values {
+		h.Float64(t.Float64Values[i])
+	}
+	h.Len(len(t.Int8Values))
+	for i := range t.Int8Values {
+		h.Int8(t.Int8Values[i])
+	}
+	h.Len(len(t.Uint8Values))
+	for i := range t.Uint8Values {
+		h.Uint8(t.Uint8Values[i])
+	}
+	h.Len(len(t.Int16Values))
+	for i := range t.Int16Values {
+		h.Int16(t.Int16Values[i])
+	}
+	h.Len(len(t.Uint16Values))
+	for i := range t.Uint16Values {
+		h.Uint16(t.Uint16Values[i])
+	}
+	h.Len(len(t.Int32Values))
+	for i := range t.Int32Values {
+		h.Int32(t.Int32Values[i])
+	}
+	h.Len(len(t.Uint32Values))
+	for i := range t.Uint32Values {
+		h.Uint32(t.Uint32Values[i])
+	}
+	h.Len(len(t.Int64Values))
+	for i := range t.Int64Values {
+		h.Int64(t.Int64Values[i])
+	}
+	h.Len(len(t.Uint64Values))
+	for i := range t.Uint64Values {
+		h.Uint64(t.Uint64Values[i])
+	}
+	h.Len(len(t.StringValues))
+	for i := range t.StringValues {
+		h.String(t.StringValues[i])
+	}
+	h.Len(len(t.BasicTypesValues))
+	for i := range t.BasicTypesValues {
+		t.BasicTypesValues[i].HashTo(h)
+	}
+	h.Len(len(t.ConstantsValues))
+	for i := range t.ConstantsValues {
+		t.ConstantsValues[i].HashTo(h)
+	}
+	h.Len(len(t.DefaultsValues))
+	for i := range t.DefaultsValues {
+		t.DefaultsValues[i].HashTo(h)
+	}
+	h.Len(len(t.BoolValuesDefault))
+	for i := range t.BoolValuesDefault {
+		h.Bool(t.BoolValuesDefault[i])
+	}
+	h.Len(len(t.ByteValuesDefault))
+	for i := range t.ByteValuesDefault {
+		h.Uint8(t.ByteValuesDefault[i])
+	}
+	h.Len(len(t.CharValuesDefault))
+	for i := range t.CharValuesDefault {
+		h.Uint8(t.CharValuesDefault[i])
+	}
+	h.Len(len(t.Float32ValuesDefault))
+	for i := range t.Float32ValuesDefault {
+		h.Float32(t.Float32ValuesDefault[i])
+	}
+	h.Len(len(t.Float64ValuesDefault))
+	for i := range t.Float64ValuesDefault {
+		h.Float64(t.Float64ValuesDefault[i])
+	}
+	h.Len(len(t.Int8ValuesDefault))
+	for i := range t.Int8ValuesDefault {
+		h.Int8(t.Int8ValuesDefault[i])
+	}
+	h.Len(len(t.Uint8ValuesDefault))
+	for i := range t.Uint8ValuesDefault {
+		h.Uint8(t.Uint8ValuesDefault[i])
+	}
+	h.Len(len(t.Int16ValuesDefault))
+	for i := range t.Int16ValuesDefault {
+		h.Int16(t.Int16ValuesDefault[i])
+	}
+	h.Len(len(t.Uint16ValuesDefault))
+	for i := range t.Uint16ValuesDefault {
+		h.Uint16(t.Uint16ValuesDefault[i])
+	}
+	h.Len(len(t.Int32ValuesDefault))
+	for i := range t.Int32ValuesDefault {
+		h.Int32(t.Int32ValuesDefault[i])
+	}
+	h.Len(len(t.Uint32ValuesDefault))
+	for i := range t.Uint32ValuesDefault {
+		h.Uint32(t.Uint32ValuesDefault[i])
+	}
+	h.Len(len(t.Int64ValuesDefault))
+	for i := range t.Int64ValuesDefault {
+		h.Int64(t.Int64ValuesDefault[i])
+	}
+	h.Len(len(t.Uint64ValuesDefault))
+	for i := range t.Uint64ValuesDefault {
+		h.Uint64(t.Uint64ValuesDefault[i])
+	}
+	h.Len(len(t.StringValuesDefault))
+	for i := range t.StringValuesDefault {
+		h.String(t.StringValuesDefault[i])
+	}
+	h.Int32(t.AlignmentCheck)
+}
+
 func (t *BoundedSequences) SetDefaults() {
 	t.BoolValues = nil
 	t.ByteValues = nil
@@ -247,6 +509,372 @@ func (t *BoundedSequences) GetTypeSupport() types.MessageTypeSupport {
 	return BoundedSequencesTypeSupport
 }
 
+func (t *BoundedSequences) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *BoundedSequences) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *BoundedSequences) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *BoundedSequences) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *BoundedSequences) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *BoundedSequences) EncodeCDR(e *cdr.Encoder) {
+	e.SequenceLength(len(t.BoolValues), 3)
+	for i := range t.BoolValues {
+		e.Bool(t.BoolValues[i])
+	}
+	e.SequenceLength(len(t.ByteValues), 3)
+	e.Uint8s(t.ByteValues[:])
+	e.SequenceLength(len(t.CharValues), 3)
+	e.Uint8s(t.CharValues[:])
+	e.SequenceLength(len(t.Float32Values), 3)
+	for i := range t.Float32Values {
+		e.Float32(t.Float32Values[i])
+	}
+	e.SequenceLength(len(t.Float64Values), 3)
+	for i := range t.Float64Values {
+		e.Float64(t.Float64Values[i])
+	}
+	e.SequenceLength(len(t.Int8Values), 3)
+	for i := range t.Int8Values {
+		e.Int8(t.Int8Values[i])
+	}
+	e.SequenceLength(len(t.Uint8Values), 3)
+	e.Uint8s(t.Uint8Values[:])
+	e.SequenceLength(len(t.Int16Values), 3)
+	for i := range t.Int16Values {
+		e.Int16(t.Int16Values[i])
+	}
+	e.SequenceLength(len(t.Uint16Values), 3)
+	for i := range t.Uint16Values {
+		e.Uint16(t.Uint16Values[i])
+	}
+	e.SequenceLength(len(t.Int32Values), 3)
+	for i := range t.Int32Values {
+		e.Int32(t.Int32Values[i])
+	}
+	e.SequenceLength(len(t.Uint32Values), 3)
+	for i := range t.Uint32Values {
+		e.Uint32(t.Uint32Values[i])
+	}
+	e.SequenceLength(len(t.Int64Values), 3)
+	for i := range t.Int64Values {
+		e.Int64(t.Int64Values[i])
+	}
+	e.SequenceLength(len(t.Uint64Values), 3)
+	for i := range t.Uint64Values {
+		e.Uint64(t.Uint64Values[i])
+	}
+	e.SequenceLength(len(t.StringValues), 3)
+	for i := range t.StringValues {
+		e.String(t.StringValues[i])
+	}
+	e.SequenceLength(len(t.BasicTypesValues), 3)
+	for i := range t.BasicTypesValues {
+		t.BasicTypesValues[i].EncodeCDR(e)
+	}
+	e.SequenceLength(len(t.ConstantsValues), 3)
+	for i := range t.ConstantsValues {
+		t.ConstantsValues[i].EncodeCDR(e)
+	}
+	e.SequenceLength(len(t.DefaultsValues), 3)
+	for i := range t.DefaultsValues {
+		t.DefaultsValues[i].EncodeCDR(e)
+	}
+	e.SequenceLength(len(t.BoolValuesDefault), 3)
+	for i := range t.BoolValuesDefault {
+		e.Bool(t.BoolValuesDefault[i])
+	}
+	e.SequenceLength(len(t.ByteValuesDefault), 3)
+	e.Uint8s(t.ByteValuesDefault[:])
+	e.SequenceLength(len(t.CharValuesDefault), 3)
+	e.Uint8s(t.CharValuesDefault[:])
+	e.SequenceLength(len(t.Float32ValuesDefault), 3)
+	for i := range t.Float32ValuesDefault {
+		e.Float32(t.Float32ValuesDefault[i])
+	}
+	e.SequenceLength(len(t.Float64ValuesDefault), 3)
+	for i := range t.Float64ValuesDefault {
+		e.Float64(t.Float64ValuesDefault[i])
+	}
+	e.SequenceLength(len(t.Int8ValuesDefault), 3)
+	for i := range t.Int8ValuesDefault {
+		e.Int8(t.Int8ValuesDefault[i])
+	}
+	e.SequenceLength(len(t.Uint8ValuesDefault), 3)
+	e.Uint8s(t.Uint8ValuesDefault[:])
+	e.SequenceLength(len(t.Int16ValuesDefault), 3)
+	for i := range t.Int16ValuesDefault {
+		e.Int16(t.Int16ValuesDefault[i])
+	}
+	e.SequenceLength(len(t.Uint16ValuesDefault), 3)
+	for i := range t.Uint16ValuesDefault {
+		e.Uint16(t.Uint16ValuesDefault[i])
+	}
+	e.SequenceLength(len(t.Int32ValuesDefault), 3)
+	for i := range t.Int32ValuesDefault {
+		e.Int32(t.Int32ValuesDefault[i])
+	}
+	e.SequenceLength(len(t.Uint32ValuesDefault), 3)
+	for i := range t.Uint32ValuesDefault {
+		e.Uint32(t.Uint32ValuesDefault[i])
+	}
+	e.SequenceLength(len(t.Int64ValuesDefault), 3)
+	for i := range t.Int64ValuesDefault {
+		e.Int64(t.Int64ValuesDefault[i])
+	}
+	e.SequenceLength(len(t.Uint64ValuesDefault), 3)
+	for i := range t.Uint64ValuesDefault {
+		e.Uint64(t.Uint64ValuesDefault[i])
+	}
+	e.SequenceLength(len(t.StringValuesDefault), 3)
+	for i := range t.StringValuesDefault {
+		e.String(t.StringValuesDefault[i])
+	}
+	e.Int32(t.AlignmentCheck)
+}
+
+func (t *BoundedSequences) DecodeCDR(d *cdr.Decoder) {
+	t.BoolValues = cdr.MakeSlice[bool](d, 3)
+	for i := range t.BoolValues {
+		t.BoolValues[i] = d.Bool()
+	}
+	t.ByteValues = cdr.MakeSlice[byte](d, 3)
+	d.Uint8s(t.ByteValues[:])
+	t.CharValues = cdr.MakeSlice[byte](d, 3)
+	d.Uint8s(t.CharValues[:])
+	t.Float32Values = cdr.MakeSlice[float32](d, 3)
+	for i := range t.Float32Values {
+		t.Float32Values[i] = d.Float32()
+	}
+	t.Float64Values = cdr.MakeSlice[float64](d, 3)
+	for i := range t.Float64Values {
+		t.Float64Values[i] = d.Float64()
+	}
+	t.Int8Values = cdr.MakeSlice[int8](d, 3)
+	for i := range t.Int8Values {
+		t.Int8Values[i] = d.Int8()
+	}
+	t.Uint8Values = cdr.MakeSlice[uint8](d, 3)
+	d.Uint8s(t.Uint8Values[:])
+	t.Int16Values = cdr.MakeSlice[int16](d, 3)
+	for i := range t.Int16Values {
+		t.Int16Values[i] = d.Int16()
+	}
+	t.Uint16Values = cdr.MakeSlice[uint16](d, 3)
+	for i := range t.Uint16Values {
+		t.Uint16Values[i] = d.Uint16()
+	}
+	t.Int32Values = cdr.MakeSlice[int32](d, 3)
+	for i := range t.Int32Values {
+		t.Int32Values[i] = d.Int32()
+	}
+	t.Uint32Values = cdr.MakeSlice[uint32](d, 3)
+	for i := range t.Uint32Values {
+		t.Uint32Values[i] = d.Uint32()
+	}
+	t.Int64Values = cdr.MakeSlice[int64](d, 3)
+	for i := range t.Int64Values {
+		t.Int64Values[i] = d.Int64()
+	}
+	t.Uint64Values = cdr.MakeSlice[uint64](d, 3)
+	for i := range t.Uint64Values {
+		t.Uint64Values[i] = d.Uint64()
+	}
+	t.StringValues = cdr.MakeSlice[string](d, 3)
+	for i := range t.StringValues {
+		t.StringValues[i] = d.String()
+	}
+	t.BasicTypesValues = cdr.MakeSlice[BasicTypes](d, 3)
+	for i := range t.BasicTypesValues {
+		t.BasicTypesValues[i].DecodeCDR(d)
+	}
+	t.ConstantsValues = cdr.MakeSlice[Constants](d, 3)
+	for i := range t.ConstantsValues {
+		t.ConstantsValues[i].DecodeCDR(d)
+	}
+	t.DefaultsValues = cdr.MakeSlice[Defaults](d, 3)
+	for i := range t.DefaultsValues {
+		t.DefaultsValues[i].DecodeCDR(d)
+	}
+	t.BoolValuesDefault = cdr.MakeSlice[bool](d, 3)
+	for i := range t.BoolValuesDefault {
+		t.BoolValuesDefault[i] = d.Bool()
+	}
+	t.ByteValuesDefault = cdr.MakeSlice[byte](d, 3)
+	d.Uint8s(t.ByteValuesDefault[:])
+	t.CharValuesDefault = cdr.MakeSlice[byte](d, 3)
+	d.Uint8s(t.CharValuesDefault[:])
+	t.Float32ValuesDefault = cdr.MakeSlice[float32](d, 3)
+	for i := range t.Float32ValuesDefault {
+		t.Float32ValuesDefault[i] = d.Float32()
+	}
+	t.Float64ValuesDefault = cdr.MakeSlice[float64](d, 3)
+	for i := range t.Float64ValuesDefault {
+		t.Float64ValuesDefault[i] = d.Float64()
+	}
+	t.Int8ValuesDefault = cdr.MakeSlice[int8](d, 3)
+	for i := range t.Int8ValuesDefault {
+		t.Int8ValuesDefault[i] = d.Int8()
+	}
+	t.Uint8ValuesDefault = cdr.MakeSlice[uint8](d, 3)
+	d.Uint8s(t.Uint8ValuesDefault[:])
+	t.Int16ValuesDefault = cdr.MakeSlice[int16](d, 3)
+	for i := range t.Int16ValuesDefault {
+		t.Int16ValuesDefault[i] = d.Int16()
+	}
+	t.Uint16ValuesDefault = cdr.MakeSlice[uint16](d, 3)
+	for i := range t.Uint16ValuesDefault {
+		t.Uint16ValuesDefault[i] = d.Uint16()
+	}
+	t.Int32ValuesDefault = cdr.MakeSlice[int32](d, 3)
+	for i := range t.Int32ValuesDefault {
+		t.Int32ValuesDefault[i] = d.Int32()
+	}
+	t.Uint32ValuesDefault = cdr.MakeSlice[uint32](d, 3)
+	for i := range t.Uint32ValuesDefault {
+		t.Uint32ValuesDefault[i] = d.Uint32()
+	}
+	t.Int64ValuesDefault = cdr.MakeSlice[int64](d, 3)
+	for i := range t.Int64ValuesDefault {
+		t.Int64ValuesDefault[i] = d.Int64()
+	}
+	t.Uint64ValuesDefault = cdr.MakeSlice[uint64](d, 3)
+	for i := range t.Uint64ValuesDefault {
+		t.Uint64ValuesDefault[i] = d.Uint64()
+	}
+	t.StringValuesDefault = cdr.MakeSlice[string](d, 3)
+	for i := range t.StringValuesDefault {
+		t.StringValuesDefault[i] = d.String()
+	}
+	t.AlignmentCheck = d.Int32()
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *BoundedSequences) Validate() error {
+	if len(t.BoolValues) > 3 {
+		return rclgo.NewBoundError("bool_values", -1, len(t.BoolValues), 3)
+	}
+	if len(t.ByteValues) > 3 {
+		return rclgo.NewBoundError("byte_values", -1, len(t.ByteValues), 3)
+	}
+	if len(t.CharValues) > 3 {
+		return rclgo.NewBoundError("char_values", -1, len(t.CharValues), 3)
+	}
+	if len(t.Float32Values) > 3 {
+		return rclgo.NewBoundError("float32_values", -1, len(t.Float32Values), 3)
+	}
+	if len(t.Float64Values) > 3 {
+		return rclgo.NewBoundError("float64_values", -1, len(t.Float64Values), 3)
+	}
+	if len(t.Int8Values) > 3 {
+		return rclgo.NewBoundError("int8_values", -1, len(t.Int8Values), 3)
+	}
+	if len(t.Uint8Values) > 3 {
+		return rclgo.NewBoundError("uint8_values", -1, len(t.Uint8Values), 3)
+	}
+	if len(t.Int16Values) > 3 {
+		return rclgo.NewBoundError("int16_values", -1, len(t.Int16Values), 3)
+	}
+	if len(t.Uint16Values) > 3 {
+		return rclgo.NewBoundError("uint16_values", -1, len(t.Uint16Values), 3)
+	}
+	if len(t.Int32Values) > 3 {
+		return rclgo.NewBoundError("int32_values", -1, len(t.Int32Values), 3)
+	}
+	if len(t.Uint32Values) > 3 {
+		return rclgo.NewBoundError("uint32_values", -1, len(t.Uint32Values), 3)
+	}
+	if len(t.Int64Values) > 3 {
+		return rclgo.NewBoundError("int64_values", -1, len(t.Int64Values), 3)
+	}
+	if len(t.Uint64Values) > 3 {
+		return rclgo.NewBoundError("uint64_values", -1, len(t.Uint64Values), 3)
+	}
+	if len(t.StringValues) > 3 {
+		return rclgo.NewBoundError("string_values", -1, len(t.StringValues), 3)
+	}
+	if len(t.BasicTypesValues) > 3 {
+		return rclgo.NewBoundError("basic_types_values", -1, len(t.BasicTypesValues), 3)
+	}
+	for i := range t.BasicTypesValues {
+		if err := t.BasicTypesValues[i].Validate(); err != nil {
+			return rclgo.WrapValidationError("basic_types_values", i, err)
+		}
+	}
+	if len(t.ConstantsValues) > 3 {
+		return rclgo.NewBoundError("constants_values", -1, len(t.ConstantsValues), 3)
+	}
+	for i := range t.ConstantsValues {
+		if err := t.ConstantsValues[i].Validate(); err != nil {
+			return rclgo.WrapValidationError("constants_values", i, err)
+		}
+	}
+	if len(t.DefaultsValues) > 3 {
+		return rclgo.NewBoundError("defaults_values", -1, len(t.DefaultsValues), 3)
+	}
+	for i := range t.DefaultsValues {
+		if err := t.DefaultsValues[i].Validate(); err != nil {
+			return rclgo.WrapValidationError("defaults_values", i, err)
+		}
+	}
+	if len(t.BoolValuesDefault) > 3 {
+		return rclgo.NewBoundError("bool_values_default", -1, len(t.BoolValuesDefault), 3)
+	}
+	if len(t.ByteValuesDefault) > 3 {
+		return rclgo.NewBoundError("byte_values_default", -1, len(t.ByteValuesDefault), 3)
+	}
+	if len(t.CharValuesDefault) > 3 {
+		return rclgo.NewBoundError("char_values_default", -1, len(t.CharValuesDefault), 3)
+	}
+	if len(t.Float32ValuesDefault) > 3 {
+		return rclgo.NewBoundError("float32_values_default", -1, len(t.Float32ValuesDefault), 3)
+	}
+	if len(t.Float64ValuesDefault) > 3 {
+		return rclgo.NewBoundError("float64_values_default", -1, len(t.Float64ValuesDefault), 3)
+	}
+	if len(t.Int8ValuesDefault) > 3 {
+		return rclgo.NewBoundError("int8_values_default", -1, len(t.Int8ValuesDefault), 3)
+	}
+	if len(t.Uint8ValuesDefault) > 3 {
+		return rclgo.NewBoundError("uint8_values_default", -1, len(t.Uint8ValuesDefault), 3)
+	}
+	if len(t.Int16ValuesDefault) > 3 {
+		return rclgo.NewBoundError("int16_values_default", -1, len(t.Int16ValuesDefault), 3)
+	}
+	if len(t.Uint16ValuesDefault) > 3 {
+		return rclgo.NewBoundError("uint16_values_default", -1, len(t.Uint16ValuesDefault), 3)
+	}
+	if len(t.Int32ValuesDefault) > 3 {
+		return rclgo.NewBoundError("int32_values_default", -1, len(t.Int32ValuesDefault), 3)
+	}
+	if len(t.Uint32ValuesDefault) > 3 {
+		return rclgo.NewBoundError("uint32_values_default", -1, len(t.Uint32ValuesDefault), 3)
+	}
+	if len(t.Int64ValuesDefault) > 3 {
+		return rclgo.NewBoundError("int64_values_default", -1, len(t.Int64ValuesDefault), 3)
+	}
+	if len(t.Uint64ValuesDefault) > 3 {
+		return rclgo.NewBoundError("uint64_values_default", -1, len(t.Uint64ValuesDefault), 3)
+	}
+	if len(t.StringValuesDefault) > 3 {
+		return rclgo.NewBoundError("string_values_default", -1, len(t.StringValuesDefault), 3)
+	}
+	return nil
+}
+
 // BoundedSequencesPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type BoundedSequencesPublisher struct {
@@ -304,6 +932,47 @@ func CloneBoundedSequencesSlice(dst, src []BoundedSequences) {
 	}
 }
 
+// BoundedSequencesDescriptor describes the fields of BoundedSequences. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var BoundedSequencesDescriptor = &types.MessageDescriptor{
+	TypeName: "test_msgs/msg/BoundedSequences",
+	Fields: []types.FieldDescriptor{
+		{Name: "bool_values", Type: "bool", GoIndex: 0, IsArray: true, ArraySize: 3, IsUpperBound: true},
+		{Name: "byte_values", Type: "byte", GoIndex: 1, IsArray: true, ArraySize: 3, IsUpperBound: true},
+		{Name: "char_values", Type: "char", GoIndex: 2, IsArray: true, ArraySize: 3, IsUpperBound: true},
+		{Name: "float32_values", Type: "float32", GoIndex: 3, IsArray: true, ArraySize: 3, IsUpperBound: true},
+		{Name: "float64_values", Type: "float64", GoIndex: 4, IsArray: true, ArraySize: 3, IsUpperBound: true},
+		{Name: "int8_values", Type: "int8", GoIndex: 5, IsArray: true, ArraySize: 3, IsUpperBound: true},
+		{Name: "uint8_values", Type: "uint8", GoIndex: 6, IsArray: true, ArraySize: 3, IsUpperBound: true},
+		{Name: "int16_values", Type: "int16", GoIndex: 7, IsArray: true, ArraySize: 3, IsUpperBound: true},
+		{Name: "uint16_values", Type: "uint16", GoIndex: 8, IsArray: true, ArraySize: 3, IsUpperBound: true},
+		{Name: "int32_values", Type: "int32", GoIndex: 9, IsArray: true, ArraySize: 3, IsUpperBound: true},
+		{Name: "uint32_values", Type: "uint32", GoIndex: 10, IsArray: true, ArraySize: 3, IsUpperBound: true},
+		{Name: "int64_values", Type: "int64", GoIndex: 11, IsArray: true, ArraySize: 3, IsUpperBound: true},
+		{Name: "uint64_values", Type: "uint64", GoIndex: 12, IsArray: true, ArraySize: 3, IsUpperBound: true},
+		{Name: "string_values", Type: "string", GoIndex: 13, IsArray: true, ArraySize: 3, IsUpperBound: true},
+		{Name: "basic_types_values", Type: "test_msgs/msg/BasicTypes", GoIndex: 14, IsArray: true, ArraySize: 3, IsUpperBound: true, Message: BasicTypesDescriptor},
+		{Name: "constants_values", Type: "test_msgs/msg/Constants", GoIndex: 15, IsArray: true, ArraySize: 3, IsUpperBound: true, Message: ConstantsDescriptor},
+		{Name: "defaults_values", Type: "test_msgs/msg/Defaults", GoIndex: 16, IsArray: true, ArraySize: 3, IsUpperBound: true, Message: DefaultsDescriptor},
+		{Name: "bool_values_default", Type: "bool", GoIndex: 17, IsArray: true, ArraySize: 3, IsUpperBound: true},
+		{Name: "byte_values_default", Type: "byte", GoIndex: 18, IsArray: true, ArraySize: 3, IsUpperBound: true},
+		{Name: "char_values_default", Type: "char", GoIndex: 19, IsArray: true, ArraySize: 3, IsUpperBound: true},
+		{Name: "float32_values_default", Type: "float32", GoIndex: 20, IsArray: true, ArraySize: 3, IsUpperBound: true},
+		{Name: "float64_values_default", Type: "float64", GoIndex: 21, IsArray: true, ArraySize: 3, IsUpperBound: true},
+		{Name: "int8_values_default", Type: "int8", GoIndex: 22, IsArray: true, ArraySize: 3, IsUpperBound: true},
+		{Name: "uint8_values_default", Type: "uint8", GoIndex: 23, IsArray: true, ArraySize: 3, IsUpperBound: true},
+		{Name: "int16_values_default", Type: "int16", GoIndex: 24, IsArray: true, ArraySize: 3, IsUpperBound: true},
+		{Name: "uint16_values_default", Type: "uint16", GoIndex: 25, IsArray: true, ArraySize: 3, IsUpperBound: true},
+		{Name: "int32_values_default", Type: "int32", GoIndex: 26, IsArray: true, ArraySize: 3, IsUpperBound: true},
+		{Name: "uint32_values_default", Type: "uint32", GoIndex: 27, IsArray: true, ArraySize: 3, IsUpperBound: true},
+		{Name: "int64_values_default", Type: "int64", GoIndex: 28, IsArray: true, ArraySize: 3, IsUpperBound: true},
+		{Name: "uint64_values_default", Type: "uint64", GoIndex: 29, IsArray: true, ArraySize: 3, IsUpperBound: true},
+		{Name: "string_values_default", Type: "string", GoIndex: 30, IsArray: true, ArraySize: 3, IsUpperBound: true},
+		{Name: "alignment_check", Type: "int32", GoIndex: 31},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var BoundedSequencesTypeSupport types.MessageTypeSupport = _BoundedSequencesTypeSupport{}
 
@@ -399,6 +1068,101 @@ func (t _BoundedSequencesTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__test_msgs__msg__BoundedSequences())
 }
 
+func (t _BoundedSequencesTypeSupport) Descriptor() *types.MessageDescriptor {
+	return BoundedSequencesDescriptor
+}
+
+func (t _BoundedSequencesTypeSupport) TypeHash() string {
+	return `RIHS01_fb361a3b3827c76a142c2190126a20a92e7e510e047f880141a7e9ef867961ed`
+}
+
+func (t _BoundedSequencesTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "test_msgs/msg/BoundedSequences", "fields": [{"name": "bool_values", "type": {"type_id": 111, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "byte_values", "type": {"type_id": 112, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "char_values", "type": {"type_id": 99, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "float32_values", "type": {"type_id": 106, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "float64_values", "type": {"type_id": 107, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "int8_values", "type": {"type_id": 98, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "uint8_values", "type": {"type_id": 99, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "int16_values", "type": {"type_id": 100, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "uint16_values", "type": {"type_id": 101, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "int32_values", "type": {"type_id": 102, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "uint32_values", "type": {"type_id": 103, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "int64_values", "type": {"type_id": 104, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "uint64_values", "type": {"type_id": 105, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "string_values", "type": {"type_id": 113, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "basic_types_values", "type": {"type_id": 97, "capacity": 3, "string_capacity": 0, "nested_type_name": "test_msgs/msg/BasicTypes"}, "default_value": ""}, {"name": "constants_values", "type": {"type_id": 97, "capacity": 3, "string_capacity": 0, "nested_type_name": "test_msgs/msg/Constants"}, "default_value": ""}, {"name": "defaults_values", "type": {"type_id": 97, "capacity": 3, "string_capacity": 0, "nested_type_name": "test_msgs/msg/Defaults"}, "default_value": ""}, {"name": "bool_values_default", "type": {"type_id": 111, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[false, true, false]"}, {"name": "byte_values_default", "type": {"type_id": 112, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[0, 1, 255]"}, {"name": "char_values_default", "type": {"type_id": 99, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[0, 1, 127]"}, {"name": "float32_values_default", "type": {"type_id": 106, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[1.125, 0.0, -1.125]"}, {"name": "float64_values_default", "type": {"type_id": 107, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[3.1415, 0.0, -3.1415]"}, {"name": "int8_values_default", "type": {"type_id": 98, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[0, 127, -128]"}, {"name": "uint8_values_default", "type": {"type_id": 99, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[0, 1, 255]"}, {"name": "int16_values_default", "type": {"type_id": 100, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[0, 32767, -32768]"}, {"name": "uint16_values_default", "type": {"type_id": 101, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[0, 1, 65535]"}, {"name": "int32_values_default", "type": {"type_id": 102, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[0, 2147483647, -2147483648]"}, {"name": "uint32_values_default", "type": {"type_id": 103, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[0, 1, 4294967295]"}, {"name": "int64_values_default", "type": {"type_id": 104, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[0, 9223372036854775807, -9223372036854775808]"}, {"name": "uint64_values_default", "type": {"type_id": 105, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[0, 1, 18446744073709551615]"}, {"name": "string_values_default", "type": {"type_id": 113, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[\"\", \"max value\", \"min value\"]"}, {"name": "alignment_check", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "test_msgs/msg/BasicTypes", "fields": [{"name": "bool_value", "type": {"type_id": 15, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "byte_value", "type": {"type_id": 16, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "char_value", "type": {"type_id": 3, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "float32_value", "type": {"type_id": 10, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "float64_value", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "int8_value", "type": {"type_id": 2, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "uint8_value", "type": {"type_id": 3, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "int16_value", "type": {"type_id": 4, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "uint16_value", "type": {"type_id": 5, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "int32_value", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "uint32_value", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "int64_value", "type": {"type_id": 8, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "uint64_value", "type": {"type_id": 9, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "test_msgs/msg/Constants", "fields": [{"name": "structure_needs_at_least_one_member", "type": {"type_id": 3, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "test_msgs/msg/Defaults", "fields": [{"name": "bool_value", "type": {"type_id": 15, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "true"}, {"name": "byte_value", "type": {"type_id": 16, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "50"}, {"name": "char_value", "type": {"type_id": 3, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "100"}, {"name": "float32_value", "type": {"type_id": 10, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "1.125"}, {"name": "float64_value", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "1.125"}, {"name": "int8_value", "type": {"type_id": 2, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "-50"}, {"name": "uint8_value", "type": {"type_id": 3, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "200"}, {"name": "int16_value", "type": {"type_id": 4, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "-1000"}, {"name": "uint16_value", "type": {"type_id": 5, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "2000"}, {"name": "int32_value", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "-30000"}, {"name": "uint32_value", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "60000"}, {"name": "int64_value", "type": {"type_id": 8, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "-40000000"}, {"name": "uint64_value", "type": {"type_id": 9, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "50000000"}]}]}`
+}
+
+func (t _BoundedSequencesTypeSupport) MessageDefinition() string {
+	return `# Bounded sequences of different types
+bool[<=3] bool_values
+byte[<=3] byte_values
+char[<=3] char_values
+float32[<=3] float32_values
+float64[<=3] float64_values
+int8[<=3] int8_values
+uint8[<=3] uint8_values
+int16[<=3] int16_values
+uint16[<=3] uint16_values
+int32[<=3] int32_values
+uint32[<=3] uint32_values
+int64[<=3] int64_values
+uint64[<=3] uint64_values
+string[<=3] string_values
+BasicTypes[<=3] basic_types_values
+Constants[<=3] constants_values
+Defaults[<=3] defaults_values
+bool[<=3] bool_values_default [false, true, false]
+byte[<=3] byte_values_default [0, 1, 255]
+char[<=3] char_values_default [0, 1, 127]
+float32[<=3] float32_values_default [1.125, 0.0, -1.125]
+float64[<=3] float64_values_default [3.1415, 0.0, -3.1415]
+int8[<=3] int8_values_default [0, 127, -128]
+uint8[<=3] uint8_values_default [0, 1, 255]
+int16[<=3] int16_values_default [0, 32767, -32768]
+uint16[<=3] uint16_values_default [0, 1, 65535]
+int32[<=3] int32_values_default [0, 2147483647, -2147483648]
+uint32[<=3] uint32_values_default [0, 1, 4294967295]
+int64[<=3] int64_values_default [0, 9223372036854775807, -9223372036854775808]
+uint64[<=3] uint64_values_default [0, 1, 18446744073709551615]
+string[<=3] string_values_default ["", "max value", "min value"]
+# Regression test: check alignment of basic field after a sequence field is correct
+int32 alignment_check
+================================================================================
+MSG: test_msgs/msg/BasicTypes
+bool bool_value
+byte byte_value
+char char_value
+float32 float32_value
+float64 float64_value
+int8 int8_value
+uint8 uint8_value
+int16 int16_value
+uint16 uint16_value
+int32 int32_value
+uint32 uint32_value
+int64 int64_value
+uint64 uint64_value
+================================================================================
+MSG: test_msgs/msg/Constants
+bool BOOL_CONST=true
+byte BYTE_CONST=50
+char CHAR_CONST=100
+float32 FLOAT32_CONST=1.125
+float64 FLOAT64_CONST=1.125
+int8 INT8_CONST=-50
+uint8 UINT8_CONST=200
+int16 INT16_CONST=-1000
+uint16 UINT16_CONST=2000
+int32 INT32_CONST=-30000
+uint32 UINT32_CONST=60000
+int64 INT64_CONST=-40000000
+uint64 UINT64_CONST=50000000
+================================================================================
+MSG: test_msgs/msg/Defaults
+bool bool_value true
+byte byte_value 50
+char char_value 100
+float32 float32_value 1.125
+float64 float64_value 1.125
+int8 int8_value -50
+uint8 uint8_value 200
+int16 int16_value -1000
+uint16 uint16_value 2000
+int32 int32_value -30000
+uint32 uint32_value 60000
+int64 int64_value -40000000
+uint64 uint64_value 50000000
+`
+}
+
 type CBoundedSequences = C.test_msgs__msg__BoundedSequences
 type CBoundedSequences__Sequence = C.test_msgs__msg__BoundedSequences__Sequence
 
@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	builtin_interfaces_msg "github.com/tiiuae/rclgo/internal/msgs/builtin_interfaces/msg"
@@ -55,6 +57,38 @@ func (t *Builtins) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *Builtins) Equal(other *Builtins) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *Builtins) EqualApprox(other *Builtins, tol float64) bool {
+	if !t.DurationValue.EqualApprox(&other.DurationValue, tol) {
+		return false
+	}
+	if !t.TimeValue.EqualApprox(&other.TimeValue, tol) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *Builtins) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *Builtins) HashTo(h *msgcmp.Hasher) {
+	t.DurationValue.HashTo(h)
+	t.TimeValue.HashTo(h)
+}
+
 func (t *Builtins) SetDefaults() {
 	t.DurationValue.SetDefaults()
 	t.TimeValue.SetDefaults()
@@ -64,6 +98,48 @@ func (t *Builtins) GetTypeSupport() types.MessageTypeSupport {
 	return BuiltinsTypeSupport
 }
 
+func (t *Builtins) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *Builtins) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *Builtins) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *Builtins) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *Builtins) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *Builtins) EncodeCDR(e *cdr.Encoder) {
+	t.DurationValue.EncodeCDR(e)
+	t.TimeValue.EncodeCDR(e)
+}
+
+func (t *Builtins) DecodeCDR(d *cdr.Decoder) {
+	t.DurationValue.DecodeCDR(d)
+	t.TimeValue.DecodeCDR(d)
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *Builtins) Validate() error {
+	if err := t.DurationValue.Validate(); err != nil {
+		return rclgo.WrapValidationError("duration_value", -1, err)
+	}
+	if err := t.TimeValue.Validate(); err != nil {
+		return rclgo.WrapValidationError("time_value", -1, err)
+	}
+	return nil
+}
+
 // BuiltinsPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type BuiltinsPublisher struct {
@@ -121,6 +197,17 @@ func CloneBuiltinsSlice(dst, src []Builtins) {
 	}
 }
 
+// BuiltinsDescriptor describes the fields of Builtins. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var BuiltinsDescriptor = &types.MessageDescriptor{
+	TypeName: "test_msgs/msg/Builtins",
+	Fields: []types.FieldDescriptor{
+		{Name: "duration_value", Type: "builtin_interfaces/msg/Duration", GoIndex: 0, Message: builtin_interfaces_msg.DurationDescriptor},
+		{Name: "time_value", Type: "builtin_interfaces/msg/Time", GoIndex: 1, Message: builtin_interfaces_msg.TimeDescriptor},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var BuiltinsTypeSupport types.MessageTypeSupport = _BuiltinsTypeSupport{}
 
@@ -156,6 +243,36 @@ func (t _BuiltinsTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__test_msgs__msg__Builtins())
 }
 
+func (t _BuiltinsTypeSupport) Descriptor() *types.MessageDescriptor {
+	return BuiltinsDescriptor
+}
+
+func (t _BuiltinsTypeSupport) TypeHash() string {
+	return `RIHS01_9e61888e7521dda35c21ac6b6cabbcaff8dae88b6d67b25b9078fdb9abf56303`
+}
+
+func (t _BuiltinsTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "test_msgs/msg/Builtins", "fields": [{"name": "duration_value", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "builtin_interfaces/msg/Duration"}, "default_value": ""}, {"name": "time_value", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "builtin_interfaces/msg/Time"}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "builtin_interfaces/msg/Duration", "fields": [{"name": "sec", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "nanosec", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "builtin_interfaces/msg/Time", "fields": [{"name": "sec", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "nanosec", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}]}`
+}
+
+func (t _BuiltinsTypeSupport) MessageDefinition() string {
+	return `builtin_interfaces/Duration duration_value
+builtin_interfaces/Time time_value
+================================================================================
+MSG: builtin_interfaces/msg/Duration
+# Seconds component, range is valid over any possible int32 value.
+int32 sec
+# Nanoseconds component in the range of [0, 10e9).
+uint32 nanosec
+================================================================================
+MSG: builtin_interfaces/msg/Time
+# The seconds component, valid over all int32 values.
+int32 sec
+# The nanoseconds component, valid in the range [0, 10e9).
+uint32 nanosec
+`
+}
+
 type CBuiltins = C.test_msgs__msg__Builtins
 type CBuiltins__Sequence = C.test_msgs__msg__Builtins__Sequence
 
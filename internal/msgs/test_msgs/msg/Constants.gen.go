@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	
@@ -65,6 +67,30 @@ func (t *Constants) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *Constants) Equal(other *Constants) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *Constants) EqualApprox(other *Constants, tol float64) bool {
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *Constants) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *Constants) HashTo(h *msgcmp.Hasher) {
+}
+
 func (t *Constants) SetDefaults() {
 }
 
@@ -72,6 +98,40 @@ func (t *Constants) GetTypeSupport() types.MessageTypeSupport {
 	return ConstantsTypeSupport
 }
 
+func (t *Constants) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *Constants) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *Constants) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *Constants) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *Constants) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *Constants) EncodeCDR(e *cdr.Encoder) {
+	e.Uint8(0)
+}
+
+func (t *Constants) DecodeCDR(d *cdr.Decoder) {
+	d.Uint8()
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *Constants) Validate() error {
+	return nil
+}
+
 // ConstantsPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type ConstantsPublisher struct {
@@ -129,6 +189,13 @@ func CloneConstantsSlice(dst, src []Constants) {
 	}
 }
 
+// ConstantsDescriptor describes the fields of Constants. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var ConstantsDescriptor = &types.MessageDescriptor{
+	TypeName: "test_msgs/msg/Constants",
+}
+
 // Modifying this variable is undefined behavior.
 var ConstantsTypeSupport types.MessageTypeSupport = _ConstantsTypeSupport{}
 
@@ -158,6 +225,35 @@ func (t _ConstantsTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__test_msgs__msg__Constants())
 }
 
+func (t _ConstantsTypeSupport) Descriptor() *types.MessageDescriptor {
+	return ConstantsDescriptor
+}
+
+func (t _ConstantsTypeSupport) TypeHash() string {
+	return `RIHS01_983e7ae7099d65b3030c31c2890b0ec2b332484fae9f28c68e01340c8f61edcf`
+}
+
+func (t _ConstantsTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "test_msgs/msg/Constants", "fields": [{"name": "structure_needs_at_least_one_member", "type": {"type_id": 3, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": []}`
+}
+
+func (t _ConstantsTypeSupport) MessageDefinition() string {
+	return `bool BOOL_CONST=true
+byte BYTE_CONST=50
+char CHAR_CONST=100
+float32 FLOAT32_CONST=1.125
+float64 FLOAT64_CONST=1.125
+int8 INT8_CONST=-50
+uint8 UINT8_CONST=200
+int16 INT16_CONST=-1000
+uint16 UINT16_CONST=2000
+int32 INT32_CONST=-30000
+uint32 UINT32_CONST=60000
+int64 INT64_CONST=-40000000
+uint64 UINT64_CONST=50000000
+`
+}
+
 type CConstants = C.test_msgs__msg__Constants
 type CConstants__Sequence = C.test_msgs__msg__Constants__Sequence
 
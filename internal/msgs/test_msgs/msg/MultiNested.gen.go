@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	
@@ -86,6 +88,126 @@ func (t *MultiNested) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *MultiNested) Equal(other *MultiNested) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *MultiNested) EqualApprox(other *MultiNested, tol float64) bool {
+	for i := range t.ArrayOfArrays {
+		if !t.ArrayOfArrays[i].EqualApprox(&other.ArrayOfArrays[i], tol) {
+			return false
+		}
+	}
+	for i := range t.ArrayOfBoundedSequences {
+		if !t.ArrayOfBoundedSequences[i].EqualApprox(&other.ArrayOfBoundedSequences[i], tol) {
+			return false
+		}
+	}
+	for i := range t.ArrayOfUnboundedSequences {
+		if !t.ArrayOfUnboundedSequences[i].EqualApprox(&other.ArrayOfUnboundedSequences[i], tol) {
+			return false
+		}
+	}
+	if len(t.BoundedSequenceOfArrays) != len(other.BoundedSequenceOfArrays) {
+		return false
+	}
+	for i := range t.BoundedSequenceOfArrays {
+		if !t.BoundedSequenceOfArrays[i].EqualApprox(&other.BoundedSequenceOfArrays[i], tol) {
+			return false
+		}
+	}
+	if len(t.BoundedSequenceOfBoundedSequences) != len(other.BoundedSequenceOfBoundedSequences) {
+		return false
+	}
+	for i := range t.BoundedSequenceOfBoundedSequences {
+		if !t.BoundedSequenceOfBoundedSequences[i].EqualApprox(&other.BoundedSequenceOfBoundedSequences[i], tol) {
+			return false
+		}
+	}
+	if len(t.BoundedSequenceOfUnboundedSequences) != len(other.BoundedSequenceOfUnboundedSequences) {
+		return false
+	}
+	for i := range t.BoundedSequenceOfUnboundedSequences {
+		if !t.BoundedSequenceOfUnboundedSequences[i].EqualApprox(&other.BoundedSequenceOfUnboundedSequences[i], tol) {
+			return false
+		}
+	}
+	if len(t.UnboundedSequenceOfArrays) != len(other.UnboundedSequenceOfArrays) {
+		return false
+	}
+	for i := range t.UnboundedSequenceOfArrays {
+		if !t.UnboundedSequenceOfArrays[i].EqualApprox(&other.UnboundedSequenceOfArrays[i], tol) {
+			return false
+		}
+	}
+	if len(t.UnboundedSequenceOfBoundedSequences) != len(other.UnboundedSequenceOfBoundedSequences) {
+		return false
+	}
+	for i := range t.UnboundedSequenceOfBoundedSequences {
+		if !t.UnboundedSequenceOfBoundedSequences[i].EqualApprox(&other.UnboundedSequenceOfBoundedSequences[i], tol) {
+			return false
+		}
+	}
+	if len(t.UnboundedSequenceOfUnboundedSequences) != len(other.UnboundedSequenceOfUnboundedSequences) {
+		return false
+	}
+	for i := range t.UnboundedSequenceOfUnboundedSequences {
+		if !t.UnboundedSequenceOfUnboundedSequences[i].EqualApprox(&other.UnboundedSequenceOfUnboundedSequences[i], tol) {
+			return false
+		}
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *MultiNested) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *MultiNested) HashTo(h *msgcmp.Hasher) {
+	for i := range t.ArrayOfArrays {
+		t.ArrayOfArrays[i].HashTo(h)
+	}
+	for i := range t.ArrayOfBoundedSequences {
+		t.ArrayOfBoundedSequences[i].HashTo(h)
+	}
+	for i := range t.ArrayOfUnboundedSequences {
+		t.ArrayOfUnboundedSequences[i].HashTo(h)
+	}
+	h.Len(len(t.BoundedSequenceOfArrays))
+	for i := range t.BoundedSequenceOfArrays {
+		t.BoundedSequenceOfArrays[i].HashTo(h)
+	}
+	h.Len(len(t.BoundedSequenceOfBoundedSequences))
+	for i := range t.BoundedSequenceOfBoundedSequences {
+		t.BoundedSequenceOfBoundedSequences[i].HashTo(h)
+	}
+	h.Len(len(t.BoundedSequenceOfUnboundedSequences))
+	for i := range t.BoundedSequenceOfUnboundedSequences {
+		t.BoundedSequenceOfUnboundedSequences[i].HashTo(h)
+	}
+	h.Len(len(t.UnboundedSequenceOfArrays))
+	for i := range t.UnboundedSequenceOfArrays {
+		t.UnboundedSequenceOfArrays[i].HashTo(h)
+	}
+	h.Len(len(t.UnboundedSequenceOfBoundedSequences))
+	for i := range t.UnboundedSequenceOfBoundedSequences {
+		t.UnboundedSequenceOfBoundedSequences[i].HashTo(h)
+	}
+	h.Len(len(t.UnboundedSequenceOfUnboundedSequences))
+	for i := range t.UnboundedSequenceOfUnboundedSequences {
+		t.UnboundedSequenceOfUnboundedSequences[i].HashTo(h)
+	}
+}
+
 func (t *MultiNested) SetDefaults() {
 	for i := range t.ArrayOfArrays {
 		t.ArrayOfArrays[i].SetDefaults()
@@ -108,6 +230,158 @@ func (t *MultiNested) GetTypeSupport() types.MessageTypeSupport {
 	return MultiNestedTypeSupport
 }
 
+func (t *MultiNested) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *MultiNested) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *MultiNested) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *MultiNested) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *MultiNested) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *MultiNested) EncodeCDR(e *cdr.Encoder) {
+	for i := range t.ArrayOfArrays {
+		t.ArrayOfArrays[i].EncodeCDR(e)
+	}
+	for i := range t.ArrayOfBoundedSequences {
+		t.ArrayOfBoundedSequences[i].EncodeCDR(e)
+	}
+	for i := range t.ArrayOfUnboundedSequences {
+		t.ArrayOfUnboundedSequences[i].EncodeCDR(e)
+	}
+	e.SequenceLength(len(t.BoundedSequenceOfArrays), 3)
+	for i := range t.BoundedSequenceOfArrays {
+		t.BoundedSequenceOfArrays[i].EncodeCDR(e)
+	}
+	e.SequenceLength(len(t.BoundedSequenceOfBoundedSequences), 3)
+	for i := range t.BoundedSequenceOfBoundedSequences {
+		t.BoundedSequenceOfBoundedSequences[i].EncodeCDR(e)
+	}
+	e.SequenceLength(len(t.BoundedSequenceOfUnboundedSequences), 3)
+	for i := range t.BoundedSequenceOfUnboundedSequences {
+		t.BoundedSequenceOfUnboundedSequences[i].EncodeCDR(e)
+	}
+	e.SequenceLength(len(t.UnboundedSequenceOfArrays), 0)
+	for i := range t.UnboundedSequenceOfArrays {
+		t.UnboundedSequenceOfArrays[i].EncodeCDR(e)
+	}
+	e.SequenceLength(len(t.UnboundedSequenceOfBoundedSequences), 0)
+	for i := range t.UnboundedSequenceOfBoundedSequences {
+		t.UnboundedSequenceOfBoundedSequences[i].EncodeCDR(e)
+	}
+	e.SequenceLength(len(t.UnboundedSequenceOfUnboundedSequences), 0)
+	for i := range t.UnboundedSequenceOfUnboundedSequences {
+		t.UnboundedSequenceOfUnboundedSequences[i].EncodeCDR(e)
+	}
+}
+
+func (t *MultiNested) DecodeCDR(d *cdr.Decoder) {
+	for i := range t.ArrayOfArrays {
+		t.ArrayOfArrays[i].DecodeCDR(d)
+	}
+	for i := range t.ArrayOfBoundedSequences {
+		t.ArrayOfBoundedSequences[i].DecodeCDR(d)
+	}
+	for i := range t.ArrayOfUnboundedSequences {
+		t.ArrayOfUnboundedSequences[i].DecodeCDR(d)
+	}
+	t.BoundedSequenceOfArrays = cdr.MakeSlice[Arrays](d, 3)
+	for i := range t.BoundedSequenceOfArrays {
+		t.BoundedSequenceOfArrays[i].DecodeCDR(d)
+	}
+	t.BoundedSequenceOfBoundedSequences = cdr.MakeSlice[BoundedSequences](d, 3)
+	for i := range t.BoundedSequenceOfBoundedSequences {
+		t.BoundedSequenceOfBoundedSequences[i].DecodeCDR(d)
+	}
+	t.BoundedSequenceOfUnboundedSequences = cdr.MakeSlice[UnboundedSequences](d, 3)
+	for i := range t.BoundedSequenceOfUnboundedSequences {
+		t.BoundedSequenceOfUnboundedSequences[i].DecodeCDR(d)
+	}
+	t.UnboundedSequenceOfArrays = cdr.MakeSlice[Arrays](d, 0)
+	for i := range t.UnboundedSequenceOfArrays {
+		t.UnboundedSequenceOfArrays[i].DecodeCDR(d)
+	}
+	t.UnboundedSequenceOfBoundedSequences = cdr.MakeSlice[BoundedSequences](d, 0)
+	for i := range t.UnboundedSequenceOfBoundedSequences {
+		t.UnboundedSequenceOfBoundedSequences[i].DecodeCDR(d)
+	}
+	t.UnboundedSequenceOfUnboundedSequences = cdr.MakeSlice[UnboundedSequences](d, 0)
+	for i := range t.UnboundedSequenceOfUnboundedSequences {
+		t.UnboundedSequenceOfUnboundedSequences[i].DecodeCDR(d)
+	}
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *MultiNested) Validate() error {
+	for i := range t.ArrayOfArrays {
+		if err := t.ArrayOfArrays[i].Validate(); err != nil {
+			return rclgo.WrapValidationError("array_of_arrays", i, err)
+		}
+	}
+	for i := range t.ArrayOfBoundedSequences {
+		if err := t.ArrayOfBoundedSequences[i].Validate(); err != nil {
+			return rclgo.WrapValidationError("array_of_bounded_sequences", i, err)
+		}
+	}
+	for i := range t.ArrayOfUnboundedSequences {
+		if err := t.ArrayOfUnboundedSequences[i].Validate(); err != nil {
+			return rclgo.WrapValidationError("array_of_unbounded_sequences", i, err)
+		}
+	}
+	if len(t.BoundedSequenceOfArrays) > 3 {
+		return rclgo.NewBoundError("bounded_sequence_of_arrays", -1, len(t.BoundedSequenceOfArrays), 3)
+	}
+	for i := range t.BoundedSequenceOfArrays {
+		if err := t.BoundedSequenceOfArrays[i].Validate(); err != nil {
+			return rclgo.WrapValidationError("bounded_sequence_of_arrays", i, err)
+		}
+	}
+	if len(t.BoundedSequenceOfBoundedSequences) > 3 {
+		return rclgo.NewBoundError("bounded_sequence_of_bounded_sequences", -1, len(t.BoundedSequenceOfBoundedSequences), 3)
+	}
+	for i := range t.BoundedSequenceOfBoundedSequences {
+		if err := t.BoundedSequenceOfBoundedSequences[i].Validate(); err != nil {
+			return rclgo.WrapValidationError("bounded_sequence_of_bounded_sequences", i, err)
+		}
+	}
+	if len(t.BoundedSequenceOfUnboundedSequences) > 3 {
+		return rclgo.NewBoundError("bounded_sequence_of_unbounded_sequences", -1, len(t.BoundedSequenceOfUnboundedSequences), 3)
+	}
+	for i := range t.BoundedSequenceOfUnboundedSequences {
+		if err := t.BoundedSequenceOfUnboundedSequences[i].Validate(); err != nil {
+			return rclgo.WrapValidationError("bounded_sequence_of_unbounded_sequences", i, err)
+		}
+	}
+	for i := range t.UnboundedSequenceOfArrays {
+		if err := t.UnboundedSequenceOfArrays[i].Validate(); err != nil {
+			return rclgo.WrapValidationError("unbounded_sequence_of_arrays", i, err)
+		}
+	}
+	for i := range t.UnboundedSequenceOfBoundedSequences {
+		if err := t.UnboundedSequenceOfBoundedSequences[i].Validate(); err != nil {
+			return rclgo.WrapValidationError("unbounded_sequence_of_bounded_sequences", i, err)
+		}
+	}
+	for i := range t.UnboundedSequenceOfUnboundedSequences {
+		if err := t.UnboundedSequenceOfUnboundedSequences[i].Validate(); err != nil {
+			return rclgo.WrapValidationError("unbounded_sequence_of_unbounded_sequences", i, err)
+		}
+	}
+	return nil
+}
+
 // MultiNestedPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type MultiNestedPublisher struct {
@@ -165,6 +439,24 @@ func CloneMultiNestedSlice(dst, src []MultiNested) {
 	}
 }
 
+// MultiNestedDescriptor describes the fields of MultiNested. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var MultiNestedDescriptor = &types.MessageDescriptor{
+	TypeName: "test_msgs/msg/MultiNested",
+	Fields: []types.FieldDescriptor{
+		{Name: "array_of_arrays", Type: "test_msgs/msg/Arrays", GoIndex: 0, IsArray: true, ArraySize: 3, Message: ArraysDescriptor},
+		{Name: "array_of_bounded_sequences", Type: "test_msgs/msg/BoundedSequences", GoIndex: 1, IsArray: true, ArraySize: 3, Message: BoundedSequencesDescriptor},
+		{Name: "array_of_unbounded_sequences", Type: "test_msgs/msg/UnboundedSequences", GoIndex: 2, IsArray: true, ArraySize: 3, Message: UnboundedSequencesDescriptor},
+		{Name: "bounded_sequence_of_arrays", Type: "test_msgs/msg/Arrays", GoIndex: 3, IsArray: true, ArraySize: 3, IsUpperBound: true, Message: ArraysDescriptor},
+		{Name: "bounded_sequence_of_bounded_sequences", Type: "test_msgs/msg/BoundedSequences", GoIndex: 4, IsArray: true, ArraySize: 3, IsUpperBound: true, Message: BoundedSequencesDescriptor},
+		{Name: "bounded_sequence_of_unbounded_sequences", Type: "test_msgs/msg/UnboundedSequences", GoIndex: 5, IsArray: true, ArraySize: 3, IsUpperBound: true, Message: UnboundedSequencesDescriptor},
+		{Name: "unbounded_sequence_of_arrays", Type: "test_msgs/msg/Arrays", GoIndex: 6, IsArray: true, Message: ArraysDescriptor},
+		{Name: "unbounded_sequence_of_bounded_sequences", Type: "test_msgs/msg/BoundedSequences", GoIndex: 7, IsArray: true, Message: BoundedSequencesDescriptor},
+		{Name: "unbounded_sequence_of_unbounded_sequences", Type: "test_msgs/msg/UnboundedSequences", GoIndex: 8, IsArray: true, Message: UnboundedSequencesDescriptor},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var MultiNestedTypeSupport types.MessageTypeSupport = _MultiNestedTypeSupport{}
 
@@ -214,6 +506,185 @@ func (t _MultiNestedTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__test_msgs__msg__MultiNested())
 }
 
+func (t _MultiNestedTypeSupport) Descriptor() *types.MessageDescriptor {
+	return MultiNestedDescriptor
+}
+
+func (t _MultiNestedTypeSupport) TypeHash() string {
+	return `RIHS01_9ee9cbfb17c2df991a750b761a56def78674034977c829449820ca07efd9758b`
+}
+
+func (t _MultiNestedTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "test_msgs/msg/MultiNested", "fields": [{"name": "array_of_arrays", "type": {"type_id": 49, "capacity": 3, "string_capacity": 0, "nested_type_name": "test_msgs/msg/Arrays"}, "default_value": ""}, {"name": "array_of_bounded_sequences", "type": {"type_id": 49, "capacity": 3, "string_capacity": 0, "nested_type_name": "test_msgs/msg/BoundedSequences"}, "default_value": ""}, {"name": "array_of_unbounded_sequences", "type": {"type_id": 49, "capacity": 3, "string_capacity": 0, "nested_type_name": "test_msgs/msg/UnboundedSequences"}, "default_value": ""}, {"name": "bounded_sequence_of_arrays", "type": {"type_id": 97, "capacity": 3, "string_capacity": 0, "nested_type_name": "test_msgs/msg/Arrays"}, "default_value": ""}, {"name": "bounded_sequence_of_bounded_sequences", "type": {"type_id": 97, "capacity": 3, "string_capacity": 0, "nested_type_name": "test_msgs/msg/BoundedSequences"}, "default_value": ""}, {"name": "bounded_sequence_of_unbounded_sequences", "type": {"type_id": 97, "capacity": 3, "string_capacity": 0, "nested_type_name": "test_msgs/msg/UnboundedSequences"}, "default_value": ""}, {"name": "unbounded_sequence_of_arrays", "type": {"type_id": 145, "capacity": 0, "string_capacity": 0, "nested_type_name": "test_msgs/msg/Arrays"}, "default_value": ""}, {"name": "unbounded_sequence_of_bounded_sequences", "type": {"type_id": 145, "capacity": 0, "string_capacity": 0, "nested_type_name": "test_msgs/msg/BoundedSequences"}, "default_value": ""}, {"name": "unbounded_sequence_of_unbounded_sequences", "type": {"type_id": 145, "capacity": 0, "string_capacity": 0, "nested_type_name": "test_msgs/msg/UnboundedSequences"}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "test_msgs/msg/Arrays", "fields": [{"name": "bool_values", "type": {"type_id": 63, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "byte_values", "type": {"type_id": 64, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "char_values", "type": {"type_id": 51, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "float32_values", "type": {"type_id": 58, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "float64_values", "type": {"type_id": 59, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "int8_values", "type": {"type_id": 50, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "uint8_values", "type": {"type_id": 51, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "int16_values", "type": {"type_id": 52, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "uint16_values", "type": {"type_id": 53, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "int32_values", "type": {"type_id": 54, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "uint32_values", "type": {"type_id": 55, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "int64_values", "type": {"type_id": 56, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "uint64_values", "type": {"type_id": 57, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "string_values", "type": {"type_id": 65, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "basic_types_values", "type": {"type_id": 49, "capacity": 3, "string_capacity": 0, "nested_type_name": "test_msgs/msg/BasicTypes"}, "default_value": ""}, {"name": "constants_values", "type": {"type_id": 49, "capacity": 3, "string_capacity": 0, "nested_type_name": "test_msgs/msg/Constants"}, "default_value": ""}, {"name": "defaults_values", "type": {"type_id": 49, "capacity": 3, "string_capacity": 0, "nested_type_name": "test_msgs/msg/Defaults"}, "default_value": ""}, {"name": "bool_values_default", "type": {"type_id": 63, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[false, true, false]"}, {"name": "byte_values_default", "type": {"type_id": 64, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[0, 1, 255]"}, {"name": "char_values_default", "type": {"type_id": 51, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[0, 1, 127]"}, {"name": "float32_values_default", "type": {"type_id": 58, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[1.125, 0.0, -1.125]"}, {"name": "float64_values_default", "type": {"type_id": 59, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[3.1415, 0.0, -3.1415]"}, {"name": "int8_values_default", "type": {"type_id": 50, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[0, 127, -128]"}, {"name": "uint8_values_default", "type": {"type_id": 51, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[0, 1, 255]"}, {"name": "int16_values_default", "type": {"type_id": 52, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[0, 32767, -32768]"}, {"name": "uint16_values_default", "type": {"type_id": 53, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[0, 1, 65535]"}, {"name": "int32_values_default", "type": {"type_id": 54, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[0, 2147483647, -2147483648]"}, {"name": "uint32_values_default", "type": {"type_id": 55, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[0, 1, 4294967295]"}, {"name": "int64_values_default", "type": {"type_id": 56, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[0, 9223372036854775807, -9223372036854775808]"}, {"name": "uint64_values_default", "type": {"type_id": 57, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[0, 1, 18446744073709551615]"}, {"name": "string_values_default", "type": {"type_id": 65, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[\"\", \"max value\", \"min value\"]"}, {"name": "alignment_check", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "test_msgs/msg/BasicTypes", "fields": [{"name": "bool_value", "type": {"type_id": 15, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "byte_value", "type": {"type_id": 16, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "char_value", "type": {"type_id": 3, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "float32_value", "type": {"type_id": 10, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "float64_value", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "int8_value", "type": {"type_id": 2, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "uint8_value", "type": {"type_id": 3, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "int16_value", "type": {"type_id": 4, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "uint16_value", "type": {"type_id": 5, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "int32_value", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "uint32_value", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "int64_value", "type": {"type_id": 8, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "uint64_value", "type": {"type_id": 9, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "test_msgs/msg/BoundedSequences", "fields": [{"name": "bool_values", "type": {"type_id": 111, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "byte_values", "type": {"type_id": 112, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "char_values", "type": {"type_id": 99, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "float32_values", "type": {"type_id": 106, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "float64_values", "type": {"type_id": 107, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "int8_values", "type": {"type_id": 98, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "uint8_values", "type": {"type_id": 99, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "int16_values", "type": {"type_id": 100, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "uint16_values", "type": {"type_id": 101, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "int32_values", "type": {"type_id": 102, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "uint32_values", "type": {"type_id": 103, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "int64_values", "type": {"type_id": 104, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "uint64_values", "type": {"type_id": 105, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "string_values", "type": {"type_id": 113, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "basic_types_values", "type": {"type_id": 97, "capacity": 3, "string_capacity": 0, "nested_type_name": "test_msgs/msg/BasicTypes"}, "default_value": ""}, {"name": "constants_values", "type": {"type_id": 97, "capacity": 3, "string_capacity": 0, "nested_type_name": "test_msgs/msg/Constants"}, "default_value": ""}, {"name": "defaults_values", "type": {"type_id": 97, "capacity": 3, "string_capacity": 0, "nested_type_name": "test_msgs/msg/Defaults"}, "default_value": ""}, {"name": "bool_values_default", "type": {"type_id": 111, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[false, true, false]"}, {"name": "byte_values_default", "type": {"type_id": 112, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[0, 1, 255]"}, {"name": "char_values_default", "type": {"type_id": 99, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[0, 1, 127]"}, {"name": "float32_values_default", "type": {"type_id": 106, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[1.125, 0.0, -1.125]"}, {"name": "float64_values_default", "type": {"type_id": 107, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[3.1415, 0.0, -3.1415]"}, {"name": "int8_values_default", "type": {"type_id": 98, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[0, 127, -128]"}, {"name": "uint8_values_default", "type": {"type_id": 99, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[0, 1, 255]"}, {"name": "int16_values_default", "type": {"type_id": 100, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[0, 32767, -32768]"}, {"name": "uint16_values_default", "type": {"type_id": 101, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[0, 1, 65535]"}, {"name": "int32_values_default", "type": {"type_id": 102, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[0, 2147483647, -2147483648]"}, {"name": "uint32_values_default", "type": {"type_id": 103, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[0, 1, 4294967295]"}, {"name": "int64_values_default", "type": {"type_id": 104, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[0, 9223372036854775807, -9223372036854775808]"}, {"name": "uint64_values_default", "type": {"type_id": 105, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[0, 1, 18446744073709551615]"}, {"name": "string_values_default", "type": {"type_id": 113, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[\"\", \"max value\", \"min value\"]"}, {"name": "alignment_check", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "test_msgs/msg/Constants", "fields": [{"name": "structure_needs_at_least_one_member", "type": {"type_id": 3, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "test_msgs/msg/Defaults", "fields": [{"name": "bool_value", "type": {"type_id": 15, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "true"}, {"name": "byte_value", "type": {"type_id": 16, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "50"}, {"name": "char_value", "type": {"type_id": 3, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "100"}, {"name": "float32_value", "type": {"type_id": 10, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "1.125"}, {"name": "float64_value", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "1.125"}, {"name": "int8_value", "type": {"type_id": 2, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "-50"}, {"name": "uint8_value", "type": {"type_id": 3, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "200"}, {"name": "int16_value", "type": {"type_id": 4, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "-1000"}, {"name": "uint16_value", "type": {"type_id": 5, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "2000"}, {"name": "int32_value", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "-30000"}, {"name": "uint32_value", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "60000"}, {"name": "int64_value", "type": {"type_id": 8, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "-40000000"}, {"name": "uint64_value", "type": {"type_id": 9, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "50000000"}]}, {"type_name": "test_msgs/msg/UnboundedSequences", "fields": [{"name": "bool_values", "type": {"type_id": 159, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "byte_values", "type": {"type_id": 160, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "char_values", "type": {"type_id": 147, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "float32_values", "type": {"type_id": 154, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "float64_values", "type": {"type_id": 155, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "int8_values", "type": {"type_id": 146, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "uint8_values", "type": {"type_id": 147, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "int16_values", "type": {"type_id": 148, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "uint16_values", "type": {"type_id": 149, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "int32_values", "type": {"type_id": 150, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "uint32_values", "type": {"type_id": 151, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "int64_values", "type": {"type_id": 152, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "uint64_values", "type": {"type_id": 153, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "string_values", "type": {"type_id": 161, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "basic_types_values", "type": {"type_id": 145, "capacity": 0, "string_capacity": 0, "nested_type_name": "test_msgs/msg/BasicTypes"}, "default_value": ""}, {"name": "constants_values", "type": {"type_id": 145, "capacity": 0, "string_capacity": 0, "nested_type_name": "test_msgs/msg/Constants"}, "default_value": ""}, {"name": "defaults_values", "type": {"type_id": 145, "capacity": 0, "string_capacity": 0, "nested_type_name": "test_msgs/msg/Defaults"}, "default_value": ""}, {"name": "bool_values_default", "type": {"type_id": 159, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[false, true, false]"}, {"name": "byte_values_default", "type": {"type_id": 160, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[0, 1, 255]"}, {"name": "char_values_default", "type": {"type_id": 147, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[0, 1, 127]"}, {"name": "float32_values_default", "type": {"type_id": 154, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[1.125, 0.0, -1.125]"}, {"name": "float64_values_default", "type": {"type_id": 155, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[3.1415, 0.0, -3.1415]"}, {"name": "int8_values_default", "type": {"type_id": 146, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[0, 127, -128]"}, {"name": "uint8_values_default", "type": {"type_id": 147, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[0, 1, 255]"}, {"name": "int16_values_default", "type": {"type_id": 148, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[0, 32767, -32768]"}, {"name": "uint16_values_default", "type": {"type_id": 149, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[0, 1, 65535]"}, {"name": "int32_values_default", "type": {"type_id": 150, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[0, 2147483647, -2147483648]"}, {"name": "uint32_values_default", "type": {"type_id": 151, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[0, 1, 4294967295]"}, {"name": "int64_values_default", "type": {"type_id": 152, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[0, 9223372036854775807, -9223372036854775808]"}, {"name": "uint64_values_default", "type": {"type_id": 153, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[0, 1, 18446744073709551615]"}, {"name": "string_values_default", "type": {"type_id": 161, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[\"\", \"max value\", \"min value\"]"}, {"name": "alignment_check", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}]}`
+}
+
+func (t _MultiNestedTypeSupport) MessageDefinition() string {
+	return `# Mulitple levels of nested messages
+Arrays[3] array_of_arrays
+BoundedSequences[3] array_of_bounded_sequences
+UnboundedSequences[3] array_of_unbounded_sequences
+Arrays[<=3] bounded_sequence_of_arrays
+BoundedSequences[<=3] bounded_sequence_of_bounded_sequences
+UnboundedSequences[<=3] bounded_sequence_of_unbounded_sequences
+Arrays[] unbounded_sequence_of_arrays
+BoundedSequences[] unbounded_sequence_of_bounded_sequences
+UnboundedSequences[] unbounded_sequence_of_unbounded_sequences
+================================================================================
+MSG: test_msgs/msg/Arrays
+# Arrays of different types
+bool[3] bool_values
+byte[3] byte_values
+char[3] char_values
+float32[3] float32_values
+float64[3] float64_values
+int8[3] int8_values
+uint8[3] uint8_values
+int16[3] int16_values
+uint16[3] uint16_values
+int32[3] int32_values
+uint32[3] uint32_values
+int64[3] int64_values
+uint64[3] uint64_values
+string[3] string_values
+BasicTypes[3] basic_types_values
+Constants[3] constants_values
+Defaults[3] defaults_values
+bool[3] bool_values_default [false, true, false]
+byte[3] byte_values_default [0, 1, 255]
+char[3] char_values_default [0, 1, 127]
+float32[3] float32_values_default [1.125, 0.0, -1.125]
+float64[3] float64_values_default [3.1415, 0.0, -3.1415]
+int8[3] int8_values_default [0, 127, -128]
+uint8[3] uint8_values_default [0, 1, 255]
+int16[3] int16_values_default [0, 32767, -32768]
+uint16[3] uint16_values_default [0, 1, 65535]
+int32[3] int32_values_default [0, 2147483647, -2147483648]
+uint32[3] uint32_values_default [0, 1, 4294967295]
+int64[3] int64_values_default [0, 9223372036854775807, -9223372036854775808]
+uint64[3] uint64_values_default [0, 1, 18446744073709551615]
+string[3] string_values_default ["", "max value", "min value"]
+# Regression test: check alignment of basic field after an array field is correct
+int32 alignment_check
+================================================================================
+MSG: test_msgs/msg/BoundedSequences
+# Bounded sequences of different types
+bool[<=3] bool_values
+byte[<=3] byte_values
+char[<=3] char_values
+float32[<=3] float32_values
+float64[<=3] float64_values
+int8[<=3] int8_values
+uint8[<=3] uint8_values
+int16[<=3] int16_values
+uint16[<=3] uint16_values
+int32[<=3] int32_values
+uint32[<=3] uint32_values
+int64[<=3] int64_values
+uint64[<=3] uint64_values
+string[<=3] string_values
+BasicTypes[<=3] basic_types_values
+Constants[<=3] constants_values
+Defaults[<=3] defaults_values
+bool[<=3] bool_values_default [false, true, false]
+byte[<=3] byte_values_default [0, 1, 255]
+char[<=3] char_values_default [0, 1, 127]
+float32[<=3] float32_values_default [1.125, 0.0, -1.125]
+float64[<=3] float64_values_default [3.1415, 0.0, -3.1415]
+int8[<=3] int8_values_default [0, 127, -128]
+uint8[<=3] uint8_values_default [0, 1, 255]
+int16[<=3] int16_values_default [0, 32767, -32768]
+uint16[<=3] uint16_values_default [0, 1, 65535]
+int32[<=3] int32_values_default [0, 2147483647, -2147483648]
+uint32[<=3] uint32_values_default [0, 1, 4294967295]
+int64[<=3] int64_values_default [0, 9223372036854775807, -9223372036854775808]
+uint64[<=3] uint64_values_default [0, 1, 18446744073709551615]
+string[<=3] string_values_default ["", "max value", "min value"]
+# Regression test: check alignment of basic field after a sequence field is correct
+int32 alignment_check
+================================================================================
+MSG: test_msgs/msg/UnboundedSequences
+# Unbounded sequences of different types
+bool[] bool_values
+byte[] byte_values
+char[] char_values
+float32[] float32_values
+float64[] float64_values
+int8[] int8_values
+uint8[] uint8_values
+int16[] int16_values
+uint16[] uint16_values
+int32[] int32_values
+uint32[] uint32_values
+int64[] int64_values
+uint64[] uint64_values
+string[] string_values
+BasicTypes[] basic_types_values
+Constants[] constants_values
+Defaults[] defaults_values
+bool[] bool_values_default [false, true, false]
+byte[] byte_values_default [0, 1, 255]
+char[] char_values_default [0, 1, 127]
+float32[] float32_values_default [1.125, 0.0, -1.125]
+float64[] float64_values_default [3.1415, 0.0, -3.1415]
+int8[] int8_values_default [0, 127, -128]
+uint8[] uint8_values_default [0, 1, 255]
+int16[] int16_values_default [0, 32767, -32768]
+uint16[] uint16_values_default [0, 1, 65535]
+int32[] int32_values_default [0, 2147483647, -2147483648]
+uint32[] uint32_values_default [0, 1, 4294967295]
+int64[] int64_values_default [0, 9223372036854775807, -9223372036854775808]
+uint64[] uint64_values_default [0, 1, 18446744073709551615]
+string[] string_values_default ["", "max value", "min value"]
+# Regression test: check alignment of basic field after a sequence field is correct
+int32 alignment_check
+================================================================================
+MSG: test_msgs/msg/BasicTypes
+bool bool_value
+byte byte_value
+char char_value
+float32 float32_value
+float64 float64_value
+int8 int8_value
+uint8 uint8_value
+int16 int16_value
+uint16 uint16_value
+int32 int32_value
+uint32 uint32_value
+int64 int64_value
+uint64 uint64_value
+================================================================================
+MSG: test_msgs/msg/Constants
+bool BOOL_CONST=true
+byte BYTE_CONST=50
+char CHAR_CONST=100
+float32 FLOAT32_CONST=1.125
+float64 FLOAT64_CONST=1.125
+int8 INT8_CONST=-50
+uint8 UINT8_CONST=200
+int16 INT16_CONST=-1000
+uint16 UINT16_CONST=2000
+int32 INT32_CONST=-30000
+uint32 UINT32_CONST=60000
+int64 INT64_CONST=-40000000
+uint64 UINT64_CONST=50000000
+================================================================================
+MSG: test_msgs/msg/Defaults
+bool bool_value true
+byte byte_value 50
+char char_value 100
+float32 float32_value 1.125
+float64 float64_value 1.125
+int8 int8_value -50
+uint8 uint8_value 200
+int16 int16_value -1000
+uint16 uint16_value 2000
+int32 int32_value -30000
+uint32 uint32_value 60000
+int64 int64_value -40000000
+uint64 uint64_value 50000000
+`
+}
+
 type CMultiNested = C.test_msgs__msg__MultiNested
 type CMultiNested__Sequence = C.test_msgs__msg__MultiNested__Sequence
 
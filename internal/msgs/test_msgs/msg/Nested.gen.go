@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	
@@ -52,6 +54,34 @@ func (t *Nested) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *Nested) Equal(other *Nested) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *Nested) EqualApprox(other *Nested, tol float64) bool {
+	if !t.BasicTypesValue.EqualApprox(&other.BasicTypesValue, tol) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *Nested) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *Nested) HashTo(h *msgcmp.Hasher) {
+	t.BasicTypesValue.HashTo(h)
+}
+
 func (t *Nested) SetDefaults() {
 	t.BasicTypesValue.SetDefaults()
 }
@@ -60,6 +90,43 @@ func (t *Nested) GetTypeSupport() types.MessageTypeSupport {
 	return NestedTypeSupport
 }
 
+func (t *Nested) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *Nested) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *Nested) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *Nested) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *Nested) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *Nested) EncodeCDR(e *cdr.Encoder) {
+	t.BasicTypesValue.EncodeCDR(e)
+}
+
+func (t *Nested) DecodeCDR(d *cdr.Decoder) {
+	t.BasicTypesValue.DecodeCDR(d)
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *Nested) Validate() error {
+	if err := t.BasicTypesValue.Validate(); err != nil {
+		return rclgo.WrapValidationError("basic_types_value", -1, err)
+	}
+	return nil
+}
+
 // NestedPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type NestedPublisher struct {
@@ -117,6 +184,16 @@ func CloneNestedSlice(dst, src []Nested) {
 	}
 }
 
+// NestedDescriptor describes the fields of Nested. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var NestedDescriptor = &types.MessageDescriptor{
+	TypeName: "test_msgs/msg/Nested",
+	Fields: []types.FieldDescriptor{
+		{Name: "basic_types_value", Type: "test_msgs/msg/BasicTypes", GoIndex: 0, Message: BasicTypesDescriptor},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var NestedTypeSupport types.MessageTypeSupport = _NestedTypeSupport{}
 
@@ -150,6 +227,38 @@ func (t _NestedTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__test_msgs__msg__Nested())
 }
 
+func (t _NestedTypeSupport) Descriptor() *types.MessageDescriptor {
+	return NestedDescriptor
+}
+
+func (t _NestedTypeSupport) TypeHash() string {
+	return `RIHS01_f964f9dc322022b7a681ee6933ee5c3c788025b942fedb7f4d7c018ed840cf86`
+}
+
+func (t _NestedTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "test_msgs/msg/Nested", "fields": [{"name": "basic_types_value", "type": {"type_id": 1, "capacity": 0, "string_capacity": 0, "nested_type_name": "test_msgs/msg/BasicTypes"}, "default_value": ""}]}, "referenced_type_descriptions": [{"type_name": "test_msgs/msg/BasicTypes", "fields": [{"name": "bool_value", "type": {"type_id": 15, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "byte_value", "type": {"type_id": 16, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "char_value", "type": {"type_id": 3, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "float32_value", "type": {"type_id": 10, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "float64_value", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "int8_value", "type": {"type_id": 2, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "uint8_value", "type": {"type_id": 3, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "int16_value", "type": {"type_id": 4, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "uint16_value", "type": {"type_id": 5, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "int32_value", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "uint32_value", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "int64_value", "type": {"type_id": 8, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "uint64_value", "type": {"type_id": 9, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}]}`
+}
+
+func (t _NestedTypeSupport) MessageDefinition() string {
+	return `BasicTypes basic_types_value
+================================================================================
+MSG: test_msgs/msg/BasicTypes
+bool bool_value
+byte byte_value
+char char_value
+float32 float32_value
+float64 float64_value
+int8 int8_value
+uint8 uint8_value
+int16 int16_value
+uint16 uint16_value
+int32 int32_value
+uint32 uint32_value
+int64 int64_value
+uint64 uint64_value
+`
+}
+
 type CNested = C.test_msgs__msg__Nested
 type CNested__Sequence = C.test_msgs__msg__Nested__Sequence
 
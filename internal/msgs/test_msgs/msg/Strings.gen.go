@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	primitives "github.com/tiiuae/rclgo/pkg/rclgo/primitives"
@@ -78,6 +80,78 @@ func (t *Strings) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *Strings) Equal(other *Strings) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *Strings) EqualApprox(other *Strings, tol float64) bool {
+	if t.StringValue != other.StringValue {
+		return false
+	}
+	if t.StringValueDefault1 != other.StringValueDefault1 {
+		return false
+	}
+	if t.StringValueDefault2 != other.StringValueDefault2 {
+		return false
+	}
+	if t.StringValueDefault3 != other.StringValueDefault3 {
+		return false
+	}
+	if t.StringValueDefault4 != other.StringValueDefault4 {
+		return false
+	}
+	if t.StringValueDefault5 != other.StringValueDefault5 {
+		return false
+	}
+	if t.BoundedStringValue != other.BoundedStringValue {
+		return false
+	}
+	if t.BoundedStringValueDefault1 != other.BoundedStringValueDefault1 {
+		return false
+	}
+	if t.BoundedStringValueDefault2 != other.BoundedStringValueDefault2 {
+		return false
+	}
+	if t.BoundedStringValueDefault3 != other.BoundedStringValueDefault3 {
+		return false
+	}
+	if t.BoundedStringValueDefault4 != other.BoundedStringValueDefault4 {
+		return false
+	}
+	if t.BoundedStringValueDefault5 != other.BoundedStringValueDefault5 {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *Strings) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *Strings) HashTo(h *msgcmp.Hasher) {
+	h.String(t.StringValue)
+	h.String(t.StringValueDefault1)
+	h.String(t.StringValueDefault2)
+	h.String(t.StringValueDefault3)
+	h.String(t.StringValueDefault4)
+	h.String(t.StringValueDefault5)
+	h.String(t.BoundedStringValue)
+	h.String(t.BoundedStringValueDefault1)
+	h.String(t.BoundedStringValueDefault2)
+	h.String(t.BoundedStringValueDefault3)
+	h.String(t.BoundedStringValueDefault4)
+	h.String(t.BoundedStringValueDefault5)
+}
+
 func (t *Strings) SetDefaults() {
 	t.StringValue = ""
 	t.StringValueDefault1 = "Hello world!"
@@ -97,6 +171,80 @@ func (t *Strings) GetTypeSupport() types.MessageTypeSupport {
 	return StringsTypeSupport
 }
 
+func (t *Strings) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *Strings) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *Strings) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *Strings) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *Strings) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *Strings) EncodeCDR(e *cdr.Encoder) {
+	e.String(t.StringValue)
+	e.String(t.StringValueDefault1)
+	e.String(t.StringValueDefault2)
+	e.String(t.StringValueDefault3)
+	e.String(t.StringValueDefault4)
+	e.String(t.StringValueDefault5)
+	e.String(t.BoundedStringValue)
+	e.String(t.BoundedStringValueDefault1)
+	e.String(t.BoundedStringValueDefault2)
+	e.String(t.BoundedStringValueDefault3)
+	e.String(t.BoundedStringValueDefault4)
+	e.String(t.BoundedStringValueDefault5)
+}
+
+func (t *Strings) DecodeCDR(d *cdr.Decoder) {
+	t.StringValue = d.String()
+	t.StringValueDefault1 = d.String()
+	t.StringValueDefault2 = d.String()
+	t.StringValueDefault3 = d.String()
+	t.StringValueDefault4 = d.String()
+	t.StringValueDefault5 = d.String()
+	t.BoundedStringValue = d.String()
+	t.BoundedStringValueDefault1 = d.String()
+	t.BoundedStringValueDefault2 = d.String()
+	t.BoundedStringValueDefault3 = d.String()
+	t.BoundedStringValueDefault4 = d.String()
+	t.BoundedStringValueDefault5 = d.String()
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *Strings) Validate() error {
+	if len(t.BoundedStringValue) > 22 {
+		return rclgo.NewBoundError("bounded_string_value", -1, len(t.BoundedStringValue), 22)
+	}
+	if len(t.BoundedStringValueDefault1) > 22 {
+		return rclgo.NewBoundError("bounded_string_value_default1", -1, len(t.BoundedStringValueDefault1), 22)
+	}
+	if len(t.BoundedStringValueDefault2) > 22 {
+		return rclgo.NewBoundError("bounded_string_value_default2", -1, len(t.BoundedStringValueDefault2), 22)
+	}
+	if len(t.BoundedStringValueDefault3) > 22 {
+		return rclgo.NewBoundError("bounded_string_value_default3", -1, len(t.BoundedStringValueDefault3), 22)
+	}
+	if len(t.BoundedStringValueDefault4) > 22 {
+		return rclgo.NewBoundError("bounded_string_value_default4", -1, len(t.BoundedStringValueDefault4), 22)
+	}
+	if len(t.BoundedStringValueDefault5) > 22 {
+		return rclgo.NewBoundError("bounded_string_value_default5", -1, len(t.BoundedStringValueDefault5), 22)
+	}
+	return nil
+}
+
 // StringsPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type StringsPublisher struct {
@@ -154,6 +302,27 @@ func CloneStringsSlice(dst, src []Strings) {
 	}
 }
 
+// StringsDescriptor describes the fields of Strings. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var StringsDescriptor = &types.MessageDescriptor{
+	TypeName: "test_msgs/msg/Strings",
+	Fields: []types.FieldDescriptor{
+		{Name: "string_value", Type: "string", GoIndex: 0},
+		{Name: "string_value_default1", Type: "string", GoIndex: 1},
+		{Name: "string_value_default2", Type: "string", GoIndex: 2},
+		{Name: "string_value_default3", Type: "string", GoIndex: 3},
+		{Name: "string_value_default4", Type: "string", GoIndex: 4},
+		{Name: "string_value_default5", Type: "string", GoIndex: 5},
+		{Name: "bounded_string_value", Type: "string", GoIndex: 6, StringUpperBound: 22},
+		{Name: "bounded_string_value_default1", Type: "string", GoIndex: 7, StringUpperBound: 22},
+		{Name: "bounded_string_value_default2", Type: "string", GoIndex: 8, StringUpperBound: 22},
+		{Name: "bounded_string_value_default3", Type: "string", GoIndex: 9, StringUpperBound: 22},
+		{Name: "bounded_string_value_default4", Type: "string", GoIndex: 10, StringUpperBound: 22},
+		{Name: "bounded_string_value_default5", Type: "string", GoIndex: 11, StringUpperBound: 22},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var StringsTypeSupport types.MessageTypeSupport = _StringsTypeSupport{}
 
@@ -209,6 +378,36 @@ func (t _StringsTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__test_msgs__msg__Strings())
 }
 
+func (t _StringsTypeSupport) Descriptor() *types.MessageDescriptor {
+	return StringsDescriptor
+}
+
+func (t _StringsTypeSupport) TypeHash() string {
+	return `RIHS01_eee55533636293ac7c94c62432eedb5777eb849847d2a17b4dcead8c4a20ab32`
+}
+
+func (t _StringsTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "test_msgs/msg/Strings", "fields": [{"name": "string_value", "type": {"type_id": 17, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "string_value_default1", "type": {"type_id": 17, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "\"Hello world!\""}, {"name": "string_value_default2", "type": {"type_id": 17, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "\"Hello'world!\""}, {"name": "string_value_default3", "type": {"type_id": 17, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "\"Hello\\\"world!\""}, {"name": "string_value_default4", "type": {"type_id": 17, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "\"Hello'world!\""}, {"name": "string_value_default5", "type": {"type_id": 17, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "\"Hello\\\"world!\""}, {"name": "bounded_string_value", "type": {"type_id": 21, "capacity": 0, "string_capacity": 22, "nested_type_name": ""}, "default_value": ""}, {"name": "bounded_string_value_default1", "type": {"type_id": 21, "capacity": 0, "string_capacity": 22, "nested_type_name": ""}, "default_value": "\"Hello world!\""}, {"name": "bounded_string_value_default2", "type": {"type_id": 21, "capacity": 0, "string_capacity": 22, "nested_type_name": ""}, "default_value": "\"Hello'world!\""}, {"name": "bounded_string_value_default3", "type": {"type_id": 21, "capacity": 0, "string_capacity": 22, "nested_type_name": ""}, "default_value": "\"Hello\\\"world!\""}, {"name": "bounded_string_value_default4", "type": {"type_id": 21, "capacity": 0, "string_capacity": 22, "nested_type_name": ""}, "default_value": "\"Hello'world!\""}, {"name": "bounded_string_value_default5", "type": {"type_id": 21, "capacity": 0, "string_capacity": 22, "nested_type_name": ""}, "default_value": "\"Hello\\\"world!\""}]}, "referenced_type_descriptions": []}`
+}
+
+func (t _StringsTypeSupport) MessageDefinition() string {
+	return `string STRING_CONST="Hello world!"
+
+string string_value
+string string_value_default1 "Hello world!"
+string string_value_default2 "Hello'world!"
+string string_value_default3 "Hello\"world!"
+string string_value_default4 "Hello'world!"
+string string_value_default5 "Hello\"world!"
+string<=22 bounded_string_value
+string<=22 bounded_string_value_default1 "Hello world!"
+string<=22 bounded_string_value_default2 "Hello'world!"
+string<=22 bounded_string_value_default3 "Hello\"world!"
+string<=22 bounded_string_value_default4 "Hello'world!"
+string<=22 bounded_string_value_default5 "Hello\"world!"
+`
+}
+
 type CStrings = C.test_msgs__msg__Strings
 type CStrings__Sequence = C.test_msgs__msg__Strings__Sequence
 
@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	primitives "github.com/tiiuae/rclgo/pkg/rclgo/primitives"
@@ -71,6 +73,66 @@ func (t *WStrings) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *WStrings) Equal(other *WStrings) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *WStrings) EqualApprox(other *WStrings, tol float64) bool {
+	if t.WstringValue != other.WstringValue {
+		return false
+	}
+	if t.WstringValueDefault1 != other.WstringValueDefault1 {
+		return false
+	}
+	if t.WstringValueDefault2 != other.WstringValueDefault2 {
+		return false
+	}
+	if t.WstringValueDefault3 != other.WstringValueDefault3 {
+		return false
+	}
+	if t.ArrayOfWstrings != other.ArrayOfWstrings {
+		return false
+	}
+	if !msgcmp.Slices(t.BoundedSequenceOfWstrings, other.BoundedSequenceOfWstrings) {
+		return false
+	}
+	if !msgcmp.Slices(t.UnboundedSequenceOfWstrings, other.UnboundedSequenceOfWstrings) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *WStrings) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *WStrings) HashTo(h *msgcmp.Hasher) {
+	h.String(t.WstringValue)
+	h.String(t.WstringValueDefault1)
+	h.String(t.WstringValueDefault2)
+	h.String(t.WstringValueDefault3)
+	for i := range t.ArrayOfWstrings {
+		h.String(t.ArrayOfWstrings[i])
+	}
+	h.Len(len(t.BoundedSequenceOfWstrings))
+	for i := range t.BoundedSequenceOfWstrings {
+		h.String(t.BoundedSequenceOfWstrings[i])
+	}
+	h.Len(len(t.UnboundedSequenceOfWstrings))
+	for i := range t.UnboundedSequenceOfWstrings {
+		h.String(t.UnboundedSequenceOfWstrings[i])
+	}
+}
+
 func (t *WStrings) SetDefaults() {
 	t.WstringValue = ""
 	t.WstringValueDefault1 = "Hello world!"
@@ -85,6 +147,71 @@ func (t *WStrings) GetTypeSupport() types.MessageTypeSupport {
 	return WStringsTypeSupport
 }
 
+func (t *WStrings) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *WStrings) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *WStrings) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *WStrings) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *WStrings) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *WStrings) EncodeCDR(e *cdr.Encoder) {
+	e.WString(t.WstringValue)
+	e.WString(t.WstringValueDefault1)
+	e.WString(t.WstringValueDefault2)
+	e.WString(t.WstringValueDefault3)
+	for i := range t.ArrayOfWstrings {
+		e.WString(t.ArrayOfWstrings[i])
+	}
+	e.SequenceLength(len(t.BoundedSequenceOfWstrings), 3)
+	for i := range t.BoundedSequenceOfWstrings {
+		e.WString(t.BoundedSequenceOfWstrings[i])
+	}
+	e.SequenceLength(len(t.UnboundedSequenceOfWstrings), 0)
+	for i := range t.UnboundedSequenceOfWstrings {
+		e.WString(t.UnboundedSequenceOfWstrings[i])
+	}
+}
+
+func (t *WStrings) DecodeCDR(d *cdr.Decoder) {
+	t.WstringValue = d.WString()
+	t.WstringValueDefault1 = d.WString()
+	t.WstringValueDefault2 = d.WString()
+	t.WstringValueDefault3 = d.WString()
+	for i := range t.ArrayOfWstrings {
+		t.ArrayOfWstrings[i] = d.WString()
+	}
+	t.BoundedSequenceOfWstrings = cdr.MakeSlice[string](d, 3)
+	for i := range t.BoundedSequenceOfWstrings {
+		t.BoundedSequenceOfWstrings[i] = d.WString()
+	}
+	t.UnboundedSequenceOfWstrings = cdr.MakeSlice[string](d, 0)
+	for i := range t.UnboundedSequenceOfWstrings {
+		t.UnboundedSequenceOfWstrings[i] = d.WString()
+	}
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *WStrings) Validate() error {
+	if len(t.BoundedSequenceOfWstrings) > 3 {
+		return rclgo.NewBoundError("bounded_sequence_of_wstrings", -1, len(t.BoundedSequenceOfWstrings), 3)
+	}
+	return nil
+}
+
 // WStringsPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type WStringsPublisher struct {
@@ -142,6 +269,22 @@ func CloneWStringsSlice(dst, src []WStrings) {
 	}
 }
 
+// WStringsDescriptor describes the fields of WStrings. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var WStringsDescriptor = &types.MessageDescriptor{
+	TypeName: "test_msgs/msg/WStrings",
+	Fields: []types.FieldDescriptor{
+		{Name: "wstring_value", Type: "wstring", GoIndex: 0},
+		{Name: "wstring_value_default1", Type: "wstring", GoIndex: 1},
+		{Name: "wstring_value_default2", Type: "wstring", GoIndex: 2},
+		{Name: "wstring_value_default3", Type: "wstring", GoIndex: 3},
+		{Name: "array_of_wstrings", Type: "wstring", GoIndex: 4, IsArray: true, ArraySize: 3},
+		{Name: "bounded_sequence_of_wstrings", Type: "wstring", GoIndex: 5, IsArray: true, ArraySize: 3, IsUpperBound: true},
+		{Name: "unbounded_sequence_of_wstrings", Type: "wstring", GoIndex: 6, IsArray: true},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var WStringsTypeSupport types.MessageTypeSupport = _WStringsTypeSupport{}
 
@@ -189,6 +332,30 @@ func (t _WStringsTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__test_msgs__msg__WStrings())
 }
 
+func (t _WStringsTypeSupport) Descriptor() *types.MessageDescriptor {
+	return WStringsDescriptor
+}
+
+func (t _WStringsTypeSupport) TypeHash() string {
+	return `RIHS01_5a2c8ddb2054600023b9a180652f2ba895373217cad4b1f0fc1258c9e4424a79`
+}
+
+func (t _WStringsTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "test_msgs/msg/WStrings", "fields": [{"name": "wstring_value", "type": {"type_id": 18, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "wstring_value_default1", "type": {"type_id": 18, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "\"Hello world!\""}, {"name": "wstring_value_default2", "type": {"type_id": 18, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "\"Hell\u00f6 w\u00f6rld!\""}, {"name": "wstring_value_default3", "type": {"type_id": 18, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "\"\u30cf\u30ed\u30fc\u30ef\u30fc\u30eb\u30c9\""}, {"name": "array_of_wstrings", "type": {"type_id": 66, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "bounded_sequence_of_wstrings", "type": {"type_id": 114, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "unbounded_sequence_of_wstrings", "type": {"type_id": 162, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": []}`
+}
+
+func (t _WStringsTypeSupport) MessageDefinition() string {
+	return `wstring wstring_value
+wstring wstring_value_default1 "Hello world!"
+wstring wstring_value_default2 "Hellö wörld!"
+wstring wstring_value_default3 "ハローワールド"
+# wstring WSTRING_CONST="Hello world!"wstring<=22 bounded_wstring_valuewstring<=22 bounded_wstring_value_default1 "Hello world!"
+wstring[3] array_of_wstrings
+wstring[<=3] bounded_sequence_of_wstrings
+wstring[] unbounded_sequence_of_wstrings
+`
+}
+
 type CWStrings = C.test_msgs__msg__WStrings
 type CWStrings__Sequence = C.test_msgs__msg__WStrings__Sequence
 
@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	test_msgs_msg "github.com/tiiuae/rclgo/internal/msgs/test_msgs/msg"
@@ -114,6 +116,222 @@ func (t *Arrays_Request) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *Arrays_Request) Equal(other *Arrays_Request) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *Arrays_Request) EqualApprox(other *Arrays_Request, tol float64) bool {
+	if t.BoolValues != other.BoolValues {
+		return false
+	}
+	if t.ByteValues != other.ByteValues {
+		return false
+	}
+	if t.CharValues != other.CharValues {
+		return false
+	}
+	if !msgcmp.Float32s(t.Float32Values[:], other.Float32Values[:], tol) {
+		return false
+	}
+	if !msgcmp.Float64s(t.Float64Values[:], other.Float64Values[:], tol) {
+		return false
+	}
+	if t.Int8Values != other.Int8Values {
+		return false
+	}
+	if t.Uint8Values != other.Uint8Values {
+		return false
+	}
+	if t.Int16Values != other.Int16Values {
+		return false
+	}
+	if t.Uint16Values != other.Uint16Values {
+		return false
+	}
+	if t.Int32Values != other.Int32Values {
+		return false
+	}
+	if t.Uint32Values != other.Uint32Values {
+		return false
+	}
+	if t.Int64Values != other.Int64Values {
+		return false
+	}
+	if t.Uint64Values != other.Uint64Values {
+		return false
+	}
+	if t.StringValues != other.StringValues {
+		return false
+	}
+	for i := range t.BasicTypesValues {
+		if !t.BasicTypesValues[i].EqualApprox(&other.BasicTypesValues[i], tol) {
+			return false
+		}
+	}
+	for i := range t.ConstantsValues {
+		if !t.ConstantsValues[i].EqualApprox(&other.ConstantsValues[i], tol) {
+			return false
+		}
+	}
+	for i := range t.DefaultsValues {
+		if !t.DefaultsValues[i].EqualApprox(&other.DefaultsValues[i], tol) {
+			return false
+		}
+	}
+	if t.BoolValuesDefault != other.BoolValuesDefault {
+		return false
+	}
+	if t.ByteValuesDefault != other.ByteValuesDefault {
+		return false
+	}
+	if t.CharValuesDefault != other.CharValuesDefault {
+		return false
+	}
+	if !msgcmp.Float32s(t.Float32ValuesDefault[:], other.Float32ValuesDefault[:], tol) {
+		return false
+	}
+	if !msgcmp.Float64s(t.Float64ValuesDefault[:], other.Float64ValuesDefault[:], tol) {
+		return false
+	}
+	if t.Int8ValuesDefault != other.Int8ValuesDefault {
+		return false
+	}
+	if t.Uint8ValuesDefault != other.Uint8ValuesDefault {
+		return false
+	}
+	if t.Int16ValuesDefault != other.Int16ValuesDefault {
+		return false
+	}
+	if t.Uint16ValuesDefault != other.Uint16ValuesDefault {
+		return false
+	}
+	if t.Int32ValuesDefault != other.Int32ValuesDefault {
+		return false
+	}
+	if t.Uint32ValuesDefault != other.Uint32ValuesDefault {
+		return false
+	}
+	if t.Int64ValuesDefault != other.Int64ValuesDefault {
+		return false
+	}
+	if t.Uint64ValuesDefault != other.Uint64ValuesDefault {
+		return false
+	}
+	if t.StringValuesDefault != other.StringValuesDefault {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *Arrays_Request) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *Arrays_Request) HashTo(h *msgcmp.Hasher) {
+	for i := range t.BoolValues {
+		h.Bool(t.BoolValues[i])
+	}
+	for i := range t.ByteValues {
+		h.Uint8(t.ByteValues[i])
+	}
+	for i := range t.CharValues {
+		h.Uint8(t.CharValues[i])
+	}
+	for i := range t.Float32Values {
+		h.Float32(t.Float32Values[i])
+	}
+	for i := range t.Float64Values {
+		h.Float64(t.Float64Values[i])
+	}
+	for i := range t.Int8Values {
+		h.Int8(t.Int8Values[i])
+	}
+	for i := range t.Uint8Values {
+		h.Uint8(t.Uint8Values[i])
+	}
+	for i := range t.Int16Values {
+		h.Int16(t.Int16Values[i])
+	}
+	for i := range t.Uint16Values {
+		h.Uint16(t.Uint16Values[i])
+	}
+	for i := range t.Int32Values {
+		h.Int32(t.Int32Values[i])
+	}
+	for i := range t.Uint32Values {
+		h.Uint32(t.Uint32Values[i])
+	}
+	for i := range t.Int64Values {
+		h.Int64(t.Int64Values[i])
+	}
+	for i := range t.Uint64Values {
+		h.Uint64(t.Uint64Values[i])
+	}
+	for i := range t.StringValues {
+		h.String(t.StringValues[i])
+	}
+	for i := range t.BasicTypesValues {
+		t.BasicTypesValues[i].HashTo(h)
+	}
+	for i := range t.ConstantsValues {
+		t.ConstantsValues[i].HashTo(h)
+	}
+	for i := range t.DefaultsValues {
+		t.DefaultsValues[i].HashTo(h)
+	}
+	for i := range t.BoolValuesDefault {
+		h.Bool(t.BoolValuesDefault[i])
+	}
+	for i := range t.ByteValuesDefault {
+		h.Uint8(t.ByteValuesDefault[i])
+	}
+	for i := range t.CharValuesDefault {
+		h.Uint8(t.CharValuesDefault[i])
+	}
+	for i := range t.Float32ValuesDefault {
+		h.Float32(t.Float32ValuesDefault[i])
+	}
+	for i := range t.Float64ValuesDefault {
+		h.Float64(t.Float64ValuesDefault[i])
+	}
+	for i := range t.Int8ValuesDefault {
+		h.Int8(t.Int8ValuesDefault[i])
+	}
+	for i := range t.Uint8ValuesDefault {
+		h.Uint8(t.Uint8ValuesDefault[i])
+	}
+	for i := range t.Int16ValuesDefault {
+		h.Int16(t.Int16ValuesDefault[i])
+	}
+	for i := range t.Uint16ValuesDefault {
+		h.Uint16(t.Uint16ValuesDefault[i])
+	}
+	for i := range t.Int32ValuesDefault {
+		h.Int32(t.Int32ValuesDefault[i])
+	}
+	for i := range t.Uint32ValuesDefault {
+		h.Uint32(t.Uint32ValuesDefault[i])
+	}
+	for i := range t.Int64ValuesDefault {
+		h.Int64(t.Int64ValuesDefault[i])
+	}
+	for i := range t.Uint64ValuesDefault {
+		h.Uint64(t.Uint64ValuesDefault[i])
+	}
+	for i := range t.StringValuesDefault {
+		h.String(t.StringValuesDefault[i])
+	}
+}
+
 func (t *Arrays_Request) SetDefaults() {
 	t.BoolValues = [3]bool{}
 	t.ByteValues = [3]byte{}
@@ -158,6 +376,215 @@ func (t *Arrays_Request) GetTypeSupport() types.MessageTypeSupport {
 	return Arrays_RequestTypeSupport
 }
 
+func (t *Arrays_Request) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *Arrays_Request) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *Arrays_Request) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *Arrays_Request) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *Arrays_Request) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *Arrays_Request) EncodeCDR(e *cdr.Encoder) {
+	for i := range t.BoolValues {
+		e.Bool(t.BoolValues[i])
+	}
+	e.Uint8s(t.ByteValues[:])
+	e.Uint8s(t.CharValues[:])
+	for i := range t.Float32Values {
+		e.Float32(t.Float32Values[i])
+	}
+	for i := range t.Float64Values {
+		e.Float64(t.Float64Values[i])
+	}
+	for i := range t.Int8Values {
+		e.Int8(t.Int8Values[i])
+	}
+	e.Uint8s(t.Uint8Values[:])
+	for i := range t.Int16Values {
+		e.Int16(t.Int16Values[i])
+	}
+	for i := range t.Uint16Values {
+		e.Uint16(t.Uint16Values[i])
+	}
+	for i := range t.Int32Values {
+		e.Int32(t.Int32Values[i])
+	}
+	for i := range t.Uint32Values {
+		e.Uint32(t.Uint32Values[i])
+	}
+	for i := range t.Int64Values {
+		e.Int64(t.Int64Values[i])
+	}
+	for i := range t.Uint64Values {
+		e.Uint64(t.Uint64Values[i])
+	}
+	for i := range t.StringValues {
+		e.String(t.StringValues[i])
+	}
+	for i := range t.BasicTypesValues {
+		t.BasicTypesValues[i].EncodeCDR(e)
+	}
+	for i := range t.ConstantsValues {
+		t.ConstantsValues[i].EncodeCDR(e)
+	}
+	for i := range t.DefaultsValues {
+		t.DefaultsValues[i].EncodeCDR(e)
+	}
+	for i := range t.BoolValuesDefault {
+		e.Bool(t.BoolValuesDefault[i])
+	}
+	e.Uint8s(t.ByteValuesDefault[:])
+	e.Uint8s(t.CharValuesDefault[:])
+	for i := range t.Float32ValuesDefault {
+		e.Float32(t.Float32ValuesDefault[i])
+	}
+	for i := range t.Float64ValuesDefault {
+		e.Float64(t.Float64ValuesDefault[i])
+	}
+	for i := range t.Int8ValuesDefault {
+		e.Int8(t.Int8ValuesDefault[i])
+	}
+	e.Uint8s(t.Uint8ValuesDefault[:])
+	for i := range t.Int16ValuesDefault {
+		e.Int16(t.Int16ValuesDefault[i])
+	}
+	for i := range t.Uint16ValuesDefault {
+		e.Uint16(t.Uint16ValuesDefault[i])
+	}
+	for i := range t.Int32ValuesDefault {
+		e.Int32(t.Int32ValuesDefault[i])
+	}
+	for i := range t.Uint32ValuesDefault {
+		e.Uint32(t.Uint32ValuesDefault[i])
+	}
+	for i := range t.Int64ValuesDefault {
+		e.Int64(t.Int64ValuesDefault[i])
+	}
+	for i := range t.Uint64ValuesDefault {
+		e.Uint64(t.Uint64ValuesDefault[i])
+	}
+	for i := range t.StringValuesDefault {
+		e.String(t.StringValuesDefault[i])
+	}
+}
+
+func (t *Arrays_Request) DecodeCDR(d *cdr.Decoder) {
+	for i := range t.BoolValues {
+		t.BoolValues[i] = d.Bool()
+	}
+	d.Uint8s(t.ByteValues[:])
+	d.Uint8s(t.CharValues[:])
+	for i := range t.Float32Values {
+		t.Float32Values[i] = d.Float32()
+	}
+	for i := range t.Float64Values {
+		t.Float64Values[i] = d.Float64()
+	}
+	for i := range t.Int8Values {
+		t.Int8Values[i] = d.Int8()
+	}
+	d.Uint8s(t.Uint8Values[:])
+	for i := range t.Int16Values {
+		t.Int16Values[i] = d.Int16()
+	}
+	for i := range t.Uint16Values {
+		t.Uint16Values[i] = d.Uint16()
+	}
+	for i := range t.Int32Values {
+		t.Int32Values[i] = d.Int32()
+	}
+	for i := range t.Uint32Values {
+		t.Uint32Values[i] = d.Uint32()
+	}
+	for i := range t.Int64Values {
+		t.Int64Values[i] = d.Int64()
+	}
+	for i := range t.Uint64Values {
+		t.Uint64Values[i] = d.Uint64()
+	}
+	for i := range t.StringValues {
+		t.StringValues[i] = d.String()
+	}
+	for i := range t.BasicTypesValues {
+		t.BasicTypesValues[i].DecodeCDR(d)
+	}
+	for i := range t.ConstantsValues {
+		t.ConstantsValues[i].DecodeCDR(d)
+	}
+	for i := range t.DefaultsValues {
+		t.DefaultsValues[i].DecodeCDR(d)
+	}
+	for i := range t.BoolValuesDefault {
+		t.BoolValuesDefault[i] = d.Bool()
+	}
+	d.Uint8s(t.ByteValuesDefault[:])
+	d.Uint8s(t.CharValuesDefault[:])
+	for i := range t.Float32ValuesDefault {
+		t.Float32ValuesDefault[i] = d.Float32()
+	}
+	for i := range t.Float64ValuesDefault {
+		t.Float64ValuesDefault[i] = d.Float64()
+	}
+	for i := range t.Int8ValuesDefault {
+		t.Int8ValuesDefault[i] = d.Int8()
+	}
+	d.Uint8s(t.Uint8ValuesDefault[:])
+	for i := range t.Int16ValuesDefault {
+		t.Int16ValuesDefault[i] = d.Int16()
+	}
+	for i := range t.Uint16ValuesDefault {
+		t.Uint16ValuesDefault[i] = d.Uint16()
+	}
+	for i := range t.Int32ValuesDefault {
+		t.Int32ValuesDefault[i] = d.Int32()
+	}
+	for i := range t.Uint32ValuesDefault {
+		t.Uint32ValuesDefault[i] = d.Uint32()
+	}
+	for i := range t.Int64ValuesDefault {
+		t.Int64ValuesDefault[i] = d.Int64()
+	}
+	for i := range t.Uint64ValuesDefault {
+		t.Uint64ValuesDefault[i] = d.Uint64()
+	}
+	for i := range t.StringValuesDefault {
+		t.StringValuesDefault[i] = d.String()
+	}
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *Arrays_Request) Validate() error {
+	for i := range t.BasicTypesValues {
+		if err := t.BasicTypesValues[i].Validate(); err != nil {
+			return rclgo.WrapValidationError("basic_types_values", i, err)
+		}
+	}
+	for i := range t.ConstantsValues {
+		if err := t.ConstantsValues[i].Validate(); err != nil {
+			return rclgo.WrapValidationError("constants_values", i, err)
+		}
+	}
+	for i := range t.DefaultsValues {
+		if err := t.DefaultsValues[i].Validate(); err != nil {
+			return rclgo.WrapValidationError("defaults_values", i, err)
+		}
+	}
+	return nil
+}
+
 // Arrays_RequestPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type Arrays_RequestPublisher struct {
@@ -215,6 +642,46 @@ func CloneArrays_RequestSlice(dst, src []Arrays_Request) {
 	}
 }
 
+// Arrays_RequestDescriptor describes the fields of Arrays_Request. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var Arrays_RequestDescriptor = &types.MessageDescriptor{
+	TypeName: "test_msgs/srv/Arrays_Request",
+	Fields: []types.FieldDescriptor{
+		{Name: "bool_values", Type: "bool", GoIndex: 0, IsArray: true, ArraySize: 3},
+		{Name: "byte_values", Type: "byte", GoIndex: 1, IsArray: true, ArraySize: 3},
+		{Name: "char_values", Type: "char", GoIndex: 2, IsArray: true, ArraySize: 3},
+		{Name: "float32_values", Type: "float32", GoIndex: 3, IsArray: true, ArraySize: 3},
+		{Name: "float64_values", Type: "float64", GoIndex: 4, IsArray: true, ArraySize: 3},
+		{Name: "int8_values", Type: "int8", GoIndex: 5, IsArray: true, ArraySize: 3},
+		{Name: "uint8_values", Type: "uint8", GoIndex: 6, IsArray: true, ArraySize: 3},
+		{Name: "int16_values", Type: "int16", GoIndex: 7, IsArray: true, ArraySize: 3},
+		{Name: "uint16_values", Type: "uint16", GoIndex: 8, IsArray: true, ArraySize: 3},
+		{Name: "int32_values", Type: "int32", GoIndex: 9, IsArray: true, ArraySize: 3},
+		{Name: "uint32_values", Type: "uint32", GoIndex: 10, IsArray: true, ArraySize: 3},
+		{Name: "int64_values", Type: "int64", GoIndex: 11, IsArray: true, ArraySize: 3},
+		{Name: "uint64_values", Type: "uint64", GoIndex: 12, IsArray: true, ArraySize: 3},
+		{Name: "string_values", Type: "string", GoIndex: 13, IsArray: true, ArraySize: 3},
+		{Name: "basic_types_values", Type: "test_msgs/msg/BasicTypes", GoIndex: 14, IsArray: true, ArraySize: 3, Message: test_msgs_msg.BasicTypesDescriptor},
+		{Name: "constants_values", Type: "test_msgs/msg/Constants", GoIndex: 15, IsArray: true, ArraySize: 3, Message: test_msgs_msg.ConstantsDescriptor},
+		{Name: "defaults_values", Type: "test_msgs/msg/Defaults", GoIndex: 16, IsArray: true, ArraySize: 3, Message: test_msgs_msg.DefaultsDescriptor},
+		{Name: "bool_values_default", Type: "bool", GoIndex: 17, IsArray: true, ArraySize: 3},
+		{Name: "byte_values_default", Type: "byte", GoIndex: 18, IsArray: true, ArraySize: 3},
+		{Name: "char_values_default", Type: "char", GoIndex: 19, IsArray: true, ArraySize: 3},
+		{Name: "float32_values_default", Type: "float32", GoIndex: 20, IsArray: true, ArraySize: 3},
+		{Name: "float64_values_default", Type: "float64", GoIndex: 21, IsArray: true, ArraySize: 3},
+		{Name: "int8_values_default", Type: "int8", GoIndex: 22, IsArray: true, ArraySize: 3},
+		{Name: "uint8_values_default", Type: "uint8", GoIndex: 23, IsArray: true, ArraySize: 3},
+		{Name: "int16_values_default", Type: "int16", GoIndex: 24, IsArray: true, ArraySize: 3},
+		{Name: "uint16_values_default", Type: "uint16", GoIndex: 25, IsArray: true, ArraySize: 3},
+		{Name: "int32_values_default", Type: "int32", GoIndex: 26, IsArray: true, ArraySize: 3},
+		{Name: "uint32_values_default", Type: "uint32", GoIndex: 27, IsArray: true, ArraySize: 3},
+		{Name: "int64_values_default", Type: "int64", GoIndex: 28, IsArray: true, ArraySize: 3},
+		{Name: "uint64_values_default", Type: "uint64", GoIndex: 29, IsArray: true, ArraySize: 3},
+		{Name: "string_values_default", Type: "string", GoIndex: 30, IsArray: true, ArraySize: 3},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var Arrays_RequestTypeSupport types.MessageTypeSupport = _Arrays_RequestTypeSupport{}
 
@@ -370,6 +837,98 @@ func (t _Arrays_RequestTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__test_msgs__srv__Arrays_Request())
 }
 
+func (t _Arrays_RequestTypeSupport) Descriptor() *types.MessageDescriptor {
+	return Arrays_RequestDescriptor
+}
+
+func (t _Arrays_RequestTypeSupport) TypeHash() string {
+	return `RIHS01_69e1d277b2efd1d2760e545a4057f11f62f7c6395c5faa17e50599399b4fb5d8`
+}
+
+func (t _Arrays_RequestTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "test_msgs/srv/Arrays_Request", "fields": [{"name": "bool_values", "type": {"type_id": 63, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "byte_values", "type": {"type_id": 64, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "char_values", "type": {"type_id": 51, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "float32_values", "type": {"type_id": 58, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "float64_values", "type": {"type_id": 59, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "int8_values", "type": {"type_id": 50, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "uint8_values", "type": {"type_id": 51, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "int16_values", "type": {"type_id": 52, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "uint16_values", "type": {"type_id": 53, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "int32_values", "type": {"type_id": 54, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "uint32_values", "type": {"type_id": 55, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "int64_values", "type": {"type_id": 56, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "uint64_values", "type": {"type_id": 57, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "string_values", "type": {"type_id": 65, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "basic_types_values", "type": {"type_id": 49, "capacity": 3, "string_capacity": 0, "nested_type_name": "test_msgs/msg/BasicTypes"}, "default_value": ""}, {"name": "constants_values", "type": {"type_id": 49, "capacity": 3, "string_capacity": 0, "nested_type_name": "test_msgs/msg/Constants"}, "default_value": ""}, {"name": "defaults_values", "type": {"type_id": 49, "capacity": 3, "string_capacity": 0, "nested_type_name": "test_msgs/msg/Defaults"}, "default_value": ""}, {"name": "bool_values_default", "type": {"type_id": 63, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[false, true, false]"}, {"name": "byte_values_default", "type": {"type_id": 64, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[0, 1, 255]"}, {"name": "char_values_default", "type": {"type_id": 51, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[0, 1, 127]"}, {"name": "float32_values_default", "type": {"type_id": 58, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[1.125, 0.0, -1.125]"}, {"name": "float64_values_default", "type": {"type_id": 59, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[3.1415, 0.0, -3.1415]"}, {"name": "int8_values_default", "type": {"type_id": 50, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[0, 127, -128]"}, {"name": "uint8_values_default", "type": {"type_id": 51, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[0, 1, 255]"}, {"name": "int16_values_default", "type": {"type_id": 52, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[0, 32767, -32768]"}, {"name": "uint16_values_default", "type": {"type_id": 53, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[0, 1, 65535]"}, {"name": "int32_values_default", "type": {"type_id": 54, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[0, 2147483647, -2147483648]"}, {"name": "uint32_values_default", "type": {"type_id": 55, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[0, 1, 4294967295]"}, {"name": "int64_values_default", "type": {"type_id": 56, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[0, 9223372036854775807, -9223372036854775808]"}, {"name": "uint64_values_default", "type": {"type_id": 57, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[0, 1, 18446744073709551615]"}, {"name": "string_values_default", "type": {"type_id": 65, "capacity": 3, "string_capacity": 0, "nested_type_name": ""}, "default_value": "[\"\", \"max value\", \"min value\"]"}]}, "referenced_type_descriptions": [{"type_name": "test_msgs/msg/BasicTypes", "fields": [{"name": "bool_value", "type": {"type_id": 15, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "byte_value", "type": {"type_id": 16, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "char_value", "type": {"type_id": 3, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "float32_value", "type": {"type_id": 10, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "float64_value", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "int8_value", "type": {"type_id": 2, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "uint8_value", "type": {"type_id": 3, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "int16_value", "type": {"type_id": 4, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "uint16_value", "type": {"type_id": 5, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "int32_value", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "uint32_value", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "int64_value", "type": {"type_id": 8, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "uint64_value", "type": {"type_id": 9, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "test_msgs/msg/Constants", "fields": [{"name": "structure_needs_at_least_one_member", "type": {"type_id": 3, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, {"type_name": "test_msgs/msg/Defaults", "fields": [{"name": "bool_value", "type": {"type_id": 15, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "true"}, {"name": "byte_value", "type": {"type_id": 16, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "50"}, {"name": "char_value", "type": {"type_id": 3, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "100"}, {"name": "float32_value", "type": {"type_id": 10, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "1.125"}, {"name": "float64_value", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "1.125"}, {"name": "int8_value", "type": {"type_id": 2, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "-50"}, {"name": "uint8_value", "type": {"type_id": 3, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "200"}, {"name": "int16_value", "type": {"type_id": 4, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "-1000"}, {"name": "uint16_value", "type": {"type_id": 5, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "2000"}, {"name": "int32_value", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "-30000"}, {"name": "uint32_value", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "60000"}, {"name": "int64_value", "type": {"type_id": 8, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "-40000000"}, {"name": "uint64_value", "type": {"type_id": 9, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": "50000000"}]}]}`
+}
+
+func (t _Arrays_RequestTypeSupport) MessageDefinition() string {
+	return `bool[3] bool_values
+byte[3] byte_values
+char[3] char_values
+float32[3] float32_values
+float64[3] float64_values
+int8[3] int8_values
+uint8[3] uint8_values
+int16[3] int16_values
+uint16[3] uint16_values
+int32[3] int32_values
+uint32[3] uint32_values
+int64[3] int64_values
+uint64[3] uint64_values
+string[3] string_values
+BasicTypes[3] basic_types_values
+Constants[3] constants_values
+Defaults[3] defaults_values
+bool[3] bool_values_default [false, true, false]
+byte[3] byte_values_default [0, 1, 255]
+char[3] char_values_default [0, 1, 127]
+float32[3] float32_values_default [1.125, 0.0, -1.125]
+float64[3] float64_values_default [3.1415, 0.0, -3.1415]
+int8[3] int8_values_default [0, 127, -128]
+uint8[3] uint8_values_default [0, 1, 255]
+int16[3] int16_values_default [0, 32767, -32768]
+uint16[3] uint16_values_default [0, 1, 65535]
+int32[3] int32_values_default [0, 2147483647, -2147483648]
+uint32[3] uint32_values_default [0, 1, 4294967295]
+int64[3] int64_values_default [0, 9223372036854775807, -9223372036854775808]
+uint64[3] uint64_values_default [0, 1, 18446744073709551615]
+string[3] string_values_default ["", "max value", "min value"]
+================================================================================
+MSG: test_msgs/msg/BasicTypes
+bool bool_value
+byte byte_value
+char char_value
+float32 float32_value
+float64 float64_value
+int8 int8_value
+uint8 uint8_value
+int16 int16_value
+uint16 uint16_value
+int32 int32_value
+uint32 uint32_value
+int64 int64_value
+uint64 uint64_value
+================================================================================
+MSG: test_msgs/msg/Constants
+bool BOOL_CONST=true
+byte BYTE_CONST=50
+char CHAR_CONST=100
+float32 FLOAT32_CONST=1.125
+float64 FLOAT64_CONST=1.125
+int8 INT8_CONST=-50
+uint8 UINT8_CONST=200
+int16 INT16_CONST=-1000
+uint16 UINT16_CONST=2000
+int32 INT32_CONST=-30000
+uint32 UINT32_CONST=60000
+int64 INT64_CONST=-40000000
+uint64 UINT64_CONST=50000000
+================================================================================
+MSG: test_msgs/msg/Defaults
+bool bool_value true
+byte byte_value 50
+char char_value 100
+float32 float32_value 1.125
+float64 float64_value 1.125
+int8 int8_value -50
+uint8 uint8_value 200
+int16 int16_value -1000
+uint16 uint16_value 2000
+int32 int32_value -30000
+uint32 uint32_value 60000
+int64 int64_value -40000000
+uint64 uint64_value 50000000
+`
+}
+
 type CArrays_Request = C.test_msgs__srv__Arrays_Request
 type CArrays_Request__Sequence = C.test_msgs__srv__Arrays_Request__Sequence
 
@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	primitives "github.com/tiiuae/rclgo/pkg/rclgo/primitives"
@@ -79,6 +81,86 @@ func (t *BasicTypes_Request) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *BasicTypes_Request) Equal(other *BasicTypes_Request) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *BasicTypes_Request) EqualApprox(other *BasicTypes_Request, tol float64) bool {
+	if t.BoolValue != other.BoolValue {
+		return false
+	}
+	if t.ByteValue != other.ByteValue {
+		return false
+	}
+	if t.CharValue != other.CharValue {
+		return false
+	}
+	if !msgcmp.Float32(t.Float32Value, other.Float32Value, tol) {
+		return false
+	}
+	if !msgcmp.Float64(t.Float64Value, other.Float64Value, tol) {
+		return false
+	}
+	if t.Int8Value != other.Int8Value {
+		return false
+	}
+	if t.Uint8Value != other.Uint8Value {
+		return false
+	}
+	if t.Int16Value != other.Int16Value {
+		return false
+	}
+	if t.Uint16Value != other.Uint16Value {
+		return false
+	}
+	if t.Int32Value != other.Int32Value {
+		return false
+	}
+	if t.Uint32Value != other.Uint32Value {
+		return false
+	}
+	if t.Int64Value != other.Int64Value {
+		return false
+	}
+	if t.Uint64Value != other.Uint64Value {
+		return false
+	}
+	if t.StringValue != other.StringValue {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *BasicTypes_Request) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *BasicTypes_Request) HashTo(h *msgcmp.Hasher) {
+	h.Bool(t.BoolValue)
+	h.Uint8(t.ByteValue)
+	h.Uint8(t.CharValue)
+	h.Float32(t.Float32Value)
+	h.Float64(t.Float64Value)
+	h.Int8(t.Int8Value)
+	h.Uint8(t.Uint8Value)
+	h.Int16(t.Int16Value)
+	h.Uint16(t.Uint16Value)
+	h.Int32(t.Int32Value)
+	h.Uint32(t.Uint32Value)
+	h.Int64(t.Int64Value)
+	h.Uint64(t.Uint64Value)
+	h.String(t.StringValue)
+}
+
 func (t *BasicTypes_Request) SetDefaults() {
 	t.BoolValue = false
 	t.ByteValue = 0
@@ -100,6 +182,66 @@ func (t *BasicTypes_Request) GetTypeSupport() types.MessageTypeSupport {
 	return BasicTypes_RequestTypeSupport
 }
 
+func (t *BasicTypes_Request) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *BasicTypes_Request) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *BasicTypes_Request) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *BasicTypes_Request) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *BasicTypes_Request) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *BasicTypes_Request) EncodeCDR(e *cdr.Encoder) {
+	e.Bool(t.BoolValue)
+	e.Uint8(t.ByteValue)
+	e.Uint8(t.CharValue)
+	e.Float32(t.Float32Value)
+	e.Float64(t.Float64Value)
+	e.Int8(t.Int8Value)
+	e.Uint8(t.Uint8Value)
+	e.Int16(t.Int16Value)
+	e.Uint16(t.Uint16Value)
+	e.Int32(t.Int32Value)
+	e.Uint32(t.Uint32Value)
+	e.Int64(t.Int64Value)
+	e.Uint64(t.Uint64Value)
+	e.String(t.StringValue)
+}
+
+func (t *BasicTypes_Request) DecodeCDR(d *cdr.Decoder) {
+	t.BoolValue = d.Bool()
+	t.ByteValue = d.Uint8()
+	t.CharValue = d.Uint8()
+	t.Float32Value = d.Float32()
+	t.Float64Value = d.Float64()
+	t.Int8Value = d.Int8()
+	t.Uint8Value = d.Uint8()
+	t.Int16Value = d.Int16()
+	t.Uint16Value = d.Uint16()
+	t.Int32Value = d.Int32()
+	t.Uint32Value = d.Uint32()
+	t.Int64Value = d.Int64()
+	t.Uint64Value = d.Uint64()
+	t.StringValue = d.String()
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *BasicTypes_Request) Validate() error {
+	return nil
+}
+
 // BasicTypes_RequestPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type BasicTypes_RequestPublisher struct {
@@ -157,6 +299,29 @@ func CloneBasicTypes_RequestSlice(dst, src []BasicTypes_Request) {
 	}
 }
 
+// BasicTypes_RequestDescriptor describes the fields of BasicTypes_Request. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var BasicTypes_RequestDescriptor = &types.MessageDescriptor{
+	TypeName: "test_msgs/srv/BasicTypes_Request",
+	Fields: []types.FieldDescriptor{
+		{Name: "bool_value", Type: "bool", GoIndex: 0},
+		{Name: "byte_value", Type: "byte", GoIndex: 1},
+		{Name: "char_value", Type: "char", GoIndex: 2},
+		{Name: "float32_value", Type: "float32", GoIndex: 3},
+		{Name: "float64_value", Type: "float64", GoIndex: 4},
+		{Name: "int8_value", Type: "int8", GoIndex: 5},
+		{Name: "uint8_value", Type: "uint8", GoIndex: 6},
+		{Name: "int16_value", Type: "int16", GoIndex: 7},
+		{Name: "uint16_value", Type: "uint16", GoIndex: 8},
+		{Name: "int32_value", Type: "int32", GoIndex: 9},
+		{Name: "uint32_value", Type: "uint32", GoIndex: 10},
+		{Name: "int64_value", Type: "int64", GoIndex: 11},
+		{Name: "uint64_value", Type: "uint64", GoIndex: 12},
+		{Name: "string_value", Type: "string", GoIndex: 13},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var BasicTypes_RequestTypeSupport types.MessageTypeSupport = _BasicTypes_RequestTypeSupport{}
 
@@ -216,6 +381,35 @@ func (t _BasicTypes_RequestTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__test_msgs__srv__BasicTypes_Request())
 }
 
+func (t _BasicTypes_RequestTypeSupport) Descriptor() *types.MessageDescriptor {
+	return BasicTypes_RequestDescriptor
+}
+
+func (t _BasicTypes_RequestTypeSupport) TypeHash() string {
+	return `RIHS01_1dafcf389e224692783e9c4f6066ba0db3fa75fdbd8f081ffbe7ed03144d73d4`
+}
+
+func (t _BasicTypes_RequestTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "test_msgs/srv/BasicTypes_Request", "fields": [{"name": "bool_value", "type": {"type_id": 15, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "byte_value", "type": {"type_id": 16, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "char_value", "type": {"type_id": 3, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "float32_value", "type": {"type_id": 10, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "float64_value", "type": {"type_id": 11, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "int8_value", "type": {"type_id": 2, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "uint8_value", "type": {"type_id": 3, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "int16_value", "type": {"type_id": 4, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "uint16_value", "type": {"type_id": 5, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "int32_value", "type": {"type_id": 6, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "uint32_value", "type": {"type_id": 7, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "int64_value", "type": {"type_id": 8, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "uint64_value", "type": {"type_id": 9, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}, {"name": "string_value", "type": {"type_id": 17, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": []}`
+}
+
+func (t _BasicTypes_RequestTypeSupport) MessageDefinition() string {
+	return `bool bool_value
+byte byte_value
+char char_value
+float32 float32_value
+float64 float64_value
+int8 int8_value
+uint8 uint8_value
+int16 int16_value
+uint16 uint16_value
+int32 int32_value
+uint32 uint32_value
+int64 int64_value
+uint64 uint64_value
+string string_value`
+}
+
 type CBasicTypes_Request = C.test_msgs__srv__BasicTypes_Request
 type CBasicTypes_Request__Sequence = C.test_msgs__srv__BasicTypes_Request__Sequence
 
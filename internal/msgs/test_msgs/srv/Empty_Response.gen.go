@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	
@@ -50,6 +52,30 @@ func (t *Empty_Response) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *Empty_Response) Equal(other *Empty_Response) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *Empty_Response) EqualApprox(other *Empty_Response, tol float64) bool {
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *Empty_Response) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *Empty_Response) HashTo(h *msgcmp.Hasher) {
+}
+
 func (t *Empty_Response) SetDefaults() {
 }
 
@@ -57,6 +83,40 @@ func (t *Empty_Response) GetTypeSupport() types.MessageTypeSupport {
 	return Empty_ResponseTypeSupport
 }
 
+func (t *Empty_Response) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *Empty_Response) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *Empty_Response) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *Empty_Response) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *Empty_Response) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *Empty_Response) EncodeCDR(e *cdr.Encoder) {
+	e.Uint8(0)
+}
+
+func (t *Empty_Response) DecodeCDR(d *cdr.Decoder) {
+	d.Uint8()
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *Empty_Response) Validate() error {
+	return nil
+}
+
 // Empty_ResponsePublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type Empty_ResponsePublisher struct {
@@ -114,6 +174,13 @@ func CloneEmpty_ResponseSlice(dst, src []Empty_Response) {
 	}
 }
 
+// Empty_ResponseDescriptor describes the fields of Empty_Response. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var Empty_ResponseDescriptor = &types.MessageDescriptor{
+	TypeName: "test_msgs/srv/Empty_Response",
+}
+
 // Modifying this variable is undefined behavior.
 var Empty_ResponseTypeSupport types.MessageTypeSupport = _Empty_ResponseTypeSupport{}
 
@@ -143,6 +210,22 @@ func (t _Empty_ResponseTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__test_msgs__srv__Empty_Response())
 }
 
+func (t _Empty_ResponseTypeSupport) Descriptor() *types.MessageDescriptor {
+	return Empty_ResponseDescriptor
+}
+
+func (t _Empty_ResponseTypeSupport) TypeHash() string {
+	return `RIHS01_7258f28034bb3747d3743b73f2e099d9eb739f92b4bf6d6957d1a8e9df043ad8`
+}
+
+func (t _Empty_ResponseTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "test_msgs/srv/Empty_Response", "fields": [{"name": "structure_needs_at_least_one_member", "type": {"type_id": 3, "capacity": 0, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": []}`
+}
+
+func (t _Empty_ResponseTypeSupport) MessageDefinition() string {
+	return ``
+}
+
 type CEmpty_Response = C.test_msgs__srv__Empty_Response
 type CEmpty_Response__Sequence = C.test_msgs__srv__Empty_Response__Sequence
 
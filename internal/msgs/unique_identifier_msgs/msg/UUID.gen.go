@@ -14,6 +14,8 @@ import (
 	"unsafe"
 
 	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/cdr"
+	"github.com/tiiuae/rclgo/pkg/rclgo/msgcmp"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	primitives "github.com/tiiuae/rclgo/pkg/rclgo/primitives"
@@ -53,6 +55,36 @@ func (t *UUID) CloneMsg() types.Message {
 	return t.Clone()
 }
 
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *UUID) Equal(other *UUID) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *UUID) EqualApprox(other *UUID, tol float64) bool {
+	if t.Uuid != other.Uuid {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *UUID) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *UUID) HashTo(h *msgcmp.Hasher) {
+	for i := range t.Uuid {
+		h.Uint8(t.Uuid[i])
+	}
+}
+
 func (t *UUID) SetDefaults() {
 	t.Uuid = [16]uint8{}
 }
@@ -61,6 +93,40 @@ func (t *UUID) GetTypeSupport() types.MessageTypeSupport {
 	return UUIDTypeSupport
 }
 
+func (t *UUID) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *UUID) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *UUID) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *UUID) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *UUID) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *UUID) EncodeCDR(e *cdr.Encoder) {
+	e.Uint8s(t.Uuid[:])
+}
+
+func (t *UUID) DecodeCDR(d *cdr.Decoder) {
+	d.Uint8s(t.Uuid[:])
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *UUID) Validate() error {
+	return nil
+}
+
 // UUIDPublisher wraps rclgo.Publisher to provide type safe helper
 // functions
 type UUIDPublisher struct {
@@ -118,6 +184,16 @@ func CloneUUIDSlice(dst, src []UUID) {
 	}
 }
 
+// UUIDDescriptor describes the fields of UUID. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var UUIDDescriptor = &types.MessageDescriptor{
+	TypeName: "unique_identifier_msgs/msg/UUID",
+	Fields: []types.FieldDescriptor{
+		{Name: "uuid", Type: "uint8", GoIndex: 0, IsArray: true, ArraySize: 16},
+	},
+}
+
 // Modifying this variable is undefined behavior.
 var UUIDTypeSupport types.MessageTypeSupport = _UUIDTypeSupport{}
 
@@ -153,6 +229,23 @@ func (t _UUIDTypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__unique_identifier_msgs__msg__UUID())
 }
 
+func (t _UUIDTypeSupport) Descriptor() *types.MessageDescriptor {
+	return UUIDDescriptor
+}
+
+func (t _UUIDTypeSupport) TypeHash() string {
+	return `RIHS01_1b8e8aca958cbea28fe6ef60bf6c19b683c97a9ef60bb34752067d0f2f7ab437`
+}
+
+func (t _UUIDTypeSupport) TypeDescription() string {
+	return `{"type_description": {"type_name": "unique_identifier_msgs/msg/UUID", "fields": [{"name": "uuid", "type": {"type_id": 51, "capacity": 16, "string_capacity": 0, "nested_type_name": ""}, "default_value": ""}]}, "referenced_type_descriptions": []}`
+}
+
+func (t _UUIDTypeSupport) MessageDefinition() string {
+	return `uint8[16] uuid
+`
+}
+
 type CUUID = C.unique_identifier_msgs__msg__UUID
 type CUUID__Sequence = C.unique_identifier_msgs__msg__UUID__Sequence
 
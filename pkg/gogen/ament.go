@@ -0,0 +1,139 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package gogen
+
+import (
+	"encoding/xml"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/kivilahtio/go-re/v0"
+)
+
+// rosidlInterfacesIndex is the path of the ament resource index listing the
+// interface files of each package, relative to a root path. The index
+// contains a file for each package, and each line of the file is the path of
+// an interface file relative to share/<package>.
+const rosidlInterfacesIndex = "share/ament_index/resource_index/rosidl_interfaces"
+
+// packageManifest contains the dependencies declared in a package.xml file.
+// Both format 1 and later formats are supported.
+type packageManifest struct {
+	Depends            []string `xml:"depend"`
+	BuildDepends       []string `xml:"build_depend"`
+	BuildExportDepends []string `xml:"build_export_depend"`
+	ExecDepends        []string `xml:"exec_depend"`
+	RunDepends         []string `xml:"run_depend"`
+}
+
+func readPackageManifest(path string) (*packageManifest, error) {
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+	manifest := &packageManifest{}
+	if err := xml.Unmarshal(data, manifest); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// Dependencies returns the build and exec dependencies of the package without
+// duplicates.
+func (m *packageManifest) Dependencies() []string {
+	seen := map[string]bool{}
+	var deps []string
+	for _, list := range [][]string{m.Depends, m.BuildDepends, m.BuildExportDepends, m.ExecDepends, m.RunDepends} {
+		for _, dep := range list {
+			dep = strings.TrimSpace(dep)
+			if dep != "" && !seen[dep] {
+				seen[dep] = true
+				deps = append(deps, dep)
+			}
+		}
+	}
+	return deps
+}
+
+func isInterfacePath(path string) bool {
+	return re.M(filepath.ToSlash(path), `m!/(msg/.+\.msg)|(srv/.+\.srv)|(action/.+\.action)|((?:msg|srv|action)/[^/]+\.idl)$!`)
+}
+
+// addInterface adds the interface defined in the file at path to found. If an
+// interface is defined both in an IDL file and in a .msg, .srv or .action file,
+// the latter is used, because rosidl installs the IDL files it generates from
+// them.
+func (g *Generator) addInterface(found map[Metadata]string, path string) {
+	skip, blacklistEntry := g.blacklisted(path)
+	if skip {
+		g.warnf(path, "blacklisted, matched regex '%s'", blacklistEntry)
+		return
+	}
+	if !isInterfacePath(path) {
+		return
+	}
+	meta, err := parseMetadataFromPath(path)
+	if err != nil {
+		g.errorf(path, "failed to parse metadata from path: %w", err)
+	} else if prev, ok := found[*meta]; !ok || isIDLPath(prev) {
+		found[*meta] = path
+	}
+}
+
+// findIndexedInterfaces finds the interfaces of the packages listed in the
+// ament resource index of root and reads the dependencies of the packages from
+// their package.xml files. Returns fs.ErrNotExist if root has no index.
+func (g *Generator) findIndexedInterfaces(root string) (found map[Metadata]string, deps map[string][]string, err error) {
+	indexDir := filepath.Join(root, filepath.FromSlash(rosidlInterfacesIndex))
+	entries, err := os.ReadDir(indexDir)
+	if err != nil {
+		return nil, nil, err
+	}
+	found = map[Metadata]string{}
+	deps = map[string][]string{}
+	for _, entry := range entries {
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		pkg := entry.Name()
+		content, err := os.ReadFile(filepath.Join(indexDir, pkg))
+		if err != nil {
+			g.warnf(filepath.Join(indexDir, pkg), "failed to read the resource index of package %s: %w", pkg, err)
+			continue
+		}
+		shareDir := filepath.Join(root, "share", pkg)
+		for _, line := range strings.Split(string(content), "\n") {
+			line = strings.TrimSpace(line)
+			if line != "" {
+				g.addInterface(found, filepath.Join(shareDir, filepath.FromSlash(line)))
+			}
+		}
+		manifest, err := readPackageManifest(filepath.Join(shareDir, "package.xml"))
+		if err != nil {
+			g.warnf(filepath.Join(shareDir, "package.xml"), "failed to read the manifest of package %s: %w", pkg, err)
+			continue
+		}
+		deps[pkg] = manifest.Dependencies()
+	}
+	return found, deps, nil
+}
+
+// walkInterfaces finds the interfaces in root by walking the whole directory
+// tree. It is used for roots which have no ament resource index.
+func (g *Generator) walkInterfaces(root string) map[Metadata]string {
+	found := map[Metadata]string{}
+	filepath.Walk(root, func(path string, info fs.FileInfo, err error) error { //nolint:errcheck
+		g.addInterface(found, path)
+		return nil
+	})
+	return found
+}
@@ -0,0 +1,68 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package gogen
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey" //nolint:revive
+)
+
+func writeTestFiles(root string, files map[string]string) {
+	for name, content := range files {
+		path := filepath.Join(root, filepath.FromSlash(name))
+		So(os.MkdirAll(filepath.Dir(path), 0o755), ShouldBeNil)
+		So(os.WriteFile(path, []byte(content), 0o600), ShouldBeNil)
+	}
+}
+
+func TestFindPackages(t *testing.T) {
+	SetDefaultFailureMode(FailureContinues)
+
+	Convey("Packages are found using the ament resource index", t, func() {
+		overlay := t.TempDir()
+		underlay := t.TempDir()
+		writeTestFiles(overlay, map[string]string{
+			rosidlInterfacesIndex + "/nav_msgs": "msg/Odometry.idl\nmsg/Odometry.msg\nsrv/GetMap.idl\n",
+			"share/nav_msgs/package.xml": `<?xml version="1.0"?>
+<package format="3">
+  <name>nav_msgs</name>
+  <depend>geometry_msgs</depend>
+  <build_depend>rosidl_default_generators</build_depend>
+  <exec_depend>std_msgs</exec_depend>
+  <exec_depend>geometry_msgs</exec_depend>
+</package>`,
+			"share/nav_msgs/msg/Odometry.idl": "",
+			"share/nav_msgs/msg/Odometry.msg": "",
+			"share/nav_msgs/srv/GetMap.idl":   "",
+			"share/nav_msgs/msg/Stray.msg":    "",
+		})
+		writeTestFiles(underlay, map[string]string{
+			"share/std_msgs/msg/Header.msg":   "",
+			"share/nav_msgs/msg/Path.msg":     "",
+			"share/nav_msgs/msg/Odometry.msg": "",
+		})
+
+		g := New(&Config{RootPaths: []string{overlay, underlay}})
+		g.findPackages()
+		So(g.allPkgs, ShouldHaveLength, 2)
+		nav := g.allPkgs["nav_msgs"]
+		So(nav.Deps, ShouldResemble, []string{"geometry_msgs", "rosidl_default_generators", "std_msgs"})
+		So(nav.Interfaces, ShouldResemble, map[Metadata]string{
+			{Package: "nav_msgs", Type: "msg", Name: "Odometry"}: filepath.Join(overlay, "share/nav_msgs/msg/Odometry.msg"),
+			{Package: "nav_msgs", Type: "srv", Name: "GetMap"}:   filepath.Join(overlay, "share/nav_msgs/srv/GetMap.idl"),
+			{Package: "nav_msgs", Type: "msg", Name: "Path"}:     filepath.Join(underlay, "share/nav_msgs/msg/Path.msg"),
+		})
+		So(g.allPkgs["std_msgs"].Interfaces, ShouldHaveLength, 1)
+		So(g.allPkgs["std_msgs"].Deps, ShouldBeNil)
+	})
+}
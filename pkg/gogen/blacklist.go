@@ -42,3 +42,17 @@ func blacklisted(path string) (bool, string) {
 	}
 	return false, ""
 }
+
+// blacklisted reports whether the interface at path is blacklisted by the
+// built-in blacklist or Config.Blacklist and returns the matching regex.
+func (g *Generator) blacklisted(path string) (bool, string) {
+	if skip, entry := blacklisted(path); skip {
+		return true, entry
+	}
+	for _, pattern := range g.config.Blacklist {
+		if pattern.MatchString(path) {
+			return true, pattern.String()
+		}
+	}
+	return false, ""
+}
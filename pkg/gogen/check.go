@@ -0,0 +1,185 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package gogen
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"text/template"
+
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// CheckResult contains the differences between the files which would be
+// generated and the files in the destination directory. Paths are
+// slash-separated and relative to the destination directory.
+type CheckResult struct {
+	// Drifted maps the files whose contents differ to unified diffs from the
+	// current to the expected contents.
+	Drifted         map[string]string
+	MissingFiles    []string
+	ExtraFiles      []string
+	MissingPackages []string
+	ExtraPackages   []string
+}
+
+// OK reports whether the destination directory is up to date.
+func (r *CheckResult) OK() bool {
+	return len(r.Drifted) == 0 &&
+		len(r.MissingFiles) == 0 &&
+		len(r.ExtraFiles) == 0 &&
+		len(r.MissingPackages) == 0 &&
+		len(r.ExtraPackages) == 0
+}
+
+// WriteReport writes a human-readable description of r to w.
+func (r *CheckResult) WriteReport(w io.Writer) error {
+	var files []string
+	for file := range r.Drifted {
+		files = append(files, file)
+	}
+	sort.Strings(files)
+	for _, file := range files {
+		if _, err := io.WriteString(w, r.Drifted[file]); err != nil {
+			return err
+		}
+	}
+	for _, list := range []struct {
+		title string
+		items []string
+	}{
+		{"Missing packages", r.MissingPackages},
+		{"Extra packages", r.ExtraPackages},
+		{"Missing files", r.MissingFiles},
+		{"Extra files", r.ExtraFiles},
+	} {
+		if len(list.items) == 0 {
+			continue
+		}
+		if _, err := fmt.Fprintf(w, "%s:\n", list.title); err != nil {
+			return err
+		}
+		for _, item := range list.items {
+			if _, err := fmt.Fprintf(w, "\t%s\n", item); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// checkGoFile renders tmpl and compares the result to the file at destPath.
+func (g *Generator) checkGoFile(destPath string, tmpl *template.Template, data templateData) error {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return err
+	}
+	rel, err := filepath.Rel(g.config.DestPath, destPath)
+	if err != nil {
+		return err
+	}
+	rel = filepath.ToSlash(rel)
+	g.rendered.Add(rel)
+	current, err := os.ReadFile(filepath.Clean(destPath))
+	if errors.Is(err, os.ErrNotExist) {
+		g.checkResult.MissingFiles = append(g.checkResult.MissingFiles, rel)
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if bytes.Equal(current, buf.Bytes()) {
+		return nil
+	}
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(current)),
+		B:        difflib.SplitLines(buf.String()),
+		FromFile: "a/" + rel,
+		ToFile:   "b/" + rel,
+		Context:  3,
+	})
+	if err != nil {
+		return err
+	}
+	g.checkResult.Drifted[rel] = diff
+	return nil
+}
+
+// interfacePackageGlobs match the directories of generated interface
+// packages relative to the destination directory.
+var interfacePackageGlobs = []string{"*/msg", "*/srv", "*/action"}
+
+// CheckResult returns the differences found since Config.Check was enabled.
+// Files and packages in the destination directory which would not be
+// generated are reported as extra.
+func (g *Generator) CheckResult() (*CheckResult, error) {
+	result := *g.checkResult
+	renderedPkgs := stringSet{}
+	for file := range g.rendered {
+		if dir := path.Dir(file); dir != "." {
+			renderedPkgs.Add(dir)
+		}
+	}
+	existingPkgs := stringSet{}
+	for _, glob := range interfacePackageGlobs {
+		files, err := filepath.Glob(filepath.Join(g.config.DestPath, glob, "*.gen.go"))
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range files {
+			rel, err := filepath.Rel(g.config.DestPath, file)
+			if err != nil {
+				return nil, err
+			}
+			rel = filepath.ToSlash(rel)
+			existingPkgs.Add(path.Dir(rel))
+			if _, ok := g.rendered[rel]; !ok {
+				result.ExtraFiles = append(result.ExtraFiles, rel)
+			}
+		}
+	}
+	for pkg := range renderedPkgs {
+		if _, ok := existingPkgs[pkg]; !ok {
+			result.MissingPackages = append(result.MissingPackages, pkg)
+		}
+	}
+	for pkg := range existingPkgs {
+		if _, ok := renderedPkgs[pkg]; !ok {
+			result.ExtraPackages = append(result.ExtraPackages, pkg)
+		}
+	}
+	// Files of missing and extra packages are not listed separately.
+	result.MissingFiles = filterFiles(result.MissingFiles, renderedPkgs, existingPkgs)
+	result.ExtraFiles = filterFiles(result.ExtraFiles, existingPkgs, renderedPkgs)
+	sort.Strings(result.MissingFiles)
+	sort.Strings(result.ExtraFiles)
+	sort.Strings(result.MissingPackages)
+	sort.Strings(result.ExtraPackages)
+	return &result, nil
+}
+
+// filterFiles returns the files whose package is in both pkgs and otherPkgs.
+func filterFiles(files []string, pkgs, otherPkgs stringSet) []string {
+	var filtered []string
+	for _, file := range files {
+		pkg := path.Dir(file)
+		_, ok1 := pkgs[pkg]
+		_, ok2 := otherPkgs[pkg]
+		if pkg == "." || ok1 && ok2 {
+			filtered = append(filtered, file)
+		}
+	}
+	return filtered
+}
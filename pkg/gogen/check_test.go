@@ -0,0 +1,80 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package gogen
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey" //nolint:revive
+)
+
+func TestCheck(t *testing.T) {
+	SetDefaultFailureMode(FailureContinues)
+
+	Convey("Check mode reports differences without writing files", t, func() {
+		root := t.TempDir()
+		dest := t.TempDir()
+		writeTestFiles(root, map[string]string{
+			"share/demo_msgs/msg/Point.msg":    "float64 x\nfloat64 y\n",
+			"share/other_msgs/msg/Counter.msg": "int64 count\n",
+		})
+		generate := func(check bool) *Generator {
+			g := New(&Config{
+				RootPaths:           []string{root},
+				DestPath:            dest,
+				RclgoImportPath:     DefaultConfig.RclgoImportPath,
+				MessageModulePrefix: "example.com/msgs",
+				Check:               check,
+			})
+			So(g.GenerateGolangMessageTypes(), ShouldBeNil)
+			So(g.GenerateROS2AllMessagesImporter(), ShouldBeNil)
+			return g
+		}
+		check := func() *CheckResult {
+			result, err := generate(true).CheckResult()
+			So(err, ShouldBeNil)
+			return result
+		}
+
+		result := check()
+		So(result.OK(), ShouldBeFalse)
+		So(result.MissingPackages, ShouldResemble, []string{"demo_msgs/msg", "other_msgs/msg"})
+		So(result.MissingFiles, ShouldResemble, []string{"msgs.gen.go"})
+		entries, err := os.ReadDir(dest)
+		So(err, ShouldBeNil)
+		So(entries, ShouldBeEmpty)
+
+		generate(false)
+		So(check().OK(), ShouldBeTrue)
+
+		writeTestFiles(root, map[string]string{"share/demo_msgs/msg/Point.msg": "float64 x\nfloat64 y\nfloat64 z\n"})
+		So(os.RemoveAll(filepath.Join(root, "share/other_msgs")), ShouldBeNil)
+		writeTestFiles(dest, map[string]string{
+			"demo_msgs/msg/Removed.gen.go":  "package demo_msgs_msg\n",
+			"stale_msgs/srv/Service.gen.go": "package stale_msgs_srv\n",
+		})
+		result = check()
+		So(result.OK(), ShouldBeFalse)
+		So(result.Drifted, ShouldContainKey, "demo_msgs/msg/Point.gen.go")
+		So(result.Drifted, ShouldContainKey, "msgs.gen.go")
+		So(result.Drifted["demo_msgs/msg/Point.gen.go"], ShouldContainSubstring, "+\tZ float64")
+		So(result.MissingPackages, ShouldBeEmpty)
+		So(result.ExtraPackages, ShouldResemble, []string{"other_msgs/msg", "stale_msgs/srv"})
+		So(result.ExtraFiles, ShouldResemble, []string{"demo_msgs/msg/Removed.gen.go"})
+
+		var report strings.Builder
+		So(result.WriteReport(&report), ShouldBeNil)
+		So(report.String(), ShouldContainSubstring, "--- a/demo_msgs/msg/Point.gen.go\n+++ b/demo_msgs/msg/Point.gen.go\n")
+		So(report.String(), ShouldContainSubstring, "Extra packages:\n\tother_msgs/msg\n\tstale_msgs/srv\n")
+	})
+}
@@ -0,0 +1,137 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package gogen
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Severity is the severity of a Diagnostic.
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+)
+
+// Diagnostic is an error or a warning found while generating bindings.
+// Line and Column are 1-based and zero if unknown.
+type Diagnostic struct {
+	Severity Severity `json:"severity"`
+	File     string   `json:"file,omitempty"`
+	Line     int      `json:"line,omitempty"`
+	Column   int      `json:"column,omitempty"`
+	Message  string   `json:"message"`
+}
+
+// String formats d like compilers do, for example
+// "pkg/msg/Foo.msg:3:1: error: unknown type".
+func (d Diagnostic) String() string {
+	var b strings.Builder
+	if d.File != "" {
+		b.WriteString(d.File)
+		if d.Line > 0 {
+			b.WriteString(":" + strconv.Itoa(d.Line))
+			if d.Column > 0 {
+				b.WriteString(":" + strconv.Itoa(d.Column))
+			}
+		}
+		b.WriteString(": ")
+	}
+	b.WriteString(string(d.Severity) + ": " + d.Message)
+	return b.String()
+}
+
+const (
+	DiagnosticsText = "text"
+	DiagnosticsJSON = "json"
+)
+
+// WriteDiagnosticsJSON writes diags to w as a JSON array.
+func WriteDiagnosticsJSON(w io.Writer, diags []Diagnostic) error {
+	if diags == nil {
+		diags = []Diagnostic{}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(diags)
+}
+
+// SyntaxError is an error at a position in an interface definition.
+type SyntaxError struct {
+	Line   int
+	Column int
+	Err    error
+}
+
+func (e *SyntaxError) Error() string {
+	if e.Column > 0 {
+		return fmt.Sprintf("line %d, column %d: %v", e.Line, e.Column, e.Err)
+	}
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+func (e *SyntaxError) Unwrap() error {
+	return e.Err
+}
+
+func newDiagnostic(severity Severity, file string, err error) Diagnostic {
+	d := Diagnostic{Severity: severity, File: file, Message: err.Error()}
+	var serr *SyntaxError
+	if errors.As(err, &serr) {
+		d.Line = serr.Line
+		d.Column = serr.Column
+		// The position is reported separately, so remove it from the message
+		// but keep the context around it.
+		d.Message = strings.Replace(d.Message, serr.Error(), serr.Err.Error(), 1)
+	}
+	return d
+}
+
+func (g *Generator) addDiagnostic(d Diagnostic) {
+	g.diagnostics = append(g.diagnostics, d)
+	if g.config.DiagnosticsFormat != DiagnosticsJSON {
+		PrintErrf("%s\n", d)
+	}
+}
+
+func (g *Generator) errorf(file string, format string, args ...interface{}) {
+	g.addDiagnostic(newDiagnostic(SeverityError, file, fmt.Errorf(format, args...)))
+}
+
+func (g *Generator) warnf(file string, format string, args ...interface{}) {
+	g.addDiagnostic(newDiagnostic(SeverityWarning, file, fmt.Errorf(format, args...)))
+}
+
+func (g *Generator) addParserWarnings(file string, p *parser) {
+	for _, w := range p.warnings {
+		g.addDiagnostic(newDiagnostic(SeverityWarning, file, w))
+	}
+}
+
+// Diagnostics returns the errors and warnings found so far.
+func (g *Generator) Diagnostics() []Diagnostic {
+	return g.diagnostics
+}
+
+func (g *Generator) errorCount() int {
+	n := 0
+	for _, d := range g.diagnostics {
+		if d.Severity == SeverityError {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,106 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package gogen
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey" //nolint:revive
+)
+
+func TestDiagnostics(t *testing.T) {
+	SetDefaultFailureMode(FailureContinues)
+
+	Convey("Errors and warnings are reported with their positions", t, func() {
+		root := t.TempDir()
+		writeTestFiles(root, map[string]string{
+			"share/demo_msgs/msg/Good.msg":    "bool b true\nint32 i 0x10\n",
+			"share/demo_msgs/msg/Warning.msg": "bool b yes\n  int32 i abc\n",
+			"share/demo_msgs/msg/Bad.msg":     "int32 a\n  float64 = 1\n",
+			"share/demo_msgs/msg/BadIDL.idl": `module demo_msgs {
+  module msg {
+    struct BadIDL {
+      int32 a;
+      float64 b[;
+    };
+  };
+};
+`,
+		})
+		generate := func(strict bool) (*Generator, error) {
+			g := New(&Config{
+				RootPaths:           []string{root},
+				DestPath:            t.TempDir(),
+				RclgoImportPath:     DefaultConfig.RclgoImportPath,
+				MessageModulePrefix: "example.com/msgs",
+				Strict:              strict,
+				DiagnosticsFormat:   DiagnosticsJSON,
+			})
+			return g, g.GenerateGolangMessageTypes()
+		}
+		pkgDir := filepath.Join(root, "share", "demo_msgs", "msg")
+
+		g, err := generate(false)
+		So(err, ShouldBeNil)
+		So(g.Summary().Generated, ShouldEqual, 2)
+		So(g.Summary().Failed, ShouldEqual, 2)
+		diags := g.Diagnostics()
+		So(diags, ShouldHaveLength, 4)
+		So(diags, ShouldContain, Diagnostic{
+			Severity: SeverityError,
+			File:     filepath.Join(pkgDir, "Bad.msg"),
+			Line:     2,
+			Column:   3,
+			Message:  `failed to convert message: couldn't parse the input row as either ROS2 Field or Constant? input 'float64 = 1'`,
+		})
+		So(diags, ShouldContain, Diagnostic{
+			Severity: SeverityWarning,
+			File:     filepath.Join(pkgDir, "Warning.msg"),
+			Line:     1,
+			Column:   1,
+			Message:  `unknown default value "yes" of field b of type bool`,
+		})
+		So(diags, ShouldContain, Diagnostic{
+			Severity: SeverityWarning,
+			File:     filepath.Join(pkgDir, "Warning.msg"),
+			Line:     2,
+			Column:   3,
+			Message:  `unknown default value "abc" of field i of type int32`,
+		})
+		var idlDiag Diagnostic
+		for _, d := range diags {
+			if strings.HasSuffix(d.File, "BadIDL.idl") {
+				idlDiag = d
+			}
+		}
+		So(idlDiag.Severity, ShouldEqual, SeverityError)
+		So(idlDiag.Line, ShouldEqual, 5)
+		So(idlDiag.Column, ShouldBeGreaterThan, 0)
+
+		_, err = generate(true)
+		So(err, ShouldNotBeNil)
+		So(err.Error(), ShouldEqual, "generation failed with 2 errors")
+
+		var b strings.Builder
+		So(WriteDiagnosticsJSON(&b, diags[:1]), ShouldBeNil)
+		var decoded []Diagnostic
+		So(json.Unmarshal([]byte(b.String()), &decoded), ShouldBeNil)
+		So(decoded, ShouldResemble, diags[:1])
+
+		b.Reset()
+		So(WriteDiagnosticsJSON(&b, nil), ShouldBeNil)
+		So(b.String(), ShouldEqual, "[]\n")
+
+		So(diags[0].String(), ShouldStartWith, diags[0].File+":")
+	})
+}
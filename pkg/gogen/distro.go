@@ -0,0 +1,109 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package gogen
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// DefaultDistro is the ROS 2 distribution bindings are generated for if
+// Config.TargetDistro is empty.
+const DefaultDistro = "humble"
+
+// Distro describes how the bindings generated for a ROS 2 distribution differ
+// from each other. Templates can access the profile of the target
+// distribution as "Distro" and vary their output by its fields. For example,
+// the interface templates branch on {{if .Distro.TypeHashes}} to choose
+// between type hashes returned by the C bindings and ones computed by the
+// generator.
+type Distro struct {
+	Name string
+	// TypeHashes is true if the C bindings of interfaces provide the type
+	// hash and type description functions introduced in Iron. If true, the
+	// type supports of generated interfaces implement types.TypeHasher.
+	TypeHashes bool
+	// ExtraIncludeDirs are the packages, in addition to the interface
+	// packages and their dependencies, whose include directories are needed
+	// to compile the generated bindings.
+	ExtraIncludeDirs []string
+	// ErrorTypeFiles are the C headers, relative to an include directory,
+	// from which rcl and rmw return codes are parsed.
+	ErrorTypeFiles []string
+}
+
+// ironIncludeDirs are needed by the type hash, type description and service
+// introspection support added in Iron.
+var ironIncludeDirs = []string{
+	"rcutils",
+	"rosidl_dynamic_typesupport",
+	"service_msgs",
+	"type_description_interfaces",
+}
+
+// Distros contains the profiles of the supported ROS 2 distributions by name.
+var Distros = map[string]*Distro{
+	"humble": {
+		Name:           "humble",
+		ErrorTypeFiles: cErrorTypeFiles,
+	},
+	"iron": {
+		Name:             "iron",
+		TypeHashes:       true,
+		ExtraIncludeDirs: ironIncludeDirs,
+		ErrorTypeFiles:   cErrorTypeFiles,
+	},
+	"jazzy": {
+		Name:             "jazzy",
+		TypeHashes:       true,
+		ExtraIncludeDirs: ironIncludeDirs,
+		ErrorTypeFiles:   cErrorTypeFiles,
+	},
+	"kilted": {
+		Name:             "kilted",
+		TypeHashes:       true,
+		ExtraIncludeDirs: ironIncludeDirs,
+		ErrorTypeFiles:   cErrorTypeFiles,
+	},
+}
+
+// DistroNames returns the names of the supported distributions sorted
+// alphabetically.
+func DistroNames() []string {
+	names := make([]string, 0, len(Distros))
+	for name := range Distros {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// LookupDistro returns the profile of the distribution called name. An empty
+// name means DefaultDistro.
+func LookupDistro(name string) (*Distro, error) {
+	if name == "" {
+		name = DefaultDistro
+	}
+	if d, ok := Distros[name]; ok {
+		return d, nil
+	}
+	return nil, fmt.Errorf("unsupported ROS 2 distribution %q, must be one of %s", name, strings.Join(DistroNames(), ", "))
+}
+
+// distro returns the profile of the target distribution. Config.TargetDistro
+// must have been validated using LookupDistro.
+func (g *Generator) distro() *Distro {
+	d, err := LookupDistro(g.config.TargetDistro)
+	if err != nil {
+		return Distros[DefaultDistro]
+	}
+	return d
+}
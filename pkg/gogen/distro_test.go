@@ -0,0 +1,112 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package gogen
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/bradleyjkemp/cupaloy/v2"
+	. "github.com/smartystreets/goconvey/convey" //nolint:revive
+)
+
+func TestTargetDistros(t *testing.T) {
+	SetDefaultFailureMode(FailureContinues)
+
+	Convey("Files generated for each distribution match golden files", t, func() {
+		root := t.TempDir()
+		writeTestFiles(root, map[string]string{
+			"share/demo_msgs/msg/Point.msg": "float64 x\n",
+			"share/demo_msgs/srv/Reset.srv": "---\nbool ok\n",
+		})
+		goldenFiles := []string{
+			"demo_msgs/msg/Point.gen.go",
+			"demo_msgs/msg/common.gen.go",
+			"demo_msgs/srv/Reset.gen.go",
+			"pkg/rclgo/flags.gen.go",
+		}
+		for _, name := range DistroNames() {
+			dest := t.TempDir()
+			config := &Config{
+				RootPaths:           []string{root},
+				DestPath:            dest,
+				RclgoImportPath:     DefaultConfig.RclgoImportPath,
+				MessageModulePrefix: "example.com/msgs",
+				TargetDistro:        name,
+			}
+			g := New(config)
+			So(g.GenerateGolangMessageTypes(), ShouldBeNil)
+			So(g.GenerateRclgoFlags(), ShouldBeNil)
+			for _, file := range goldenFiles {
+				data, err := os.ReadFile(filepath.Join(dest, filepath.FromSlash(file)))
+				So(err, ShouldBeNil)
+				content := strings.ReplaceAll(string(data), root, "/opt/ros/"+name)
+				snapshotName := strings.NewReplacer("/", "-", ".go", "").Replace(file)
+				So(cupaloy.SnapshotMulti(name+"-"+snapshotName, content), ShouldBeNil)
+			}
+		}
+	})
+
+	Convey("Type hashes of actions are provided by the typesupport on Iron and newer", t, func() {
+		root := t.TempDir()
+		writeTestFiles(root, map[string]string{
+			"share/demo_msgs/action/Move.action": "int32 goal\n---\n---\n",
+		})
+		generate := func(distro string) string {
+			dest := t.TempDir()
+			So(New(&Config{
+				RootPaths:           []string{root},
+				DestPath:            dest,
+				RclgoImportPath:     DefaultConfig.RclgoImportPath,
+				MessageModulePrefix: "example.com/msgs",
+				TargetDistro:        distro,
+			}).GenerateGolangMessageTypes(), ShouldBeNil)
+			data, err := os.ReadFile(filepath.Join(dest, "demo_msgs/action/Move.gen.go"))
+			So(err, ShouldBeNil)
+			return string(data)
+		}
+		So(generate("humble"), ShouldNotContainSubstring, "get_type_hash")
+		So(generate("humble"), ShouldContainSubstring, "return `RIHS01_")
+		So(generate("jazzy"), ShouldContainSubstring, "C.demo_msgs__action__Move__get_type_hash(nil)")
+	})
+
+	Convey("Return codes are parsed from the error headers of the distribution", t, func() {
+		root := t.TempDir()
+		writeTestFiles(root, map[string]string{
+			"include/rcl/types.h":  "#define RCL_RET_OK 0\n",
+			"include/rcl/custom.h": "#define RCL_RET_CUSTOM 4242\n",
+		})
+		Distros["custom"] = &Distro{Name: "custom", ErrorTypeFiles: []string{"rcl/custom.h"}}
+		defer delete(Distros, "custom")
+		dest := t.TempDir()
+		So(New(&Config{
+			RootPaths:       []string{root},
+			DestPath:        dest,
+			RclgoImportPath: DefaultConfig.RclgoImportPath,
+			TargetDistro:    "custom",
+		}).GenerateROS2ErrorTypes(), ShouldBeNil)
+		data, err := os.ReadFile(filepath.Join(dest, "pkg/rclgo/errortypes.gen.go"))
+		So(err, ShouldBeNil)
+		So(string(data), ShouldContainSubstring, "#include <rcl/custom.h>")
+		So(string(data), ShouldContainSubstring, "rclRetCode: 4242")
+		So(string(data), ShouldNotContainSubstring, "rcl/types.h")
+	})
+
+	Convey("Unsupported distributions are rejected", t, func() {
+		_, err := LookupDistro("foxy")
+		So(err, ShouldNotBeNil)
+		d, err := LookupDistro("")
+		So(err, ShouldBeNil)
+		So(d.Name, ShouldEqual, DefaultDistro)
+		So(New(&Config{TargetDistro: "foxy"}).GenerateGolangMessageTypes(), ShouldNotBeNil)
+	})
+}
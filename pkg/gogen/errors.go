@@ -8,23 +8,19 @@ import (
 	"github.com/kivilahtio/go-re/v0"
 )
 
-var errorTypesCFileMatchingRegexp string
-
-func init() {
-	prepareErrorTypesCFileMatchingRegexp()
-}
-
 /*
-prepareErrorTypesCFileMatchingRegexp is a convenience function to be more easily able to define the C header files to look for error definitions without needing to fiddle with complex regexp
+errorTypesCFileMatchingRegexp is a convenience function to be more easily able to define the C header files to look for error definitions without needing to fiddle with complex regexp
 */
-func prepareErrorTypesCFileMatchingRegexp() {
-	errorTypesCFileMatchingRegexp = "(" + strings.Join(cErrorTypeFiles, ")|(") + ")"
-	re.R(&errorTypesCFileMatchingRegexp, `s!\!!\!!`)
-	errorTypesCFileMatchingRegexp = "m!" + errorTypesCFileMatchingRegexp + "!"
+func errorTypesCFileMatchingRegexp(files []string) string {
+	pattern := "(" + strings.Join(files, ")|(") + ")"
+	re.R(&pattern, `s!\!!\!!`)
+	return "m!" + pattern + "!"
 }
 
 func (g *Generator) GenerateROS2ErrorTypes() error {
 	destFilePath := filepath.Join(g.config.DestPath, "pkg/rclgo/errortypes.gen.go")
+	errorTypeFiles := g.distro().ErrorTypeFiles
+	fileRegexp := errorTypesCFileMatchingRegexp(errorTypeFiles)
 	var errorTypes []*ROS2ErrorType
 
 	for _, includeLookupDir := range g.config.RootPaths {
@@ -32,7 +28,7 @@ func (g *Generator) GenerateROS2ErrorTypes() error {
 			PrintErrf("Looking for rcl C include files to parse error definitions from '%s'\n", includeLookupDir)
 
 			filepath.Walk(includeLookupDir, func(path string, info os.FileInfo, err error) error { //nolint:errcheck
-				if err == nil && re.M(path, errorTypesCFileMatchingRegexp) {
+				if err == nil && re.M(path, fileRegexp) {
 					PrintErrf("Analyzing: %s\n", path)
 					errorTypes, err = generateGolangErrorTypesFromROS2ErrorDefinitionsPath(errorTypes, path)
 					if err != nil {
@@ -63,7 +59,7 @@ func (g *Generator) GenerateROS2ErrorTypes() error {
 		ros2ErrorCodes,
 		templateData{
 			"errorTypes":  errorTypes,
-			"includes":    cErrorTypeFiles,
+			"includes":    errorTypeFiles,
 			"dedupFilter": ros2errorTypesDeduplicationFilter,
 		},
 	)
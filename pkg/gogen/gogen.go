@@ -23,7 +23,6 @@ import (
 	"text/template"
 
 	"github.com/alessio/shellescape"
-	"github.com/kivilahtio/go-re/v0"
 )
 
 type Rule struct {
@@ -61,6 +60,40 @@ type Config struct {
 	GoPkgIncludes  []string
 
 	LicenseHeader string
+
+	// If Check is true, generated files are compared to the files in
+	// DestPath instead of being written. See Generator.CheckResult.
+	Check bool
+	// If Strict is true, GenerateGolangMessageTypes returns an error if any
+	// errors were found. The errors are available from
+	// Generator.Diagnostics.
+	Strict bool
+	// DiagnosticsFormat is DiagnosticsText (the default) if diagnostics are
+	// printed to stderr as they are found, or DiagnosticsJSON if they are
+	// not printed.
+	DiagnosticsFormat string
+
+	// EnumFields maps fields to the prefixes of the constant groups whose Go
+	// enum type the fields use, for example
+	// "action_msgs/msg/GoalStatus.status" to "STATUS".
+	EnumFields map[string]string
+
+	// TemplateOverrides replace the built-in templates of the given kinds.
+	TemplateOverrides map[TemplateKind]*template.Template
+	// ExtraTemplates generate additional files for each interface.
+	ExtraTemplates []ExtraTemplate
+
+	// Blacklist contains regular expressions matched against the paths of
+	// interface files in addition to the built-in blacklist. Matching
+	// interfaces are not generated.
+	Blacklist []*regexp.Regexp
+	// TypeRenames maps messages, such as "std_msgs/msg/String", to the names
+	// of their generated Go types.
+	TypeRenames map[string]string
+
+	// TargetDistro is the name of the ROS 2 distribution bindings are
+	// generated for. See Distros. If empty, DefaultDistro is used.
+	TargetDistro string
 }
 
 var DefaultConfig = Config{
@@ -83,12 +116,29 @@ type Generator struct {
 	cImportsByPkgAndType map[string]stringSet
 	allPkgs              map[string]*rosPkgRef
 	actionMsgsNeeded     bool
+
+	oldManifest  *manifest
+	newManifest  *manifest
+	currentEntry *manifestEntry // Manifest entry of the files being generated
+	summary      GenerationSummary
+
+	rendered    stringSet // Files rendered in check mode
+	checkResult *CheckResult
+
+	diagnostics []Diagnostic
+
+	// knownTypes contains the descriptions of the types which have been
+	// parsed, keyed by type name. See lookupType.
+	knownTypes   map[string]*knownType
+	sourceHashes map[string]string // Hashes of dependency source files
 }
 
 func New(config *Config) *Generator {
 	return &Generator{
 		config:               config,
 		cImportsByPkgAndType: make(map[string]stringSet),
+		rendered:             stringSet{},
+		checkResult:          &CheckResult{Drifted: map[string]string{}},
 	}
 }
 
@@ -106,7 +156,7 @@ func (g *Generator) GenerateRclgoFlags() error {
 		"rclgo flags",
 		filepath.Join(g.config.DestPath, "pkg/rclgo/flags.gen.go"),
 		rclgoFlags,
-		templateData{"ROSIncludes": rclgoROSIncludes},
+		templateData{"ROSIncludes": g.rclgoIncludeDirs()},
 	)
 }
 
@@ -126,16 +176,26 @@ func (g *Generator) generateRclgoFile(fileType, destFilePath string, tmpl *templ
 
 func (g *Generator) GenerateROS2AllMessagesImporter() error {
 	pkgs := map[string]struct{}{}
-	for _, glob := range []string{"*/msg", "*/srv", "*/action"} {
-		dirs, err := filepath.Glob(filepath.Join(g.config.DestPath, glob))
-		if err != nil {
-			return err
+	if g.config.Check {
+		// Nothing was written, so the packages are the ones which were
+		// rendered.
+		for file := range g.rendered {
+			if dir := path.Dir(file); dir != "." {
+				pkgs[dir] = struct{}{}
+			}
 		}
-		for _, d := range dirs {
-			pkgs[path.Join(
-				filepath.Base(filepath.Dir(d)),
-				filepath.Base(d),
-			)] = struct{}{}
+	} else {
+		for _, glob := range interfacePackageGlobs {
+			dirs, err := filepath.Glob(filepath.Join(g.config.DestPath, glob))
+			if err != nil {
+				return err
+			}
+			for _, d := range dirs {
+				pkgs[path.Join(
+					filepath.Base(filepath.Dir(d)),
+					filepath.Base(d),
+				)] = struct{}{}
+			}
 		}
 	}
 	return g.generateGoFile(
@@ -150,6 +210,7 @@ var rclgoROSIncludes = []string{
 	"rmw",
 	"rosidl_runtime_c",
 	"rosidl_typesupport_interface",
+	"rosidl_typesupport_introspection_c",
 	"rcutils",
 	"rcl_action",
 	"action_msgs",
@@ -158,6 +219,23 @@ var rclgoROSIncludes = []string{
 	"rcl_yaml_param_parser",
 }
 
+// rclgoIncludeDirs returns the packages whose include directories are needed
+// to compile rclgo for the target distribution.
+func (g *Generator) rclgoIncludeDirs() []string {
+	dirs := append([]string(nil), rclgoROSIncludes...)
+	found := stringSet{}
+	for _, dir := range dirs {
+		found.Add(dir)
+	}
+	for _, dir := range g.distro().ExtraIncludeDirs {
+		if _, ok := found[dir]; !ok {
+			found.Add(dir)
+			dirs = append(dirs, dir)
+		}
+	}
+	return dirs
+}
+
 func includeDirFlag(rootPath, rosPkg string) string {
 	return fmt.Sprintf("-I%s", filepath.Join(rootPath, "include", rosPkg))
 }
@@ -184,7 +262,7 @@ func (g *Generator) GenerateCGOFlags() error {
 	includes := stringSet{}
 	for _, rootPath := range g.config.RootPaths {
 		libDirs.Add(libDirFlag(rootPath))
-		for _, dep := range rclgoROSIncludes {
+		for _, dep := range g.rclgoIncludeDirs() {
 			includes.Add(includeDirFlag(rootPath, dep))
 		}
 		for pkgAndType, imports := range g.cImportsByPkgAndType {
@@ -216,11 +294,40 @@ func (g *Generator) GenerateCGOFlags() error {
 
 type rosPkgRef struct {
 	Interfaces map[Metadata]string
-	Generated  bool
+	// Deps contains the dependencies declared in the package.xml file of the
+	// package. It is only set for packages found using the ament resource
+	// index.
+	Deps      []string
+	Generated bool
 }
 
+// GenerateGolangMessageTypes generates Go bindings for the interfaces found in
+// the root paths. Interfaces whose files are up to date according to the
+// manifest in the destination directory are skipped, and the files of
+// interfaces which no longer exist are removed.
 func (g *Generator) GenerateGolangMessageTypes() error {
+	_, err := LookupDistro(g.config.TargetDistro)
+	if err != nil {
+		return err
+	}
+	if err = validateTemplates(g.config); err != nil {
+		return err
+	}
+	if err = validateTypeRenames(g.config.TypeRenames); err != nil {
+		return err
+	}
+	if g.config.Check {
+		g.oldManifest = newManifest()
+	} else if g.oldManifest, err = readManifest(g.config.DestPath); err != nil {
+		g.warnf(filepath.Join(g.config.DestPath, ManifestFileName), "failed to read the generation manifest, regenerating all files: %w", err)
+		g.oldManifest = newManifest()
+	}
+	g.newManifest = newManifest()
+	g.summary = GenerationSummary{}
 	g.findPackages()
+	if rosType, err := findTypeRenameCollision(g.config.TypeRenames, g.hasInterface); err != nil {
+		return fmt.Errorf("type rename %s: %w", rosType, err)
+	}
 	if len(g.config.RegexIncludes) == 0 && len(g.config.ROSPkgIncludes) == 0 && len(g.config.GoPkgIncludes) == 0 {
 		for pkg := range g.allPkgs {
 			g.generatePkg(pkg, false)
@@ -249,19 +356,42 @@ func (g *Generator) GenerateGolangMessageTypes() error {
 			}
 		}
 	}
+	g.currentEntry = &manifestEntry{
+		GeneratorVersion: generatorVersion(),
+		ConfigHash:       configHash(g.config),
+	}
 	for pkgAndType, imports := range g.cImportsByPkgAndType {
 		err := g.generateCommonPackageGoFile(pkgAndType, imports)
 		if err != nil {
-			PrintErrf("Failed to generate common package file for package %s: %v\n", pkgAndType, err)
+			g.errorf("", "failed to generate common package file for package %s: %w", pkgAndType, err)
 		}
 	}
+	g.currentEntry = nil
+	if !g.config.Check {
+		g.removeStaleFiles()
+		if err := g.newManifest.write(g.config.DestPath); err != nil {
+			return fmt.Errorf("failed to write the generation manifest: %w", err)
+		}
+		PrintErrf(
+			"Generated %d interfaces, skipped %d unchanged interfaces, %d interfaces failed, removed %d stale files\n",
+			g.summary.Generated, g.summary.Unchanged, g.summary.Failed, g.summary.Removed,
+		)
+	}
+	if n := g.errorCount(); g.config.Strict && n > 0 {
+		return fmt.Errorf("generation failed with %d errors", n)
+	}
 	return nil
 }
 
+// Summary returns the summary of the last call to GenerateGolangMessageTypes.
+func (g *Generator) Summary() GenerationSummary {
+	return g.summary
+}
+
 func (g *Generator) generatePkg(pkg string, genDeps bool) {
 	ref := g.allPkgs[pkg]
 	if ref == nil {
-		PrintErrf("Failed to generate package %s: package not found\n", pkg)
+		g.errorf("", "failed to generate package %s: package not found", pkg)
 	} else if !ref.Generated {
 		ref.Generated = true
 		for meta, path := range ref.Interfaces {
@@ -277,6 +407,11 @@ func (g *Generator) generatePkg(pkg string, genDeps bool) {
 			for imp := range g.cImportsByPkgAndType[pkg+"_action"] {
 				g.generatePkg(imp, genDeps)
 			}
+			for _, dep := range ref.Deps {
+				if g.allPkgs[dep] != nil {
+					g.generatePkg(dep, genDeps)
+				}
+			}
 		}
 	}
 }
@@ -291,27 +426,53 @@ func (g *Generator) getCImportsForPkgAndType(pkgAndType string) stringSet {
 }
 
 func (g *Generator) generateInterface(meta Metadata, ifacePath string) {
+	sourceHash, err := hashFile(ifacePath)
+	if err != nil {
+		g.errorf(ifacePath, "failed to read interface file: %w", err)
+		g.summary.Failed++
+		return
+	}
+	g.currentEntry = &manifestEntry{
+		Source:           ifacePath,
+		SourceHash:       sourceHash,
+		GeneratorVersion: generatorVersion(),
+		ConfigHash:       configHash(g.config),
+	}
+	defer func() { g.currentEntry = nil }()
+	if g.skipUnchanged(ifacePath, g.getCImportsForPkgAndType(meta.GoPackage())) {
+		if meta.Type == "action" {
+			g.actionMsgsNeeded = true
+		}
+		g.summary.Unchanged++
+		return
+	}
 	PrintErrf("Generating: %s\n", ifacePath)
 	switch meta.Type {
 	case "msg":
-		result, err := g.generateMessage(&meta, ifacePath)
+		var result *ROS2Message
+		result, err = g.generateMessage(&meta, ifacePath)
 		if err != nil {
-			PrintErrf("Error converting ROS2 Message '%s' to '%s', error: %v\n", ifacePath, g.config.DestPath, err)
+			err = fmt.Errorf("failed to convert message: %w", err)
+			break
 		}
 		set := g.getCImportsForPkgAndType(result.GoPackage())
 		set.AddFrom(result.CImports)
 	case "srv":
-		result, err := g.generateService(&meta, ifacePath)
+		var result *ROS2Service
+		result, err = g.generateService(&meta, ifacePath)
 		if err != nil {
-			PrintErrf("Error converting ROS2 Service '%s' to '%s', error: %v\n", ifacePath, g.config.DestPath, err)
+			err = fmt.Errorf("failed to convert service: %w", err)
+			break
 		}
 		set := g.getCImportsForPkgAndType(result.GoPackage())
 		set.AddFrom(result.Request.CImports)
 		set.AddFrom(result.Response.CImports)
 	case "action":
-		result, err := g.generateAction(ifacePath)
+		var result *ROS2Action
+		result, err = g.generateAction(ifacePath)
 		if err != nil {
-			PrintErrf("Error converting ROS2 Action '%s' to '%s', error: %v\n", ifacePath, g.config.DestPath, err)
+			err = fmt.Errorf("failed to convert action: %w", err)
+			break
 		}
 		g.actionMsgsNeeded = true
 		set := g.getCImportsForPkgAndType(result.GoPackage())
@@ -324,58 +485,97 @@ func (g *Generator) generateInterface(meta Metadata, ifacePath string) {
 		set.AddFrom(result.Feedback.CImports)
 		set.AddFrom(result.FeedbackMessage.CImports)
 	default:
-		PrintErrf("Interface file %s has an invalid type: %s\n", ifacePath, meta.Type)
+		err = fmt.Errorf("invalid interface type: %s", meta.Type)
 	}
+	if err != nil {
+		g.errorf(ifacePath, "%w", err)
+		g.summary.Failed++
+		// Files generated before the error are incomplete, so they must not
+		// be treated as up to date on the next run.
+		for _, file := range g.newManifest.generatedFrom(ifacePath) {
+			delete(g.newManifest.Files, file)
+		}
+		return
+	}
+	g.summary.Generated++
 }
 
+// findPackages finds the interface definitions in the root paths. Paths
+// earlier in the list take precedence. Interfaces are looked up from the ament
+// resource index of each root path, and if a root path has no index, the
+// whole root path is searched instead.
 func (g *Generator) findPackages() {
 	g.allPkgs = map[string]*rosPkgRef{}
 	for i := len(g.config.RootPaths) - 1; i >= 0; i-- {
-		filepath.Walk(g.config.RootPaths[i], func(path string, info fs.FileInfo, err error) error { //nolint:errcheck
-			skip, blacklistEntry := blacklisted(path)
-			if skip {
-				PrintErrf("Blacklisted: %s, matched regex '%s'\n", path, blacklistEntry)
-				return nil
-			}
-			if re.M(filepath.ToSlash(path), `m!/(msg/.+\.msg)|(srv/.+\.srv)|(action/.+\.action)$!`) {
-				meta, err := parseMetadataFromPath(path)
-				if err != nil {
-					PrintErrf("Failed to parse metadata from path %s: %v\n", path, err)
-				} else {
-					ref := g.allPkgs[meta.Package]
-					if ref == nil {
-						ref = &rosPkgRef{Interfaces: map[Metadata]string{}}
-						g.allPkgs[meta.Package] = ref
-					}
-					ref.Interfaces[*meta] = path
-				}
-			}
-			return nil
-		})
+		root := g.config.RootPaths[i]
+		found, deps, err := g.findIndexedInterfaces(root)
+		if errors.Is(err, fs.ErrNotExist) {
+			found = g.walkInterfaces(root)
+		} else if err != nil {
+			g.warnf(root, "failed to read the ament resource index: %w", err)
+			found = g.walkInterfaces(root)
+		}
+		for meta, path := range found {
+			g.getPkgRef(meta.Package).Interfaces[meta] = path
+		}
+		for pkg, pkgDeps := range deps {
+			g.getPkgRef(pkg).Deps = pkgDeps
+		}
 	}
 }
 
+func (g *Generator) getPkgRef(pkg string) *rosPkgRef {
+	ref := g.allPkgs[pkg]
+	if ref == nil {
+		ref = &rosPkgRef{Interfaces: map[Metadata]string{}}
+		g.allPkgs[pkg] = ref
+	}
+	return ref
+}
+
+func isIDLPath(p string) bool {
+	return filepath.Ext(p) == ".idl"
+}
+
 func (g *Generator) generateMessage(md *Metadata, sourcePath string) (*ROS2Message, error) {
+	msg, parser, err := g.parseMessage(md, sourcePath)
+	if err != nil {
+		return nil, err
+	}
+	g.addMessageTypes(parser, sourcePath, msg)
+	err = g.generateMessageGoFile(parser, msg)
+	if err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+func (g *Generator) parseMessage(md *Metadata, sourcePath string) (*ROS2Message, *parser, error) {
 	msg := ROS2MessageNew("", "")
 	var err error
 
 	msg.Metadata = md
+	msg.GoName = g.config.goTypeName(md.Package, md.Name)
 
 	content, err := os.ReadFile(sourcePath)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	parser := parser{config: g.config}
-	err = parser.ParseROS2Message(msg, string(content))
-	if err != nil {
-		return nil, err
+	parser := &parser{config: g.config}
+	if isIDLPath(sourcePath) {
+		err = parser.ParseIDLMessage(msg, string(content))
+	} else {
+		err = parser.ParseROS2Message(msg, string(content))
 	}
-	err = g.generateMessageGoFile(&parser, msg)
+	g.addParserWarnings(sourcePath, parser)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	return msg, nil
+	if hasTimeHelpers(msg) {
+		msg.GoImports["time"] = ""
+	}
+	return msg, parser, nil
 }
 
 func parseMetadataFromPath(p string) (*Metadata, error) {
@@ -385,6 +585,9 @@ func parseMetadataFromPath(p string) (*Metadata, error) {
 		Name: strings.TrimSuffix(base, ext),
 		Type: ext[1:],
 	}
+	if isIDLPath(p) {
+		m.Type = filepath.Base(filepath.Dir(p))
+	}
 	dirs := strings.Split(p, string(filepath.Separator))
 
 	if len(dirs) >= 2 {
@@ -401,97 +604,164 @@ func ifaceFilePath(destPathPkgRoot string, m *Metadata) string {
 }
 
 func (g *Generator) generateService(m *Metadata, srcPath string) (*ROS2Service, error) {
-	service := NewROS2Service(m.Package, m.Name)
-	srcFile, err := os.ReadFile(filepath.Clean(srcPath))
+	service, parser, err := g.parseService(m, srcPath)
 	if err != nil {
 		return nil, err
 	}
-	parser := parser{config: g.config}
-	if err = parser.ParseService(service, string(srcFile)); err != nil {
-		return nil, err
-	}
-	err = g.generateServiceGoFiles(&parser, service)
+	g.addMessageTypes(parser, srcPath, service.Request, service.Response)
+	g.addServiceType(service, srcPath)
+	err = g.generateServiceGoFiles(parser, service)
 	if err != nil {
 		return nil, err
 	}
 	return service, nil
 }
 
+func (g *Generator) parseService(m *Metadata, srcPath string) (*ROS2Service, *parser, error) {
+	service := NewROS2Service(m.Package, m.Name)
+	srcFile, err := os.ReadFile(filepath.Clean(srcPath))
+	if err != nil {
+		return nil, nil, err
+	}
+	parser := &parser{config: g.config}
+	if isIDLPath(srcPath) {
+		err = parser.ParseIDLService(service, string(srcFile))
+	} else {
+		err = parser.ParseService(service, string(srcFile))
+	}
+	g.addParserWarnings(srcPath, parser)
+	if err != nil {
+		return nil, nil, err
+	}
+	return service, parser, nil
+}
+
 func (g *Generator) generateAction(srcPath string) (*ROS2Action, error) {
 	m, err := parseMetadataFromPath(srcPath)
 	if err != nil {
 		return nil, err
 	}
-	action := NewROS2Action(m.Package, m.Name)
-	srcFile, err := os.ReadFile(srcPath)
+	action, parser, err := g.parseAction(m, srcPath)
 	if err != nil {
 		return nil, err
 	}
-	parser := parser{config: g.config}
-	if err = parser.ParseAction(action, string(srcFile)); err != nil {
-		return nil, err
-	}
+	g.addMessageTypes(
+		parser,
+		srcPath,
+		action.Goal,
+		action.Result,
+		action.Feedback,
+		action.SendGoal.Request,
+		action.SendGoal.Response,
+		action.GetResult.Request,
+		action.GetResult.Response,
+		action.FeedbackMessage,
+	)
+	g.addServiceType(action.SendGoal, srcPath)
+	g.addServiceType(action.GetResult, srcPath)
+	g.addActionType(action, srcPath)
 	err = g.generateIfaceGoFile(
+		ActionTemplate,
 		action.Metadata,
+		nil,
 		ros2ActionToGolangTypeTemplate,
-		templateData{"Action": action},
+		templateData{
+			"Action":      action,
+			"Description": g.describe(action.Metadata, false),
+		},
 	)
 	if err != nil {
 		return nil, err
 	}
-	err = g.generateMessageGoFile(&parser, action.Goal)
+	err = g.generateMessageGoFile(parser, action.Goal)
 	if err != nil {
 		return nil, err
 	}
-	err = g.generateMessageGoFile(&parser, action.Result)
+	err = g.generateMessageGoFile(parser, action.Result)
 	if err != nil {
 		return nil, err
 	}
-	err = g.generateMessageGoFile(&parser, action.Feedback)
+	err = g.generateMessageGoFile(parser, action.Feedback)
 	if err != nil {
 		return nil, err
 	}
-	err = g.generateServiceGoFiles(&parser, action.SendGoal)
+	err = g.generateServiceGoFiles(parser, action.SendGoal)
 	if err != nil {
 		return nil, err
 	}
-	err = g.generateServiceGoFiles(&parser, action.GetResult)
+	err = g.generateServiceGoFiles(parser, action.GetResult)
 	if err != nil {
 		return nil, err
 	}
-	err = g.generateMessageGoFile(&parser, action.FeedbackMessage)
+	err = g.generateMessageGoFile(parser, action.FeedbackMessage)
 	if err != nil {
 		return nil, err
 	}
 	return action, nil
 }
 
+func (g *Generator) parseAction(m *Metadata, srcPath string) (*ROS2Action, *parser, error) {
+	action := NewROS2Action(m.Package, m.Name)
+	srcFile, err := os.ReadFile(filepath.Clean(srcPath))
+	if err != nil {
+		return nil, nil, err
+	}
+	parser := &parser{config: g.config}
+	if isIDLPath(srcPath) {
+		err = parser.ParseIDLAction(action, string(srcFile))
+	} else {
+		err = parser.ParseAction(action, string(srcFile))
+	}
+	g.addParserWarnings(srcPath, parser)
+	if err != nil {
+		return nil, nil, err
+	}
+	return action, parser, nil
+}
+
 type templateData = map[string]interface{}
 
 func (g *Generator) generateGoFile(destPath string, tmpl *template.Template, data templateData) error {
+	if data == nil {
+		data = templateData{}
+	}
+	if _, ok := data["Config"]; !ok {
+		data["Config"] = g.config
+	}
+	if _, ok := data["Distro"]; !ok {
+		data["Distro"] = g.distro()
+	}
+	if g.config.Check {
+		return g.checkGoFile(destPath, tmpl, data)
+	}
 	f, err := mkdir_p(destPath)
 	if err != nil {
 		return err
 	}
 	defer f.Close()
-	if data == nil {
-		data = templateData{"Config": g.config}
-	} else if _, ok := data["Config"]; !ok {
-		data["Config"] = g.config
-	}
 	return tmpl.Execute(f, data)
 }
 
-func (g *Generator) generateIfaceGoFile(meta *Metadata, tmpl *template.Template, data templateData) error {
-	return g.generateGoFile(ifaceFilePath(g.config.DestPath, meta), tmpl, data)
+func (g *Generator) generateIfaceGoFile(kind TemplateKind, meta *Metadata, cImports stringSet, builtin *template.Template, data templateData) error {
+	destPath := ifaceFilePath(g.config.DestPath, meta)
+	if err := g.generateGoFile(destPath, g.template(kind, builtin), data); err != nil {
+		return err
+	}
+	if g.currentEntry != nil {
+		g.recordOutput(destPath, cImports)
+	}
+	return g.generateExtraFiles(kind, meta, cImports, data)
 }
 
 func (g *Generator) generateMessageGoFile(parser *parser, msg *ROS2Message) error {
 	return g.generateIfaceGoFile(
+		MessageTemplate,
 		msg.Metadata,
+		msg.CImports,
 		ros2MsgToGolangTypeTemplate,
 		templateData{
 			"Message":             msg,
+			"Description":         g.describe(msg.Metadata, true),
 			"cSerializationCode":  parser.cSerializationCode,
 			"goSerializationCode": parser.goSerializationCode,
 		},
@@ -500,9 +770,14 @@ func (g *Generator) generateMessageGoFile(parser *parser, msg *ROS2Message) erro
 
 func (g *Generator) generateServiceGoFiles(parser *parser, srv *ROS2Service) error {
 	err := g.generateIfaceGoFile(
+		ServiceTemplate,
 		srv.Metadata,
+		nil,
 		ros2ServiceToGolangTypeTemplate,
-		templateData{"Service": srv},
+		templateData{
+			"Service":     srv,
+			"Description": g.describe(srv.Metadata, false),
+		},
 	)
 	if err != nil {
 		return err
@@ -519,15 +794,23 @@ func (g *Generator) generateCommonPackageGoFile(pkgAndType string, cImports stri
 	if err != nil {
 		return err
 	}
-	return g.generateGoFile(
-		filepath.Join(g.config.DestPath, cPkg, pkgType, "common.gen.go"),
-		ros2PackageCommonTemplate,
+	destPath := filepath.Join(g.config.DestPath, cPkg, pkgType, "common.gen.go")
+	err = g.generateGoFile(
+		destPath,
+		g.template(CommonPackageTemplate, ros2PackageCommonTemplate),
 		templateData{
 			"GoPackage": pkgAndType,
 			"CPackage":  cPkg,
 			"CImports":  cImports,
 		},
 	)
+	if err != nil {
+		return err
+	}
+	if g.currentEntry != nil {
+		g.recordOutput(destPath, nil)
+	}
+	return nil
 }
 
 func parsePkgAndType(pkgAndType string) (pkg string, typ string, err error) {
@@ -0,0 +1,776 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package gogen
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+/*
+This file implements the subset of OMG IDL used by ROS 2 interface definitions,
+see https://design.ros2.org/articles/idl_interface_definition.html. The parsed
+definitions are converted to the same models as .msg, .srv and .action files
+so that the templates do not need to know where a definition came from.
+
+Supported are modules, structs, constants, typedefs, sequences, bounded
+strings, arrays and the @default and @verbatim annotations. Other annotations
+are ignored. Unions, enums and multidimensional arrays are not supported.
+*/
+
+type idlTokenKind int
+
+const (
+	idlIdent idlTokenKind = iota
+	idlNumber
+	idlString
+	idlPunct
+)
+
+type idlToken struct {
+	kind   idlTokenKind
+	text   string // Unquoted value of string literals
+	line   int
+	column int
+}
+
+func (t idlToken) String() string {
+	if t.kind == idlString {
+		return strconv.Quote(t.text)
+	}
+	return t.text
+}
+
+func isIDLIdentByte(c byte, first bool) bool {
+	return c == '_' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || !first && '0' <= c && c <= '9'
+}
+
+func isIDLDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+// tokenizeIDL splits source into tokens. Comments and preprocessor directives
+// are skipped, because ROS 2 IDL files use #include only to reference other
+// interfaces, which are resolved by name instead.
+func tokenizeIDL(source string) ([]idlToken, error) {
+	var toks []idlToken
+	line := 1
+	lineStart := true
+	lineOffset := 0
+	errorf := func(i int, format string, args ...interface{}) error {
+		return &SyntaxError{Line: line, Column: i - lineOffset + 1, Err: fmt.Errorf(format, args...)}
+	}
+	for i := 0; i < len(source); {
+		c := source[i]
+		switch {
+		case c == '\n':
+			line++
+			lineStart = true
+			i++
+			lineOffset = i
+			continue
+		case c == ' ' || c == '\t' || c == '\r':
+			i++
+			continue
+		case c == '#' && lineStart:
+			for i < len(source) && source[i] != '\n' {
+				i++
+			}
+			continue
+		case strings.HasPrefix(source[i:], "//"):
+			for i < len(source) && source[i] != '\n' {
+				i++
+			}
+			continue
+		case strings.HasPrefix(source[i:], "/*"):
+			end := strings.Index(source[i+2:], "*/")
+			if end < 0 {
+				return nil, errorf(i, "unterminated comment")
+			}
+			if n := strings.Count(source[i:i+2+end], "\n"); n > 0 {
+				line += n
+				lineOffset = i + strings.LastIndex(source[i:i+2+end], "\n") + 1
+			}
+			i += end + 4
+			continue
+		}
+		lineStart = false
+		start := i
+		column := i - lineOffset + 1
+		switch {
+		case c == '"' || c == 'L' && strings.HasPrefix(source[i+1:], `"`):
+			if c == 'L' {
+				i++
+				start++
+			}
+			i++
+			for i < len(source) && source[i] != '"' {
+				if source[i] == '\\' {
+					i++
+				}
+				if i < len(source) && source[i] == '\n' {
+					return nil, errorf(start, "unterminated string literal")
+				}
+				i++
+			}
+			if i >= len(source) {
+				return nil, errorf(start, "unterminated string literal")
+			}
+			i++
+			s, err := strconv.Unquote(source[start:i])
+			if err != nil {
+				return nil, errorf(start, "invalid string literal %s: %w", source[start:i], err)
+			}
+			toks = append(toks, idlToken{kind: idlString, text: s, line: line, column: column})
+		case isIDLDigit(c) || (c == '-' || c == '+' || c == '.') && i+1 < len(source) && (isIDLDigit(source[i+1]) || source[i+1] == '.'):
+			i++
+			for i < len(source) {
+				c := source[i]
+				if isIDLIdentByte(c, false) || c == '.' ||
+					(c == '-' || c == '+') && (source[i-1] == 'e' || source[i-1] == 'E') && !strings.HasPrefix(source[start:], "0x") {
+					i++
+				} else {
+					break
+				}
+			}
+			toks = append(toks, idlToken{kind: idlNumber, text: source[start:i], line: line, column: column})
+		case isIDLIdentByte(c, true):
+			for i < len(source) && isIDLIdentByte(source[i], false) {
+				i++
+			}
+			toks = append(toks, idlToken{kind: idlIdent, text: source[start:i], line: line, column: column})
+		case strings.HasPrefix(source[i:], "::"):
+			i += 2
+			toks = append(toks, idlToken{kind: idlPunct, text: "::", line: line, column: column})
+		case strings.IndexByte("{}()<>[];,=@:", c) >= 0:
+			i++
+			toks = append(toks, idlToken{kind: idlPunct, text: source[start:i], line: line, column: column})
+		default:
+			return nil, errorf(i, "unexpected character %q", c)
+		}
+	}
+	return toks, nil
+}
+
+// idlValue is the value of a constant or an annotation parameter.
+type idlValue struct {
+	text     string
+	isString bool
+}
+
+type idlAnnotation struct {
+	name   string
+	params map[string]idlValue
+}
+
+// idlType is a type specification converted to the components of a .msg
+// field type. The names of the components match the capture groups used by
+// isRowConstantOrField.
+type idlType struct {
+	pkg           string
+	name          string // ROS 2 type name, such as float64 or Point
+	boundedString string // <=N for bounded strings
+	array         string // [N] for arrays, [] for sequences and [<=N] for bounded sequences
+	bounded       string // <= for bounded sequences
+	size          string // Size of arrays and bound of bounded sequences
+}
+
+type idlMember struct {
+	typ          *idlType
+	name         string
+	comment      string
+	defaultValue *idlValue
+	line, column int
+}
+
+type idlStruct struct {
+	members []*idlMember
+}
+
+type idlConst struct {
+	typ          *idlType
+	name         string
+	value        idlValue
+	comment      string
+	line, column int
+}
+
+// idlDefinitions contains the definitions of an IDL file. The keys of the maps
+// are fully scoped names, such as sensor_msgs::msg::NavSatStatus.
+type idlDefinitions struct {
+	structs   map[string]*idlStruct
+	constants map[string][]*idlConst // Keyed by the name of the enclosing module
+	typedefs  map[string]*idlType
+}
+
+var idlPrimitiveTypes = map[string]string{
+	"boolean":            "bool",
+	"octet":              "byte",
+	"char":               "char",
+	"float":              "float32",
+	"double":             "float64",
+	"short":              "int16",
+	"unsigned short":     "uint16",
+	"long":               "int32",
+	"unsigned long":      "uint32",
+	"long long":          "int64",
+	"unsigned long long": "uint64",
+	"int8":               "int8",
+	"uint8":              "uint8",
+	"int16":              "int16",
+	"uint16":             "uint16",
+	"int32":              "int32",
+	"uint32":             "uint32",
+	"int64":              "int64",
+	"uint64":             "uint64",
+	"string":             "string",
+	"wstring":            "wstring",
+}
+
+type idlParser struct {
+	toks []idlToken
+	pos  int
+	defs *idlDefinitions
+}
+
+func parseIDLDefinitions(source string) (*idlDefinitions, error) {
+	toks, err := tokenizeIDL(source)
+	if err != nil {
+		return nil, err
+	}
+	p := &idlParser{
+		toks: toks,
+		defs: &idlDefinitions{
+			structs:   map[string]*idlStruct{},
+			constants: map[string][]*idlConst{},
+			typedefs:  map[string]*idlType{},
+		},
+	}
+	if err := p.definitions(nil); err != nil {
+		return nil, err
+	}
+	return p.defs, nil
+}
+
+func (p *idlParser) peek() *idlToken {
+	if p.pos >= len(p.toks) {
+		return nil
+	}
+	return &p.toks[p.pos]
+}
+
+// errorf returns an error at the position of the next token, or at the last
+// token at the end of the file.
+func (p *idlParser) errorf(format string, args ...interface{}) error {
+	err := &SyntaxError{Err: fmt.Errorf(format, args...)}
+	if t := p.peek(); t != nil {
+		err.Line, err.Column = t.line, t.column
+	} else if len(p.toks) > 0 {
+		err.Line, err.Column = p.toks[len(p.toks)-1].line, p.toks[len(p.toks)-1].column
+	}
+	return err
+}
+
+func (p *idlParser) next() (idlToken, error) {
+	t := p.peek()
+	if t == nil {
+		return idlToken{}, p.errorf("unexpected end of file")
+	}
+	p.pos++
+	return *t, nil
+}
+
+func (p *idlParser) accept(text string) bool {
+	if t := p.peek(); t != nil && t.kind != idlString && t.text == text {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *idlParser) expect(text string) error {
+	if !p.accept(text) {
+		if t := p.peek(); t != nil {
+			return p.errorf("expected '%s', got '%s'", text, t)
+		}
+		return p.errorf("expected '%s', got end of file", text)
+	}
+	return nil
+}
+
+func (p *idlParser) ident() (string, error) {
+	t, err := p.next()
+	if err != nil {
+		return "", err
+	}
+	if t.kind != idlIdent {
+		p.pos--
+		return "", p.errorf("expected an identifier, got '%s'", t)
+	}
+	return t.text, nil
+}
+
+func (p *idlParser) positiveInt() (string, error) {
+	t, err := p.next()
+	if err != nil {
+		return "", err
+	}
+	if n, err := strconv.ParseUint(t.text, 0, 31); t.kind != idlNumber || err != nil || n == 0 {
+		p.pos--
+		return "", p.errorf("expected a positive integer, got '%s'", t)
+	}
+	return t.text, nil
+}
+
+func scoped(scope []string, name string) string {
+	return strings.Join(append(scope[:len(scope):len(scope)], name), "::")
+}
+
+func (p *idlParser) definitions(scope []string) error {
+	for {
+		t := p.peek()
+		if t == nil {
+			if len(scope) > 0 {
+				return p.errorf("unexpected end of file in module %s", strings.Join(scope, "::"))
+			}
+			return nil
+		}
+		if t.text == "}" && t.kind == idlPunct {
+			if len(scope) == 0 {
+				return p.errorf("unexpected '}'")
+			}
+			return nil
+		}
+		annotations, err := p.annotations()
+		if err != nil {
+			return err
+		}
+		keyword, err := p.next()
+		if err != nil {
+			return err
+		}
+		switch keyword.text {
+		case "module":
+			name, err := p.ident()
+			if err != nil {
+				return err
+			}
+			if err = p.expect("{"); err != nil {
+				return err
+			}
+			if err = p.definitions(append(scope[:len(scope):len(scope)], name)); err != nil {
+				return err
+			}
+			if err = p.expect("}"); err != nil {
+				return err
+			}
+		case "struct":
+			err = p.structDef(scope)
+		case "const":
+			err = p.constDef(scope, annotations)
+		case "typedef":
+			err = p.typedef(scope)
+		case ";":
+			continue
+		default:
+			return p.errorf("unsupported definition '%s'", keyword)
+		}
+		if err != nil {
+			return err
+		}
+		if err = p.expect(";"); err != nil {
+			return err
+		}
+	}
+}
+
+func (p *idlParser) annotations() ([]idlAnnotation, error) {
+	var annotations []idlAnnotation
+	for p.accept("@") {
+		name, err := p.ident()
+		if err != nil {
+			return nil, err
+		}
+		a := idlAnnotation{name: name, params: map[string]idlValue{}}
+		if p.accept("(") {
+			for !p.accept(")") {
+				key := "value"
+				if t := p.peek(); t != nil && t.kind == idlIdent && p.pos+1 < len(p.toks) &&
+					p.toks[p.pos+1].kind == idlPunct && p.toks[p.pos+1].text == "=" {
+					key = t.text
+					p.pos += 2
+				}
+				v, err := p.value(",", ")")
+				if err != nil {
+					return nil, err
+				}
+				a.params[key] = v
+				p.accept(",")
+			}
+		}
+		annotations = append(annotations, a)
+	}
+	return annotations, nil
+}
+
+// value parses a literal ending before one of the terminators. Adjacent
+// string literals are concatenated.
+func (p *idlParser) value(terminators ...string) (idlValue, error) {
+	var v idlValue
+	var parts []string
+	for {
+		t := p.peek()
+		if t == nil {
+			return v, p.errorf("unexpected end of file")
+		}
+		if t.kind == idlPunct {
+			for _, term := range terminators {
+				if t.text == term {
+					if len(parts) == 0 {
+						return v, p.errorf("expected a value, got '%s'", t)
+					}
+					v.text = strings.Join(parts, "")
+					return v, nil
+				}
+			}
+		}
+		if len(parts) > 0 && v.isString != (t.kind == idlString) {
+			return v, p.errorf("unsupported expression")
+		}
+		v.isString = t.kind == idlString
+		parts = append(parts, t.text)
+		p.pos++
+	}
+}
+
+func idlComment(annotations []idlAnnotation) string {
+	var comments []string
+	for _, a := range annotations {
+		if a.name == "verbatim" && a.params["language"].text == "comment" {
+			comments = append(comments, strings.Join(strings.Fields(a.params["text"].text), " "))
+		}
+	}
+	return strings.Join(comments, " ")
+}
+
+func (p *idlParser) typeSpec(scope []string) (*idlType, error) {
+	t, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	switch t.text {
+	case "sequence":
+		if err = p.expect("<"); err != nil {
+			return nil, err
+		}
+		elem, err := p.typeSpec(scope)
+		if err != nil {
+			return nil, err
+		}
+		if elem.array != "" {
+			return nil, p.errorf("sequences of sequences and arrays are not supported")
+		}
+		seq := *elem
+		seq.array = "[]"
+		if p.accept(",") {
+			if seq.size, err = p.positiveInt(); err != nil {
+				return nil, err
+			}
+			seq.bounded = "<="
+			seq.array = "[<=" + seq.size + "]"
+		}
+		return &seq, p.expect(">")
+	case "string", "wstring":
+		typ := &idlType{name: idlPrimitiveTypes[t.text]}
+		if p.accept("<") {
+			bound, err := p.positiveInt()
+			if err != nil {
+				return nil, err
+			}
+			typ.boundedString = "<=" + bound
+			return typ, p.expect(">")
+		}
+		return typ, nil
+	case "unsigned", "long":
+		name := t.text
+		if name == "unsigned" {
+			next, err := p.ident()
+			if err != nil {
+				return nil, err
+			}
+			name += " " + next
+		}
+		if strings.HasSuffix(name, "long") && p.accept("long") {
+			name += " long"
+		} else if name == "long" && p.accept("double") {
+			return nil, p.errorf("unsupported type 'long double'")
+		}
+		if typ, ok := idlPrimitiveTypes[name]; ok {
+			return &idlType{name: typ}, nil
+		}
+		return nil, p.errorf("unsupported type '%s'", name)
+	}
+	var parts []string
+	if t.text == "::" {
+		t, err = p.next()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if t.kind != idlIdent {
+		p.pos--
+		return nil, p.errorf("expected a type, got '%s'", t)
+	}
+	parts = append(parts, t.text)
+	for p.accept("::") {
+		name, err := p.ident()
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, name)
+	}
+	if len(parts) == 1 {
+		if typ, ok := idlPrimitiveTypes[parts[0]]; ok {
+			return &idlType{name: typ}, nil
+		}
+		for i := len(scope); i >= 0; i-- {
+			if typ := p.defs.typedefs[scoped(scope[:i], parts[0])]; typ != nil {
+				return typ, nil
+			}
+		}
+		if len(scope) == 0 {
+			return nil, p.errorf("unknown type '%s'", parts[0])
+		}
+		return &idlType{pkg: scope[0], name: parts[0]}, nil
+	}
+	if typ := p.defs.typedefs[strings.Join(parts, "::")]; typ != nil {
+		return typ, nil
+	}
+	return &idlType{pkg: parts[0], name: parts[len(parts)-1]}, nil
+}
+
+// arrayDeclarator parses the optional array size following the name of a
+// member or a typedef.
+func (p *idlParser) arrayDeclarator(typ *idlType) (*idlType, error) {
+	if !p.accept("[") {
+		return typ, nil
+	}
+	if typ.array != "" {
+		return nil, p.errorf("multidimensional arrays are not supported")
+	}
+	size, err := p.positiveInt()
+	if err != nil {
+		return nil, err
+	}
+	if err = p.expect("]"); err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t != nil && t.kind == idlPunct && t.text == "[" {
+		return nil, p.errorf("multidimensional arrays are not supported")
+	}
+	array := *typ
+	array.array = "[" + size + "]"
+	array.size = size
+	return &array, nil
+}
+
+func (p *idlParser) structDef(scope []string) error {
+	name, err := p.ident()
+	if err != nil {
+		return err
+	}
+	if !p.accept("{") {
+		return nil // Forward declaration
+	}
+	s := &idlStruct{}
+	for !p.accept("}") {
+		annotations, err := p.annotations()
+		if err != nil {
+			return err
+		}
+		m := &idlMember{comment: idlComment(annotations)}
+		if t := p.peek(); t != nil {
+			m.line, m.column = t.line, t.column
+		}
+		typ, err := p.typeSpec(scope)
+		if err != nil {
+			return err
+		}
+		if m.name, err = p.ident(); err != nil {
+			return err
+		}
+		if m.typ, err = p.arrayDeclarator(typ); err != nil {
+			return err
+		}
+		for _, a := range annotations {
+			if a.name == "default" {
+				v := a.params["value"]
+				m.defaultValue = &v
+			}
+		}
+		if err = p.expect(";"); err != nil {
+			return err
+		}
+		s.members = append(s.members, m)
+	}
+	p.defs.structs[scoped(scope, name)] = s
+	return nil
+}
+
+func (p *idlParser) constDef(scope []string, annotations []idlAnnotation) error {
+	c := &idlConst{comment: idlComment(annotations)}
+	if t := p.peek(); t != nil {
+		c.line, c.column = t.line, t.column
+	}
+	var err error
+	if c.typ, err = p.typeSpec(scope); err != nil {
+		return err
+	}
+	if c.name, err = p.ident(); err != nil {
+		return err
+	}
+	if err = p.expect("="); err != nil {
+		return err
+	}
+	if c.value, err = p.value(";"); err != nil {
+		return err
+	}
+	module := strings.Join(scope, "::")
+	p.defs.constants[module] = append(p.defs.constants[module], c)
+	return nil
+}
+
+func (p *idlParser) typedef(scope []string) error {
+	typ, err := p.typeSpec(scope)
+	if err != nil {
+		return err
+	}
+	name, err := p.ident()
+	if err != nil {
+		return err
+	}
+	if typ, err = p.arrayDeclarator(typ); err != nil {
+		return err
+	}
+	p.defs.typedefs[scoped(scope, name)] = typ
+	return nil
+}
+
+// idlLiteral converts an IDL literal to the syntax used in .msg files.
+func idlLiteral(typ *idlType, v idlValue) string {
+	if typ.array != "" {
+		s := strings.TrimSpace(v.text)
+		if len(s) >= 2 && (s[0] == '(' && s[len(s)-1] == ')' || s[0] == '[' && s[len(s)-1] == ']') {
+			s = s[1 : len(s)-1]
+		}
+		if typ.name == "bool" {
+			s = strings.ToLower(s)
+		}
+		return "[" + s + "]"
+	}
+	switch typ.name {
+	case "string", "wstring":
+		return strconv.Quote(v.text)
+	case "bool":
+		return strings.ToLower(v.text)
+	}
+	return v.text
+}
+
+// idlEmptyStructMember is the member added by rosidl to messages without
+// fields, because empty structs are not allowed in IDL.
+const idlEmptyStructMember = "structure_needs_at_least_one_member"
+
+// ParseIDLMessage parses the definition of res from the contents of an IDL
+// file.
+func (p *parser) ParseIDLMessage(res *ROS2Message, source string) error {
+	return p.parseIDL(source, res)
+}
+
+func (p *parser) ParseIDLService(service *ROS2Service, source string) error {
+	return p.parseIDL(source, service.Request, service.Response)
+}
+
+func (p *parser) ParseIDLAction(action *ROS2Action, source string) error {
+	err := p.parseIDL(source, action.Goal, action.Result, action.Feedback)
+	if err != nil {
+		return err
+	}
+	p.addActionServices(action)
+	return nil
+}
+
+func (p *parser) parseIDL(source string, sections ...*ROS2Message) error {
+	defs, err := parseIDLDefinitions(source)
+	if err != nil {
+		return err
+	}
+	for _, msg := range sections {
+		name := msg.Package + "::" + msg.Type + "::" + msg.Name
+		s := defs.structs[name]
+		if s == nil {
+			return fmt.Errorf("struct %s is not defined", name)
+		}
+		for _, c := range defs.constants[name+"_Constants"] {
+			p.line, p.column = c.line, c.column
+			con, err := p.idlConstant(c)
+			if err != nil {
+				return &SyntaxError{Line: c.line, Column: c.column, Err: fmt.Errorf("constant %s of %s: %w", c.name, name, err)}
+			}
+			msg.Constants = append(msg.Constants, con)
+		}
+		for _, m := range s.members {
+			if m.name == idlEmptyStructMember && len(s.members) == 1 {
+				break
+			}
+			p.line, p.column = m.line, m.column
+			f, err := p.idlField(m, msg)
+			if err != nil {
+				return &SyntaxError{Line: m.line, Column: m.column, Err: fmt.Errorf("member %s of %s: %w", m.name, name, err)}
+			}
+			p.addField(msg, f)
+		}
+		if err := p.detectEnums(msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *parser) idlConstant(c *idlConst) (*ROS2Constant, error) {
+	if c.typ.pkg != "" || c.typ.array != "" {
+		return nil, errors.New("constants must have a primitive type")
+	}
+	return p.ParseROS2MessageConstant(map[string]string{
+		"type":    c.typ.name,
+		"field":   c.name,
+		"default": idlLiteral(c.typ, c.value),
+		"comment": c.comment,
+	})
+}
+
+func (p *parser) idlField(m *idlMember, msg *ROS2Message) (*ROS2Field, error) {
+	capture := map[string]string{
+		"package":       m.typ.pkg,
+		"type":          m.typ.name,
+		"boundedString": m.typ.boundedString,
+		"array":         m.typ.array,
+		"bounded":       m.typ.bounded,
+		"size":          m.typ.size,
+		"field":         m.name,
+		"comment":       m.comment,
+	}
+	if m.defaultValue != nil {
+		capture["default"] = idlLiteral(m.typ, *m.defaultValue)
+	}
+	return p.ParseROS2MessageField(capture, msg)
+}
@@ -0,0 +1,198 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package gogen
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey" //nolint:revive
+)
+
+const idlTestMessage = `
+// generated from rosidl_adapter/resource/msg.idl.em
+
+#include "builtin_interfaces/msg/Time.idl"
+#include "test_msgs/msg/BasicTypes.idl"
+
+module test_msgs {
+  module msg {
+    typedef double double__3[3];
+    module Sample_Constants {
+      const int8 MODE_OFF = 0;
+      const int8 MODE_ON = 1;
+      @verbatim (language="comment", text=
+        "Greeting")
+      const string GREETING = "hello \"world\"";
+      const boolean ENABLED = TRUE;
+    };
+    @verbatim (language="comment", text=
+      "A message for testing IDL parsing.")
+    struct Sample {
+      @verbatim (language="comment", text=
+        "Current" "\n"
+        "mode")
+      int8 mode;
+
+      @default (value=TRUE)
+      boolean flag;
+
+      @default (value=-3.5)
+      float scale;
+
+      @default (value="default name")
+      string name;
+
+      string<22> short_name;
+
+      wstring wide;
+
+      double__3 vector;
+
+      @default (value="(0, 1, -1)")
+      long values[3];
+
+      sequence<unsigned long long> ids;
+
+      sequence<octet, 4> bytes;
+
+      @default (value="[\"a\", \"b\"]")
+      sequence<string<5>, 3> labels;
+
+      builtin_interfaces::msg::Time stamp;
+
+      sequence<test_msgs::msg::BasicTypes> basic;
+
+      BasicTypes basic_array[2];
+    };
+  };
+};
+`
+
+const idlTestMessageEquivalent = `
+int8 MODE_OFF = 0
+int8 MODE_ON = 1
+string GREETING = "hello \"world\""
+bool ENABLED = true
+
+int8 mode # Current mode
+bool flag true
+float32 scale -3.5
+string name "default name"
+string<=22 short_name
+wstring wide
+float64[3] vector
+int32[3] values [0, 1, -1]
+uint64[] ids
+byte[<=4] bytes
+string<=5[<=3] labels ["a", "b"]
+builtin_interfaces/Time stamp
+BasicTypes[] basic
+BasicTypes[2] basic_array
+`
+
+const idlTestService = `
+module example_interfaces {
+  module srv {
+    struct AddTwoInts_Request {
+      int64 a;
+      int64 b;
+    };
+    struct AddTwoInts_Response {
+      int64 sum;
+    };
+  };
+};
+`
+
+const idlTestAction = `
+#include "builtin_interfaces/msg/Duration.idl"
+
+module test_msgs {
+  module action {
+    module Fibonacci_Goal_Constants {
+      const int32 LIMIT_LOW = 1;
+      const int32 LIMIT_HIGH = 10;
+    };
+    struct Fibonacci_Goal {
+      int32 order;
+    };
+    struct Fibonacci_Result {
+      sequence<int32> sequence;
+    };
+    struct Fibonacci_Feedback {
+      uint8 structure_needs_at_least_one_member;
+    };
+  };
+};
+`
+
+func TestParseIDL(t *testing.T) {
+	SetDefaultFailureMode(FailureContinues)
+
+	Convey("IDL messages produce the same models as .msg files", t, func() {
+		p := parser{config: &DefaultConfig}
+		idlMsg := ROS2MessageNew("test_msgs", "Sample")
+		So(p.ParseIDLMessage(idlMsg, idlTestMessage), ShouldBeNil)
+		msg := ROS2MessageNew("test_msgs", "Sample")
+		So(p.ParseROS2Message(msg, idlTestMessageEquivalent), ShouldBeNil)
+
+		So(idlMsg.Constants, ShouldHaveLength, len(msg.Constants))
+		So(idlMsg.Fields, ShouldHaveLength, len(msg.Fields))
+		msg.Constants[2].Comment = "Greeting"
+		So(idlMsg.Constants, ShouldResemble, msg.Constants)
+		for i := range msg.Fields {
+			So(idlMsg.Fields[i], ShouldResemble, msg.Fields[i])
+		}
+		So(idlMsg.Enums, ShouldResemble, msg.Enums)
+		So(idlMsg.GoImports, ShouldResemble, msg.GoImports)
+		So(idlMsg.CImports, ShouldResemble, msg.CImports)
+	})
+	Convey("IDL services", t, func() {
+		p := parser{config: &DefaultConfig}
+		srv := NewROS2Service("example_interfaces", "AddTwoInts")
+		So(p.ParseIDLService(srv, idlTestService), ShouldBeNil)
+		So(srv.Request.Fields, ShouldHaveLength, 2)
+		So(srv.Response.Fields, ShouldHaveLength, 1)
+		So(srv.Response.Fields[0].GoName, ShouldEqual, "Sum")
+		So(srv.Response.Fields[0].GoType, ShouldEqual, "int64")
+	})
+	Convey("IDL actions", t, func() {
+		p := parser{config: &DefaultConfig}
+		action := NewROS2Action("test_msgs", "Fibonacci")
+		So(p.ParseIDLAction(action, idlTestAction), ShouldBeNil)
+		So(action.Goal.Constants, ShouldHaveLength, 2)
+		So(action.Goal.Enums, ShouldHaveLength, 1)
+		So(action.Result.Fields[0].TypeArray, ShouldEqual, "[]")
+		So(action.Feedback.Fields, ShouldBeEmpty)
+		So(action.SendGoal.Request.Fields, ShouldHaveLength, 2)
+		So(action.GetResult.Response.Fields[1].GoType, ShouldEqual, "Fibonacci_Result")
+	})
+	Convey("Unsupported or invalid IDL is rejected", t, func() {
+		p := parser{config: &DefaultConfig}
+		for _, source := range []string{
+			`module a { module msg { struct M { long x[2][3]; }; }; };`,
+			`module a { module msg { struct M { sequence<sequence<long>> x; }; }; };`,
+			`module a { module msg { union M switch (long) { case 1: long x; }; }; };`,
+			`module a { module msg { struct M { long x; }; };`,
+			`module a { module msg { struct M { long double x; }; }; };`,
+			`module a { module msg { struct M { string<0> x; }; }; };`,
+			`module a { module msg { struct M { wstring<3> x; }; }; };`,
+			`module a { module msg { struct M { long x; }; }; }; /* unterminated`,
+			`module a { module msg { struct Other { long x; }; }; };`,
+		} {
+			So(p.ParseIDLMessage(ROS2MessageNew("a", "M"), source), ShouldBeError)
+		}
+	})
+	Convey("Interface metadata is parsed from IDL paths", t, func() {
+		m, err := parseMetadataFromPath("/opt/ros/humble/share/test_msgs/action/Fibonacci.idl")
+		So(err, ShouldBeNil)
+		So(*m, ShouldResemble, Metadata{Name: "Fibonacci", Package: "test_msgs", Type: "action"})
+	})
+}
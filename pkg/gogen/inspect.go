@@ -0,0 +1,217 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package gogen
+
+import (
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+	"text/tabwriter"
+)
+
+// InterfaceInfo is an interface definition found in the root paths.
+type InterfaceInfo struct {
+	Metadata
+	Path string // Path of the definition file
+}
+
+// String returns the name of the interface, such as "std_msgs/msg/Header".
+func (i *InterfaceInfo) String() string {
+	return i.Package + "/" + i.Type + "/" + i.Name
+}
+
+// ListInterfaces returns the interfaces found in the root paths sorted by
+// name.
+func (g *Generator) ListInterfaces() []InterfaceInfo {
+	if g.allPkgs == nil {
+		g.findPackages()
+	}
+	var ifaces []InterfaceInfo
+	for _, ref := range g.allPkgs {
+		for meta, p := range ref.Interfaces {
+			ifaces = append(ifaces, InterfaceInfo{Metadata: meta, Path: p})
+		}
+	}
+	sort.Slice(ifaces, func(i, j int) bool {
+		return ifaces[i].String() < ifaces[j].String()
+	})
+	return ifaces
+}
+
+func (g *Generator) findInterface(meta Metadata) (string, bool) {
+	if g.allPkgs == nil {
+		g.findPackages()
+	}
+	ref := g.allPkgs[meta.Package]
+	if ref == nil {
+		return "", false
+	}
+	p, ok := ref.Interfaces[meta]
+	return p, ok
+}
+
+func (g *Generator) hasInterface(meta Metadata) bool {
+	_, ok := g.findInterface(meta)
+	return ok
+}
+
+// WriteInterface writes a description of the interface called name, such as
+// "geometry_msgs/msg/Pose", to w. Each constant and field is listed with the
+// Go identifier and type it is translated to. If expand is true, the constants
+// and fields of nested messages are listed too.
+func (g *Generator) WriteInterface(w io.Writer, name string, expand bool) error {
+	parts := strings.Split(name, "/")
+	if len(parts) != 3 {
+		return fmt.Errorf("invalid interface name %q, expected <package>/<type>/<name>", name)
+	}
+	meta := Metadata{Package: parts[0], Type: parts[1], Name: parts[2]}
+	srcPath, ok := g.findInterface(meta)
+	if !ok {
+		return fmt.Errorf("interface %s not found", name)
+	}
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	d := &interfaceDescriber{g: g, w: tw, expand: expand, visited: stringSet{}}
+	var goType string
+	var describe func()
+	switch meta.Type {
+	case "msg":
+		msg, _, err := g.parseMessage(&meta, srcPath)
+		if err != nil {
+			return err
+		}
+		goType = msg.GoName
+		d.visited.Add(name)
+		describe = func() {
+			fmt.Fprintln(tw)
+			d.message(msg, "")
+		}
+	case "srv":
+		srv, _, err := g.parseService(&meta, srcPath)
+		if err != nil {
+			return err
+		}
+		goType = srv.Name
+		describe = func() {
+			d.section("Request", srv.Request)
+			d.section("Response", srv.Response)
+		}
+	case "action":
+		action, _, err := g.parseAction(&meta, srcPath)
+		if err != nil {
+			return err
+		}
+		goType = action.Name
+		describe = func() {
+			d.section("Goal", action.Goal)
+			d.section("Result", action.Result)
+			d.section("Feedback", action.Feedback)
+		}
+	default:
+		return fmt.Errorf("invalid interface type %q", meta.Type)
+	}
+	fmt.Fprintf(tw, "%s\n", name)
+	fmt.Fprintf(tw, "Source:\t%s\n", srcPath)
+	fmt.Fprintf(tw, "Go type:\t%s.%s\n", meta.GoPackage(), goType)
+	fmt.Fprintf(tw, "Import path:\t%s\n", path.Join(g.config.MessageModulePrefix, meta.Package, meta.Type))
+	describe()
+	return tw.Flush()
+}
+
+type interfaceDescriber struct {
+	g       *Generator
+	w       io.Writer
+	expand  bool
+	visited stringSet // Messages being described, to avoid infinite recursion
+}
+
+func (d *interfaceDescriber) section(title string, msg *ROS2Message) {
+	fmt.Fprintf(d.w, "\n%s: %s.%s\n", title, msg.GoPackage(), msg.GoName)
+	d.message(msg, "  ")
+}
+
+func (d *interfaceDescriber) message(msg *ROS2Message, indent string) {
+	for _, c := range msg.Constants {
+		goType := c.GoType
+		if c.EnumType != "" {
+			goType = msg.GoPackage() + "." + c.EnumType
+		}
+		fmt.Fprintf(d.w, "%s%s %s=%s\t%s_%s\t%s\n", indent, c.RosType, c.RosName, c.Value, msg.GoName, c.RosName, goType)
+	}
+	for _, f := range msg.Fields {
+		decl := indent + rosFieldType(f) + " " + f.RosName
+		if f.DefaultValue != "" {
+			decl += " " + f.DefaultValue
+		}
+		fmt.Fprintf(d.w, "%s\t%s\t%s\n", decl, f.GoName, goFieldType(f, msg))
+		if d.expand && f.PkgName != "" {
+			d.nested(f, msg, indent+"  ")
+		}
+	}
+}
+
+func (d *interfaceDescriber) nested(f *ROS2Field, parent *ROS2Message, indent string) {
+	pkg := f.PkgName
+	if pkg == "." {
+		pkg = parent.Package
+	}
+	meta := Metadata{Package: pkg, Type: "msg", Name: f.RosType}
+	name := pkg + "/msg/" + f.RosType
+	if _, ok := d.visited[name]; ok {
+		return
+	}
+	srcPath, ok := d.g.findInterface(meta)
+	if !ok {
+		fmt.Fprintf(d.w, "%s# %s not found\n", indent, name)
+		return
+	}
+	msg, _, err := d.g.parseMessage(&meta, srcPath)
+	if err != nil {
+		fmt.Fprintf(d.w, "%s# failed to parse %s: %v\n", indent, name, err)
+		return
+	}
+	d.visited.Add(name)
+	d.message(msg, indent)
+	delete(d.visited, name)
+}
+
+// rosFieldType returns the type of f as written in message definitions.
+func rosFieldType(f *ROS2Field) string {
+	t := f.RosType
+	if t == "U16String" {
+		t = "wstring"
+	}
+	switch f.PkgName {
+	case "", ".", "time", "primitives":
+	default:
+		t = f.PkgName + "/" + t
+	}
+	t += f.StringBounded
+	if f.ArrayBounded != "" {
+		return t + "[" + f.ArrayBounded + "]"
+	}
+	return t + f.TypeArray
+}
+
+// goFieldType returns the Go type of f qualified with its package name.
+func goFieldType(f *ROS2Field, msg *ROS2Message) string {
+	t := f.GoType
+	switch {
+	case f.EnumType != "":
+		t = msg.GoPackage() + "." + f.EnumType
+	case f.PkgName == "":
+	case f.PkgIsLocal:
+		t = msg.GoPackage() + "." + t
+	default:
+		t = f.GoPkgName + "." + t
+	}
+	return f.TypeArray + t
+}
@@ -0,0 +1,98 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package gogen
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey" //nolint:revive
+)
+
+func TestInspectInterfaces(t *testing.T) {
+	SetDefaultFailureMode(FailureContinues)
+
+	Convey("Interfaces can be listed and described", t, func() {
+		root := t.TempDir()
+		writeTestFiles(root, map[string]string{
+			"share/demo_msgs/msg/Point.msg":      "uint8 KIND_A=0\nuint8 KIND_B=1\nuint8 kind\nfloat64 x 1.5\n",
+			"share/demo_msgs/msg/Polygon.msg":    "Point[] points\nstd_msgs/Header header\nstring<=8 name \"poly\"\n",
+			"share/demo_msgs/srv/Area.srv":       "Polygon polygon\n---\nfloat64 area\n",
+			"share/std_msgs/msg/Header.msg":      "int32 stamp\nstring frame_id\n",
+			"share/demo_msgs/action/Move.action": "Point target\n---\nbool ok\n---\nfloat64 progress\n",
+		})
+		gen := New(&Config{
+			RootPaths:           []string{root},
+			RclgoImportPath:     DefaultConfig.RclgoImportPath,
+			MessageModulePrefix: "example.com/msgs",
+			TypeRenames:         map[string]string{"demo_msgs/msg/Point": "Vec2"},
+		})
+		show := func(name string, expand bool) (string, error) {
+			var buf bytes.Buffer
+			err := gen.WriteInterface(&buf, name, expand)
+			// Compare lines with columns separated by single spaces.
+			lines := strings.Split(buf.String(), "\n")
+			for i, line := range lines {
+				indent := line[:len(line)-len(strings.TrimLeft(line, " "))]
+				lines[i] = indent + strings.Join(strings.Fields(line), " ")
+			}
+			return strings.Join(lines, "\n"), err
+		}
+
+		var names []string
+		for _, iface := range gen.ListInterfaces() {
+			names = append(names, iface.String())
+		}
+		So(names, ShouldResemble, []string{
+			"demo_msgs/action/Move",
+			"demo_msgs/msg/Point",
+			"demo_msgs/msg/Polygon",
+			"demo_msgs/srv/Area",
+			"std_msgs/msg/Header",
+		})
+
+		out, err := show("demo_msgs/msg/Point", false)
+		So(err, ShouldBeNil)
+		So(out, ShouldContainSubstring, "Go type: demo_msgs_msg.Vec2\n")
+		So(out, ShouldContainSubstring, "Import path: example.com/msgs/demo_msgs/msg\n")
+		So(out, ShouldContainSubstring, "uint8 KIND_A=0 Vec2_KIND_A uint8\n")
+		So(out, ShouldContainSubstring, "float64 x 1.5 X float64\n")
+
+		out, err = show("demo_msgs/msg/Polygon", false)
+		So(err, ShouldBeNil)
+		So(out, ShouldContainSubstring, "Point[] points Points []demo_msgs_msg.Vec2\n")
+		So(out, ShouldContainSubstring, "std_msgs/Header header Header std_msgs_msg.Header\n")
+		So(out, ShouldContainSubstring, `string<=8 name "poly" Name string`)
+		So(out, ShouldNotContainSubstring, "frame_id")
+
+		out, err = show("demo_msgs/msg/Polygon", true)
+		So(err, ShouldBeNil)
+		So(out, ShouldContainSubstring, "\n  string frame_id FrameId string\n")
+		So(out, ShouldContainSubstring, "\n  float64 x 1.5 X float64\n")
+
+		out, err = show("demo_msgs/srv/Area", true)
+		So(err, ShouldBeNil)
+		So(out, ShouldContainSubstring, "Go type: demo_msgs_srv.Area\n")
+		So(out, ShouldContainSubstring, "Request: demo_msgs_srv.Area_Request\n")
+		So(out, ShouldContainSubstring, "Response: demo_msgs_srv.Area_Response\n")
+		So(out, ShouldContainSubstring, "\n      string frame_id FrameId string\n")
+
+		out, err = show("demo_msgs/action/Move", false)
+		So(err, ShouldBeNil)
+		So(out, ShouldContainSubstring, "Goal:")
+		So(out, ShouldContainSubstring, "Feedback:")
+
+		_, err = show("demo_msgs/msg/Missing", false)
+		So(err, ShouldNotBeNil)
+		_, err = show("demo_msgs/Point", false)
+		So(err, ShouldNotBeNil)
+	})
+}
@@ -0,0 +1,292 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package gogen
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime/debug"
+	"sort"
+	"sync"
+)
+
+// ManifestFileName is the name of the file in the destination directory
+// which records the files generated from interface definitions.
+const ManifestFileName = "rclgo-gen.manifest.json"
+
+// manifestEntry describes how a generated file was generated. Files which are
+// not generated from a single interface definition, such as common.gen.go,
+// have no source.
+type manifestEntry struct {
+	Source           string   `json:"source,omitempty"`
+	SourceHash       string   `json:"source_hash,omitempty"`
+	GeneratorVersion string   `json:"generator_version"`
+	ConfigHash       string   `json:"config_hash"`
+	CImports         []string `json:"c_imports,omitempty"`
+	// Dependencies maps the paths of the other interface files whose
+	// definitions are embedded in the generated file to their hashes.
+	Dependencies map[string]string `json:"dependencies,omitempty"`
+}
+
+func (e *manifestEntry) sameGeneration(other *manifestEntry) bool {
+	return e.Source == other.Source &&
+		e.SourceHash == other.SourceHash &&
+		e.GeneratorVersion == other.GeneratorVersion &&
+		e.ConfigHash == other.ConfigHash
+}
+
+type manifest struct {
+	// Files is keyed by the slash-separated path of the generated file
+	// relative to the destination directory.
+	Files map[string]*manifestEntry `json:"files"`
+}
+
+func newManifest() *manifest {
+	return &manifest{Files: map[string]*manifestEntry{}}
+}
+
+// readManifest reads the manifest in destPath. An empty manifest is returned
+// if the file does not exist.
+func readManifest(destPath string) (*manifest, error) {
+	data, err := os.ReadFile(filepath.Join(destPath, ManifestFileName))
+	if errors.Is(err, os.ErrNotExist) {
+		return newManifest(), nil
+	} else if err != nil {
+		return nil, err
+	}
+	m := newManifest()
+	if err := json.Unmarshal(data, m); err != nil {
+		return nil, err
+	}
+	if m.Files == nil {
+		m.Files = map[string]*manifestEntry{}
+	}
+	return m, nil
+}
+
+func (m *manifest) write(destPath string) error {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	//#nosec G306 -- The manifest doesn't contain secrets.
+	return os.WriteFile(filepath.Join(destPath, ManifestFileName), append(data, '\n'), 0644)
+}
+
+// generatedFrom returns the files generated from source, sorted by path.
+func (m *manifest) generatedFrom(source string) []string {
+	var files []string
+	for file, e := range m.Files {
+		if e.Source == source {
+			files = append(files, file)
+		}
+	}
+	sort.Strings(files)
+	return files
+}
+
+func hashFile(p string) (string, error) {
+	f, err := os.Open(filepath.Clean(p))
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// configHash returns a hash of the configuration options which affect the
+// contents of the files generated from interface definitions.
+func configHash(c *Config) string {
+	input := map[string]interface{}{
+		"RclgoImportPath":     c.RclgoImportPath,
+		"MessageModulePrefix": c.MessageModulePrefix,
+		"LicenseHeader":       c.LicenseHeader,
+		"EnumFields":          c.EnumFields,
+	}
+	// Optional settings are added only when used so that the hashes of
+	// existing manifests stay valid.
+	if len(c.TypeRenames) > 0 {
+		input["TypeRenames"] = c.TypeRenames
+	}
+	if c.TargetDistro != "" && c.TargetDistro != DefaultDistro {
+		input["TargetDistro"] = c.TargetDistro
+	}
+	if templates := templatesHashInput(c); templates != nil {
+		input["Templates"] = templates
+	}
+	data, err := json.Marshal(input)
+	if err != nil {
+		panic(err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+var (
+	generatorVersionOnce  sync.Once
+	generatorVersionValue string
+)
+
+// generatorVersion returns the version of the rclgo module the generator was
+// built from. Development builds have no version, so they are identified by
+// the hash of the executable instead.
+func generatorVersion() string {
+	generatorVersionOnce.Do(func() {
+		generatorVersionValue = "unknown"
+		if info, ok := debug.ReadBuildInfo(); ok {
+			mods := append([]*debug.Module{&info.Main}, info.Deps...)
+			for _, mod := range mods {
+				if mod.Path == DefaultConfig.RclgoImportPath && mod.Version != "" && mod.Version != "(devel)" {
+					generatorVersionValue = mod.Version
+					return
+				}
+			}
+		}
+		if exe, err := os.Executable(); err == nil {
+			if sum, err := hashFile(exe); err == nil {
+				generatorVersionValue = "devel-" + sum
+			}
+		}
+	})
+	return generatorVersionValue
+}
+
+// GenerationSummary contains the number of files handled by
+// GenerateGolangMessageTypes.
+type GenerationSummary struct {
+	Generated int // Interfaces which were generated
+	Unchanged int // Interfaces which were skipped, because they were up to date
+	Failed    int // Interfaces which could not be generated
+	Removed   int // Stale files which were removed
+}
+
+// skipUnchanged reports whether the files generated from the interface at
+// ifacePath are up to date. If they are, they are recorded in the new
+// manifest and the C imports of the interface are added to cImports.
+func (g *Generator) skipUnchanged(ifacePath string, cImports stringSet) bool {
+	files := g.oldManifest.generatedFrom(ifacePath)
+	if len(files) == 0 {
+		return false
+	}
+	for _, file := range files {
+		e := g.oldManifest.Files[file]
+		if !e.sameGeneration(g.currentEntry) {
+			return false
+		}
+		for dep, hash := range e.Dependencies {
+			if h, err := g.dependencyHash(dep); err != nil || h != hash {
+				return false
+			}
+		}
+		if _, err := os.Stat(filepath.Join(g.config.DestPath, filepath.FromSlash(file))); err != nil {
+			return false
+		}
+	}
+	for _, file := range files {
+		e := g.oldManifest.Files[file]
+		g.newManifest.Files[file] = e
+		cImports.Add(e.CImports...)
+	}
+	return true
+}
+
+// recordOutput records that the file at destPath was generated from the
+// interface which is currently being generated.
+func (g *Generator) recordOutput(destPath string, cImports stringSet) {
+	rel, err := filepath.Rel(g.config.DestPath, destPath)
+	if err != nil {
+		return
+	}
+	e := *g.currentEntry
+	e.CImports = cImports.ToSortedSlice()
+	g.newManifest.Files[filepath.ToSlash(rel)] = &e
+}
+
+// removeStaleFiles removes the files in the old manifest whose sources have
+// disappeared or no longer produce them and records the remaining files in the
+// new manifest. Files whose sources still exist but which were not generated
+// by this run, for example because they were excluded, are kept.
+func (g *Generator) removeStaleFiles() {
+	sources := stringSet{}
+	for _, ref := range g.allPkgs {
+		for _, p := range ref.Interfaces {
+			sources.Add(p)
+		}
+	}
+	generatedSources := stringSet{}
+	for _, e := range g.newManifest.Files {
+		generatedSources.Add(e.Source)
+	}
+	var files []string
+	for file := range g.oldManifest.Files {
+		files = append(files, file)
+	}
+	sort.Strings(files)
+	var stale []string
+	for _, file := range files {
+		e := g.oldManifest.Files[file]
+		if _, ok := g.newManifest.Files[file]; ok || e.Source == "" {
+			continue
+		}
+		_, exists := sources[e.Source]
+		_, generated := generatedSources[e.Source]
+		if exists && !generated {
+			g.newManifest.Files[file] = e
+		} else {
+			stale = append(stale, file)
+		}
+	}
+	// Files without a source are kept as long as the directory contains
+	// other generated files.
+	dirs := stringSet{}
+	for file, e := range g.newManifest.Files {
+		if e.Source != "" {
+			dirs.Add(path.Dir(file))
+		}
+	}
+	for _, file := range files {
+		e := g.oldManifest.Files[file]
+		if _, ok := g.newManifest.Files[file]; ok || e.Source != "" {
+			continue
+		}
+		if _, ok := dirs[path.Dir(file)]; ok {
+			g.newManifest.Files[file] = e
+		} else {
+			stale = append(stale, file)
+		}
+	}
+	for _, file := range stale {
+		p := filepath.Join(g.config.DestPath, filepath.FromSlash(file))
+		if err := os.Remove(p); err != nil && !errors.Is(err, os.ErrNotExist) {
+			g.errorf(p, "failed to remove stale file: %w", err)
+			g.newManifest.Files[file] = g.oldManifest.Files[file]
+			continue
+		}
+		PrintErrf("Removed stale file: %s\n", p)
+		g.summary.Removed++
+		// Remove the directories which became empty. Removing a non-empty
+		// directory fails, which ends the loop.
+		for dir := filepath.Dir(p); dir != filepath.Clean(g.config.DestPath); dir = filepath.Dir(dir) {
+			if os.Remove(dir) != nil {
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,80 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package gogen
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey" //nolint:revive
+)
+
+func TestIncrementalGeneration(t *testing.T) {
+	SetDefaultFailureMode(FailureContinues)
+
+	Convey("Unchanged interfaces are skipped and stale files are removed", t, func() {
+		root := t.TempDir()
+		dest := t.TempDir()
+		writeTestFiles(root, map[string]string{
+			"share/demo_msgs/msg/Point.msg":    "float64 x\nfloat64 y\n",
+			"share/demo_msgs/msg/Polygon.msg":  "Point[] points\n",
+			"share/demo_msgs/srv/Measure.srv":  "Polygon polygon\n---\nfloat64 area\n",
+			"share/other_msgs/msg/Counter.msg": "int64 count\n",
+		})
+		generate := func(config Config) GenerationSummary {
+			config.RootPaths = []string{root}
+			config.DestPath = dest
+			config.RclgoImportPath = DefaultConfig.RclgoImportPath
+			config.MessageModulePrefix = "example.com/msgs"
+			g := New(&config)
+			So(g.GenerateGolangMessageTypes(), ShouldBeNil)
+			return g.Summary()
+		}
+		exists := func(name string) bool {
+			_, err := os.Stat(filepath.Join(dest, filepath.FromSlash(name)))
+			return err == nil
+		}
+
+		So(generate(Config{}), ShouldResemble, GenerationSummary{Generated: 4})
+		So(exists(ManifestFileName), ShouldBeTrue)
+		So(exists("demo_msgs/srv/Measure_Request.gen.go"), ShouldBeTrue)
+		So(exists("other_msgs/msg/common.gen.go"), ShouldBeTrue)
+
+		So(generate(Config{}), ShouldResemble, GenerationSummary{Unchanged: 4})
+
+		// Polygon and Measure embed the definition of Point, so they are
+		// regenerated too.
+		writeTestFiles(root, map[string]string{"share/demo_msgs/msg/Point.msg": "float64 x\nfloat64 y\nfloat64 z\n"})
+		So(generate(Config{}), ShouldResemble, GenerationSummary{Generated: 3, Unchanged: 1})
+
+		So(generate(Config{LicenseHeader: "Test"}), ShouldResemble, GenerationSummary{Generated: 4})
+
+		Convey("Excluded interfaces are kept", func() {
+			rules := RuleSet{}
+			rule, err := NewRule("other_msgs")
+			So(err, ShouldBeNil)
+			rules = append(rules, rule)
+			So(generate(Config{LicenseHeader: "Test", RegexIncludes: rules}), ShouldResemble, GenerationSummary{Unchanged: 1})
+			So(exists("demo_msgs/msg/Point.gen.go"), ShouldBeTrue)
+			So(generate(Config{LicenseHeader: "Test"}), ShouldResemble, GenerationSummary{Unchanged: 4})
+		})
+
+		Convey("Files of removed interfaces are removed", func() {
+			So(os.Remove(filepath.Join(root, "share/demo_msgs/srv/Measure.srv")), ShouldBeNil)
+			So(os.RemoveAll(filepath.Join(root, "share/other_msgs")), ShouldBeNil)
+			So(generate(Config{LicenseHeader: "Test"}), ShouldResemble, GenerationSummary{Unchanged: 2, Removed: 6})
+			So(exists("demo_msgs/srv"), ShouldBeFalse)
+			So(exists("other_msgs"), ShouldBeFalse)
+			So(exists("demo_msgs/msg/Polygon.gen.go"), ShouldBeTrue)
+			So(exists("demo_msgs/msg/common.gen.go"), ShouldBeTrue)
+		})
+	})
+}
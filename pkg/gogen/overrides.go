@@ -0,0 +1,167 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package gogen
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// TemplateKind identifies a kind of file generated for interfaces. The data
+// passed to the templates of each kind is a map containing "Config", "Distro"
+// (*Distro) and:
+//
+//   - MessageTemplate: "Message" (*ROS2Message)
+//   - ServiceTemplate: "Service" (*ROS2Service)
+//   - ActionTemplate: "Action" (*ROS2Action)
+//   - CommonPackageTemplate: "GoPackage", "CPackage" and "CImports"
+//
+// Message, service and action templates receive also "Description"
+// (*InterfaceDescription), which is nil if the interface could not be
+// described.
+//
+// Message templates are executed also for the request and response messages
+// of services and the messages of actions, and service templates for the
+// services of actions.
+type TemplateKind string
+
+const (
+	MessageTemplate       TemplateKind = "message"
+	ServiceTemplate       TemplateKind = "service"
+	ActionTemplate        TemplateKind = "action"
+	CommonPackageTemplate TemplateKind = "common"
+)
+
+// ExtraTemplate is executed for each interface of a kind in addition to the
+// built-in templates.
+type ExtraTemplate struct {
+	// Kind is MessageTemplate, ServiceTemplate or ActionTemplate.
+	Kind TemplateKind
+	// The file generated for an interface is placed in the Go package of the
+	// interface and named <interface name><Suffix>.gen.go.
+	Suffix   string
+	Template *template.Template
+}
+
+// NewTemplate parses a template which can use the same functions as the
+// built-in templates.
+func NewTemplate(name, text string) (*template.Template, error) {
+	return template.New(name).Funcs(templateFuncMap).Parse(text)
+}
+
+// ParseTemplateFile parses the template in the file at path using
+// NewTemplate.
+func ParseTemplateFile(path string) (*template.Template, error) {
+	text, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+	return NewTemplate(filepath.Base(path), string(text))
+}
+
+func validateTemplates(c *Config) error {
+	for kind, tmpl := range c.TemplateOverrides {
+		switch kind {
+		case MessageTemplate, ServiceTemplate, ActionTemplate, CommonPackageTemplate:
+		default:
+			return fmt.Errorf("invalid template kind %q", kind)
+		}
+		if tmpl == nil {
+			return fmt.Errorf("template override of kind %q is nil", kind)
+		}
+	}
+	suffixes := map[TemplateKind]stringSet{}
+	for _, extra := range c.ExtraTemplates {
+		switch extra.Kind {
+		case MessageTemplate, ServiceTemplate, ActionTemplate:
+		default:
+			return fmt.Errorf("invalid extra template kind %q", extra.Kind)
+		}
+		if extra.Suffix == "" || strings.ContainsAny(extra.Suffix, `/\`) {
+			return fmt.Errorf("invalid suffix %q of extra %s template", extra.Suffix, extra.Kind)
+		}
+		if extra.Template == nil {
+			return fmt.Errorf("extra %s template with suffix %q is nil", extra.Kind, extra.Suffix)
+		}
+		if suffixes[extra.Kind] == nil {
+			suffixes[extra.Kind] = stringSet{}
+		}
+		if _, ok := suffixes[extra.Kind][extra.Suffix]; ok {
+			return fmt.Errorf("duplicate suffix %q of extra %s templates", extra.Suffix, extra.Kind)
+		}
+		suffixes[extra.Kind].Add(extra.Suffix)
+	}
+	return nil
+}
+
+// template returns the template used to generate files of kind.
+func (g *Generator) template(kind TemplateKind, builtin *template.Template) *template.Template {
+	if tmpl := g.config.TemplateOverrides[kind]; tmpl != nil {
+		return tmpl
+	}
+	return builtin
+}
+
+// generateExtraFiles executes the extra templates of kind for the interface
+// described by meta.
+func (g *Generator) generateExtraFiles(kind TemplateKind, meta *Metadata, cImports stringSet, data templateData) error {
+	for _, extra := range g.config.ExtraTemplates {
+		if extra.Kind != kind {
+			continue
+		}
+		destPath := filepath.Join(g.config.DestPath, meta.ImportPath(), meta.Name+extra.Suffix+".gen.go")
+		if err := g.generateGoFile(destPath, extra.Template, data); err != nil {
+			return fmt.Errorf("extra %s template with suffix %q: %w", kind, extra.Suffix, err)
+		}
+		if g.currentEntry != nil {
+			g.recordOutput(destPath, cImports)
+		}
+	}
+	return nil
+}
+
+// templatesHashInput returns the custom templates of c in a form which can be
+// hashed, or nil if there are none.
+func templatesHashInput(c *Config) interface{} {
+	if len(c.TemplateOverrides) == 0 && len(c.ExtraTemplates) == 0 {
+		return nil
+	}
+	overrides := map[TemplateKind]string{}
+	for kind, tmpl := range c.TemplateOverrides {
+		overrides[kind] = templateSource(tmpl)
+	}
+	var extras []string
+	for _, extra := range c.ExtraTemplates {
+		extras = append(extras, string(extra.Kind), extra.Suffix, templateSource(extra.Template))
+	}
+	return []interface{}{overrides, extras}
+}
+
+// templateSource returns a textual representation of tmpl and its associated
+// templates.
+func templateSource(tmpl *template.Template) string {
+	if tmpl == nil {
+		return ""
+	}
+	tmpls := tmpl.Templates()
+	sort.Slice(tmpls, func(i, j int) bool { return tmpls[i].Name() < tmpls[j].Name() })
+	var b strings.Builder
+	for _, t := range tmpls {
+		if t.Tree == nil || t.Tree.Root == nil {
+			continue
+		}
+		fmt.Fprintf(&b, "{{define %q}}%s{{end}}", t.Name(), t.Tree.Root)
+	}
+	return b.String()
+}
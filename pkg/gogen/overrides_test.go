@@ -0,0 +1,117 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package gogen
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"text/template"
+
+	. "github.com/smartystreets/goconvey/convey" //nolint:revive
+)
+
+func TestTemplateOverrides(t *testing.T) {
+	SetDefaultFailureMode(FailureContinues)
+
+	Convey("Custom templates", t, func() {
+		root := t.TempDir()
+		dest := t.TempDir()
+		writeTestFiles(root, map[string]string{
+			"share/demo_msgs/msg/Point.msg":   "float64 x\nfloat64 y\n",
+			"share/demo_msgs/srv/Measure.srv": "Point point\n---\nfloat64 length\n",
+		})
+		mustParse := func(text string) *template.Template {
+			tmpl, err := NewTemplate("test", text)
+			So(err, ShouldBeNil)
+			return tmpl
+		}
+		generate := func(config Config) (GenerationSummary, error) {
+			config.RootPaths = []string{root}
+			config.DestPath = dest
+			config.RclgoImportPath = DefaultConfig.RclgoImportPath
+			config.MessageModulePrefix = "example.com/msgs"
+			g := New(&config)
+			err := g.GenerateGolangMessageTypes()
+			return g.Summary(), err
+		}
+		read := func(name string) string {
+			data, err := os.ReadFile(filepath.Join(dest, filepath.FromSlash(name)))
+			if err != nil {
+				return ""
+			}
+			return string(data)
+		}
+		config := Config{
+			TemplateOverrides: map[TemplateKind]*template.Template{
+				CommonPackageTemplate: mustParse("package {{.GoPackage}} // {{.CPackage}}\n"),
+			},
+			ExtraTemplates: []ExtraTemplate{
+				{
+					Kind:     MessageTemplate,
+					Suffix:   "_fields",
+					Template: mustParse("package {{.Message.GoPackage}}\n{{range .Message.Fields}}// {{.RosName | ucFirst}}\n{{end}}"),
+				},
+				{
+					Kind:     ServiceTemplate,
+					Suffix:   "_info",
+					Template: mustParse("package {{.Service.GoPackage}} // {{.Service.Name}}\n"),
+				},
+			},
+		}
+
+		summary, err := generate(config)
+		So(err, ShouldBeNil)
+		So(summary, ShouldResemble, GenerationSummary{Generated: 2})
+		So(read("demo_msgs/msg/common.gen.go"), ShouldEqual, "package demo_msgs_msg // demo_msgs\n")
+		So(read("demo_msgs/msg/Point_fields.gen.go"), ShouldEqual, "package demo_msgs_msg\n// X\n// Y\n")
+		So(read("demo_msgs/srv/Measure_Request_fields.gen.go"), ShouldEqual, "package demo_msgs_srv\n// Point\n")
+		So(read("demo_msgs/srv/Measure_Response_fields.gen.go"), ShouldEqual, "package demo_msgs_srv\n// Length\n")
+		So(read("demo_msgs/srv/Measure_info.gen.go"), ShouldEqual, "package demo_msgs_srv // Measure\n")
+		So(read("demo_msgs/msg/Point.gen.go"), ShouldContainSubstring, "type Point struct")
+
+		summary, err = generate(config)
+		So(err, ShouldBeNil)
+		So(summary, ShouldResemble, GenerationSummary{Unchanged: 2})
+
+		Convey("Changing a template regenerates the files", func() {
+			config.ExtraTemplates[0].Template = mustParse("package {{.Message.GoPackage}}\n")
+			summary, err := generate(config)
+			So(err, ShouldBeNil)
+			So(summary, ShouldResemble, GenerationSummary{Generated: 2})
+			So(read("demo_msgs/msg/Point_fields.gen.go"), ShouldEqual, "package demo_msgs_msg\n")
+		})
+
+		Convey("Removing an extra template removes its files", func() {
+			config.ExtraTemplates = config.ExtraTemplates[1:]
+			summary, err := generate(config)
+			So(err, ShouldBeNil)
+			So(summary, ShouldResemble, GenerationSummary{Generated: 2, Removed: 3})
+			So(read("demo_msgs/msg/Point_fields.gen.go"), ShouldBeEmpty)
+			So(read("demo_msgs/srv/Measure_info.gen.go"), ShouldNotBeEmpty)
+		})
+
+		Convey("Invalid templates are rejected", func() {
+			_, err := generate(Config{TemplateOverrides: map[TemplateKind]*template.Template{
+				"unknown": mustParse(""),
+			}})
+			So(err, ShouldNotBeNil)
+			_, err = generate(Config{ExtraTemplates: []ExtraTemplate{
+				{Kind: CommonPackageTemplate, Suffix: "_x", Template: mustParse("")},
+			}})
+			So(err, ShouldNotBeNil)
+			_, err = generate(Config{ExtraTemplates: []ExtraTemplate{
+				{Kind: MessageTemplate, Suffix: "_x", Template: mustParse("")},
+				{Kind: MessageTemplate, Suffix: "_x", Template: mustParse("")},
+			}})
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
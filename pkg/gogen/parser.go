@@ -38,6 +38,20 @@ type parser struct {
 	// Collect pre-field comments here to be included in the comments. Flushed
 	// on empty lines.
 	ros2messagesCommentsBuffer strings.Builder
+	// Position of the definition being parsed, used in warnings.
+	line, column int
+	warnings     []error
+	// definitions contains the .msg source text of each parsed message.
+	definitions map[*ROS2Message]string
+}
+
+// warnf records a warning about the definition being parsed.
+func (p *parser) warnf(format string, args ...interface{}) {
+	p.warnings = append(p.warnings, &SyntaxError{
+		Line:   p.line,
+		Column: p.column,
+		Err:    fmt.Errorf(format, args...),
+	})
 }
 
 func ParseMessage(config *Config, content string) (*ROS2Message, error) {
@@ -63,6 +77,13 @@ func (p *parser) ParseAction(action *ROS2Action, source string) error {
 	if err != nil {
 		return err
 	}
+	p.addActionServices(action)
+	return nil
+}
+
+// addActionServices adds the fields of the messages of action which are
+// derived from the goal, result and feedback messages.
+func (p *parser) addActionServices(action *ROS2Action) {
 	p.addImport(action.SendGoal.Request, "unique_identifier_msgs")
 	action.SendGoal.Request.Fields = []*ROS2Field{
 		p.goalIDField(),
@@ -81,7 +102,7 @@ func (p *parser) ParseAction(action *ROS2Action, source string) error {
 
 			RosType: "Time",
 			CType:   "Time",
-			GoType:  "Time",
+			GoType:  p.config.goTypeName("builtin_interfaces", "Time"),
 		},
 	}
 	p.addImport(action.GetResult.Request, "unique_identifier_msgs")
@@ -95,7 +116,6 @@ func (p *parser) ParseAction(action *ROS2Action, source string) error {
 		p.goalIDField(),
 		p.actionLocalField("feedback", "Feedback", action, action.Feedback),
 	}
-	return nil
 }
 
 func (p *parser) goalIDField() *ROS2Field {
@@ -109,7 +129,7 @@ func (p *parser) goalIDField() *ROS2Field {
 
 		RosType: "UUID",
 		CType:   "UUID",
-		GoType:  "UUID",
+		GoType:  p.config.goTypeName("unique_identifier_msgs", "UUID"),
 	}
 }
 
@@ -158,20 +178,195 @@ func (p *parser) addImport(msg *ROS2Message, pkg string) {
 
 func (p *parser) parseSections(source string, sections ...*ROS2Message) error {
 	current := 0
+	lines := make([][]string, len(sections))
 	for i, line := range strings.Split(source, "\n") {
+		p.line = i + 1
+		p.column = len(line) - len(strings.TrimLeft(line, " \t")) + 1
+		rawLine := line
 		line = strings.TrimSpace(line)
 		if line == "---" {
-			if current >= len(sections) {
-				return errors.New("too many sections")
+			if current >= len(sections)-1 {
+				return &SyntaxError{Line: p.line, Column: p.column, Err: errors.New("too many sections")}
 			}
 			current++
 		} else if err := p.parseLine(sections[current], line); err != nil {
-			return fmt.Errorf("error on line %d: %w", i+1, err)
+			return &SyntaxError{Line: p.line, Column: p.column, Err: err}
+		} else {
+			lines[current] = append(lines[current], rawLine)
+		}
+	}
+	if p.definitions == nil {
+		p.definitions = map[*ROS2Message]string{}
+	}
+	for i, msg := range sections {
+		p.definitions[msg] = strings.Join(lines[i], "\n")
+	}
+	for _, msg := range sections {
+		if err := p.detectEnums(msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var enumTypes = map[string]bool{
+	"byte": true, "char": true,
+	"int8": true, "int16": true, "int32": true, "int64": true,
+	"uint8": true, "uint16": true, "uint32": true, "uint64": true,
+}
+
+// detectEnums groups the integer constants of msg whose names share a prefix
+// and which have the same type into enums, see enumGroups. Fields mapped to an
+// enum in Config.EnumFields use the enum type, and so do the constants of the
+// enum.
+func (p *parser) detectEnums(msg *ROS2Message) error {
+	enums := map[string]*ROS2Enum{}
+	for _, g := range enumGroups(msg.Constants, 1) {
+		enum := &ROS2Enum{
+			GoName:    msg.GoName + "_" + snakeToCamel(strings.ToLower(g.prefix)),
+			Prefix:    g.prefix,
+			GoType:    g.consts[0].GoType,
+			Unsigned:  g.consts[0].GoType == "byte" || strings.HasPrefix(g.consts[0].GoType, "uint"),
+			Constants: g.consts,
+		}
+		seen := map[string]bool{}
+		for _, c := range g.consts {
+			if value := constantValue(c); !seen[value] {
+				seen[value] = true
+				enum.Values = append(enum.Values, c)
+			}
+		}
+		enums[g.prefix] = enum
+		msg.Enums = append(msg.Enums, enum)
+	}
+	if len(msg.Enums) > 0 {
+		msg.GoImports["strconv"] = ""
+	}
+	for _, f := range msg.Fields {
+		key := msg.Package + "/" + msg.Type + "/" + msg.Name + "." + f.RosName
+		prefix, ok := p.config.EnumFields[key]
+		if !ok {
+			continue
+		}
+		enum := enums[prefix]
+		if enum == nil {
+			return fmt.Errorf("field %s is mapped to enum %s, which does not exist", key, prefix)
+		}
+		if f.TypeArray != "" || f.PkgName != "" || f.GoType != enum.GoType {
+			return fmt.Errorf("field %s does not have the type %s of enum %s", key, enum.GoType, prefix)
+		}
+		f.EnumType = enum.GoName
+		for _, c := range enum.Constants {
+			c.EnumType = enum.GoName
 		}
 	}
 	return nil
 }
 
+type enumGroup struct {
+	prefix string
+	consts []*ROS2Constant
+}
+
+// enumGroups groups consts by the first depth segments of their names, which
+// are separated by underscores. Each group is named by the longest prefix of
+// segments shared by its constants, leaving at least one segment to each
+// name. If the constants of a group have different types or values which
+// collide, the group is split by the next segment so that, for example,
+// POWER_SUPPLY_STATUS_* and POWER_SUPPLY_HEALTH_* become separate enums. If
+// splitting does not produce any groups, constants with the same type and
+// colliding values are kept together as aliases. Groups of less than two
+// constants are dropped.
+func enumGroups(consts []*ROS2Constant, depth int) []enumGroup {
+	var keys []string
+	byKey := map[string][]*ROS2Constant{}
+	for _, c := range consts {
+		segments := strings.Split(c.RosName, "_")
+		if len(segments) <= depth || segments[0] == "" {
+			continue
+		}
+		key := strings.Join(segments[:depth], "_")
+		if byKey[key] == nil {
+			keys = append(keys, key)
+		}
+		byKey[key] = append(byKey[key], c)
+	}
+	var groups []enumGroup
+	for _, key := range keys {
+		group := byKey[key]
+		if len(group) < 2 {
+			continue
+		}
+		prefixLen := commonEnumPrefixLen(group)
+		sameType, unique := enumGroupKind(group)
+		if sameType && unique {
+			groups = append(groups, enumGroup{
+				prefix: strings.Join(strings.Split(group[0].RosName, "_")[:prefixLen], "_"),
+				consts: group,
+			})
+			continue
+		}
+		subgroups := enumGroups(group, prefixLen+1)
+		if len(subgroups) == 0 && sameType {
+			subgroups = []enumGroup{{
+				prefix: strings.Join(strings.Split(group[0].RosName, "_")[:prefixLen], "_"),
+				consts: group,
+			}}
+		}
+		groups = append(groups, subgroups...)
+	}
+	return groups
+}
+
+// commonEnumPrefixLen returns the number of segments shared by the names of
+// consts, leaving at least one segment to each name.
+func commonEnumPrefixLen(consts []*ROS2Constant) int {
+	first := strings.Split(consts[0].RosName, "_")
+	n := len(first) - 1
+	for _, c := range consts[1:] {
+		segments := strings.Split(c.RosName, "_")
+		if len(segments)-1 < n {
+			n = len(segments) - 1
+		}
+		for i := 0; i < n; i++ {
+			if segments[i] != first[i] {
+				n = i
+				break
+			}
+		}
+	}
+	return n
+}
+
+// enumGroupKind reports whether consts have the same integer type and whether
+// their values are unique.
+func enumGroupKind(consts []*ROS2Constant) (sameType, unique bool) {
+	sameType, unique = enumTypes[consts[0].RosType], true
+	seen := map[string]bool{}
+	for _, c := range consts {
+		if c.RosType != consts[0].RosType {
+			sameType = false
+		}
+		value := constantValue(c)
+		if seen[value] {
+			unique = false
+		}
+		seen[value] = true
+	}
+	return sameType, unique
+}
+
+// constantValue returns the value of c normalized so that equal integers are
+// represented by equal strings.
+func constantValue(c *ROS2Constant) string {
+	if n, err := strconv.ParseInt(c.Value, 0, 64); err == nil {
+		return strconv.FormatInt(n, 10)
+	} else if n, err := strconv.ParseUint(c.Value, 0, 64); err == nil {
+		return strconv.FormatUint(n, 10)
+	}
+	return c.Value
+}
+
 func (p *parser) parseLine(msg *ROS2Message, line string) error {
 	obj, err := p.parseMessageLine(line, msg)
 	if err != nil {
@@ -182,18 +377,7 @@ func (p *parser) parseLine(msg *ROS2Message, line string) error {
 	case *ROS2Constant:
 		msg.Constants = append(msg.Constants, obj)
 	case *ROS2Field:
-		msg.Fields = append(msg.Fields, obj)
-		switch obj.PkgName {
-		case "":
-		case ".":
-		case "time":
-			msg.GoImports["time"] = ""
-		case "primitives":
-			msg.GoImports[p.config.RclgoImportPath+"/pkg/rclgo/"+obj.PkgName] = obj.GoPkgName
-		default:
-			msg.GoImports[p.config.MessageModulePrefix+"/"+obj.PkgName+"/msg"] = obj.GoPkgName
-			msg.CImports.Add(obj.PkgName)
-		}
+		p.addField(msg, obj)
 	case nil:
 	default:
 		return fmt.Errorf("couldn't parse the input row '%s'", line)
@@ -201,6 +385,21 @@ func (p *parser) parseLine(msg *ROS2Message, line string) error {
 	return nil
 }
 
+func (p *parser) addField(msg *ROS2Message, f *ROS2Field) {
+	msg.Fields = append(msg.Fields, f)
+	switch f.PkgName {
+	case "":
+	case ".":
+	case "time":
+		msg.GoImports["time"] = ""
+	case "primitives":
+		msg.GoImports[p.config.RclgoImportPath+"/pkg/rclgo/"+f.PkgName] = f.GoPkgName
+	default:
+		msg.GoImports[p.config.MessageModulePrefix+"/"+f.PkgName+"/msg"] = f.GoPkgName
+		msg.CImports.Add(f.PkgName)
+	}
+}
+
 func (p *parser) parseMessageLine(testRow string, ros2msg *ROS2Message) (interface{}, error) {
 	re.R(&testRow, `m!^#\s*(.*)$!`) // Extract comments from comment-only lines to be included in the pre-field comments
 	if re.R0.Matches > 0 {
@@ -300,19 +499,21 @@ func (p *parser) ParseROS2MessageField(capture map[string]string, ros2msg *ROS2M
 		size = 0
 	}
 	f := &ROS2Field{
-		Comment:      commentSerializer(capture["comment"], &p.ros2messagesCommentsBuffer),
-		GoName:       snakeToCamel(capture["field"]),
-		RosName:      capture["field"],
-		CName:        cName(capture["field"]),
-		RosType:      capture["type"],
-		TypeArray:    capture["array"],
-		ArrayBounded: capture["bounded"],
-		ArraySize:    int(size),
-		DefaultValue: capture["default"],
-		PkgName:      capture["package"],
+		Comment:       commentSerializer(capture["comment"], &p.ros2messagesCommentsBuffer),
+		GoName:        snakeToCamel(capture["field"]),
+		RosName:       capture["field"],
+		CName:         cName(capture["field"]),
+		RosType:       capture["type"],
+		TypeArray:     capture["array"],
+		ArrayBounded:  capture["bounded"],
+		ArraySize:     int(size),
+		StringBounded: capture["boundedString"],
+		DefaultValue:  capture["default"],
+		PkgName:       capture["package"],
 	}
 
 	f.PkgName, f.CType, f.GoType = translateROS2Type(f, ros2msg)
+	p.checkDefaultValue(f)
 	f.GoPkgName = f.PkgName
 	switch f.PkgName {
 	case "", "time", "primitives":
@@ -326,6 +527,13 @@ func (p *parser) ParseROS2MessageField(capture map[string]string, ros2msg *ROS2M
 	default:
 		f.GoPkgName = f.PkgName + "_msg"
 	}
+	switch f.PkgName {
+	case "", "time", "primitives":
+	case ".":
+		f.GoType = p.config.goTypeName(ros2msg.Package, f.RosType)
+	default:
+		f.GoType = p.config.goTypeName(f.PkgName, f.RosType)
+	}
 	// Prepopulate extra Go imports
 	p.cSerializationCode(f, ros2msg)
 	p.goSerializationCode(f, ros2msg)
@@ -333,6 +541,44 @@ func (p *parser) ParseROS2MessageField(capture map[string]string, ros2msg *ROS2M
 	return f, nil
 }
 
+// checkDefaultValue warns about default values which the generated code would
+// not handle correctly.
+func (p *parser) checkDefaultValue(f *ROS2Field) {
+	value := strings.TrimSpace(f.DefaultValue)
+	if value == "" {
+		return
+	}
+	if f.PkgName != "" {
+		p.warnf("the default value of field %s is ignored, because the field does not have a primitive type", f.RosName)
+		return
+	}
+	values := []string{value}
+	if f.TypeArray != "" {
+		values = splitMsgDefaultArrayValues(f.RosType, value)
+	}
+	for _, v := range values {
+		if !validDefaultValue(f.RosType, strings.TrimSpace(v)) {
+			p.warnf("unknown default value %q of field %s of type %s", v, f.RosName, f.RosType)
+			return
+		}
+	}
+}
+
+func validDefaultValue(rosType, value string) bool {
+	var err error
+	switch rosType {
+	case "bool":
+		return value == "true" || value == "false"
+	case "float32", "float64":
+		_, err = strconv.ParseFloat(value, 64)
+	case "int8", "int16", "int32", "int64":
+		_, err = strconv.ParseInt(value, 0, 64)
+	case "uint8", "uint16", "uint32", "uint64", "byte", "char":
+		_, err = strconv.ParseUint(value, 0, 64)
+	}
+	return err == nil
+}
+
 func translateROS2Type(f *ROS2Field, m *ROS2Message) (pkgName string, cType string, goType string) {
 	t, ok := primitiveTypeMappings[f.RosType]
 	if ok {
@@ -376,19 +622,19 @@ func translateROS2Type(f *ROS2Field, m *ROS2Message) (pkgName string, cType stri
 func (p *parser) cSerializationCode(f *ROS2Field, m *ROS2Message) string {
 	if f.TypeArray != "" && f.ArraySize > 0 && f.PkgName != "" && f.PkgIsLocal {
 		// Complex value Array local package reference
-		return ucFirst(f.RosType) + `__Array_to_C(mem.` + f.CName + `[:], m.` + f.GoName + `[:])`
+		return f.GoType + `__Array_to_C(mem.` + f.CName + `[:], m.` + f.GoName + `[:])`
 
 	} else if f.TypeArray != "" && f.ArraySize > 0 && f.PkgName != "" && !f.PkgIsLocal {
 		// Complex value Array remote package reference
 		return `cSlice_` + f.RosName + ` := mem.` + f.CName + `[:]
-	` + f.GoPkgReference() + ucFirst(f.RosType) + `__Array_to_C(*(*[]` + f.GoPkgReference() + `C` + ucFirst(f.RosType) + `)(unsafe.Pointer(&cSlice_` + f.RosName + `)), m.` + f.GoName + `[:])`
+	` + f.GoPkgReference() + f.GoType + `__Array_to_C(*(*[]` + f.GoPkgReference() + `C` + f.GoType + `)(unsafe.Pointer(&cSlice_` + f.RosName + `)), m.` + f.GoName + `[:])`
 	} else if f.TypeArray != "" && f.ArraySize == 0 && f.PkgName != "" && f.PkgIsLocal {
 		// Complex value Slice local package reference
-		return ucFirst(f.RosType) + `__Sequence_to_C(&mem.` + f.CName + `, m.` + f.GoName + `)`
+		return f.GoType + `__Sequence_to_C(&mem.` + f.CName + `, m.` + f.GoName + `)`
 
 	} else if f.TypeArray != "" && f.ArraySize == 0 && f.PkgName != "" && !f.PkgIsLocal {
 		// Complex value Slice remote package reference
-		return f.GoPkgReference() + ucFirst(f.RosType) + `__Sequence_to_C((*` + f.GoPkgReference() + `C` + ucFirst(f.RosType) + `__Sequence)(unsafe.Pointer(&mem.` + f.CName + `)), m.` + f.GoName + `)`
+		return f.GoPkgReference() + f.GoType + `__Sequence_to_C((*` + f.GoPkgReference() + `C` + f.GoType + `__Sequence)(unsafe.Pointer(&mem.` + f.CName + `)), m.` + f.GoName + `)`
 
 	} else if f.TypeArray == "" && f.PkgName != "" {
 		// Complex value single
@@ -427,20 +673,20 @@ func (p *parser) goSerializationCode(f *ROS2Field, m *ROS2Message) string {
 
 	if f.TypeArray != "" && f.ArraySize > 0 && f.PkgName != "" && f.PkgIsLocal {
 		// Complex value Array local package reference
-		return ucFirst(f.RosType) + `__Array_to_Go(m.` + f.GoName + `[:], mem.` + f.CName + `[:])`
+		return f.GoType + `__Array_to_Go(m.` + f.GoName + `[:], mem.` + f.CName + `[:])`
 
 	} else if f.TypeArray != "" && f.ArraySize > 0 && f.PkgName != "" {
 		// Complex value Array remote package reference
 		return `cSlice_` + f.RosName + ` := mem.` + f.CName + `[:]
-	` + f.GoPkgReference() + ucFirst(f.RosType) + `__Array_to_Go(m.` + f.GoName + `[:], *(*[]` + f.GoPkgReference() + `C` + ucFirst(f.RosType) + `)(unsafe.Pointer(&cSlice_` + f.RosName + `)))`
+	` + f.GoPkgReference() + f.GoType + `__Array_to_Go(m.` + f.GoName + `[:], *(*[]` + f.GoPkgReference() + `C` + f.GoType + `)(unsafe.Pointer(&cSlice_` + f.RosName + `)))`
 
 	} else if f.TypeArray != "" && f.ArraySize == 0 && f.PkgName != "" && f.PkgIsLocal {
 		// Complex value Slice local package reference
-		return ucFirst(f.RosType) + `__Sequence_to_Go(&m.` + f.GoName + `, mem.` + f.CName + `)`
+		return f.GoType + `__Sequence_to_Go(&m.` + f.GoName + `, mem.` + f.CName + `)`
 
 	} else if f.TypeArray != "" && f.ArraySize == 0 && f.PkgName != "" && !f.PkgIsLocal {
 		// Complex value Slice remote package reference
-		return f.GoPkgReference() + ucFirst(f.RosType) + `__Sequence_to_Go(&m.` + f.GoName + `, *(*` + f.GoPkgReference() + `C` + ucFirst(f.RosType) + `__Sequence)(unsafe.Pointer(&mem.` + f.CName + `)))`
+		return f.GoPkgReference() + f.GoType + `__Sequence_to_Go(&m.` + f.GoName + `, *(*` + f.GoPkgReference() + `C` + f.GoType + `__Sequence)(unsafe.Pointer(&mem.` + f.CName + `)))`
 
 	} else if f.TypeArray == "" && f.PkgName != "" {
 		// Complex value single
@@ -470,6 +716,9 @@ func (p *parser) goSerializationCode(f *ROS2Field, m *ROS2Message) string {
 			m.GoImports[p.config.RclgoImportPath+"/pkg/rclgo/primitives"] = "primitives"
 			return "primitives.U16StringAsGoStruct(&m." + f.GoName + ", unsafe.Pointer(&mem." + f.CName + "))"
 		}
+		if f.EnumType != "" {
+			return `m.` + f.GoName + ` = ` + f.EnumType + `(mem.` + f.CName + `)`
+		}
 		return `m.` + f.GoName + ` = ` + f.GoType + `(mem.` + f.CName + `)`
 
 	}
@@ -570,3 +819,247 @@ func cloneCode(f *ROS2Field) string {
 	}
 	return "//<MISSING cloneCode!!>"
 }
+
+// cdrMethods maps ROS primitive types to the names of the cdr.Encoder and
+// cdr.Decoder methods used to encode and decode them.
+var cdrMethods = map[string]string{
+	"bool":      "Bool",
+	"byte":      "Uint8",
+	"char":      "Uint8",
+	"int8":      "Int8",
+	"uint8":     "Uint8",
+	"int16":     "Int16",
+	"uint16":    "Uint16",
+	"int32":     "Int32",
+	"uint32":    "Uint32",
+	"int64":     "Int64",
+	"uint64":    "Uint64",
+	"float32":   "Float32",
+	"float64":   "Float64",
+	"string":    "String",
+	"U16String": "WString",
+}
+
+// cdrSequenceBound returns the upper bound of a bounded sequence field, or "0"
+// if the sequence is unbounded.
+func cdrSequenceBound(f *ROS2Field) string {
+	if bound := strings.TrimPrefix(f.ArrayBounded, "<="); bound != "" {
+		return bound
+	}
+	return "0"
+}
+
+func cdrEncodeCode(f *ROS2Field) string {
+	method := cdrMethods[f.RosType]
+	seqLen := ""
+	if f.TypeArray != "" && f.ArraySize == 0 {
+		seqLen = "e.SequenceLength(len(t." + f.GoName + "), " + cdrSequenceBound(f) + ")\n\t"
+	}
+	if f.PkgName != "" && f.TypeArray != "" {
+		// complex value array and slice
+		return seqLen + "for i := range t." + f.GoName + " {\n" +
+			"\t\tt." + f.GoName + "[i].EncodeCDR(e)\n" +
+			"\t}"
+	} else if f.PkgName != "" {
+		// complex value single
+		return "t." + f.GoName + ".EncodeCDR(e)"
+	} else if method == "" {
+		return "//<MISSING cdrEncodeCode!!>"
+	} else if f.TypeArray != "" && method == "Uint8" {
+		// byte value array and slice
+		return seqLen + "e.Uint8s(t." + f.GoName + "[:])"
+	} else if f.TypeArray != "" {
+		// primitive value array and slice
+		return seqLen + "for i := range t." + f.GoName + " {\n" +
+			"\t\te." + method + "(t." + f.GoName + "[i])\n" +
+			"\t}"
+	}
+	// primitive value single
+	if f.EnumType != "" {
+		return "e." + method + "(" + f.GoType + "(t." + f.GoName + "))"
+	}
+	return "e." + method + "(t." + f.GoName + ")"
+}
+
+func cdrDecodeCode(f *ROS2Field) string {
+	method := cdrMethods[f.RosType]
+	makeSlice := ""
+	if f.TypeArray != "" && f.ArraySize == 0 {
+		makeSlice = "t." + f.GoName + " = cdr.MakeSlice[" + f.GoPkgReference() + f.GoType + "](d, " + cdrSequenceBound(f) + ")\n\t"
+	}
+	if f.PkgName != "" && f.TypeArray != "" {
+		// complex value array and slice
+		return makeSlice + "for i := range t." + f.GoName + " {\n" +
+			"\t\tt." + f.GoName + "[i].DecodeCDR(d)\n" +
+			"\t}"
+	} else if f.PkgName != "" {
+		// complex value single
+		return "t." + f.GoName + ".DecodeCDR(d)"
+	} else if method == "" {
+		return "//<MISSING cdrDecodeCode!!>"
+	} else if f.TypeArray != "" && method == "Uint8" {
+		// byte value array and slice
+		return makeSlice + "d.Uint8s(t." + f.GoName + "[:])"
+	} else if f.TypeArray != "" {
+		// primitive value array and slice
+		return makeSlice + "for i := range t." + f.GoName + " {\n" +
+			"\t\tt." + f.GoName + "[i] = d." + method + "()\n" +
+			"\t}"
+	}
+	// primitive value single
+	if f.EnumType != "" {
+		return "t." + f.GoName + " = " + f.EnumType + "(d." + method + "())"
+	}
+	return "t." + f.GoName + " = d." + method + "()"
+}
+
+// validateCode returns the code checking the bounds of f, or an empty string if
+// f has no bounds to check.
+func validateCode(f *ROS2Field) string {
+	var code []string
+	rosName := strconv.Quote(f.RosName)
+	if f.TypeArray != "" && f.ArrayBounded != "" {
+		// bounded sequence
+		bound := strings.TrimPrefix(f.ArrayBounded, "<=")
+		code = append(code, "if len(t."+f.GoName+") > "+bound+" {\n"+
+			"\t\treturn rclgo.NewBoundError("+rosName+", -1, len(t."+f.GoName+"), "+bound+")\n"+
+			"\t}")
+	}
+	if f.PkgName == "" && f.StringBounded != "" {
+		bound := strings.TrimPrefix(f.StringBounded, "<=")
+		if f.TypeArray != "" {
+			// bounded string array and slice
+			code = append(code, "for i := range t."+f.GoName+" {\n"+
+				"\t\tif len(t."+f.GoName+"[i]) > "+bound+" {\n"+
+				"\t\t\treturn rclgo.NewBoundError("+rosName+", i, len(t."+f.GoName+"[i]), "+bound+")\n"+
+				"\t\t}\n"+
+				"\t}")
+		} else {
+			// bounded string single
+			code = append(code, "if len(t."+f.GoName+") > "+bound+" {\n"+
+				"\t\treturn rclgo.NewBoundError("+rosName+", -1, len(t."+f.GoName+"), "+bound+")\n"+
+				"\t}")
+		}
+	} else if f.PkgName != "" && f.TypeArray != "" {
+		// complex value array and slice
+		code = append(code, "for i := range t."+f.GoName+" {\n"+
+			"\t\tif err := t."+f.GoName+"[i].Validate(); err != nil {\n"+
+			"\t\t\treturn rclgo.WrapValidationError("+rosName+", i, err)\n"+
+			"\t\t}\n"+
+			"\t}")
+	} else if f.PkgName != "" {
+		// complex value single
+		code = append(code, "if err := t."+f.GoName+".Validate(); err != nil {\n"+
+			"\t\treturn rclgo.WrapValidationError("+rosName+", -1, err)\n"+
+			"\t}")
+	}
+	return strings.Join(code, "\n\t")
+}
+
+func equalCode(f *ROS2Field) string {
+	notEqual := ""
+	switch {
+	case f.PkgName != "" && f.TypeArray != "":
+		// complex value array and slice
+		code := "for i := range t." + f.GoName + " {\n" +
+			"\t\tif !t." + f.GoName + "[i].EqualApprox(&other." + f.GoName + "[i], tol) {\n" +
+			"\t\t\treturn false\n" +
+			"\t\t}\n" +
+			"\t}"
+		if f.ArraySize == 0 {
+			code = "if len(t." + f.GoName + ") != len(other." + f.GoName + ") {\n" +
+				"\t\treturn false\n" +
+				"\t}\n\t" + code
+		}
+		return code
+	case f.PkgName != "":
+		// complex value single
+		notEqual = "!t." + f.GoName + ".EqualApprox(&other." + f.GoName + ", tol)"
+	case f.RosType == "float32" || f.RosType == "float64":
+		if f.TypeArray != "" {
+			// float value array and slice
+			slice := ""
+			if f.ArraySize > 0 {
+				slice = "[:]"
+			}
+			notEqual = "!msgcmp." + ucFirst(f.RosType) + "s(t." + f.GoName + slice + ", other." + f.GoName + slice + ", tol)"
+		} else {
+			// float value single
+			notEqual = "!msgcmp." + ucFirst(f.RosType) + "(t." + f.GoName + ", other." + f.GoName + ", tol)"
+		}
+	case f.TypeArray != "" && f.ArraySize == 0:
+		// primitive value slice
+		notEqual = "!msgcmp.Slices(t." + f.GoName + ", other." + f.GoName + ")"
+	default:
+		// primitive value single and array
+		notEqual = "t." + f.GoName + " != other." + f.GoName
+	}
+	return "if " + notEqual + " {\n" +
+		"\t\treturn false\n" +
+		"\t}"
+}
+
+// hashCode returns the code adding f to a msgcmp.Hasher. Returns an error if
+// values of the type of f cannot be hashed, so that generation fails instead of
+// leaving the field out of the hash.
+func hashCode(f *ROS2Field) (string, error) {
+	var elem string
+	if f.PkgName != "" {
+		elem = "t." + f.GoName + "%s.HashTo(h)"
+	} else if method := cdrMethods[f.RosType]; method == "WString" {
+		elem = "h.String(t." + f.GoName + "%s)"
+	} else if method != "" && f.EnumType != "" {
+		elem = "h." + method + "(" + f.GoType + "(t." + f.GoName + "%s))"
+	} else if method != "" {
+		elem = "h." + method + "(t." + f.GoName + "%s)"
+	} else {
+		return "", fmt.Errorf("cannot generate hash code for field %q of type %q", f.RosName, f.RosType)
+	}
+	if f.TypeArray == "" {
+		return fmt.Sprintf(elem, ""), nil
+	}
+	code := "for i := range t." + f.GoName + " {\n" +
+		"\t\t" + fmt.Sprintf(elem, "[i]") + "\n" +
+		"\t}"
+	if f.ArraySize == 0 {
+		code = "h.Len(len(t." + f.GoName + "))\n\t" + code
+	}
+	return code, nil
+}
+
+// fieldDescriptor returns the types.FieldDescriptor literal describing field
+// f, which is the field at index i of msg.
+func fieldDescriptor(i int, f *ROS2Field, msg *ROS2Message) string {
+	typ := f.RosType
+	if typ == "U16String" {
+		typ = "wstring"
+	}
+	nested := ""
+	switch f.PkgName {
+	case "", "time", "primitives":
+	default:
+		typ = nestedTypeName(f, msg)
+		nested = f.GoPkgReference() + f.GoType + "Descriptor"
+	}
+	parts := []string{
+		"Name: " + strconv.Quote(f.RosName),
+		"Type: " + strconv.Quote(typ),
+		"GoIndex: " + strconv.Itoa(i),
+	}
+	if f.TypeArray != "" {
+		parts = append(parts, "IsArray: true")
+	}
+	switch {
+	case f.ArrayBounded != "":
+		parts = append(parts, "ArraySize: "+strconv.FormatUint(parseBound(f.ArrayBounded), 10), "IsUpperBound: true")
+	case f.ArraySize > 0:
+		parts = append(parts, "ArraySize: "+strconv.Itoa(f.ArraySize))
+	}
+	if f.StringBounded != "" {
+		parts = append(parts, "StringUpperBound: "+strconv.FormatUint(parseBound(f.StringBounded), 10))
+	}
+	if nested != "" {
+		parts = append(parts, "Message: "+nested)
+	}
+	return "{" + strings.Join(parts, ", ") + "}"
+}
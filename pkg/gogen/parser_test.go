@@ -14,6 +14,8 @@ package gogen
 import (
 	"crypto/md5"
 	"encoding/hex"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/bradleyjkemp/cupaloy/v2"
@@ -232,6 +234,264 @@ string input
 		t.StringValues[i].SetDefaults()
 	}`)
 	})
+
+	Convey("cdrEncodeCode() and cdrDecodeCode() generators", t, func() {
+		bounded := &ROS2Field{
+			TypeArray:    "[]",
+			ArrayBounded: "<=3",
+			RosType:      "int16",
+			GoType:       "int16",
+			GoName:       "Int16Values",
+		}
+		So(cdrEncodeCode(bounded), ShouldEqual, `e.SequenceLength(len(t.Int16Values), 3)
+	for i := range t.Int16Values {
+		e.Int16(t.Int16Values[i])
+	}`)
+		So(cdrDecodeCode(bounded), ShouldEqual, `t.Int16Values = cdr.MakeSlice[int16](d, 3)
+	for i := range t.Int16Values {
+		t.Int16Values[i] = d.Int16()
+	}`)
+		bytes := &ROS2Field{TypeArray: "[4]", ArraySize: 4, RosType: "byte", GoType: "byte", GoName: "Data"}
+		So(cdrEncodeCode(bytes), ShouldEqual, `e.Uint8s(t.Data[:])`)
+		So(cdrDecodeCode(bytes), ShouldEqual, `d.Uint8s(t.Data[:])`)
+		complexSeq := &ROS2Field{
+			TypeArray: "[]",
+			PkgName:   "std_msgs",
+			GoPkgName: "std_msgs_msg",
+			RosType:   "Header",
+			GoType:    "Header",
+			GoName:    "Headers",
+		}
+		So(cdrDecodeCode(complexSeq), ShouldEqual, `t.Headers = cdr.MakeSlice[std_msgs_msg.Header](d, 0)
+	for i := range t.Headers {
+		t.Headers[i].DecodeCDR(d)
+	}`)
+		So(cdrEncodeCode(&ROS2Field{RosType: "U16String", GoType: "string", GoName: "Name"}), ShouldEqual, `e.WString(t.Name)`)
+	})
+
+	Convey("validateCode() generator", t, func() {
+		So(validateCode(&ROS2Field{RosType: "int32", GoName: "Count", RosName: "count"}), ShouldEqual, "")
+		So(validateCode(&ROS2Field{
+			TypeArray:     "[]",
+			ArrayBounded:  "<=3",
+			StringBounded: "<=22",
+			RosType:       "string",
+			GoType:        "string",
+			GoName:        "Names",
+			RosName:       "names",
+		}), ShouldEqual, `if len(t.Names) > 3 {
+		return rclgo.NewBoundError("names", -1, len(t.Names), 3)
+	}
+	for i := range t.Names {
+		if len(t.Names[i]) > 22 {
+			return rclgo.NewBoundError("names", i, len(t.Names[i]), 22)
+		}
+	}`)
+		So(validateCode(&ROS2Field{
+			PkgName:   "std_msgs",
+			GoPkgName: "std_msgs_msg",
+			RosType:   "Header",
+			GoType:    "Header",
+			GoName:    "Header",
+			RosName:   "header",
+		}), ShouldEqual, `if err := t.Header.Validate(); err != nil {
+		return rclgo.WrapValidationError("header", -1, err)
+	}`)
+	})
+
+	Convey("equalCode() and hashCode() generators", t, func() {
+		floats := &ROS2Field{TypeArray: "[3]", ArraySize: 3, RosType: "float32", GoType: "float32", GoName: "Values"}
+		So(equalCode(floats), ShouldEqual, `if !msgcmp.Float32s(t.Values[:], other.Values[:], tol) {
+		return false
+	}`)
+		code, err := hashCode(floats)
+		So(err, ShouldBeNil)
+		So(code, ShouldEqual, `for i := range t.Values {
+		h.Float32(t.Values[i])
+	}`)
+		poses := &ROS2Field{
+			TypeArray: "[]",
+			PkgName:   "geometry_msgs",
+			GoPkgName: "geometry_msgs_msg",
+			RosType:   "Pose",
+			GoType:    "Pose",
+			GoName:    "Poses",
+		}
+		So(equalCode(poses), ShouldEqual, `if len(t.Poses) != len(other.Poses) {
+		return false
+	}
+	for i := range t.Poses {
+		if !t.Poses[i].EqualApprox(&other.Poses[i], tol) {
+			return false
+		}
+	}`)
+		code, err = hashCode(poses)
+		So(err, ShouldBeNil)
+		So(code, ShouldEqual, `h.Len(len(t.Poses))
+	for i := range t.Poses {
+		t.Poses[i].HashTo(h)
+	}`)
+		So(equalCode(&ROS2Field{TypeArray: "[]", RosType: "string", GoType: "string", GoName: "Names"}), ShouldEqual, `if !msgcmp.Slices(t.Names, other.Names) {
+		return false
+	}`)
+		_, err = hashCode(&ROS2Field{RosType: "float128", GoType: "float128", GoName: "Value", RosName: "value"})
+		So(err, ShouldBeError)
+	})
+}
+
+func TestDetectEnums(t *testing.T) {
+	SetDefaultFailureMode(FailureContinues)
+
+	const definition = `
+int8 STATUS_UNKNOWN = 0
+int8 STATUS_OK = 1
+int8 STATUS_FIX = 1
+uint16 SERVICE_GPS = 1
+uint16 SERVICE_GLONASS = 2
+int8 ALONE_VALUE = 3
+float32 RATIO_MIN = 0.0
+float32 RATIO_MAX = 1.0
+int8 status
+uint16 service
+int8[] statuses
+`
+	const batteryState = `
+uint8 POWER_SUPPLY_STATUS_UNKNOWN = 0
+uint8 POWER_SUPPLY_STATUS_CHARGING = 1
+uint8 POWER_SUPPLY_STATUS_NOT_CHARGING = 3
+uint8 POWER_SUPPLY_HEALTH_UNKNOWN = 0
+uint8 POWER_SUPPLY_HEALTH_GOOD = 1
+uint8 POWER_SUPPLY_HEALTH_SAFETY_TIMER_EXPIRE = 8
+uint8 POWER_SUPPLY_TECHNOLOGY_UNKNOWN = 0
+uint8 POWER_SUPPLY_TECHNOLOGY_NIMH = 1
+int8 STATUS_NO_FIX = -1
+int8 STATUS_FIX = 0
+int8 STATUS_SBAS_FIX = 1
+uint8 power_supply_health
+`
+	parse := func(enumFields map[string]string) (*ROS2Message, error) {
+		p := &parser{config: &Config{EnumFields: enumFields}}
+		msg := ROS2MessageNew("sensor_msgs", "Fix")
+		msg.Type = "msg"
+		return msg, p.ParseROS2Message(msg, definition)
+	}
+
+	Convey("Groups of integer constants become enums", t, func() {
+		msg, err := parse(nil)
+		So(err, ShouldBeNil)
+		So(msg.Enums, ShouldHaveLength, 2)
+		So(msg.Enums[0].GoName, ShouldEqual, "Fix_Status")
+		So(msg.Enums[0].GoType, ShouldEqual, "int8")
+		So(msg.Enums[0].Unsigned, ShouldBeFalse)
+		So(msg.Enums[0].Constants, ShouldHaveLength, 3)
+		So(msg.Enums[0].Values, ShouldHaveLength, 2)
+		So(msg.Enums[1].GoName, ShouldEqual, "Fix_Service")
+		So(msg.Enums[1].Unsigned, ShouldBeTrue)
+		So(msg.GoImports, ShouldContainKey, "strconv")
+		So(msg.Fields[0].EnumType, ShouldEqual, "")
+		So(msg.Constants[0].EnumType, ShouldEqual, "")
+	})
+	Convey("Mapped fields use the enum type", t, func() {
+		msg, err := parse(map[string]string{"sensor_msgs/msg/Fix.status": "STATUS"})
+		So(err, ShouldBeNil)
+		So(msg.Fields[0].EnumType, ShouldEqual, "Fix_Status")
+		So(msg.Fields[1].EnumType, ShouldEqual, "")
+		So(msg.Constants[2].EnumType, ShouldEqual, "Fix_Status")
+		So(msg.Constants[3].EnumType, ShouldEqual, "")
+	})
+	Convey("Invalid mappings are rejected", t, func() {
+		_, err := parse(map[string]string{"sensor_msgs/msg/Fix.status": "RATIO"})
+		So(err, ShouldBeError)
+		_, err = parse(map[string]string{"sensor_msgs/msg/Fix.service": "STATUS"})
+		So(err, ShouldBeError)
+		_, err = parse(map[string]string{"sensor_msgs/msg/Fix.statuses": "STATUS"})
+		So(err, ShouldBeError)
+	})
+	Convey("Constant groups with colliding values are split by the longest shared prefix", t, func() {
+		p := &parser{config: &Config{EnumFields: map[string]string{
+			"sensor_msgs/msg/BatteryState.power_supply_health": "POWER_SUPPLY_HEALTH",
+		}}}
+		msg := ROS2MessageNew("sensor_msgs", "BatteryState")
+		msg.Type = "msg"
+		So(p.ParseROS2Message(msg, batteryState), ShouldBeNil)
+		So(msg.Enums, ShouldHaveLength, 4)
+		names := make([]string, len(msg.Enums))
+		for i, e := range msg.Enums {
+			names[i] = e.GoName
+			So(e.Values, ShouldHaveLength, len(e.Constants))
+		}
+		So(names, ShouldResemble, []string{
+			"BatteryState_PowerSupplyStatus",
+			"BatteryState_PowerSupplyHealth",
+			"BatteryState_PowerSupplyTechnology",
+			"BatteryState_Status",
+		})
+		So(msg.Enums[1].Prefix, ShouldEqual, "POWER_SUPPLY_HEALTH")
+		So(msg.Enums[1].Constants, ShouldHaveLength, 3)
+		So(msg.Enums[3].Constants, ShouldHaveLength, 3)
+		So(msg.Fields[0].EnumType, ShouldEqual, "BatteryState_PowerSupplyHealth")
+	})
+}
+
+func TestTimeHelpers(t *testing.T) {
+	SetDefaultFailureMode(FailureContinues)
+
+	parse := func(pkg, name, definition string) *ROS2Message {
+		p := &parser{config: &Config{}}
+		msg := ROS2MessageNew(pkg, name)
+		msg.Type = "msg"
+		So(p.ParseROS2Message(msg, definition), ShouldBeNil)
+		return msg
+	}
+
+	Convey("Time helpers are generated for time messages", t, func() {
+		So(hasTimeHelpers(parse("builtin_interfaces", "Time", "int32 sec\nuint32 nanosec\n")), ShouldBeTrue)
+		So(hasTimeHelpers(parse("builtin_interfaces", "Duration", "int32 sec\nuint32 nanosec\n")), ShouldBeTrue)
+		So(hasTimeHelpers(parse("std_msgs", "Header", "builtin_interfaces/Time stamp\nstring frame_id\n")), ShouldBeTrue)
+	})
+	Convey("Time helpers are not generated for other messages", t, func() {
+		So(hasTimeHelpers(parse("demo_msgs", "Time", "int32 sec\nuint32 nanosec\n")), ShouldBeFalse)
+		So(hasTimeHelpers(parse("builtin_interfaces", "Time", "int64 sec\nuint32 nanosec\n")), ShouldBeFalse)
+		So(hasTimeHelpers(parse("std_msgs", "Header", "string frame_id\n")), ShouldBeFalse)
+	})
+	Convey("Time helpers are generated", t, func() {
+		root := t.TempDir()
+		dest := t.TempDir()
+		writeTestFiles(root, map[string]string{
+			"share/builtin_interfaces/msg/Time.msg":     "int32 sec\nuint32 nanosec\n",
+			"share/builtin_interfaces/msg/Duration.msg": "int32 sec\nuint32 nanosec\n",
+			"share/std_msgs/msg/Header.msg":             "builtin_interfaces/Time stamp\nstring frame_id\n",
+		})
+		g := New(&Config{
+			RootPaths:           []string{root},
+			DestPath:            dest,
+			RclgoImportPath:     DefaultConfig.RclgoImportPath,
+			MessageModulePrefix: "example.com/msgs",
+		})
+		So(g.GenerateGolangMessageTypes(), ShouldBeNil)
+		for file, funcs := range map[string][]string{
+			"builtin_interfaces/msg/Time.gen.go": {
+				"func (t *Time) ToTime() time.Time",
+				"func (t *Time) FromTime(tm time.Time)",
+				"func Now(clock *rclgo.Clock) (*Time, error)",
+			},
+			"builtin_interfaces/msg/Duration.gen.go": {
+				"func (t *Duration) ToDuration() time.Duration",
+				"func (t *Duration) FromDuration(d time.Duration)",
+			},
+			"std_msgs/msg/Header.gen.go": {
+				"func (t *Header) SetStamp(tm time.Time)",
+				"func (t *Header) StampNow(clock *rclgo.Clock) error",
+			},
+		} {
+			data, err := os.ReadFile(filepath.Join(dest, filepath.FromSlash(file)))
+			So(err, ShouldBeNil)
+			So(string(data), ShouldContainSubstring, `"time"`)
+			for _, f := range funcs {
+				So(string(data), ShouldContainSubstring, f)
+			}
+		}
+	})
 }
 
 func TestCErrorTypeParser(t *testing.T) {
@@ -0,0 +1,255 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package gogen
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ProjectConfigFileName is the name of the project configuration file which
+// rclgo-gen looks for in the root of the Go module.
+const ProjectConfigFileName = "rclgo-gen.yaml"
+
+// ProjectConfig contains the generation settings of a project. The keys of the
+// file are the names of the corresponding flags of rclgo-gen generate, except
+// that the keys of the repeatable flags root-path, include-package and
+// type-rename are in plural. Relative paths are resolved against the directory
+// of the file by LoadProjectConfig.
+type ProjectConfig struct {
+	RootPaths           []string `yaml:"root-paths"`
+	DestPath            string   `yaml:"dest-path"`
+	MessageModulePrefix string   `yaml:"message-module-prefix"`
+	CGOFlagsPath        string   `yaml:"cgo-flags-path"`
+
+	IncludePackages      []string `yaml:"include-packages"`
+	IncludePackageDeps   []string `yaml:"include-package-deps"`
+	IncludeGoPackageDeps []string `yaml:"include-go-package-deps"`
+	Blacklist            []string `yaml:"blacklist"`
+
+	// TypeRenames maps messages, such as "std_msgs/msg/String", to the names
+	// of their generated Go types.
+	TypeRenames map[string]string `yaml:"type-renames"`
+
+	LicenseHeaderPath string `yaml:"license-header-path"`
+	TargetDistro      string `yaml:"target-distro"`
+
+	// Path is the path of the file the configuration was loaded from.
+	Path string `yaml:"-"`
+}
+
+// ProjectConfigError is an invalid value in a project configuration file.
+type ProjectConfigError struct {
+	Path   string
+	Key    string // Such as "include-packages[1]"
+	Line   int
+	Column int
+	Err    error
+}
+
+func (e *ProjectConfigError) Error() string {
+	return fmt.Sprintf("%s:%d:%d: %s: %v", e.Path, e.Line, e.Column, e.Key, e.Err)
+}
+
+func (e *ProjectConfigError) Unwrap() error {
+	return e.Err
+}
+
+// FindProjectConfig returns the path of the project configuration file in the
+// root of the Go module containing dir, or an empty string if the file does
+// not exist. If dir is not in a module, the file is looked for in dir.
+func FindProjectConfig(dir string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	root := dir
+	for d := dir; ; d = filepath.Dir(d) {
+		if _, err := os.Stat(filepath.Join(d, "go.mod")); err == nil {
+			root = d
+			break
+		}
+		if filepath.Dir(d) == d {
+			break
+		}
+	}
+	p := filepath.Join(root, ProjectConfigFileName)
+	if _, err := os.Stat(p); errors.Is(err, os.ErrNotExist) {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+	return p, nil
+}
+
+// LoadProjectConfig reads and validates the project configuration file at
+// path. Validation errors are of type *ProjectConfigError.
+func LoadProjectConfig(path string) (*ProjectConfig, error) {
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	c := &ProjectConfig{Path: path}
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(c); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if err := c.validate(&root); err != nil {
+		return nil, err
+	}
+	c.resolvePaths()
+	return c, nil
+}
+
+func (c *ProjectConfig) validate(root *yaml.Node) error {
+	for i, p := range c.RootPaths {
+		if p == "" {
+			return c.errorAt(root, errors.New("must not be empty"), "root-paths", i)
+		}
+	}
+	for i, pattern := range c.IncludePackages {
+		if _, err := NewRule(pattern); err != nil {
+			return c.errorAt(root, err, "include-packages", i)
+		}
+	}
+	for i, pattern := range c.Blacklist {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return c.errorAt(root, err, "blacklist", i)
+		}
+	}
+	rosTypes := make([]string, 0, len(c.TypeRenames))
+	for rosType := range c.TypeRenames {
+		rosTypes = append(rosTypes, rosType)
+	}
+	sort.Strings(rosTypes)
+	for _, rosType := range rosTypes {
+		if err := validateTypeRename(rosType, c.TypeRenames[rosType]); err != nil {
+			return c.errorAt(root, err, "type-renames", rosType)
+		}
+	}
+	if err := validateTypeRenames(c.TypeRenames); err != nil {
+		return c.errorAt(root, err, "type-renames")
+	}
+	if len(c.TypeRenames) > 0 && len(c.RootPaths) > 0 {
+		rootPaths := make([]string, len(c.RootPaths))
+		for i, p := range c.RootPaths {
+			rootPaths[i] = c.resolvePath(p)
+		}
+		blacklist := make([]*regexp.Regexp, len(c.Blacklist))
+		for i, pattern := range c.Blacklist {
+			blacklist[i] = regexp.MustCompile(pattern)
+		}
+		g := New(&Config{RootPaths: rootPaths, Blacklist: blacklist})
+		if rosType, err := findTypeRenameCollision(c.TypeRenames, g.hasInterface); err != nil {
+			return c.errorAt(root, err, "type-renames", rosType)
+		}
+	}
+	if c.TargetDistro != "" {
+		if _, err := LookupDistro(c.TargetDistro); err != nil {
+			return c.errorAt(root, err, "target-distro")
+		}
+	}
+	if c.LicenseHeaderPath != "" {
+		if _, err := os.Stat(c.resolvePath(c.LicenseHeaderPath)); err != nil {
+			return c.errorAt(root, err, "license-header-path")
+		}
+	}
+	return nil
+}
+
+// errorAt returns a *ProjectConfigError positioned at the node found by
+// following keys, which are mapping keys (string) or sequence indices (int),
+// from root.
+func (c *ProjectConfig) errorAt(root *yaml.Node, err error, keys ...interface{}) error {
+	e := &ProjectConfigError{Path: c.Path, Err: err}
+	node := root
+	if node.Kind == yaml.DocumentNode && len(node.Content) > 0 {
+		node = node.Content[0]
+	}
+	var keyPath strings.Builder
+	for _, key := range keys {
+		switch key := key.(type) {
+		case string:
+			if keyPath.Len() > 0 {
+				keyPath.WriteString(".")
+			}
+			keyPath.WriteString(key)
+			node = mappingValue(node, key)
+		case int:
+			fmt.Fprintf(&keyPath, "[%d]", key)
+			if node != nil && node.Kind == yaml.SequenceNode && key < len(node.Content) {
+				node = node.Content[key]
+			} else {
+				node = nil
+			}
+		}
+		if node != nil {
+			e.Line, e.Column = node.Line, node.Column
+		}
+	}
+	e.Key = keyPath.String()
+	return e
+}
+
+// mappingValue returns the value of key in node, or nil if node is not a
+// mapping containing key.
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func (c *ProjectConfig) resolvePath(p string) string {
+	if p == "" || filepath.IsAbs(p) {
+		return p
+	}
+	dir, err := filepath.Abs(filepath.Dir(c.Path))
+	if err != nil {
+		dir = filepath.Dir(c.Path)
+	}
+	return filepath.Join(dir, p)
+}
+
+func (c *ProjectConfig) resolvePaths() {
+	for i, p := range c.RootPaths {
+		c.RootPaths[i] = c.resolvePath(p)
+	}
+	c.DestPath = c.resolvePath(c.DestPath)
+	c.LicenseHeaderPath = c.resolvePath(c.LicenseHeaderPath)
+	// "-" means stdout.
+	if c.CGOFlagsPath != "-" {
+		c.CGOFlagsPath = c.resolvePath(c.CGOFlagsPath)
+	}
+	for i, p := range c.IncludeGoPackageDeps {
+		if p == "." || p == ".." || strings.HasPrefix(p, "./") || strings.HasPrefix(p, "../") {
+			c.IncludeGoPackageDeps[i] = c.resolvePath(p)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package gogen
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey" //nolint:revive
+)
+
+func TestProjectConfig(t *testing.T) {
+	SetDefaultFailureMode(FailureContinues)
+
+	Convey("Project configuration files", t, func() {
+		dir := t.TempDir()
+		writeTestFiles(dir, map[string]string{
+			"go.mod":                              "module example.com/project\n",
+			"LICENSE.header":                      "License\n",
+			"cmd/app/main.go":                     "package main\n",
+			"ros/share/demo_msgs/msg/Point.msg":   "float64 x\n",
+			"ros/share/demo_msgs/msg/Polygon.msg": "Point[] points\n",
+			"ros/share/demo_msgs/msg/Shape.msg":   "Point[] points\n",
+		})
+		configPath := filepath.Join(dir, ProjectConfigFileName)
+		load := func(content string) (*ProjectConfig, error) {
+			writeTestFiles(dir, map[string]string{ProjectConfigFileName: content})
+			return LoadProjectConfig(configPath)
+		}
+
+		Convey("are found in the module root", func() {
+			p, err := FindProjectConfig(filepath.Join(dir, "cmd", "app"))
+			So(err, ShouldBeNil)
+			So(p, ShouldBeEmpty)
+			writeTestFiles(dir, map[string]string{ProjectConfigFileName: ""})
+			p, err = FindProjectConfig(filepath.Join(dir, "cmd", "app"))
+			So(err, ShouldBeNil)
+			So(p, ShouldEqual, configPath)
+		})
+
+		Convey("resolve relative paths against their directory", func() {
+			c, err := load(`
+root-paths: [ros, /opt/ros/humble]
+dest-path: msgs
+cgo-flags-path: "-"
+include-packages: [std_msgs, "geometry_.*"]
+include-package-deps: [nav_msgs]
+include-go-package-deps: [./..., example.com/other]
+blacklist: ["libstatistics_collector/.*"]
+type-renames:
+  std_msgs/msg/String: StringMsg
+license-header-path: LICENSE.header
+`)
+			So(err, ShouldBeNil)
+			So(c, ShouldResemble, &ProjectConfig{
+				RootPaths:            []string{filepath.Join(dir, "ros"), "/opt/ros/humble"},
+				DestPath:             filepath.Join(dir, "msgs"),
+				CGOFlagsPath:         "-",
+				IncludePackages:      []string{"std_msgs", "geometry_.*"},
+				IncludePackageDeps:   []string{"nav_msgs"},
+				IncludeGoPackageDeps: []string{filepath.Join(dir, "..."), "example.com/other"},
+				Blacklist:            []string{"libstatistics_collector/.*"},
+				TypeRenames:          map[string]string{"std_msgs/msg/String": "StringMsg"},
+				LicenseHeaderPath:    filepath.Join(dir, "LICENSE.header"),
+				Path:                 configPath,
+			})
+		})
+
+		Convey("report the position of invalid values", func() {
+			for _, tc := range []struct {
+				content, key string
+				line, column int
+			}{
+				{"include-packages:\n  - std_msgs\n  - \"foo(\"\n", "include-packages[1]", 3, 5},
+				{"blacklist: [\"[\"]\n", "blacklist[0]", 1, 13},
+				{"root-paths: [\"\"]\n", "root-paths[0]", 1, 14},
+				{"type-renames:\n  std_msgs/msg/String: string\n", "type-renames.std_msgs/msg/String", 2, 24},
+				{"type-renames:\n  std_msgs/String: Str\n", "type-renames.std_msgs/String", 2, 20},
+				{"dest-path: msgs\nlicense-header-path: missing\n", "license-header-path", 2, 22},
+				{"root-paths: [ros]\ntype-renames:\n  demo_msgs/msg/Polygon: Shape\n  demo_msgs/msg/Point: Polygon\n", "type-renames.demo_msgs/msg/Polygon", 3, 26},
+			} {
+				_, err := load(tc.content)
+				var cerr *ProjectConfigError
+				So(errors.As(err, &cerr), ShouldBeTrue)
+				if cerr != nil {
+					So(cerr.Path, ShouldEqual, configPath)
+					So(cerr.Key, ShouldEqual, tc.key)
+					So(cerr.Line, ShouldEqual, tc.line)
+					So(cerr.Column, ShouldEqual, tc.column)
+				}
+			}
+		})
+
+		Convey("reject unknown keys", func() {
+			_, err := load("dest-paht: msgs\n")
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "line 1: field dest-paht not found")
+		})
+	})
+}
@@ -0,0 +1,87 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package gogen
+
+import (
+	"fmt"
+	"go/token"
+	"sort"
+	"strings"
+)
+
+// goTypeName returns the name of the Go type generated for the message
+// pkg/msg/name.
+func (c *Config) goTypeName(pkg, name string) string {
+	if goName, ok := c.TypeRenames[pkg+"/msg/"+name]; ok {
+		return goName
+	}
+	return name
+}
+
+// validateTypeRename checks that the message rosType can be renamed to
+// goName.
+func validateTypeRename(rosType, goName string) error {
+	parts := strings.Split(rosType, "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] != "msg" || parts[2] == "" {
+		return fmt.Errorf("invalid message type %q, expected <package>/msg/<name>", rosType)
+	}
+	if !token.IsIdentifier(goName) || !token.IsExported(goName) {
+		return fmt.Errorf("invalid Go type name %q, must be an exported identifier", goName)
+	}
+	return nil
+}
+
+func validateTypeRenames(renames map[string]string) error {
+	rosTypes := make([]string, 0, len(renames))
+	for rosType := range renames {
+		rosTypes = append(rosTypes, rosType)
+	}
+	sort.Strings(rosTypes)
+	renamed := map[string]string{}
+	for _, rosType := range rosTypes {
+		goName := renames[rosType]
+		if err := validateTypeRename(rosType, goName); err != nil {
+			return fmt.Errorf("type rename %s: %w", rosType, err)
+		}
+		target := strings.SplitN(rosType, "/", 2)[0] + "/" + goName
+		if other, ok := renamed[target]; ok {
+			return fmt.Errorf("types %s and %s are both renamed to %s", other, rosType, goName)
+		}
+		renamed[target] = rosType
+	}
+	return nil
+}
+
+// findTypeRenameCollision returns the first message, in sorted order, which
+// is renamed to the name of another message of the same package that is not
+// renamed itself, and an error describing the collision. exists reports
+// whether a message exists.
+func findTypeRenameCollision(renames map[string]string, exists func(meta Metadata) bool) (string, error) {
+	rosTypes := make([]string, 0, len(renames))
+	for rosType := range renames {
+		rosTypes = append(rosTypes, rosType)
+	}
+	sort.Strings(rosTypes)
+	for _, rosType := range rosTypes {
+		goName := renames[rosType]
+		pkg := strings.SplitN(rosType, "/", 2)[0]
+		other := pkg + "/msg/" + goName
+		if other == rosType {
+			continue
+		}
+		if _, ok := renames[other]; ok {
+			continue
+		}
+		if exists(Metadata{Package: pkg, Type: "msg", Name: goName}) {
+			return rosType, fmt.Errorf("%s is renamed to %s, which is the name of message %s", rosType, goName, other)
+		}
+	}
+	return "", nil
+}
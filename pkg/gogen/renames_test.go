@@ -0,0 +1,93 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package gogen
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey" //nolint:revive
+)
+
+func TestTypeRenames(t *testing.T) {
+	SetDefaultFailureMode(FailureContinues)
+
+	Convey("Renamed and blacklisted types", t, func() {
+		root := t.TempDir()
+		dest := t.TempDir()
+		writeTestFiles(root, map[string]string{
+			"share/demo_msgs/msg/Point.msg":    "uint8 KIND_A=0\nuint8 KIND_B=1\nuint8 kind\nfloat64 x\n",
+			"share/demo_msgs/msg/Polygon.msg":  "Point[] points\nPoint[3] triangle\nPoint center\n",
+			"share/demo_msgs/msg/Internal.msg": "int32 value\n",
+			"share/other_msgs/msg/Shape.msg":   "demo_msgs/Point[] points\n",
+		})
+		generate := func(config Config) error {
+			config.RootPaths = []string{root}
+			config.DestPath = dest
+			config.RclgoImportPath = DefaultConfig.RclgoImportPath
+			config.MessageModulePrefix = "example.com/msgs"
+			return New(&config).GenerateGolangMessageTypes()
+		}
+		read := func(name string) string {
+			data, err := os.ReadFile(filepath.Join(dest, filepath.FromSlash(name)))
+			if err != nil {
+				return ""
+			}
+			return string(data)
+		}
+
+		So(generate(Config{
+			TypeRenames: map[string]string{"demo_msgs/msg/Point": "Vec2"},
+			Blacklist:   []*regexp.Regexp{regexp.MustCompile(`demo_msgs/msg/Internal\.msg$`)},
+		}), ShouldBeNil)
+
+		point := read("demo_msgs/msg/Point.gen.go")
+		So(point, ShouldContainSubstring, "type Vec2 struct")
+		So(point, ShouldContainSubstring, "func NewVec2() *Vec2")
+		So(point, ShouldContainSubstring, "Vec2_KIND_A uint8 = 0")
+		So(point, ShouldContainSubstring, "type Vec2_Kind uint8")
+		So(point, ShouldContainSubstring, `typemap.RegisterMessage("demo_msgs/Point", Vec2TypeSupport)`)
+		So(point, ShouldContainSubstring, "type CVec2 = C.demo_msgs__msg__Point")
+		So(point, ShouldContainSubstring, "C.demo_msgs__msg__Point__create()")
+		So(point, ShouldContainSubstring, "#include <demo_msgs/msg/point.h>")
+		So(point, ShouldNotContainSubstring, "type Point struct")
+
+		polygon := read("demo_msgs/msg/Polygon.gen.go")
+		So(polygon, ShouldContainSubstring, "Points []Vec2")
+		So(polygon, ShouldContainSubstring, "Triangle [3]Vec2")
+		So(polygon, ShouldContainSubstring, "Center Vec2")
+		So(polygon, ShouldContainSubstring, "Vec2__Sequence_to_C(&mem.points, m.Points)")
+		So(polygon, ShouldContainSubstring, "Vec2__Array_to_Go(m.Triangle[:], mem.triangle[:])")
+		So(polygon, ShouldContainSubstring, "Vec2TypeSupport.AsCStruct(unsafe.Pointer(&mem.center), &m.Center)")
+		So(polygon, ShouldContainSubstring, "CloneVec2Slice(c.Points, t.Points)")
+
+		shape := read("other_msgs/msg/Shape.gen.go")
+		So(shape, ShouldContainSubstring, "Points []demo_msgs_msg.Vec2")
+		So(shape, ShouldContainSubstring, "demo_msgs_msg.Vec2__Sequence_to_Go(&m.Points, *(*demo_msgs_msg.CVec2__Sequence)")
+
+		So(read("demo_msgs/msg/Internal.gen.go"), ShouldBeEmpty)
+
+		Convey("Invalid renames are rejected", func() {
+			So(generate(Config{TypeRenames: map[string]string{"demo_msgs/srv/Point": "Vec2"}}), ShouldNotBeNil)
+			So(generate(Config{TypeRenames: map[string]string{"demo_msgs/msg/Point": "vec2"}}), ShouldNotBeNil)
+			So(generate(Config{TypeRenames: map[string]string{
+				"demo_msgs/msg/Point":   "Vec2",
+				"demo_msgs/msg/Polygon": "Vec2",
+			}}), ShouldNotBeNil)
+			So(generate(Config{TypeRenames: map[string]string{"demo_msgs/msg/Point": "Polygon"}}), ShouldNotBeNil)
+			So(generate(Config{TypeRenames: map[string]string{
+				"demo_msgs/msg/Point":   "Polygon",
+				"demo_msgs/msg/Polygon": "Shape",
+			}}), ShouldBeNil)
+		})
+	})
+}
@@ -24,10 +24,18 @@ var templateFuncMap template.FuncMap = template.FuncMap{
 	"actionNameFromActionSrvName": actionNameFromActionSrvName,
 	"cReturnCodeNameToGo":         cReturnCodeNameToGo,
 	"cloneCode":                   cloneCode,
+	"cdrEncodeCode":               cdrEncodeCode,
+	"cdrDecodeCode":               cdrDecodeCode,
+	"validateCode":                validateCode,
+	"equalCode":                   equalCode,
+	"hashCode":                    hashCode,
 	"actionHasSuffix":             actionHasSuffix,
 	"matchMsg":                    matchMsg,
 	"sanitizeValue":               defaultValueSanitizer,
 	"goLicenseHeader":             goLicenseHeader,
+	"goString":                    goStringLiteral,
+	"hasTimeHelpers":              hasTimeHelpers,
+	"fieldDescriptor":             fieldDescriptor,
 }
 
 var ros2PackageCommonTemplate = template.Must(
@@ -59,6 +67,9 @@ package {{ .GoPackage }}
 #cgo CFLAGS: "-I{{$dir}}/include/std_srvs"
 #cgo CFLAGS: "-I{{$dir}}/include/test_msgs"
 #cgo CFLAGS: "-I{{$dir}}/include/unique_identifier_msgs"
+{{- range $.Distro.ExtraIncludeDirs}}
+#cgo CFLAGS: "-I{{$dir}}/include/{{.}}"
+{{- end}}
 {{- range $k, $v := $.CImports}}
 #cgo CFLAGS: "-I{{$dir}}/include/{{$k}}"
 {{end}}
@@ -80,6 +91,8 @@ import (
 	"unsafe"
 
 	"{{.Config.RclgoImportPath}}/pkg/rclgo"
+	"{{.Config.RclgoImportPath}}/pkg/rclgo/cdr"
+	"{{.Config.RclgoImportPath}}/pkg/rclgo/msgcmp"
 	"{{.Config.RclgoImportPath}}/pkg/rclgo/types"
 	"{{.Config.RclgoImportPath}}/pkg/rclgo/typemap"
 	{{range $path, $name := $Md.GoImports -}}
@@ -99,52 +112,164 @@ import (
 import "C"
 
 func init() {
-	typemap.RegisterMessage("{{$Md.Package}}/{{$Md.Name}}", {{$Md.Name}}TypeSupport)
-	typemap.RegisterMessage("{{$Md.Package}}/{{$Md.Type}}/{{$Md.Name}}", {{$Md.Name}}TypeSupport)
+	typemap.RegisterMessage("{{$Md.Package}}/{{$Md.Name}}", {{$Md.GoName}}TypeSupport)
+	typemap.RegisterMessage("{{$Md.Package}}/{{$Md.Type}}/{{$Md.Name}}", {{$Md.GoName}}TypeSupport)
 }
 
 {{- if $Md.Constants }}
 const (
 {{- range $Md.Constants }}
-	{{$Md.Name}}_{{.RosName}} {{.GoPkgReference}}{{.GoType}} = {{sanitizeValue .RosType .Value}}{{if .Comment -}} // {{.Comment}}{{- end}}
+	{{$Md.GoName}}_{{.RosName}} {{if .EnumType}}{{.EnumType}}{{else}}{{.GoPkgReference}}{{.GoType}}{{end}} = {{sanitizeValue .RosType .Value}}{{if .Comment -}} // {{.Comment}}{{- end}}
 {{- end }}
 )
 {{- end }}
 
-type {{$Md.Name}} struct {
+{{- range $e := $Md.Enums }}
+
+// {{$e.GoName}} is the type of the {{$Md.GoName}}_{{$e.Prefix}}_* constants.
+type {{$e.GoName}} {{$e.GoType}}
+
+// {{$e.GoName}}Values contains the distinct values of the
+// {{$Md.GoName}}_{{$e.Prefix}}_* constants.
+var {{$e.GoName}}Values = []{{$e.GoName}}{
+	{{- range $e.Values }}
+	{{$e.GoName}}({{$Md.GoName}}_{{.RosName}}),
+	{{- end }}
+}
+
+// String returns the ROS name of the constant whose value is v.
+func (v {{$e.GoName}}) String() string {
+	switch v {
+	{{- range $e.Values }}
+	case {{$e.GoName}}({{$Md.GoName}}_{{.RosName}}):
+		return "{{.RosName}}"
+	{{- end }}
+	}
+	return "{{$e.GoName}}(" + {{if $e.Unsigned}}strconv.FormatUint(uint64(v), 10){{else}}strconv.FormatInt(int64(v), 10){{end}} + ")"
+}
+
+// IsValid reports whether v is the value of one of the
+// {{$Md.GoName}}_{{$e.Prefix}}_* constants.
+func (v {{$e.GoName}}) IsValid() bool {
+	switch v {
+	case {{range $i, $c := $e.Values}}{{if $i}}, {{end}}{{$e.GoName}}({{$Md.GoName}}_{{$c.RosName}}){{end}}:
+		return true
+	}
+	return false
+}
+{{- end }}
+
+type {{$Md.GoName}} struct {
 	{{- range $k, $v := $Md.Fields }}
-	{{$v.GoName }} {{$v.TypeArray}}{{$v.GoPkgReference}}{{$v.GoType}}` +
+	{{$v.GoName }} {{if $v.EnumType}}{{$v.EnumType}}{{else}}{{$v.TypeArray}}{{$v.GoPkgReference}}{{$v.GoType}}{{end}}` +
 			"{{\"\"}} `yaml:\"{{$v.RosName}}\"`" + `{{if .Comment -}} // {{.Comment}}{{- end}}
 	{{- end }}
 }
 
-// New{{$Md.Name}} creates a new {{$Md.Name}} with default values.
-func New{{$Md.Name}}() *{{$Md.Name}} {
-	self := {{$Md.Name}}{}
+// New{{$Md.GoName}} creates a new {{$Md.GoName}} with default values.
+func New{{$Md.GoName}}() *{{$Md.GoName}} {
+	self := {{$Md.GoName}}{}
 	self.SetDefaults()
 	return &self
 }
 
-func (t *{{$Md.Name}}) Clone() *{{$Md.Name}} {
-	c := &{{$Md.Name}}{}
+func (t *{{$Md.GoName}}) Clone() *{{$Md.GoName}} {
+	c := &{{$Md.GoName}}{}
 	{{- range $f := $Md.Fields }}
 	{{cloneCode $f}}
 	{{- end }}
 	return c
 }
 
-func (t *{{$Md.Name}}) CloneMsg() types.Message {
+func (t *{{$Md.GoName}}) CloneMsg() types.Message {
 	return t.Clone()
 }
 
-func (t *{{$Md.Name}}) SetDefaults() {
+// Equal reports whether t and other are equal. Nil and empty sequences are
+// equal, and NaN values are equal to each other.
+func (t *{{$Md.GoName}}) Equal(other *{{$Md.GoName}}) bool {
+	return t.EqualApprox(other, 0)
+}
+
+// EqualApprox is like Equal but floating point values are equal if they
+// differ by at most tol.
+func (t *{{$Md.GoName}}) EqualApprox(other *{{$Md.GoName}}, tol float64) bool {
+	{{- range $f := $Md.Fields }}
+	{{equalCode $f}}
+	{{- end }}
+	return true
+}
+
+// Hash returns a hash of t which is the same for messages which are equal
+// according to Equal.
+func (t *{{$Md.GoName}}) Hash() uint64 {
+	h := msgcmp.NewHasher()
+	t.HashTo(h)
+	return h.Sum64()
+}
+
+// HashTo adds the fields of t to h.
+func (t *{{$Md.GoName}}) HashTo(h *msgcmp.Hasher) {
+	{{- range $f := $Md.Fields }}
+	{{hashCode $f}}
+	{{- end }}
+}
+
+func (t *{{$Md.GoName}}) SetDefaults() {
 	{{- range $k, $v := $Md.Fields }}
 	{{defaultCode $v}}
 	{{- end }}
 }
 
-func (t *{{$Md.Name}}) GetTypeSupport() types.MessageTypeSupport {
-	return {{$Md.Name}}TypeSupport
+func (t *{{$Md.GoName}}) GetTypeSupport() types.MessageTypeSupport {
+	return {{$Md.GoName}}TypeSupport
+}
+
+func (t *{{$Md.GoName}}) MarshalJSON() ([]byte, error) {
+	return rclgo.MarshalJSON(t)
+}
+
+func (t *{{$Md.GoName}}) UnmarshalJSON(data []byte) error {
+	return rclgo.UnmarshalJSON(data, t)
+}
+
+func (t *{{$Md.GoName}}) MarshalYAML() (interface{}, error) {
+	return rclgo.YAMLNode(t)
+}
+
+func (t *{{$Md.GoName}}) MarshalCDR() ([]byte, error) {
+	return cdr.Marshal(t)
+}
+
+func (t *{{$Md.GoName}}) UnmarshalCDR(data []byte) error {
+	return cdr.Unmarshal(data, t)
+}
+
+func (t *{{$Md.GoName}}) EncodeCDR(e *cdr.Encoder) {
+	{{- range $f := $Md.Fields }}
+	{{cdrEncodeCode $f}}
+	{{- else }}
+	e.Uint8(0)
+	{{- end }}
+}
+
+func (t *{{$Md.GoName}}) DecodeCDR(d *cdr.Decoder) {
+	{{- range $f := $Md.Fields }}
+	{{cdrDecodeCode $f}}
+	{{- else }}
+	d.Uint8()
+	{{- end }}
+}
+
+// Validate checks that the bounded sequences and strings of t, including the
+// ones in nested messages, do not exceed their upper bounds.
+func (t *{{$Md.GoName}}) Validate() error {
+	{{- range $f := $Md.Fields }}
+	{{- with validateCode $f }}
+	{{.}}
+	{{- end }}
+	{{- end }}
+	return nil
 }
 
 {{- /* Some special cased methods to avoid cyclic dependency in actions */ -}}
@@ -155,138 +280,231 @@ func (t *{{$Md.Name}}) GetTypeSupport() types.MessageTypeSupport {
 	"_CancelGoal_Request"
 	"_FeedbackMessage"
 }}
-func (t *{{$Md.Name}}) GetGoalID() *types.GoalID {
+func (t *{{$Md.GoName}}) GetGoalID() *types.GoalID {
 	return (*types.GoalID)(&t.GoalID.Uuid)
 }
 
-func (t *{{$Md.Name}}) SetGoalID(id *types.GoalID) {
+func (t *{{$Md.GoName}}) SetGoalID(id *types.GoalID) {
 	t.GoalID.Uuid = *id
 }
 {{- end -}}
 
 {{- if actionHasSuffix $Md "_SendGoal_Request" }}
-func (t *{{$Md.Name}}) GetGoalDescription() types.Message {
+func (t *{{$Md.GoName}}) GetGoalDescription() types.Message {
 	return &t.Goal
 }
 
-func (t *{{$Md.Name}}) SetGoalDescription(desc types.Message) {
+func (t *{{$Md.GoName}}) SetGoalDescription(desc types.Message) {
 	t.Goal = *desc.(*{{$Md.Name | actionNameFromActionMsgName}}_Goal)
 }
 {{- end -}}
 
 {{- if actionHasSuffix $Md "_SendGoal_Response" }}
-func (t *{{$Md.Name}}) GetGoalAccepted() bool {
+func (t *{{$Md.GoName}}) GetGoalAccepted() bool {
 	return t.Accepted
 }
 {{- end -}}
 
 {{ if matchMsg $Md "action_msgs_srv" "CancelGoal_Request" }}
-func (t *{{$Md.Name}}) GetGoalID() *types.GoalID {
+func (t *{{$Md.GoName}}) GetGoalID() *types.GoalID {
 	return (*types.GoalID)(&t.GoalInfo.GoalId.Uuid)
 }
 
-func (t *{{$Md.Name}}) SetGoalID(id *types.GoalID) {
+func (t *{{$Md.GoName}}) SetGoalID(id *types.GoalID) {
 	t.GoalInfo.GoalId.Uuid = *id
 }
 {{- else if matchMsg $Md "action_msgs_srv" "CancelGoal_Response" }}
-func (t *{{$Md.Name}}) CallForEach(f func(interface{})) {
+func (t *{{$Md.GoName}}) CallForEach(f func(interface{})) {
 	for i := range t.GoalsCanceling {
 		f((*types.GoalID)(&t.GoalsCanceling[i].GoalId.Uuid))
 	}
 }
 {{- else if matchMsg $Md "action_msgs_msg" "GoalStatus" }}
-func (t *{{$Md.Name}}) GetGoalID() *types.GoalID {
+func (t *{{$Md.GoName}}) GetGoalID() *types.GoalID {
 	return (*types.GoalID)(&t.GoalInfo.GoalId.Uuid)
 }
 
-func (t *{{$Md.Name}}) SetGoalID(id *types.GoalID) {
+func (t *{{$Md.GoName}}) SetGoalID(id *types.GoalID) {
 	t.GoalInfo.GoalId.Uuid = *id
 }
 {{- else if matchMsg $Md "action_msgs_msg" "GoalStatusArray" }}
-func (t *{{$Md.Name}}) CallForEach(f func(interface{})) {
+func (t *{{$Md.GoName}}) CallForEach(f func(interface{})) {
 	for i := range t.StatusList {
 		f(&t.StatusList[i])
 	}
 }
 {{- end }}
 
-// {{$Md.Name}}Publisher wraps rclgo.Publisher to provide type safe helper
+{{- if hasTimeHelpers $Md }}
+{{- if matchMsg $Md "builtin_interfaces_msg" "Time" }}
+
+// ToTime returns t as a time.Time.
+func (t *{{$Md.GoName}}) ToTime() time.Time {
+	return time.Unix(int64(t.Sec), int64(t.Nanosec))
+}
+
+// FromTime sets t to tm. The seconds of tm are truncated to 32 bits.
+func (t *{{$Md.GoName}}) FromTime(tm time.Time) {
+	t.Sec = int32(tm.Unix())
+	t.Nanosec = uint32(tm.Nanosecond())
+}
+
+// {{$Md.GoName}}From returns tm as a {{$Md.GoName}}.
+func {{$Md.GoName}}From(tm time.Time) *{{$Md.GoName}} {
+	t := New{{$Md.GoName}}()
+	t.FromTime(tm)
+	return t
+}
+
+// Now returns the current time of clock.
+func Now(clock *rclgo.Clock) (*{{$Md.GoName}}, error) {
+	now, err := clock.Now()
+	if err != nil {
+		return nil, err
+	}
+	return {{$Md.GoName}}From(now), nil
+}
+{{- else if matchMsg $Md "builtin_interfaces_msg" "Duration" }}
+
+// ToDuration returns t as a time.Duration. Durations which don't fit in a
+// time.Duration overflow.
+func (t *{{$Md.GoName}}) ToDuration() time.Duration {
+	return time.Duration(t.Sec)*time.Second + time.Duration(t.Nanosec)
+}
+
+// FromDuration sets t to d. Like in rclcpp, Nanosec is always non-negative, so
+// negative durations have a negative Sec and a positive Nanosec.
+func (t *{{$Md.GoName}}) FromDuration(d time.Duration) {
+	sec, nsec := d/time.Second, d%time.Second
+	if nsec < 0 {
+		sec--
+		nsec += time.Second
+	}
+	t.Sec = int32(sec)
+	t.Nanosec = uint32(nsec)
+}
+
+// {{$Md.GoName}}From returns d as a {{$Md.GoName}}.
+func {{$Md.GoName}}From(d time.Duration) *{{$Md.GoName}} {
+	t := New{{$Md.GoName}}()
+	t.FromDuration(d)
+	return t
+}
+{{- else if matchMsg $Md "std_msgs_msg" "Header" }}
+
+// StampTime returns the stamp of t as a time.Time.
+func (t *{{$Md.GoName}}) StampTime() time.Time {
+	return t.Stamp.ToTime()
+}
+
+// SetStamp sets the stamp of t to tm.
+func (t *{{$Md.GoName}}) SetStamp(tm time.Time) {
+	t.Stamp.FromTime(tm)
+}
+
+// StampNow sets the stamp of t to the current time of clock.
+func (t *{{$Md.GoName}}) StampNow(clock *rclgo.Clock) error {
+	now, err := clock.Now()
+	if err != nil {
+		return err
+	}
+	t.SetStamp(now)
+	return nil
+}
+{{- end }}
+{{- end }}
+
+// {{$Md.GoName}}Publisher wraps rclgo.Publisher to provide type safe helper
 // functions
-type {{$Md.Name}}Publisher struct {
+type {{$Md.GoName}}Publisher struct {
 	*rclgo.Publisher
 }
 
-// New{{$Md.Name}}Publisher creates and returns a new publisher for the
-// {{$Md.Name}}
-func New{{$Md.Name}}Publisher(node *rclgo.Node, topic_name string, options *rclgo.PublisherOptions) (*{{$Md.Name}}Publisher, error) {
-	pub, err := node.NewPublisher(topic_name, {{$Md.Name}}TypeSupport, options)
+// New{{$Md.GoName}}Publisher creates and returns a new publisher for the
+// {{$Md.GoName}}
+func New{{$Md.GoName}}Publisher(node *rclgo.Node, topic_name string, options *rclgo.PublisherOptions) (*{{$Md.GoName}}Publisher, error) {
+	pub, err := node.NewPublisher(topic_name, {{$Md.GoName}}TypeSupport, options)
 	if err != nil {
 		return nil, err
 	}
-	return &{{$Md.Name}}Publisher{pub}, nil
+	return &{{$Md.GoName}}Publisher{pub}, nil
 }
 
-func (p *{{$Md.Name}}Publisher) Publish(msg *{{$Md.Name}}) error {
+func (p *{{$Md.GoName}}Publisher) Publish(msg *{{$Md.GoName}}) error {
 	return p.Publisher.Publish(msg)
 }
 
-// {{$Md.Name}}Subscription wraps rclgo.Subscription to provide type safe helper
+// {{$Md.GoName}}Subscription wraps rclgo.Subscription to provide type safe helper
 // functions
-type {{$Md.Name}}Subscription struct {
+type {{$Md.GoName}}Subscription struct {
 	*rclgo.Subscription
 }
 
-// {{$Md.Name}}SubscriptionCallback type is used to provide a subscription
-// handler function for a {{$Md.Name}}Subscription.
-type {{$Md.Name}}SubscriptionCallback func(msg *{{$Md.Name}}, info *rclgo.MessageInfo, err error)
+// {{$Md.GoName}}SubscriptionCallback type is used to provide a subscription
+// handler function for a {{$Md.GoName}}Subscription.
+type {{$Md.GoName}}SubscriptionCallback func(msg *{{$Md.GoName}}, info *rclgo.MessageInfo, err error)
 
-// New{{$Md.Name}}Subscription creates and returns a new subscription for the
-// {{$Md.Name}}
-func New{{$Md.Name}}Subscription(node *rclgo.Node, topic_name string, opts *rclgo.SubscriptionOptions, subscriptionCallback {{$Md.Name}}SubscriptionCallback) (*{{$Md.Name}}Subscription, error) {
+// New{{$Md.GoName}}Subscription creates and returns a new subscription for the
+// {{$Md.GoName}}
+func New{{$Md.GoName}}Subscription(node *rclgo.Node, topic_name string, opts *rclgo.SubscriptionOptions, subscriptionCallback {{$Md.GoName}}SubscriptionCallback) (*{{$Md.GoName}}Subscription, error) {
 	callback := func(s *rclgo.Subscription) {
-		var msg {{$Md.Name}}
+		var msg {{$Md.GoName}}
 		info, err := s.TakeMessage(&msg)
 		subscriptionCallback(&msg, info, err)
 	}
-	sub, err := node.NewSubscription(topic_name, {{$Md.Name}}TypeSupport, opts, callback)
+	sub, err := node.NewSubscription(topic_name, {{$Md.GoName}}TypeSupport, opts, callback)
 	if err != nil {
 		return nil, err
 	}
-	return &{{$Md.Name}}Subscription{sub}, nil
+	return &{{$Md.GoName}}Subscription{sub}, nil
 }
 
-func (s *{{$Md.Name}}Subscription) TakeMessage(out *{{$Md.Name}}) (*rclgo.MessageInfo, error) {
+func (s *{{$Md.GoName}}Subscription) TakeMessage(out *{{$Md.GoName}}) (*rclgo.MessageInfo, error) {
 	return s.Subscription.TakeMessage(out)
 }
 
-// Clone{{$Md.Name}}Slice clones src to dst by calling Clone for each element in
+// Clone{{$Md.GoName}}Slice clones src to dst by calling Clone for each element in
 // src. Panics if len(dst) < len(src).
-func Clone{{$Md.Name}}Slice(dst, src []{{$Md.Name}}) {
+func Clone{{$Md.GoName}}Slice(dst, src []{{$Md.GoName}}) {
 	for i := range src {
 		dst[i] = *src[i].Clone()
 	}
 }
 
+// {{$Md.GoName}}Descriptor describes the fields of {{$Md.GoName}}. It is used to
+// access the fields by their ROS names, see rclgo.GetField. Modifying this
+// variable is undefined behavior.
+var {{$Md.GoName}}Descriptor = &types.MessageDescriptor{
+	TypeName: "{{$Md.Package}}/{{$Md.Type}}/{{$Md.Name}}",
+	{{- if $Md.Fields }}
+	Fields: []types.FieldDescriptor{
+		{{- range $i, $f := $Md.Fields }}
+		{{fieldDescriptor $i $f $Md}},
+		{{- end }}
+	},
+	{{- end }}
+}
+
 // Modifying this variable is undefined behavior.
-var {{$Md.Name}}TypeSupport types.MessageTypeSupport = _{{$Md.Name}}TypeSupport{}
+var {{$Md.GoName}}TypeSupport types.MessageTypeSupport = _{{$Md.GoName}}TypeSupport{}
 
-type _{{$Md.Name}}TypeSupport struct{}
+type _{{$Md.GoName}}TypeSupport struct{}
 
-func (t _{{$Md.Name}}TypeSupport) New() types.Message {
-	return New{{$Md.Name}}()
+func (t _{{$Md.GoName}}TypeSupport) New() types.Message {
+	return New{{$Md.GoName}}()
 }
 
-func (t _{{$Md.Name}}TypeSupport) PrepareMemory() unsafe.Pointer { //returns *C.{{$Md.Package}}__{{$Md.Type}}__{{$Md.Name}}
+func (t _{{$Md.GoName}}TypeSupport) PrepareMemory() unsafe.Pointer { //returns *C.{{$Md.Package}}__{{$Md.Type}}__{{$Md.Name}}
 	return (unsafe.Pointer)(C.{{$Md.Package}}__{{$Md.Type}}__{{$Md.Name}}__create())
 }
 
-func (t _{{$Md.Name}}TypeSupport) ReleaseMemory(pointer_to_free unsafe.Pointer) {
+func (t _{{$Md.GoName}}TypeSupport) ReleaseMemory(pointer_to_free unsafe.Pointer) {
 	C.{{$Md.Package}}__{{$Md.Type}}__{{$Md.Name}}__destroy((*C.{{$Md.Package}}__{{$Md.Type}}__{{$Md.Name}})(pointer_to_free))
 }
 
-func (t _{{$Md.Name}}TypeSupport) AsCStruct(dst unsafe.Pointer, msg types.Message) {
+func (t _{{$Md.GoName}}TypeSupport) AsCStruct(dst unsafe.Pointer, msg types.Message) {
 	{{ if $Md.Fields -}}
-	m := msg.(*{{$Md.Name}})
+	m := msg.(*{{$Md.GoName}})
 	mem := (*C.{{$Md.Package}}__{{$Md.Type}}__{{$Md.Name}})(dst)
 	{{- range $Md.Fields }}
 	{{call $.cSerializationCode . $Md}}
@@ -294,9 +512,9 @@ func (t _{{$Md.Name}}TypeSupport) AsCStruct(dst unsafe.Pointer, msg types.Messag
 	{{- end }}
 }
 
-func (t _{{$Md.Name}}TypeSupport) AsGoStruct(msg types.Message, ros2_message_buffer unsafe.Pointer) {
+func (t _{{$Md.GoName}}TypeSupport) AsGoStruct(msg types.Message, ros2_message_buffer unsafe.Pointer) {
 	{{if $Md.Fields -}}
-	m := msg.(*{{$Md.Name}})
+	m := msg.(*{{$Md.GoName}})
 	mem := (*C.{{$Md.Package}}__{{$Md.Type}}__{{$Md.Name}})(ros2_message_buffer)
 	{{- range $Md.Fields }}
 	{{call $.goSerializationCode . $Md}}
@@ -304,24 +522,50 @@ func (t _{{$Md.Name}}TypeSupport) AsGoStruct(msg types.Message, ros2_message_buf
 	{{- end }}
 }
 
-func (t _{{$Md.Name}}TypeSupport) TypeSupport() unsafe.Pointer {
+func (t _{{$Md.GoName}}TypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_message_type_support_handle__{{$Md.Package}}__{{$Md.Type}}__{{$Md.Name}}())
 }
 
-type C{{$Md.Name}} = C.{{$Md.Package}}__{{$Md.Type}}__{{$Md.Name}}
-type C{{$Md.Name}}__Sequence = C.{{$Md.Package}}__{{$Md.Type}}__{{$Md.Name}}__Sequence
+func (t _{{$Md.GoName}}TypeSupport) Descriptor() *types.MessageDescriptor {
+	return {{$Md.GoName}}Descriptor
+}
+{{- if .Distro.TypeHashes }}
+
+func (t _{{$Md.GoName}}TypeSupport) TypeHash() string {
+	h := C.{{$Md.Package}}__{{$Md.Type}}__{{$Md.Name}}__get_type_hash(nil)
+	return types.FormatTypeHash(uint8(h.version), C.GoBytes(unsafe.Pointer(&h.value[0]), C.int(len(h.value))))
+}
+{{- else if .Description }}
 
-func {{$Md.Name}}__Sequence_to_Go(goSlice *[]{{$Md.Name}}, cSlice C{{$Md.Name}}__Sequence) {
+func (t _{{$Md.GoName}}TypeSupport) TypeHash() string {
+	return {{goString .Description.TypeHash}}
+}
+{{- end }}
+{{- with .Description }}
+
+func (t _{{$Md.GoName}}TypeSupport) TypeDescription() string {
+	return {{goString .TypeDescription}}
+}
+
+func (t _{{$Md.GoName}}TypeSupport) MessageDefinition() string {
+	return {{goString .MessageDefinition}}
+}
+{{- end }}
+
+type C{{$Md.GoName}} = C.{{$Md.Package}}__{{$Md.Type}}__{{$Md.Name}}
+type C{{$Md.GoName}}__Sequence = C.{{$Md.Package}}__{{$Md.Type}}__{{$Md.Name}}__Sequence
+
+func {{$Md.GoName}}__Sequence_to_Go(goSlice *[]{{$Md.GoName}}, cSlice C{{$Md.GoName}}__Sequence) {
 	if cSlice.size == 0 {
 		return
 	}
-	*goSlice = make([]{{$Md.Name}}, cSlice.size)
+	*goSlice = make([]{{$Md.GoName}}, cSlice.size)
 	src := unsafe.Slice(cSlice.data, cSlice.size)
 	for i := range src {
-		{{$Md.Name}}TypeSupport.AsGoStruct(&(*goSlice)[i], unsafe.Pointer(&src[i]))
+		{{$Md.GoName}}TypeSupport.AsGoStruct(&(*goSlice)[i], unsafe.Pointer(&src[i]))
 	}
 }
-func {{$Md.Name}}__Sequence_to_C(cSlice *C{{$Md.Name}}__Sequence, goSlice []{{$Md.Name}}) {
+func {{$Md.GoName}}__Sequence_to_C(cSlice *C{{$Md.GoName}}__Sequence, goSlice []{{$Md.GoName}}) {
 	if len(goSlice) == 0 {
 		cSlice.data = nil
 		cSlice.capacity = 0
@@ -333,17 +577,17 @@ func {{$Md.Name}}__Sequence_to_C(cSlice *C{{$Md.Name}}__Sequence, goSlice []{{$M
 	cSlice.size = cSlice.capacity
 	dst := unsafe.Slice(cSlice.data, cSlice.size)
 	for i := range goSlice {
-		{{$Md.Name}}TypeSupport.AsCStruct(unsafe.Pointer(&dst[i]), &goSlice[i])
+		{{$Md.GoName}}TypeSupport.AsCStruct(unsafe.Pointer(&dst[i]), &goSlice[i])
 	}
 }
-func {{$Md.Name}}__Array_to_Go(goSlice []{{$Md.Name}}, cSlice []C{{$Md.Name}}) {
+func {{$Md.GoName}}__Array_to_Go(goSlice []{{$Md.GoName}}, cSlice []C{{$Md.GoName}}) {
 	for i := 0; i < len(cSlice); i++ {
-		{{$Md.Name}}TypeSupport.AsGoStruct(&goSlice[i], unsafe.Pointer(&cSlice[i]))
+		{{$Md.GoName}}TypeSupport.AsGoStruct(&goSlice[i], unsafe.Pointer(&cSlice[i]))
 	}
 }
-func {{$Md.Name}}__Array_to_C(cSlice []C{{$Md.Name}}, goSlice []{{$Md.Name}}) {
+func {{$Md.GoName}}__Array_to_C(cSlice []C{{$Md.GoName}}, goSlice []{{$Md.GoName}}) {
 	for i := 0; i < len(goSlice); i++ {
-		{{$Md.Name}}TypeSupport.AsCStruct(unsafe.Pointer(&cSlice[i]), &goSlice[i])
+		{{$Md.GoName}}TypeSupport.AsCStruct(unsafe.Pointer(&cSlice[i]), &goSlice[i])
 	}
 }
 `),
@@ -396,6 +640,24 @@ func (s _{{.Service.Name}}TypeSupport) Response() types.MessageTypeSupport {
 func (s _{{.Service.Name}}TypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_service_type_support_handle__{{.Service.Package}}__{{.Service.Type}}__{{.Service.Name}}())
 }
+{{- if .Distro.TypeHashes }}
+
+func (s _{{.Service.Name}}TypeSupport) TypeHash() string {
+	h := C.{{.Service.Package}}__{{.Service.Type}}__{{.Service.Name}}__get_type_hash(nil)
+	return types.FormatTypeHash(uint8(h.version), C.GoBytes(unsafe.Pointer(&h.value[0]), C.int(len(h.value))))
+}
+{{- else if .Description }}
+
+func (s _{{.Service.Name}}TypeSupport) TypeHash() string {
+	return {{goString .Description.TypeHash}}
+}
+{{- end }}
+{{- with .Description }}
+
+func (s _{{$.Service.Name}}TypeSupport) TypeDescription() string {
+	return {{goString .TypeDescription}}
+}
+{{- end }}
 
 // Modifying this variable is undefined behavior.
 var {{ .Service.Name }}TypeSupport types.ServiceTypeSupport = _{{.Service.Name}}TypeSupport{}
@@ -557,6 +819,24 @@ func (s _{{.Action.Name}}TypeSupport) GoalStatusArray() types.MessageTypeSupport
 func (s _{{.Action.Name}}TypeSupport) TypeSupport() unsafe.Pointer {
 	return unsafe.Pointer(C.rosidl_typesupport_c__get_action_type_support_handle__{{.Action.Package}}__{{.Action.Type}}__{{.Action.Name}}())
 }
+{{- if .Distro.TypeHashes }}
+
+func (s _{{.Action.Name}}TypeSupport) TypeHash() string {
+	h := C.{{.Action.Package}}__{{.Action.Type}}__{{.Action.Name}}__get_type_hash(nil)
+	return types.FormatTypeHash(uint8(h.version), C.GoBytes(unsafe.Pointer(&h.value[0]), C.int(len(h.value))))
+}
+{{- else if .Description }}
+
+func (s _{{.Action.Name}}TypeSupport) TypeHash() string {
+	return {{goString .Description.TypeHash}}
+}
+{{- end }}
+{{- with .Description }}
+
+func (s _{{$.Action.Name}}TypeSupport) TypeDescription() string {
+	return {{goString .TypeDescription}}
+}
+{{- end }}
 
 // Modifying this variable is undefined behavior.
 var {{.Action.Name}}TypeSupport types.ActionTypeSupport = _{{.Action.Name}}TypeSupport{}
@@ -717,7 +997,10 @@ package primitives
 {{range $rootPath := $.Config.RootPaths -}}
 #cgo LDFLAGS: "-L{{$rootPath}}/lib" "-Wl,-rpath={{$rootPath}}/lib"
 #cgo CFLAGS: "-I{{$rootPath}}/include/rosidl_runtime_c"
+{{range $dep := $.Distro.ExtraIncludeDirs -}}
+#cgo CFLAGS: "-I{{$rootPath}}/include/{{$dep}}"
 {{end}}
+{{- end}}
 #cgo LDFLAGS: -lrcl -lrosidl_runtime_c -lrosidl_typesupport_c -lrcutils -lrmw_implementation
 
 #include "rosidl_runtime_c/string.h"
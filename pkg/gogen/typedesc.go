@@ -0,0 +1,574 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package gogen
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+// FieldType IDs of type_description_interfaces/msg/FieldType.
+const (
+	fieldTypeNested         = 1
+	fieldTypeInt8           = 2
+	fieldTypeUint8          = 3
+	fieldTypeInt16          = 4
+	fieldTypeUint16         = 5
+	fieldTypeInt32          = 6
+	fieldTypeUint32         = 7
+	fieldTypeInt64          = 8
+	fieldTypeUint64         = 9
+	fieldTypeFloat          = 10
+	fieldTypeDouble         = 11
+	fieldTypeBoolean        = 15
+	fieldTypeByte           = 16
+	fieldTypeString         = 17
+	fieldTypeWString        = 18
+	fieldTypeBoundedString  = 21
+	fieldTypeBoundedWString = 22
+
+	// Offsets added to the ID of the element type of arrays and sequences.
+	fieldTypeArrayOffset           = 48
+	fieldTypeBoundedSequenceOffset = 96
+	fieldTypeSequenceOffset        = 144
+)
+
+var primitiveFieldTypeIDs = map[string]uint8{
+	"int8":    fieldTypeInt8,
+	"uint8":   fieldTypeUint8,
+	"char":    fieldTypeUint8, // char is uint8 in ROS 2 IDL
+	"int16":   fieldTypeInt16,
+	"uint16":  fieldTypeUint16,
+	"int32":   fieldTypeInt32,
+	"uint32":  fieldTypeUint32,
+	"int64":   fieldTypeInt64,
+	"uint64":  fieldTypeUint64,
+	"float32": fieldTypeFloat,
+	"float64": fieldTypeDouble,
+	"bool":    fieldTypeBoolean,
+	"byte":    fieldTypeByte,
+}
+
+// TypeDescription is the description of an interface and the types it
+// references, as defined by type_description_interfaces/msg/TypeDescription.
+type TypeDescription struct {
+	TypeDescription IndividualTypeDescription
+	// ReferencedTypeDescriptions is sorted by type name.
+	ReferencedTypeDescriptions []IndividualTypeDescription
+}
+
+// IndividualTypeDescription is the description of a single type without the
+// types it references.
+type IndividualTypeDescription struct {
+	TypeName string // For example "std_msgs/msg/String"
+	Fields   []FieldDescription
+}
+
+type FieldDescription struct {
+	Name         string
+	Type         FieldType
+	DefaultValue string
+}
+
+type FieldType struct {
+	TypeID         uint8
+	Capacity       uint64 // Size of arrays and bound of bounded sequences
+	StringCapacity uint64 // Bound of bounded strings
+	NestedTypeName string
+}
+
+// JSON returns d as JSON in the format used by ROS 2 tools.
+func (d *TypeDescription) JSON() string {
+	return d.marshal(true)
+}
+
+// Hash returns the RIHS01 type hash of d, which is the SHA-256 hash of the
+// JSON representation of d without default values.
+func (d *TypeDescription) Hash() string {
+	sum := sha256.Sum256([]byte(d.marshal(false)))
+	return "RIHS01_" + hex.EncodeToString(sum[:])
+}
+
+// marshal encodes d like json.dumps of Python with separators ", " and ": ",
+// which is what rosidl hashes.
+func (d *TypeDescription) marshal(withDefaults bool) string {
+	var b strings.Builder
+	b.WriteString(`{"type_description": `)
+	d.TypeDescription.marshal(&b, withDefaults)
+	b.WriteString(`, "referenced_type_descriptions": [`)
+	for i := range d.ReferencedTypeDescriptions {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		d.ReferencedTypeDescriptions[i].marshal(&b, withDefaults)
+	}
+	b.WriteString("]}")
+	return b.String()
+}
+
+func (d *IndividualTypeDescription) marshal(b *strings.Builder, withDefaults bool) {
+	b.WriteString(`{"type_name": `)
+	writeJSONString(b, d.TypeName)
+	b.WriteString(`, "fields": [`)
+	for i, f := range d.Fields {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(`{"name": `)
+		writeJSONString(b, f.Name)
+		fmt.Fprintf(b, `, "type": {"type_id": %d, "capacity": %d, "string_capacity": %d, "nested_type_name": `,
+			f.Type.TypeID, f.Type.Capacity, f.Type.StringCapacity)
+		writeJSONString(b, f.Type.NestedTypeName)
+		b.WriteString("}")
+		if withDefaults {
+			b.WriteString(`, "default_value": `)
+			writeJSONString(b, f.DefaultValue)
+		}
+		b.WriteString("}")
+	}
+	b.WriteString("]}")
+}
+
+// writeJSONString writes s as a JSON string with all non-printable and
+// non-ASCII characters escaped.
+func writeJSONString(b *strings.Builder, s string) {
+	b.WriteByte('"')
+	for _, r := range s {
+		switch {
+		case r == '"' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r == '\n':
+			b.WriteString(`\n`)
+		case r == '\r':
+			b.WriteString(`\r`)
+		case r == '\t':
+			b.WriteString(`\t`)
+		case r == '\b':
+			b.WriteString(`\b`)
+		case r == '\f':
+			b.WriteString(`\f`)
+		case r >= ' ' && r <= '~':
+			b.WriteRune(r)
+		case r > 0xffff:
+			r1, r2 := utf16.EncodeRune(r)
+			fmt.Fprintf(b, `\u%04x\u%04x`, r1, r2)
+		default:
+			fmt.Fprintf(b, `\u%04x`, r)
+		}
+	}
+	b.WriteByte('"')
+}
+
+// InterfaceDescription contains the descriptions of an interface embedded in
+// the generated code. It is passed to templates as "Description".
+type InterfaceDescription struct {
+	TypeHash        string
+	TypeDescription string // JSON, see TypeDescription.JSON
+	// MessageDefinition is the ros2msg definition of a message including its
+	// dependencies. It is empty for services and actions.
+	MessageDefinition string
+}
+
+// knownType is a type whose description has been built.
+type knownType struct {
+	desc *IndividualTypeDescription
+	// definition is the ros2msg definition of a message type without its
+	// dependencies.
+	definition string
+	// source is the path of the file defining the type. It is empty if the
+	// type is synthesized or uses a built-in definition.
+	source string
+}
+
+// builtinDefinitions are used for the types referenced by generated
+// descriptions which may not be available in the root paths, for example
+// because the target distribution is newer than the installed one.
+var builtinDefinitions = map[string]string{
+	"builtin_interfaces/msg/Time":       "int32 sec\nuint32 nanosec\n",
+	"builtin_interfaces/msg/Duration":   "int32 sec\nuint32 nanosec\n",
+	"unique_identifier_msgs/msg/UUID":   "uint8[16] uuid\n",
+	"service_msgs/msg/ServiceEventInfo": "uint8 REQUEST_SENT = 0\nuint8 REQUEST_RECEIVED = 1\nuint8 RESPONSE_SENT = 2\nuint8 RESPONSE_RECEIVED = 3\n\nuint8 event_type\nbuiltin_interfaces/Time stamp\nchar[16] client_gid\nint64 sequence_number\n",
+}
+
+func typeName(m *Metadata) string {
+	return m.Package + "/" + m.Type + "/" + m.Name
+}
+
+// describeMessage returns the description of msg. Like rosidl, a member is
+// added to messages without fields.
+func describeMessage(msg *ROS2Message) *IndividualTypeDescription {
+	d := &IndividualTypeDescription{TypeName: typeName(msg.Metadata)}
+	for _, f := range msg.Fields {
+		d.Fields = append(d.Fields, describeField(f, msg))
+	}
+	if len(d.Fields) == 0 {
+		d.Fields = []FieldDescription{{
+			Name: idlEmptyStructMember,
+			Type: FieldType{TypeID: fieldTypeUint8},
+		}}
+	}
+	return d
+}
+
+func describeField(f *ROS2Field, msg *ROS2Message) FieldDescription {
+	d := FieldDescription{
+		Name:         f.RosName,
+		DefaultValue: strings.TrimSpace(f.DefaultValue),
+	}
+	t := &d.Type
+	switch {
+	case f.RosType == "time" && f.PkgName == "":
+		t.TypeID = fieldTypeNested
+		t.NestedTypeName = "builtin_interfaces/msg/Time"
+	case f.RosType == "duration" && f.PkgName == "":
+		t.TypeID = fieldTypeNested
+		t.NestedTypeName = "builtin_interfaces/msg/Duration"
+	case f.RosType == "string" && f.PkgName == "":
+		t.TypeID = fieldTypeString
+		if f.StringBounded != "" {
+			t.TypeID = fieldTypeBoundedString
+			t.StringCapacity = parseBound(f.StringBounded)
+		}
+	case f.RosType == "U16String" && f.PkgName == "":
+		t.TypeID = fieldTypeWString
+		if f.StringBounded != "" {
+			t.TypeID = fieldTypeBoundedWString
+			t.StringCapacity = parseBound(f.StringBounded)
+		}
+	case f.PkgName == "":
+		t.TypeID = primitiveFieldTypeIDs[f.RosType]
+	default:
+		t.TypeID = fieldTypeNested
+		t.NestedTypeName = nestedTypeName(f, msg)
+	}
+	switch {
+	case f.ArrayBounded != "":
+		t.TypeID += fieldTypeBoundedSequenceOffset
+		t.Capacity = parseBound(f.ArrayBounded)
+	case f.ArraySize > 0:
+		t.TypeID += fieldTypeArrayOffset
+		t.Capacity = uint64(f.ArraySize)
+	case f.TypeArray != "":
+		t.TypeID += fieldTypeSequenceOffset
+	}
+	return d
+}
+
+func parseBound(bound string) uint64 {
+	n, _ := strconv.ParseUint(strings.TrimPrefix(bound, "<="), 10, 64)
+	return n
+}
+
+// nestedTypeName returns the full name of the type of field f of msg. Fields
+// referring to other messages of the same action have the type of the action
+// and all other fields refer to messages.
+func nestedTypeName(f *ROS2Field, msg *ROS2Message) string {
+	pkg := f.PkgName
+	if pkg == "." {
+		pkg = msg.Package
+	}
+	if f.PkgIsLocal && msg.Type != "msg" {
+		return pkg + "/" + msg.Type + "/" + f.RosType
+	}
+	return pkg + "/msg/" + f.RosType
+}
+
+// renderDefinition returns a ros2msg definition of msg, which is used for
+// messages which are not parsed from .msg files.
+func renderDefinition(msg *ROS2Message) string {
+	var b strings.Builder
+	for _, c := range msg.Constants {
+		t := c.RosType
+		if t == "U16String" {
+			t = "wstring"
+		}
+		fmt.Fprintf(&b, "%s %s=%s\n", t, c.RosName, c.Value)
+	}
+	for _, f := range msg.Fields {
+		fmt.Fprintf(&b, "%s %s", rosFieldType(f), f.RosName)
+		if v := strings.TrimSpace(f.DefaultValue); v != "" {
+			b.WriteString(" " + v)
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// addMessageTypes makes the descriptions of msgs, which were parsed by parser
+// from the file at source, available to describe.
+func (g *Generator) addMessageTypes(parser *parser, source string, msgs ...*ROS2Message) {
+	for _, msg := range msgs {
+		def, ok := parser.definitions[msg]
+		if !ok {
+			def = renderDefinition(msg)
+		}
+		g.addKnownType(&knownType{
+			desc:       describeMessage(msg),
+			definition: def,
+			source:     source,
+		})
+	}
+}
+
+// addServiceType makes the descriptions of srv and its event message
+// available to describe. The descriptions of the request and response must be
+// added separately.
+func (g *Generator) addServiceType(srv *ROS2Service, source string) {
+	name := typeName(srv.Metadata)
+	request := typeName(srv.Request.Metadata)
+	response := typeName(srv.Response.Metadata)
+	event := name + "_Event"
+	nested := func(name, typ string) FieldDescription {
+		return FieldDescription{
+			Name: name,
+			Type: FieldType{TypeID: fieldTypeNested, NestedTypeName: typ},
+		}
+	}
+	optional := func(name, typ string) FieldDescription {
+		f := nested(name, typ)
+		f.Type.TypeID += fieldTypeBoundedSequenceOffset
+		f.Type.Capacity = 1
+		return f
+	}
+	g.addKnownType(&knownType{
+		desc: &IndividualTypeDescription{
+			TypeName: name,
+			Fields: []FieldDescription{
+				nested("request_message", request),
+				nested("response_message", response),
+				nested("event_message", event),
+			},
+		},
+		source: source,
+	})
+	g.addKnownType(&knownType{
+		desc: &IndividualTypeDescription{
+			TypeName: event,
+			Fields: []FieldDescription{
+				nested("info", "service_msgs/msg/ServiceEventInfo"),
+				optional("request", request),
+				optional("response", response),
+			},
+		},
+		source: source,
+	})
+}
+
+// addActionType makes the description of action available to describe. The
+// descriptions of its messages and services must be added separately.
+func (g *Generator) addActionType(action *ROS2Action, source string) {
+	d := &IndividualTypeDescription{TypeName: typeName(action.Metadata)}
+	for _, f := range []struct {
+		name string
+		m    *Metadata
+	}{
+		{"goal", action.Goal.Metadata},
+		{"result", action.Result.Metadata},
+		{"feedback", action.Feedback.Metadata},
+		{"send_goal_service", action.SendGoal.Metadata},
+		{"get_result_service", action.GetResult.Metadata},
+		{"feedback_message", action.FeedbackMessage.Metadata},
+	} {
+		d.Fields = append(d.Fields, FieldDescription{
+			Name: f.name,
+			Type: FieldType{TypeID: fieldTypeNested, NestedTypeName: typeName(f.m)},
+		})
+	}
+	g.addKnownType(&knownType{desc: d, source: source})
+}
+
+func (g *Generator) addKnownType(t *knownType) {
+	if g.knownTypes == nil {
+		g.knownTypes = map[string]*knownType{}
+	}
+	g.knownTypes[t.desc.TypeName] = t
+}
+
+// lookupType returns the description of the type with the given name. Message
+// types which have not been added are loaded from the root paths, or if they
+// are not found there, from builtinDefinitions. The files the type is loaded
+// from are recorded as dependencies of the interface being generated.
+func (g *Generator) lookupType(name string) (*knownType, error) {
+	t := g.knownTypes[name]
+	if t == nil {
+		var err error
+		t, err = g.loadType(name)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if t.source != "" && g.currentEntry != nil && t.source != g.currentEntry.Source {
+		hash, err := g.dependencyHash(t.source)
+		if err != nil {
+			return nil, err
+		}
+		if g.currentEntry.Dependencies == nil {
+			g.currentEntry.Dependencies = map[string]string{}
+		}
+		g.currentEntry.Dependencies[t.source] = hash
+	}
+	return t, nil
+}
+
+func (g *Generator) loadType(name string) (*knownType, error) {
+	parts := strings.Split(name, "/")
+	if len(parts) != 3 || parts[1] != "msg" {
+		return nil, fmt.Errorf("definition of type %s not found", name)
+	}
+	md := &Metadata{Package: parts[0], Type: parts[1], Name: parts[2]}
+	var source, content string
+	if ref := g.allPkgs[md.Package]; ref != nil && ref.Interfaces[*md] != "" {
+		source = ref.Interfaces[*md]
+		data, err := os.ReadFile(filepath.Clean(source))
+		if err != nil {
+			return nil, err
+		}
+		content = string(data)
+	} else if def, ok := builtinDefinitions[name]; ok {
+		content = def
+	} else {
+		return nil, fmt.Errorf("definition of type %s not found", name)
+	}
+	msg := ROS2MessageNew(md.Package, md.Name)
+	msg.GoName = g.config.goTypeName(md.Package, md.Name)
+	// Warnings are not reported, because they are reported when the type
+	// itself is generated.
+	parser := &parser{config: g.config}
+	var err error
+	if isIDLPath(source) {
+		err = parser.ParseIDLMessage(msg, content)
+	} else {
+		err = parser.ParseROS2Message(msg, content)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", name, err)
+	}
+	g.addMessageTypes(parser, source, msg)
+	return g.knownTypes[name], nil
+}
+
+func (g *Generator) dependencyHash(source string) (string, error) {
+	if hash, ok := g.sourceHashes[source]; ok {
+		return hash, nil
+	}
+	hash, err := hashFile(source)
+	if err != nil {
+		return "", err
+	}
+	if g.sourceHashes == nil {
+		g.sourceHashes = map[string]string{}
+	}
+	g.sourceHashes[source] = hash
+	return hash, nil
+}
+
+// walkTypes calls f for the type with the given name and all types it
+// references, in breadth-first order of first appearance.
+func (g *Generator) walkTypes(name string, f func(*knownType)) error {
+	seen := stringSet{}
+	seen.Add(name)
+	queue := []string{name}
+	for len(queue) > 0 {
+		t, err := g.lookupType(queue[0])
+		if err != nil {
+			return err
+		}
+		queue = queue[1:]
+		f(t)
+		for _, field := range t.desc.Fields {
+			nested := field.Type.NestedTypeName
+			if _, ok := seen[nested]; nested != "" && !ok {
+				seen.Add(nested)
+				queue = append(queue, nested)
+			}
+		}
+	}
+	return nil
+}
+
+// typeDescription returns the full description of the type with the given
+// name.
+func (g *Generator) typeDescription(name string) (*TypeDescription, error) {
+	d := &TypeDescription{}
+	err := g.walkTypes(name, func(t *knownType) {
+		if t.desc.TypeName == name {
+			d.TypeDescription = *t.desc
+		} else {
+			d.ReferencedTypeDescriptions = append(d.ReferencedTypeDescriptions, *t.desc)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(d.ReferencedTypeDescriptions, func(i, j int) bool {
+		return d.ReferencedTypeDescriptions[i].TypeName < d.ReferencedTypeDescriptions[j].TypeName
+	})
+	return d, nil
+}
+
+const messageDefinitionSeparator = "================================================================================\n"
+
+// messageDefinition returns the ros2msg definition of the message type with
+// the given name followed by the definitions of its dependencies, in the
+// format used by rosbag2 in MCAP schemas.
+func (g *Generator) messageDefinition(name string) (string, error) {
+	var b strings.Builder
+	err := g.walkTypes(name, func(t *knownType) {
+		if t.desc.TypeName != name {
+			if b.Len() > 0 && !strings.HasSuffix(b.String(), "\n") {
+				b.WriteString("\n")
+			}
+			b.WriteString(messageDefinitionSeparator)
+			b.WriteString("MSG: " + t.desc.TypeName + "\n")
+		}
+		b.WriteString(t.definition)
+	})
+	return b.String(), err
+}
+
+// describe returns the descriptions of the interface with metadata m, which
+// must have been added with addMessageTypes, addServiceType or addActionType.
+// Nil is returned and a warning is reported if the interface could not be
+// described.
+func (g *Generator) describe(m *Metadata, isMessage bool) *InterfaceDescription {
+	name := typeName(m)
+	d, err := g.typeDescription(name)
+	if err != nil {
+		g.warnf(g.currentSource(), "failed to describe %s: %w", name, err)
+		return nil
+	}
+	desc := &InterfaceDescription{
+		TypeHash:        d.Hash(),
+		TypeDescription: d.JSON(),
+	}
+	if isMessage {
+		desc.MessageDefinition, err = g.messageDefinition(name)
+		if err != nil {
+			g.warnf(g.currentSource(), "failed to describe %s: %w", name, err)
+			return nil
+		}
+	}
+	return desc
+}
+
+func (g *Generator) currentSource() string {
+	if g.currentEntry == nil {
+		return ""
+	}
+	return g.currentEntry.Source
+}
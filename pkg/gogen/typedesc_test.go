@@ -0,0 +1,129 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package gogen
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey" //nolint:revive
+)
+
+func TestTypeDescriptions(t *testing.T) {
+	SetDefaultFailureMode(FailureContinues)
+
+	Convey("Interfaces are described", t, func() {
+		root := t.TempDir()
+		writeTestFiles(root, map[string]string{
+			"share/builtin_interfaces/msg/Time.msg": "# Time\nint32 sec\nuint32 nanosec\n",
+			"share/std_msgs/msg/String.msg":         "string data\n",
+			"share/std_msgs/msg/Header.msg":         "builtin_interfaces/Time stamp\nstring frame_id\n",
+			"share/demo_msgs/msg/Sample.msg": `uint8 MODE_FAST=1
+std_msgs/Header header
+float64[3] position
+int32[<=4] counts
+string<=8[] names "a"
+Point[] points
+`,
+			"share/demo_msgs/msg/Point.msg":      "float64 x\n",
+			"share/demo_msgs/srv/Reset.srv":      "---\nbool ok\n",
+			"share/demo_msgs/action/Move.action": "int32 goal\n---\n---\n",
+		})
+		newGenerator := func() *Generator {
+			g := New(&Config{
+				RootPaths:           []string{root},
+				DestPath:            t.TempDir(),
+				RclgoImportPath:     DefaultConfig.RclgoImportPath,
+				MessageModulePrefix: "example.com/msgs",
+			})
+			g.findPackages()
+			return g
+		}
+
+		Convey("Type hashes match the hashes computed by rosidl", func() {
+			g := newGenerator()
+			for name, hash := range map[string]string{
+				"std_msgs/msg/String":         "RIHS01_df668c740482bbd48fb39d76a70dfd4bd59db1288021743503259e948f6b1a18",
+				"builtin_interfaces/msg/Time": "RIHS01_b106235e25a4c5ed35098aa0a61a3ee9c9b18d197f398b0e4206cea9acf9c197",
+				"std_msgs/msg/Header":         "RIHS01_f49fb3ae2cf070f793645ff749683ac6b06203e41c891e17701b1cb597ce6a01",
+			} {
+				d, err := g.typeDescription(name)
+				So(err, ShouldBeNil)
+				So(d.Hash(), ShouldEqual, hash)
+			}
+		})
+
+		Convey("Fields are described", func() {
+			g := newGenerator()
+			d, err := g.typeDescription("demo_msgs/msg/Sample")
+			So(err, ShouldBeNil)
+			So(d.TypeDescription.Fields, ShouldResemble, []FieldDescription{
+				{Name: "header", Type: FieldType{TypeID: fieldTypeNested, NestedTypeName: "std_msgs/msg/Header"}},
+				{Name: "position", Type: FieldType{TypeID: 59, Capacity: 3}},
+				{Name: "counts", Type: FieldType{TypeID: 102, Capacity: 4}},
+				{Name: "names", Type: FieldType{TypeID: 165, StringCapacity: 8}, DefaultValue: `"a"`},
+				{Name: "points", Type: FieldType{TypeID: 145, NestedTypeName: "demo_msgs/msg/Point"}},
+			})
+			var referenced []string
+			for _, r := range d.ReferencedTypeDescriptions {
+				referenced = append(referenced, r.TypeName)
+			}
+			So(referenced, ShouldResemble, []string{
+				"builtin_interfaces/msg/Time",
+				"demo_msgs/msg/Point",
+				"std_msgs/msg/Header",
+			})
+			So(d.JSON(), ShouldContainSubstring, `"default_value": "\"a\""`)
+		})
+
+		Convey("Message definitions include dependencies", func() {
+			g := newGenerator()
+			def, err := g.messageDefinition("std_msgs/msg/Header")
+			So(err, ShouldBeNil)
+			So(def, ShouldEqual, "builtin_interfaces/Time stamp\nstring frame_id\n"+
+				messageDefinitionSeparator+
+				"MSG: builtin_interfaces/msg/Time\n"+
+				"# Time\nint32 sec\nuint32 nanosec\n")
+		})
+
+		Convey("Services and actions are described", func() {
+			dest := t.TempDir()
+			g := New(&Config{
+				RootPaths:           []string{root},
+				DestPath:            dest,
+				RclgoImportPath:     DefaultConfig.RclgoImportPath,
+				MessageModulePrefix: "example.com/msgs",
+			})
+			So(g.GenerateGolangMessageTypes(), ShouldBeNil)
+			So(g.Diagnostics(), ShouldBeEmpty)
+			read := func(name string) string {
+				data, err := os.ReadFile(filepath.Join(dest, filepath.FromSlash(name)))
+				So(err, ShouldBeNil)
+				return string(data)
+			}
+			srv := read("demo_msgs/srv/Reset.gen.go")
+			So(srv, ShouldContainSubstring, `"type_name": "demo_msgs/srv/Reset_Event"`)
+			So(srv, ShouldContainSubstring, `"type_name": "service_msgs/msg/ServiceEventInfo"`)
+			action := read("demo_msgs/action/Move.gen.go")
+			for _, name := range []string{
+				"demo_msgs/action/Move_SendGoal_Event",
+				"demo_msgs/action/Move_FeedbackMessage",
+				"unique_identifier_msgs/msg/UUID",
+			} {
+				So(action, ShouldContainSubstring, `"type_name": "`+name+`"`)
+			}
+			feedback := read("demo_msgs/action/Move_FeedbackMessage.gen.go")
+			So(feedback, ShouldContainSubstring, "unique_identifier_msgs/UUID goal_id\ndemo_msgs/Move_Feedback feedback\n"+
+				strings.TrimSuffix(messageDefinitionSeparator, "\n"))
+		})
+	})
+}
@@ -31,8 +31,10 @@ Use ROS2MessageNew() to initialize the struct
 */
 type ROS2Message struct {
 	*Metadata
+	GoName    string // Name of the generated Go type, Name unless renamed
 	Fields    []*ROS2Field
 	Constants []*ROS2Constant
+	Enums     []*ROS2Enum
 	GoImports map[string]string
 	CImports  stringSet
 }
@@ -48,6 +50,7 @@ func newMessageWithType(pkg, name, typ string) *ROS2Message {
 			Package: pkg,
 			Type:    typ,
 		},
+		GoName:    name,
 		GoImports: map[string]string{},
 		CImports:  stringSet{},
 	}
@@ -62,6 +65,7 @@ type ROS2Constant struct {
 	Comment    string
 	PkgName    string
 	PkgIsLocal bool
+	EnumType   string
 }
 
 func (t *ROS2Constant) GoPkgReference() string {
@@ -73,20 +77,22 @@ func (t *ROS2Constant) GoPkgReference() string {
 
 // Field is a message field.
 type ROS2Field struct {
-	TypeArray    string
-	ArrayBounded string
-	ArraySize    int
-	DefaultValue string
-	PkgName      string
-	GoPkgName    string
-	PkgIsLocal   bool
-	RosType      string
-	CType        string
-	GoType       string
-	RosName      string
-	CName        string
-	GoName       string
-	Comment      string
+	TypeArray     string
+	ArrayBounded  string
+	ArraySize     int
+	StringBounded string
+	DefaultValue  string
+	PkgName       string
+	GoPkgName     string
+	PkgIsLocal    bool
+	RosType       string
+	CType         string
+	GoType        string
+	RosName       string
+	CName         string
+	GoName        string
+	Comment       string
+	EnumType      string
 }
 
 func (t *ROS2Field) GoPkgReference() string {
@@ -100,6 +106,19 @@ func (t *ROS2Field) IsSingleComplex() bool {
 	return t.TypeArray == "" && t.PkgName != ""
 }
 
+// ROS2Enum is a group of integer constants of a message whose names share a
+// prefix and which have the same type, such as STATUS_ACCEPTED and
+// STATUS_ABORTED.
+type ROS2Enum struct {
+	GoName    string // Name of the generated Go type, such as GoalStatus_Status
+	Prefix    string // Prefix of the names of the constants, such as STATUS
+	GoType    string
+	Unsigned  bool
+	Constants []*ROS2Constant
+	// Values contains the first constant with each distinct value.
+	Values []*ROS2Constant
+}
+
 type ROS2Service struct {
 	*Metadata
 	Request  *ROS2Message
@@ -198,7 +217,7 @@ var blacklistedMessages = []string{
 }
 
 /*
-cErrorTypeFiles are looked for #definitions and parsed as Golang ros2 error types
+cErrorTypeFiles are looked for #definitions and parsed as Golang ros2 error types. See Distro.ErrorTypeFiles.
 */
 var cErrorTypeFiles = []string{
 	"rcl/types.h",
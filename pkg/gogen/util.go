@@ -17,6 +17,7 @@ import (
 	"os"
 	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
 	"unicode"
 	"unicode/utf8"
@@ -34,6 +35,15 @@ func goLicenseHeader(license string) string {
 	return "/*\n" + license + "*/\n\n"
 }
 
+// goStringLiteral returns s as a Go string literal. A raw string literal is
+// used unless s contains characters which raw string literals can't contain.
+func goStringLiteral(s string) string {
+	if strings.ContainsAny(s, "`\r\x00\ufeff") || !utf8.ValidString(s) {
+		return strconv.Quote(s)
+	}
+	return "`" + s + "`"
+}
+
 func ucFirst(s string) string {
 	r, size := utf8.DecodeRuneInString(s)
 	if r == utf8.RuneError {
@@ -225,6 +235,29 @@ func matchMsg(msg *ROS2Message, pkg, name string) bool {
 	return msg.GoPackage() == pkg && msg.Name == name
 }
 
+// hasTimeHelpers reports whether helpers converting msg to and from Go time
+// types are generated. They are generated for builtin_interfaces/msg/Time,
+// builtin_interfaces/msg/Duration and std_msgs/msg/Header if the messages have
+// the expected fields.
+func hasTimeHelpers(msg *ROS2Message) bool {
+	hasField := func(name, pkg, typ string) bool {
+		for _, f := range msg.Fields {
+			if f.RosName == name {
+				return f.PkgName == pkg && f.RosType == typ && f.TypeArray == ""
+			}
+		}
+		return false
+	}
+	switch {
+	case matchMsg(msg, "builtin_interfaces_msg", "Time"),
+		matchMsg(msg, "builtin_interfaces_msg", "Duration"):
+		return hasField("sec", "", "int32") && hasField("nanosec", "", "uint32")
+	case matchMsg(msg, "std_msgs_msg", "Header"):
+		return hasField("stamp", "builtin_interfaces", "Time")
+	}
+	return false
+}
+
 func loadGoPkgDeps(pkgPaths ...string) (stringSet, error) {
 	deps := stringSet{}
 	if len(pkgPaths) > 0 {
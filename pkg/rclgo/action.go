@@ -899,6 +899,11 @@ func (c *ActionClient) Node() *Node {
 	return c.node
 }
 
+// TypeSupport returns the type support of c.
+func (c *ActionClient) TypeSupport() types.ActionTypeSupport {
+	return c.typeSupport
+}
+
 // WatchGoal combines functionality of SendGoal and WatchFeedback. It sends a
 // goal to the server. If the goal is accepted, feedback for the goal is watched
 // until the goal reaches a terminal state or ctx is canceled. If the goal is
@@ -0,0 +1,398 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package bag
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey" //nolint:revive
+)
+
+type testRecord struct {
+	op     opcode
+	offset int
+	body   []byte
+}
+
+func splitRecords(data []byte, offset int) []testRecord {
+	var recs []testRecord
+	for len(data) >= recordHeaderSize {
+		n := int(binary.LittleEndian.Uint64(data[1:]))
+		recs = append(recs, testRecord{
+			op:     opcode(data[0]),
+			offset: offset,
+			body:   data[recordHeaderSize : recordHeaderSize+n],
+		})
+		data = data[recordHeaderSize+n:]
+		offset += recordHeaderSize + n
+	}
+	return recs
+}
+
+// chunkRecords returns the records contained in the body of a chunk record.
+func chunkRecords(body []byte) []testRecord {
+	compressionLen := int(binary.LittleEndian.Uint32(body[28:]))
+	records := body[32+compressionLen+8:]
+	return splitRecords(records, 0)
+}
+
+func TestMCAPWriter(t *testing.T) {
+	Convey("Given an MCAP file written with small chunks", t, func() {
+		var buf bytes.Buffer
+		w, err := NewMCAPWriter(&buf, &MCAPWriterOptions{Profile: ProfileROS2, ChunkSize: 64})
+		So(err, ShouldBeNil)
+		schemaID, err := w.AddSchema("std_msgs/msg/String", SchemaEncodingROS2Msg, []byte("string data\n"))
+		So(err, ShouldBeNil)
+		channelID, err := w.AddChannel(schemaID, "/chatter", MessageEncodingCDR, map[string]string{"a": "b"})
+		So(err, ShouldBeNil)
+		_, err = w.AddChannel(42, "/invalid", MessageEncodingCDR, nil)
+		So(err, ShouldNotBeNil)
+		for i := 0; i < 10; i++ {
+			So(w.WriteMessage(&Message{
+				ChannelID: channelID,
+				Sequence:  uint32(i),
+				LogTime:   uint64(1000 + i),
+				Data:      bytes.Repeat([]byte{byte(i)}, 20),
+			}), ShouldBeNil)
+		}
+		So(w.AddMetadata("meta", map[string]string{"x": "y"}), ShouldBeNil)
+		So(w.Close(), ShouldBeNil)
+		data := buf.Bytes()
+
+		Convey("The file starts and ends with the magic", func() {
+			So(data[:len(mcapMagic)], ShouldResemble, mcapMagic)
+			So(data[len(data)-len(mcapMagic):], ShouldResemble, mcapMagic)
+		})
+		recs := splitRecords(data[len(mcapMagic):len(data)-len(mcapMagic)], len(mcapMagic))
+		counts := make(map[opcode]int)
+		messages := 0
+		for _, r := range recs {
+			counts[r.op]++
+			if r.op == opChunk {
+				for _, cr := range chunkRecords(r.body) {
+					if cr.op == opMessage {
+						messages++
+					}
+				}
+			}
+		}
+		Convey("All messages are written in multiple chunks", func() {
+			So(messages, ShouldEqual, 10)
+			So(counts[opChunk], ShouldBeGreaterThan, 1)
+			So(counts[opChunkIndex], ShouldEqual, counts[opChunk])
+			So(counts[opStatistics], ShouldEqual, 1)
+			So(counts[opMetadataIndex], ShouldEqual, 1)
+			So(counts[opFooter], ShouldEqual, 1)
+		})
+		Convey("The data section CRC is valid", func() {
+			for _, r := range recs {
+				if r.op == opDataEnd {
+					So(binary.LittleEndian.Uint32(r.body), ShouldEqual, crc32.ChecksumIEEE(data[:r.offset]))
+				}
+			}
+		})
+		Convey("The footer points to a valid summary", func() {
+			footer := recs[len(recs)-1]
+			So(footer.op, ShouldEqual, opFooter)
+			summaryStart := binary.LittleEndian.Uint64(footer.body)
+			summaryOffsetStart := binary.LittleEndian.Uint64(footer.body[8:])
+			So(opcode(data[summaryStart]), ShouldEqual, opSchema)
+			So(opcode(data[summaryOffsetStart]), ShouldEqual, opSummaryOffset)
+			crc := crc32.ChecksumIEEE(data[summaryStart : footer.offset+recordHeaderSize+16])
+			So(binary.LittleEndian.Uint32(footer.body[16:]), ShouldEqual, crc)
+		})
+		Convey("Chunk indexes point to chunks", func() {
+			for _, r := range recs {
+				if r.op == opChunkIndex {
+					offset := binary.LittleEndian.Uint64(r.body[16:])
+					So(opcode(data[offset]), ShouldEqual, opChunk)
+				}
+			}
+		})
+	})
+}
+
+func writeFile(path, content string) {
+	So(os.MkdirAll(filepath.Dir(path), 0755), ShouldBeNil)
+	So(os.WriteFile(path, []byte(content), 0600), ShouldBeNil)
+}
+
+func TestSchemaLoader(t *testing.T) {
+	Convey("Given message definitions in a prefix", t, func() {
+		prefix := t.TempDir()
+		writeFile(filepath.Join(prefix, "share/geometry_msgs/msg/Pose.msg"), "Point position\nQuaternion orientation\n")
+		writeFile(filepath.Join(prefix, "share/geometry_msgs/msg/Point.msg"), "float64 x\nfloat64 y\nfloat64 z\n")
+		writeFile(filepath.Join(prefix, "share/geometry_msgs/msg/Quaternion.msg"), "float64 x 0\nfloat64 w 1")
+		writeFile(filepath.Join(prefix, "share/test_msgs/msg/Poses.msg"),
+			"# Comment\nint32 CONST=1\ngeometry_msgs/Pose[] poses\ngeometry_msgs/msg/Point[<=3] points\nstring<=5 name\n")
+		l := &SchemaLoader{Prefixes: []string{t.TempDir(), prefix}}
+
+		Convey("Schemas contain the definitions of dependencies", func() {
+			schema, err := l.Load("test_msgs/msg/Poses")
+			So(err, ShouldBeNil)
+			So(string(schema), ShouldEqual,
+				"# Comment\nint32 CONST=1\ngeometry_msgs/Pose[] poses\ngeometry_msgs/msg/Point[<=3] points\nstring<=5 name\n"+
+					schemaSeparator+"MSG: geometry_msgs/msg/Pose\nPoint position\nQuaternion orientation\n"+
+					schemaSeparator+"MSG: geometry_msgs/msg/Point\nfloat64 x\nfloat64 y\nfloat64 z\n"+
+					schemaSeparator+"MSG: geometry_msgs/msg/Quaternion\nfloat64 x 0\nfloat64 w 1",
+			)
+		})
+		Convey("Missing definitions are reported", func() {
+			_, err := l.Load("test_msgs/msg/Missing")
+			So(err, ShouldNotBeNil)
+			_, err = l.Load("invalid")
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestWriter(t *testing.T) {
+	Convey("Given a bag writer splitting files by duration", t, func() {
+		dir := filepath.Join(t.TempDir(), "test_bag")
+		w, err := NewWriter(dir, &WriterOptions{MaxFileDuration: time.Second})
+		So(err, ShouldBeNil)
+		So(w.AddTopic(TopicMetadata{Name: "/a", Type: "std_msgs/msg/String"}, nil), ShouldBeNil)
+		So(w.AddTopic(TopicMetadata{Name: "/a", Type: "std_msgs/msg/String"}, nil), ShouldNotBeNil)
+		So(w.Write("/b", time.Now(), time.Now(), nil), ShouldNotBeNil)
+		start := time.Unix(100, 0)
+		for i := 0; i < 5; i++ {
+			stamp := start.Add(time.Duration(i) * 600 * time.Millisecond)
+			So(w.Write("/a", stamp, stamp, []byte{0, 1, 0, 0}), ShouldBeNil)
+		}
+		So(w.Close(), ShouldBeNil)
+
+		Convey("Messages are split to multiple files", func() {
+			m, err := ReadMetadata(dir)
+			So(err, ShouldBeNil)
+			So(m.StorageIdentifier, ShouldEqual, StorageIdentifier)
+			So(m.MessageCount, ShouldEqual, 5)
+			So(m.RelativeFilePaths, ShouldResemble, []string{"test_bag_0.mcap", "test_bag_1.mcap", "test_bag_2.mcap"})
+			So(m.Files[0].MessageCount, ShouldEqual, 2)
+			So(m.StartingTime.NanosecondsSinceEpoch, ShouldEqual, start.UnixNano())
+			So(m.Duration.Nanoseconds, ShouldEqual, int64(2400*time.Millisecond))
+			So(m.TopicsWithMessageCount[0].TopicMetadata.SerializationFormat, ShouldEqual, MessageEncodingCDR)
+			So(m.Version, ShouldEqual, MetadataVersion)
+			for _, p := range m.RelativeFilePaths {
+				_, err := os.Stat(filepath.Join(dir, p))
+				So(err, ShouldBeNil)
+			}
+		})
+		Convey("The metadata file contains the keys required by its version", func() {
+			data, err := os.ReadFile(filepath.Join(dir, MetadataFileName))
+			So(err, ShouldBeNil)
+			So(string(data), ShouldContainSubstring, "custom_data: {}")
+			So(string(data), ShouldContainSubstring, `type_description_hash: ""`)
+		})
+		Convey("Existing directories are not overwritten", func() {
+			_, err := NewWriter(dir, nil)
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestWriterSchemas(t *testing.T) {
+	Convey("Topics of the same type share a schema", t, func() {
+		dir := filepath.Join(t.TempDir(), "bag")
+		w, err := NewWriter(dir, nil)
+		So(err, ShouldBeNil)
+		So(w.AddTopic(TopicMetadata{Name: "/a", Type: "std_msgs/msg/String"}, []byte("string data\n")), ShouldBeNil)
+		So(w.AddTopic(TopicMetadata{Name: "/b", Type: "std_msgs/msg/String"}, []byte("string data\n")), ShouldBeNil)
+		So(w.AddTopic(TopicMetadata{Name: "/c", Type: "std_msgs/msg/Int32"}, nil), ShouldBeNil)
+		for _, topic := range []string{"/a", "/b", "/c"} {
+			So(w.Write(topic, time.Unix(1, 0), time.Unix(1, 0), []byte{0}), ShouldBeNil)
+		}
+		So(w.Close(), ShouldBeNil)
+
+		f, err := os.Open(filepath.Join(dir, "bag_0.mcap"))
+		So(err, ShouldBeNil)
+		defer f.Close()
+		r, err := NewMCAPReader(f)
+		So(err, ShouldBeNil)
+		So(r.Schemas(), ShouldHaveLength, 1)
+		schemaIDs := make(map[string]uint16)
+		for _, c := range r.Channels() {
+			schemaIDs[c.Topic] = c.SchemaID
+		}
+		So(schemaIDs["/a"], ShouldNotEqual, 0)
+		So(schemaIDs["/b"], ShouldEqual, schemaIDs["/a"])
+		So(schemaIDs["/c"], ShouldEqual, 0)
+	})
+}
+
+func TestOfferedQos(t *testing.T) {
+	Convey("Offered QoS profiles survive a round trip", t, func() {
+		profiles := []offeredQos{{
+			History:  1,
+			Depth:    10,
+			Deadline: newQosDuration(9223372036*time.Second + 854775807),
+		}}
+		s, err := marshalOfferedQos(profiles)
+		So(err, ShouldBeNil)
+		So(s, ShouldContainSubstring, "sec: 9223372036")
+		parsed, err := unmarshalOfferedQos(s)
+		So(err, ShouldBeNil)
+		So(parsed, ShouldResemble, profiles)
+	})
+}
+
+func TestReader(t *testing.T) {
+	Convey("Given a bag written by Writer", t, func() {
+		dir := filepath.Join(t.TempDir(), "bag")
+		w, err := NewWriter(dir, &WriterOptions{MaxFileSize: 200, ChunkSize: 50})
+		So(err, ShouldBeNil)
+		So(w.AddTopic(TopicMetadata{Name: "/a", Type: "std_msgs/msg/String", OfferedQosProfiles: "- depth: 5\n"}, []byte("string data\n")), ShouldBeNil)
+		So(w.AddTopic(TopicMetadata{Name: "/b", Type: "std_msgs/msg/Int32"}, nil), ShouldBeNil)
+		start := time.Unix(100, 0)
+		for i := 0; i < 20; i++ {
+			topic := "/a"
+			if i%2 == 1 {
+				topic = "/b"
+			}
+			stamp := start.Add(time.Duration(i) * time.Millisecond)
+			So(w.Write(topic, stamp, stamp, []byte{byte(i)}), ShouldBeNil)
+		}
+		So(w.Close(), ShouldBeNil)
+
+		Convey("The bag can be read from the directory", func() {
+			r, err := NewReader(dir)
+			So(err, ShouldBeNil)
+			So(len(r.Files()), ShouldBeGreaterThan, 1)
+			topics, err := r.Topics()
+			So(err, ShouldBeNil)
+			So(topics, ShouldResemble, []TopicMetadata{
+				{Name: "/a", Type: "std_msgs/msg/String", SerializationFormat: "cdr", OfferedQosProfiles: "- depth: 5\n"},
+				{Name: "/b", Type: "std_msgs/msg/Int32", SerializationFormat: "cdr"},
+			})
+			var data []byte
+			var last uint64
+			err = r.ForEach(func(topic *TopicMetadata, msg *Message) error {
+				So(msg.LogTime, ShouldBeGreaterThanOrEqualTo, last)
+				last = msg.LogTime
+				So(topic.Name == "/b", ShouldEqual, msg.Data[0]%2 == 1)
+				data = append(data, msg.Data...)
+				return nil
+			})
+			So(err, ShouldBeNil)
+			So(len(data), ShouldEqual, 20)
+			for i, d := range data {
+				So(d, ShouldEqual, i)
+			}
+		})
+		Convey("A single file of the bag can be read", func() {
+			r, err := NewReader(filepath.Join(dir, "bag_0.mcap"))
+			So(err, ShouldBeNil)
+			count := 0
+			So(r.ForEach(func(*TopicMetadata, *Message) error { count++; return nil }), ShouldBeNil)
+			So(count, ShouldBeGreaterThan, 0)
+			So(count, ShouldBeLessThan, 20)
+		})
+	})
+	Convey("Files without a summary section are scanned", t, func() {
+		var b recordBuilder
+		var buf bytes.Buffer
+		buf.Write(mcapMagic)
+		b.begin(opHeader)
+		b.str(ProfileROS2)
+		b.str("")
+		buf.Write(b.end())
+		buf.Write(b.schema(&Schema{ID: 1, Name: "std_msgs/msg/String", Encoding: SchemaEncodingROS2Msg}))
+		buf.Write(b.channel(&Channel{ID: 3, SchemaID: 1, Topic: "/x", MessageEncoding: MessageEncodingCDR}))
+		buf.Write(b.message(&Message{ChannelID: 3, LogTime: 20, Data: []byte{1}}))
+		buf.Write(b.message(&Message{ChannelID: 3, LogTime: 10, Data: []byte{2}}))
+		b.begin(opDataEnd)
+		b.u32(0)
+		buf.Write(b.end())
+		b.begin(opFooter)
+		b.u64(0)
+		b.u64(0)
+		b.u32(0)
+		buf.Write(b.end())
+		buf.Write(mcapMagic)
+
+		r, err := NewMCAPReader(bytes.NewReader(buf.Bytes()))
+		So(err, ShouldBeNil)
+		So(r.Profile(), ShouldEqual, ProfileROS2)
+		So(r.Channels()[3].Topic, ShouldEqual, "/x")
+		it := r.Messages()
+		m, err := it.Next()
+		So(err, ShouldBeNil)
+		So(m.LogTime, ShouldEqual, 20)
+		m, err = it.Next()
+		So(err, ShouldBeNil)
+		So(m.Data, ShouldResemble, []byte{2})
+		_, err = it.Next()
+		So(err, ShouldEqual, io.EOF)
+	})
+	Convey("Invalid files are rejected", t, func() {
+		_, err := NewMCAPReader(bytes.NewReader([]byte("not an MCAP file")))
+		So(err, ShouldWrap, ErrInvalidMCAP)
+	})
+}
+
+func TestPlaybackClock(t *testing.T) {
+	Convey("Given a playback clock with a fake wall clock", t, func() {
+		wall := time.Unix(1000, 0)
+		c := newPlaybackClock(2, false, func() time.Time { return wall })
+		c.Reset(100)
+		Convey("Bag time advances at the playback rate", func() {
+			wall = wall.Add(10)
+			So(c.BagTime(), ShouldEqual, 120)
+			c.SetRate(0.5)
+			wall = wall.Add(10)
+			So(c.BagTime(), ShouldEqual, 125)
+		})
+		Convey("Bag time stops while paused", func() {
+			c.SetPaused(true)
+			wall = wall.Add(10)
+			So(c.BagTime(), ShouldEqual, 100)
+			c.SetPaused(false)
+			wall = wall.Add(10)
+			So(c.BagTime(), ShouldEqual, 120)
+		})
+	})
+	Convey("Given a paused playback clock", t, func() {
+		c := newPlaybackClock(1, true, nil)
+		c.Reset(0)
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		Convey("Waiting does not finish until stepped", func() {
+			done := make(chan error, 1)
+			go func() { done <- c.WaitUntil(ctx, int64(time.Hour)) }()
+			select {
+			case <-done:
+				So("wait finished while paused", ShouldBeEmpty)
+			case <-time.After(20 * time.Millisecond):
+			}
+			So(c.Step(), ShouldBeTrue)
+			So(<-done, ShouldBeNil)
+			So(c.BagTime(), ShouldEqual, int64(time.Hour))
+		})
+		Convey("Waiting finishes after resuming", func() {
+			done := make(chan error, 1)
+			go func() { done <- c.WaitUntil(ctx, int64(10*time.Millisecond)) }()
+			c.SetPaused(false)
+			So(<-done, ShouldBeNil)
+			So(c.Step(), ShouldBeFalse)
+		})
+		Convey("Waiting stops when the context is done", func() {
+			ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+			defer cancel()
+			So(c.WaitUntil(ctx, int64(time.Hour)), ShouldWrap, context.DeadlineExceeded)
+		})
+	})
+}
@@ -0,0 +1,157 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package bag
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// playbackClock maps wall time to bag time taking the playback rate and
+// pausing into account.
+type playbackClock struct {
+	mutex      sync.Mutex
+	now        func() time.Time
+	rate       float64
+	paused     bool
+	steps      int
+	anchorBag  int64 // Bag time in nanoseconds at anchorWall
+	anchorWall time.Time
+	changed    chan struct{}
+}
+
+func newPlaybackClock(rate float64, paused bool, now func() time.Time) *playbackClock {
+	if now == nil {
+		now = time.Now
+	}
+	return &playbackClock{
+		now:        now,
+		rate:       rate,
+		paused:     paused,
+		anchorWall: now(),
+		changed:    make(chan struct{}),
+	}
+}
+
+func (c *playbackClock) notify() {
+	close(c.changed)
+	c.changed = make(chan struct{})
+}
+
+func (c *playbackClock) bagTimeLocked(wall time.Time) int64 {
+	if c.paused {
+		return c.anchorBag
+	}
+	return c.anchorBag + int64(float64(wall.Sub(c.anchorWall))*c.rate)
+}
+
+func (c *playbackClock) reanchor(bagTime int64) {
+	c.anchorBag = bagTime
+	c.anchorWall = c.now()
+}
+
+// BagTime returns the current bag time in nanoseconds since the epoch.
+func (c *playbackClock) BagTime() int64 {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.bagTimeLocked(c.now())
+}
+
+// Reset sets the current bag time.
+func (c *playbackClock) Reset(bagTime int64) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.reanchor(bagTime)
+	c.notify()
+}
+
+func (c *playbackClock) Rate() float64 {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.rate
+}
+
+func (c *playbackClock) SetRate(rate float64) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.reanchor(c.bagTimeLocked(c.now()))
+	c.rate = rate
+	c.notify()
+}
+
+func (c *playbackClock) Paused() bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.paused
+}
+
+func (c *playbackClock) SetPaused(paused bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.paused == paused {
+		return
+	}
+	c.reanchor(c.bagTimeLocked(c.now()))
+	c.paused = paused
+	c.steps = 0
+	c.notify()
+}
+
+// Step allows the next call of WaitUntil to return immediately while paused.
+// Returns false if the clock is not paused.
+func (c *playbackClock) Step() bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if !c.paused {
+		return false
+	}
+	c.steps++
+	c.notify()
+	return true
+}
+
+// WaitUntil waits until the bag time reaches bagTime or a step is requested.
+// In the latter case the bag time jumps to bagTime.
+func (c *playbackClock) WaitUntil(ctx context.Context, bagTime int64) error {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	<-timer.C
+	for {
+		c.mutex.Lock()
+		if c.paused && c.steps > 0 {
+			c.steps--
+			c.reanchor(bagTime)
+			c.mutex.Unlock()
+			return nil
+		}
+		var timeout <-chan time.Time
+		if !c.paused {
+			remaining := bagTime - c.bagTimeLocked(c.now())
+			if remaining <= 0 {
+				c.mutex.Unlock()
+				return nil
+			}
+			timer.Reset(time.Duration(float64(remaining) / c.rate))
+			timeout = timer.C
+		}
+		changed := c.changed
+		c.mutex.Unlock()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-changed:
+			if timeout != nil && !timer.Stop() {
+				<-timer.C
+			}
+		case <-timeout:
+		}
+	}
+}
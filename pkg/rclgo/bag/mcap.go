@@ -0,0 +1,574 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package bag
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+	"sort"
+)
+
+// The MCAP format is specified at https://mcap.dev/spec.
+
+var mcapMagic = []byte{0x89, 'M', 'C', 'A', 'P', '0', '\r', '\n'}
+
+type opcode byte
+
+const (
+	opHeader         opcode = 0x01
+	opFooter         opcode = 0x02
+	opSchema         opcode = 0x03
+	opChannel        opcode = 0x04
+	opMessage        opcode = 0x05
+	opChunk          opcode = 0x06
+	opMessageIndex   opcode = 0x07
+	opChunkIndex     opcode = 0x08
+	opAttachment     opcode = 0x09
+	opAttachmentIdx  opcode = 0x0A
+	opStatistics     opcode = 0x0B
+	opMetadata       opcode = 0x0C
+	opMetadataIndex  opcode = 0x0D
+	opSummaryOffset  opcode = 0x0E
+	opDataEnd        opcode = 0x0F
+	recordHeaderSize        = 9
+)
+
+const (
+	// ProfileROS2 is the MCAP profile of files containing ROS 2 messages.
+	ProfileROS2 = "ros2"
+	// SchemaEncodingROS2Msg is the encoding of schemas containing ROS 2 .msg
+	// definitions.
+	SchemaEncodingROS2Msg = "ros2msg"
+	// MessageEncodingCDR is the encoding of serialized ROS 2 messages.
+	MessageEncodingCDR = "cdr"
+)
+
+// DefaultChunkSize is the default uncompressed size of MCAP chunks.
+const DefaultChunkSize = 768 * 1024
+
+// recordBuilder serializes the fields of a single record.
+type recordBuilder struct {
+	buf []byte
+}
+
+func (b *recordBuilder) u8(x uint8)   { b.buf = append(b.buf, x) }
+func (b *recordBuilder) u16(x uint16) { b.buf = binary.LittleEndian.AppendUint16(b.buf, x) }
+func (b *recordBuilder) u32(x uint32) { b.buf = binary.LittleEndian.AppendUint32(b.buf, x) }
+func (b *recordBuilder) u64(x uint64) { b.buf = binary.LittleEndian.AppendUint64(b.buf, x) }
+
+func (b *recordBuilder) str(s string) {
+	b.u32(uint32(len(s)))
+	b.buf = append(b.buf, s...)
+}
+
+func (b *recordBuilder) bytes32(p []byte) {
+	b.u32(uint32(len(p)))
+	b.buf = append(b.buf, p...)
+}
+
+func (b *recordBuilder) stringMap(m map[string]string) {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	lenPos := len(b.buf)
+	b.u32(0)
+	for _, k := range keys {
+		b.str(k)
+		b.str(m[k])
+	}
+	binary.LittleEndian.PutUint32(b.buf[lenPos:], uint32(len(b.buf)-lenPos-4))
+}
+
+func (b *recordBuilder) begin(op opcode) {
+	b.buf = b.buf[:0]
+	b.u8(byte(op))
+	b.u64(0)
+}
+
+func (b *recordBuilder) end() []byte {
+	binary.LittleEndian.PutUint64(b.buf[1:], uint64(len(b.buf)-recordHeaderSize))
+	return b.buf
+}
+
+// Schema describes the structure of the messages of a channel.
+type Schema struct {
+	ID       uint16
+	Name     string
+	Encoding string
+	Data     []byte
+}
+
+// Channel describes a stream of messages, typically a topic.
+type Channel struct {
+	ID              uint16
+	SchemaID        uint16
+	Topic           string
+	MessageEncoding string
+	Metadata        map[string]string
+}
+
+// Message is a single timestamped message of a channel.
+type Message struct {
+	ChannelID uint16
+	Sequence  uint32
+	// LogTime is the time the message was recorded, in nanoseconds since
+	// the epoch.
+	LogTime uint64
+	// PublishTime is the time the message was published, in nanoseconds
+	// since the epoch.
+	PublishTime uint64
+	Data        []byte
+}
+
+func (b *recordBuilder) schema(s *Schema) []byte {
+	b.begin(opSchema)
+	b.u16(s.ID)
+	b.str(s.Name)
+	b.str(s.Encoding)
+	b.bytes32(s.Data)
+	return b.end()
+}
+
+func (b *recordBuilder) channel(c *Channel) []byte {
+	b.begin(opChannel)
+	b.u16(c.ID)
+	b.u16(c.SchemaID)
+	b.str(c.Topic)
+	b.str(c.MessageEncoding)
+	b.stringMap(c.Metadata)
+	return b.end()
+}
+
+func (b *recordBuilder) message(m *Message) []byte {
+	b.begin(opMessage)
+	b.u16(m.ChannelID)
+	b.u32(m.Sequence)
+	b.u64(m.LogTime)
+	b.u64(m.PublishTime)
+	b.buf = append(b.buf, m.Data...)
+	return b.end()
+}
+
+// MCAPWriterOptions contains options for an MCAPWriter.
+type MCAPWriterOptions struct {
+	// Profile is written to the header of the file.
+	Profile string
+	// Library is written to the header of the file.
+	Library string
+	// ChunkSize is the uncompressed size after which a chunk is written. If
+	// it is not positive, DefaultChunkSize is used.
+	ChunkSize int
+}
+
+// NewDefaultMCAPWriterOptions returns the default options of an MCAPWriter
+// used for ROS 2 bags.
+func NewDefaultMCAPWriterOptions() *MCAPWriterOptions {
+	return &MCAPWriterOptions{
+		Profile:   ProfileROS2,
+		Library:   "rclgo",
+		ChunkSize: DefaultChunkSize,
+	}
+}
+
+type countingWriter struct {
+	w   io.Writer
+	n   uint64
+	crc hash.Hash32
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+	w.n += uint64(n)
+	w.crc.Write(p[:n]) //nolint:errcheck
+	return n, err
+}
+
+type chunkIndex struct {
+	startTime, endTime               uint64
+	offset, length                   uint64
+	messageIndexOffsets              map[uint16]uint64
+	messageIndexLength               uint64
+	compressedSize, uncompressedSize uint64
+}
+
+type metadataIndex struct {
+	offset, length uint64
+	name           string
+}
+
+// MCAPWriter writes uncompressed, chunked MCAP files. MCAPWriter is not safe
+// for concurrent use.
+type MCAPWriter struct {
+	w       *countingWriter
+	opts    MCAPWriterOptions
+	builder recordBuilder
+	closed  bool
+
+	schemas  []*Schema
+	channels []*Channel
+
+	chunk             bytes.Buffer
+	chunkStart        uint64
+	chunkEnd          uint64
+	chunkHasMessages  bool
+	chunkMessageIndex map[uint16][]uint64 // pairs of log time and offset
+
+	chunkIndexes    []chunkIndex
+	metadataIndexes []metadataIndex
+
+	messageCount         uint64
+	messageStart         uint64
+	messageEnd           uint64
+	channelMessageCounts map[uint16]uint64
+}
+
+// NewMCAPWriter writes the MCAP magic and header to w and returns an
+// MCAPWriter writing to w. If opts is nil, NewDefaultMCAPWriterOptions is used.
+func NewMCAPWriter(w io.Writer, opts *MCAPWriterOptions) (*MCAPWriter, error) {
+	if opts == nil {
+		opts = NewDefaultMCAPWriterOptions()
+	}
+	mw := &MCAPWriter{
+		w:                    &countingWriter{w: w, crc: crc32.NewIEEE()},
+		opts:                 *opts,
+		chunkMessageIndex:    make(map[uint16][]uint64),
+		channelMessageCounts: make(map[uint16]uint64),
+	}
+	if mw.opts.ChunkSize <= 0 {
+		mw.opts.ChunkSize = DefaultChunkSize
+	}
+	if _, err := mw.w.Write(mcapMagic); err != nil {
+		return nil, err
+	}
+	mw.builder.begin(opHeader)
+	mw.builder.str(mw.opts.Profile)
+	mw.builder.str(mw.opts.Library)
+	if _, err := mw.w.Write(mw.builder.end()); err != nil {
+		return nil, err
+	}
+	return mw, nil
+}
+
+// Size returns the number of bytes written so far, including the current
+// unfinished chunk.
+func (w *MCAPWriter) Size() uint64 {
+	return w.w.n + uint64(w.chunk.Len())
+}
+
+// MessageCount returns the number of messages written so far.
+func (w *MCAPWriter) MessageCount() uint64 {
+	return w.messageCount
+}
+
+// AddSchema adds a schema to the file and returns its ID.
+func (w *MCAPWriter) AddSchema(name, encoding string, data []byte) (uint16, error) {
+	if w.closed {
+		return 0, errors.New("writer is closed")
+	}
+	if len(w.schemas) == 0xFFFF {
+		return 0, errors.New("too many schemas")
+	}
+	s := &Schema{
+		ID:       uint16(len(w.schemas) + 1),
+		Name:     name,
+		Encoding: encoding,
+		Data:     data,
+	}
+	w.schemas = append(w.schemas, s)
+	w.chunk.Write(w.builder.schema(s))
+	return s.ID, nil
+}
+
+// AddChannel adds a channel to the file and returns its ID. schemaID must be
+// an ID returned by AddSchema or zero if the channel has no schema.
+func (w *MCAPWriter) AddChannel(schemaID uint16, topic, messageEncoding string, metadata map[string]string) (uint16, error) {
+	if w.closed {
+		return 0, errors.New("writer is closed")
+	}
+	if int(schemaID) > len(w.schemas) {
+		return 0, fmt.Errorf("unknown schema ID %d", schemaID)
+	}
+	if len(w.channels) == 0xFFFF {
+		return 0, errors.New("too many channels")
+	}
+	c := &Channel{
+		ID:              uint16(len(w.channels)),
+		SchemaID:        schemaID,
+		Topic:           topic,
+		MessageEncoding: messageEncoding,
+		Metadata:        metadata,
+	}
+	w.channels = append(w.channels, c)
+	w.chunk.Write(w.builder.channel(c))
+	return c.ID, nil
+}
+
+// WriteMessage writes msg to the file.
+func (w *MCAPWriter) WriteMessage(msg *Message) error {
+	if w.closed {
+		return errors.New("writer is closed")
+	}
+	if int(msg.ChannelID) >= len(w.channels) {
+		return fmt.Errorf("unknown channel ID %d", msg.ChannelID)
+	}
+	if !w.chunkHasMessages || msg.LogTime < w.chunkStart {
+		w.chunkStart = msg.LogTime
+	}
+	if !w.chunkHasMessages || msg.LogTime > w.chunkEnd {
+		w.chunkEnd = msg.LogTime
+	}
+	w.chunkHasMessages = true
+	w.chunkMessageIndex[msg.ChannelID] = append(
+		w.chunkMessageIndex[msg.ChannelID], msg.LogTime, uint64(w.chunk.Len()),
+	)
+	w.chunk.Write(w.builder.message(msg))
+	if w.messageCount == 0 || msg.LogTime < w.messageStart {
+		w.messageStart = msg.LogTime
+	}
+	if w.messageCount == 0 || msg.LogTime > w.messageEnd {
+		w.messageEnd = msg.LogTime
+	}
+	w.messageCount++
+	w.channelMessageCounts[msg.ChannelID]++
+	if w.chunk.Len() >= w.opts.ChunkSize {
+		return w.flushChunk()
+	}
+	return nil
+}
+
+// AddMetadata writes a metadata record to the file.
+func (w *MCAPWriter) AddMetadata(name string, metadata map[string]string) error {
+	if w.closed {
+		return errors.New("writer is closed")
+	}
+	if err := w.flushChunk(); err != nil {
+		return err
+	}
+	w.builder.begin(opMetadata)
+	w.builder.str(name)
+	w.builder.stringMap(metadata)
+	rec := w.builder.end()
+	w.metadataIndexes = append(w.metadataIndexes, metadataIndex{
+		offset: w.w.n,
+		length: uint64(len(rec)),
+		name:   name,
+	})
+	_, err := w.w.Write(rec)
+	return err
+}
+
+// Flush writes the current chunk to the underlying writer.
+func (w *MCAPWriter) Flush() error {
+	if w.closed {
+		return errors.New("writer is closed")
+	}
+	return w.flushChunk()
+}
+
+func (w *MCAPWriter) flushChunk() error {
+	if w.chunk.Len() == 0 {
+		return nil
+	}
+	records := w.chunk.Bytes()
+	idx := chunkIndex{
+		offset:              w.w.n,
+		messageIndexOffsets: make(map[uint16]uint64),
+		compressedSize:      uint64(len(records)),
+		uncompressedSize:    uint64(len(records)),
+	}
+	if w.chunkHasMessages {
+		idx.startTime, idx.endTime = w.chunkStart, w.chunkEnd
+	}
+	w.builder.begin(opChunk)
+	w.builder.u64(idx.startTime)
+	w.builder.u64(idx.endTime)
+	w.builder.u64(uint64(len(records)))
+	w.builder.u32(crc32.ChecksumIEEE(records))
+	w.builder.str("")
+	w.builder.u64(uint64(len(records)))
+	// The records are written separately to avoid copying them.
+	header := w.builder.end()
+	binary.LittleEndian.PutUint64(header[1:], uint64(len(header)-recordHeaderSize+len(records)))
+	if _, err := w.w.Write(header); err != nil {
+		return err
+	}
+	if _, err := w.w.Write(records); err != nil {
+		return err
+	}
+	idx.length = w.w.n - idx.offset
+
+	channelIDs := make([]uint16, 0, len(w.chunkMessageIndex))
+	for id := range w.chunkMessageIndex {
+		channelIDs = append(channelIDs, id)
+	}
+	sort.Slice(channelIDs, func(i, j int) bool { return channelIDs[i] < channelIDs[j] })
+	indexStart := w.w.n
+	for _, id := range channelIDs {
+		entries := w.chunkMessageIndex[id]
+		w.builder.begin(opMessageIndex)
+		w.builder.u16(id)
+		w.builder.u32(uint32(len(entries) * 8))
+		for _, x := range entries {
+			w.builder.u64(x)
+		}
+		idx.messageIndexOffsets[id] = w.w.n
+		if _, err := w.w.Write(w.builder.end()); err != nil {
+			return err
+		}
+	}
+	idx.messageIndexLength = w.w.n - indexStart
+	w.chunkIndexes = append(w.chunkIndexes, idx)
+
+	w.chunk.Reset()
+	w.chunkHasMessages = false
+	w.chunkMessageIndex = make(map[uint16][]uint64)
+	return nil
+}
+
+// Close writes the remaining data, the summary section and the footer of the
+// file. Close does not close the underlying writer.
+func (w *MCAPWriter) Close() error {
+	if w.closed {
+		return errors.New("writer is closed")
+	}
+	if err := w.flushChunk(); err != nil {
+		return err
+	}
+	w.closed = true
+
+	w.builder.begin(opDataEnd)
+	w.builder.u32(w.w.crc.Sum32())
+	if _, err := w.w.Write(w.builder.end()); err != nil {
+		return err
+	}
+
+	summaryStart := w.w.n
+	var summary bytes.Buffer
+	type group struct {
+		op            opcode
+		start, length uint64
+	}
+	var groups []group
+	writeGroup := func(op opcode, records func()) {
+		start := summary.Len()
+		records()
+		if summary.Len() > start {
+			groups = append(groups, group{
+				op:     op,
+				start:  summaryStart + uint64(start),
+				length: uint64(summary.Len() - start),
+			})
+		}
+	}
+	writeGroup(opSchema, func() {
+		for _, s := range w.schemas {
+			summary.Write(w.builder.schema(s))
+		}
+	})
+	writeGroup(opChannel, func() {
+		for _, c := range w.channels {
+			summary.Write(w.builder.channel(c))
+		}
+	})
+	writeGroup(opStatistics, func() {
+		b := &w.builder
+		b.begin(opStatistics)
+		b.u64(w.messageCount)
+		b.u16(uint16(len(w.schemas)))
+		b.u32(uint32(len(w.channels)))
+		b.u32(0)
+		b.u32(uint32(len(w.metadataIndexes)))
+		b.u32(uint32(len(w.chunkIndexes)))
+		b.u64(w.messageStart)
+		b.u64(w.messageEnd)
+		ids := make([]uint16, 0, len(w.channelMessageCounts))
+		for id := range w.channelMessageCounts {
+			ids = append(ids, id)
+		}
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+		b.u32(uint32(len(ids) * 10))
+		for _, id := range ids {
+			b.u16(id)
+			b.u64(w.channelMessageCounts[id])
+		}
+		summary.Write(b.end())
+	})
+	writeGroup(opChunkIndex, func() {
+		b := &w.builder
+		for _, idx := range w.chunkIndexes {
+			b.begin(opChunkIndex)
+			b.u64(idx.startTime)
+			b.u64(idx.endTime)
+			b.u64(idx.offset)
+			b.u64(idx.length)
+			ids := make([]uint16, 0, len(idx.messageIndexOffsets))
+			for id := range idx.messageIndexOffsets {
+				ids = append(ids, id)
+			}
+			sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+			b.u32(uint32(len(ids) * 10))
+			for _, id := range ids {
+				b.u16(id)
+				b.u64(idx.messageIndexOffsets[id])
+			}
+			b.u64(idx.messageIndexLength)
+			b.str("")
+			b.u64(idx.compressedSize)
+			b.u64(idx.uncompressedSize)
+			summary.Write(b.end())
+		}
+	})
+	writeGroup(opMetadataIndex, func() {
+		b := &w.builder
+		for _, idx := range w.metadataIndexes {
+			b.begin(opMetadataIndex)
+			b.u64(idx.offset)
+			b.u64(idx.length)
+			b.str(idx.name)
+			summary.Write(b.end())
+		}
+	})
+	summaryOffsetStart := summaryStart + uint64(summary.Len())
+	for _, g := range groups {
+		b := &w.builder
+		b.begin(opSummaryOffset)
+		b.u8(byte(g.op))
+		b.u64(g.start)
+		b.u64(g.length)
+		summary.Write(b.end())
+	}
+
+	w.builder.begin(opFooter)
+	w.builder.u64(summaryStart)
+	w.builder.u64(summaryOffsetStart)
+	footer := w.builder.end()
+	// The CRC covers the summary section and the footer up to the CRC field,
+	// so the length of the footer must include the CRC before computing it.
+	binary.LittleEndian.PutUint64(footer[1:], uint64(len(footer)-recordHeaderSize+4))
+	crc := crc32.NewIEEE()
+	crc.Write(summary.Bytes()) //nolint:errcheck
+	crc.Write(footer)          //nolint:errcheck
+	footer = binary.LittleEndian.AppendUint32(footer, crc.Sum32())
+	if _, err := w.w.Write(summary.Bytes()); err != nil {
+		return err
+	}
+	if _, err := w.w.Write(footer); err != nil {
+		return err
+	}
+	_, err := w.w.Write(mcapMagic)
+	return err
+}
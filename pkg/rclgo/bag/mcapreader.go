@@ -0,0 +1,532 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package bag
+
+import (
+	"bytes"
+	"container/heap"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"sort"
+)
+
+// ErrInvalidMCAP is returned when reading a file that is not a valid MCAP
+// file.
+var ErrInvalidMCAP = errors.New("invalid MCAP file")
+
+func invalidf(format string, a ...interface{}) error {
+	return fmt.Errorf("%w: %s", ErrInvalidMCAP, fmt.Sprintf(format, a...))
+}
+
+// recordParser deserializes the fields of a single record.
+type recordParser struct {
+	buf []byte
+	err error
+}
+
+func (p *recordParser) take(n int) []byte {
+	if p.err != nil {
+		return nil
+	}
+	if n < 0 || n > len(p.buf) {
+		p.err = invalidf("record is truncated")
+		return nil
+	}
+	b := p.buf[:n]
+	p.buf = p.buf[n:]
+	return b
+}
+
+func (p *recordParser) u8() uint8 {
+	if b := p.take(1); b != nil {
+		return b[0]
+	}
+	return 0
+}
+
+func (p *recordParser) u16() uint16 {
+	if b := p.take(2); b != nil {
+		return binary.LittleEndian.Uint16(b)
+	}
+	return 0
+}
+
+func (p *recordParser) u32() uint32 {
+	if b := p.take(4); b != nil {
+		return binary.LittleEndian.Uint32(b)
+	}
+	return 0
+}
+
+func (p *recordParser) u64() uint64 {
+	if b := p.take(8); b != nil {
+		return binary.LittleEndian.Uint64(b)
+	}
+	return 0
+}
+
+func (p *recordParser) str() string {
+	return string(p.take(int(p.u32())))
+}
+
+func (p *recordParser) bytes32() []byte {
+	return append([]byte(nil), p.take(int(p.u32()))...)
+}
+
+func (p *recordParser) stringMap() map[string]string {
+	sub := recordParser{buf: p.take(int(p.u32()))}
+	if p.err != nil {
+		return nil
+	}
+	m := make(map[string]string)
+	for len(sub.buf) > 0 && sub.err == nil {
+		k := sub.str()
+		m[k] = sub.str()
+	}
+	p.err = sub.err
+	return m
+}
+
+func parseSchema(body []byte) (*Schema, error) {
+	p := recordParser{buf: body}
+	s := &Schema{
+		ID:       p.u16(),
+		Name:     p.str(),
+		Encoding: p.str(),
+		Data:     p.bytes32(),
+	}
+	return s, p.err
+}
+
+func parseChannel(body []byte) (*Channel, error) {
+	p := recordParser{buf: body}
+	c := &Channel{
+		ID:              p.u16(),
+		SchemaID:        p.u16(),
+		Topic:           p.str(),
+		MessageEncoding: p.str(),
+		Metadata:        p.stringMap(),
+	}
+	return c, p.err
+}
+
+func parseMessage(body []byte) (*Message, error) {
+	p := recordParser{buf: body}
+	m := &Message{
+		ChannelID:   p.u16(),
+		Sequence:    p.u32(),
+		LogTime:     p.u64(),
+		PublishTime: p.u64(),
+	}
+	m.Data = append([]byte(nil), p.buf...)
+	return m, p.err
+}
+
+// forEachRecord calls f for each record in data.
+func forEachRecord(data []byte, f func(op opcode, body []byte) error) error {
+	for len(data) > 0 {
+		if len(data) < recordHeaderSize {
+			return invalidf("record header is truncated")
+		}
+		n := binary.LittleEndian.Uint64(data[1:])
+		if n > uint64(len(data)-recordHeaderSize) {
+			return invalidf("record is truncated")
+		}
+		if err := f(opcode(data[0]), data[recordHeaderSize:recordHeaderSize+int(n)]); err != nil {
+			return err
+		}
+		data = data[recordHeaderSize+int(n):]
+	}
+	return nil
+}
+
+// chunkContents returns the uncompressed records of a chunk record.
+func chunkContents(body []byte) (startTime, endTime uint64, records []byte, err error) {
+	p := recordParser{buf: body}
+	startTime = p.u64()
+	endTime = p.u64()
+	size := p.u64()
+	crc := p.u32()
+	compression := p.str()
+	records = p.take(int(p.u64()))
+	if p.err != nil {
+		return 0, 0, nil, p.err
+	}
+	if compression != "" {
+		return 0, 0, nil, fmt.Errorf("unsupported chunk compression: %q", compression)
+	}
+	if uint64(len(records)) != size {
+		return 0, 0, nil, invalidf("chunk size mismatch")
+	}
+	if crc != 0 && crc32.ChecksumIEEE(records) != crc {
+		return 0, 0, nil, invalidf("chunk CRC mismatch")
+	}
+	return startTime, endTime, records, nil
+}
+
+// MCAPReader reads MCAP files. Only uncompressed chunks are supported.
+// MCAPReader is not safe for concurrent use.
+type MCAPReader struct {
+	r        io.ReadSeeker
+	profile  string
+	library  string
+	schemas  map[uint16]*Schema
+	channels map[uint16]*Channel
+	metadata map[string]map[string]string
+	chunks   []chunkIndex
+	metaIdx  []metadataIndex
+	// dataEnd is the offset of the end of the data section.
+	dataEnd uint64
+	// indexed is true if the file has chunk indexes which can be used to
+	// read messages in log time order.
+	indexed bool
+}
+
+// NewMCAPReader reads the header and the summary of the MCAP file read from r
+// and returns an MCAPReader for it. If the file has no summary section, the
+// whole file is scanned.
+func NewMCAPReader(r io.ReadSeeker) (*MCAPReader, error) {
+	mr := &MCAPReader{
+		r:        r,
+		schemas:  make(map[uint16]*Schema),
+		channels: make(map[uint16]*Channel),
+		metadata: make(map[string]map[string]string),
+	}
+	if err := mr.readHeader(); err != nil {
+		return nil, err
+	}
+	size, err := r.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, err
+	}
+	const footerSize = recordHeaderSize + 20
+	if size < int64(2*len(mcapMagic)+footerSize) {
+		return nil, invalidf("file is too small")
+	}
+	tail := make([]byte, footerSize+len(mcapMagic))
+	if _, err := r.Seek(size-int64(len(tail)), io.SeekStart); err != nil {
+		return nil, err
+	}
+	if _, err := io.ReadFull(r, tail); err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(tail[footerSize:], mcapMagic) || opcode(tail[0]) != opFooter {
+		return nil, invalidf("footer not found")
+	}
+	p := recordParser{buf: tail[recordHeaderSize:]}
+	summaryStart := p.u64()
+	footerStart := uint64(size) - uint64(len(tail))
+	if summaryStart == 0 {
+		mr.dataEnd = footerStart
+		return mr, mr.scan()
+	}
+	if summaryStart > footerStart {
+		return nil, invalidf("invalid summary offset")
+	}
+	mr.dataEnd = summaryStart
+	summary := make([]byte, footerStart-summaryStart)
+	if _, err := r.Seek(int64(summaryStart), io.SeekStart); err != nil {
+		return nil, err
+	}
+	if _, err := io.ReadFull(r, summary); err != nil {
+		return nil, err
+	}
+	if err := forEachRecord(summary, mr.handleSummaryRecord); err != nil {
+		return nil, err
+	}
+	if !mr.indexed {
+		return mr, mr.scan()
+	}
+	return mr, mr.readMetadata()
+}
+
+func (r *MCAPReader) readHeader() error {
+	head := make([]byte, len(mcapMagic)+recordHeaderSize)
+	if _, err := io.ReadFull(r.r, head); err != nil {
+		return invalidf("header not found: %v", err)
+	}
+	if !bytes.Equal(head[:len(mcapMagic)], mcapMagic) || opcode(head[len(mcapMagic)]) != opHeader {
+		return invalidf("header not found")
+	}
+	body := make([]byte, binary.LittleEndian.Uint64(head[len(mcapMagic)+1:]))
+	if _, err := io.ReadFull(r.r, body); err != nil {
+		return invalidf("header is truncated")
+	}
+	p := recordParser{buf: body}
+	r.profile = p.str()
+	r.library = p.str()
+	return p.err
+}
+
+func (r *MCAPReader) handleSummaryRecord(op opcode, body []byte) error {
+	switch op {
+	case opSchema, opChannel:
+		return r.handleDefinition(op, body)
+	case opChunkIndex:
+		p := recordParser{buf: body}
+		idx := chunkIndex{
+			startTime: p.u64(),
+			endTime:   p.u64(),
+			offset:    p.u64(),
+			length:    p.u64(),
+		}
+		p.take(int(p.u32())) // Message index offsets
+		idx.messageIndexLength = p.u64()
+		compression := p.str()
+		idx.compressedSize = p.u64()
+		idx.uncompressedSize = p.u64()
+		if p.err != nil {
+			return p.err
+		}
+		if compression != "" {
+			return fmt.Errorf("unsupported chunk compression: %q", compression)
+		}
+		r.chunks = append(r.chunks, idx)
+		r.indexed = true
+	case opMetadataIndex:
+		p := recordParser{buf: body}
+		offset, length := p.u64(), p.u64()
+		name := p.str()
+		if p.err != nil {
+			return p.err
+		}
+		r.metaIdx = append(r.metaIdx, metadataIndex{offset: offset, length: length, name: name})
+	}
+	return nil
+}
+
+func (r *MCAPReader) handleDefinition(op opcode, body []byte) error {
+	switch op {
+	case opSchema:
+		s, err := parseSchema(body)
+		if err != nil {
+			return err
+		}
+		r.schemas[s.ID] = s
+	case opChannel:
+		c, err := parseChannel(body)
+		if err != nil {
+			return err
+		}
+		r.channels[c.ID] = c
+	}
+	return nil
+}
+
+// readMetadata reads the metadata records listed in the summary.
+func (r *MCAPReader) readMetadata() error {
+	for _, idx := range r.metaIdx {
+		data, err := r.readAt(idx.offset, idx.length)
+		if err != nil {
+			return err
+		}
+		err = forEachRecord(data, func(op opcode, body []byte) error {
+			return r.handleMetadata(op, body)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	sort.SliceStable(r.chunks, func(i, j int) bool {
+		return r.chunks[i].startTime < r.chunks[j].startTime
+	})
+	return nil
+}
+
+func (r *MCAPReader) handleMetadata(op opcode, body []byte) error {
+	if op != opMetadata {
+		return nil
+	}
+	p := recordParser{buf: body}
+	name := p.str()
+	m := p.stringMap()
+	if p.err != nil {
+		return p.err
+	}
+	r.metadata[name] = m
+	return nil
+}
+
+func (r *MCAPReader) readAt(offset, length uint64) ([]byte, error) {
+	if _, err := r.r.Seek(int64(offset), io.SeekStart); err != nil {
+		return nil, err
+	}
+	data := make([]byte, length)
+	if _, err := io.ReadFull(r.r, data); err != nil {
+		return nil, invalidf("failed to read record at offset %d: %v", offset, err)
+	}
+	return data, nil
+}
+
+// scan reads the whole data section collecting schemas, channels, metadata and
+// the locations of chunks.
+func (r *MCAPReader) scan() error {
+	r.chunks = nil
+	r.indexed = false
+	start := uint64(len(mcapMagic))
+	data, err := r.readAt(start, r.dataEnd-start)
+	if err != nil {
+		return err
+	}
+	offset := start
+	return forEachRecord(data, func(op opcode, body []byte) error {
+		recStart := offset
+		offset += recordHeaderSize + uint64(len(body))
+		switch op {
+		case opSchema, opChannel:
+			return r.handleDefinition(op, body)
+		case opMetadata:
+			return r.handleMetadata(op, body)
+		case opMessage:
+			r.chunks = append(r.chunks, chunkIndex{offset: recStart, length: offset - recStart})
+		case opChunk:
+			startTime, endTime, records, err := chunkContents(body)
+			if err != nil {
+				return err
+			}
+			r.chunks = append(r.chunks, chunkIndex{
+				startTime: startTime,
+				endTime:   endTime,
+				offset:    recStart,
+				length:    offset - recStart,
+			})
+			return forEachRecord(records, r.handleDefinition)
+		}
+		return nil
+	})
+}
+
+// Profile returns the profile of the file.
+func (r *MCAPReader) Profile() string {
+	return r.profile
+}
+
+// Library returns the library used to write the file.
+func (r *MCAPReader) Library() string {
+	return r.library
+}
+
+// Schemas returns the schemas of the file by ID.
+func (r *MCAPReader) Schemas() map[uint16]*Schema {
+	return r.schemas
+}
+
+// Channels returns the channels of the file by ID.
+func (r *MCAPReader) Channels() map[uint16]*Channel {
+	return r.channels
+}
+
+// Metadata returns the metadata records of the file by name.
+func (r *MCAPReader) Metadata() map[string]map[string]string {
+	return r.metadata
+}
+
+// Messages returns an iterator over the messages of the file. If the file is
+// indexed, messages are returned in log time order. Otherwise they are
+// returned in the order they are stored in the file.
+func (r *MCAPReader) Messages() *MessageIterator {
+	return &MessageIterator{r: r}
+}
+
+// MessageIterator iterates over the messages of an MCAP file.
+type MessageIterator struct {
+	r       *MCAPReader
+	next    int // Index of the next chunk to load
+	seq     uint64
+	pending messageHeap
+	err     error
+}
+
+// Next returns the next message. Returns io.EOF after the last message.
+func (it *MessageIterator) Next() (*Message, error) {
+	if it.err != nil {
+		return nil, it.err
+	}
+	for {
+		// Chunks may overlap in time, so every chunk starting before the
+		// earliest pending message must be loaded before returning it.
+		if it.next < len(it.r.chunks) && (len(it.pending) == 0 ||
+			(it.r.indexed && it.r.chunks[it.next].startTime <= it.pending[0].key)) {
+			if it.err = it.load(it.r.chunks[it.next]); it.err != nil {
+				return nil, it.err
+			}
+			it.next++
+			continue
+		}
+		if len(it.pending) == 0 {
+			it.err = io.EOF
+			return nil, it.err
+		}
+		return heap.Pop(&it.pending).(pendingMessage).msg, nil
+	}
+}
+
+func (it *MessageIterator) load(idx chunkIndex) error {
+	data, err := it.r.readAt(idx.offset, idx.length)
+	if err != nil {
+		return err
+	}
+	push := func(op opcode, body []byte) error {
+		if op != opMessage {
+			return nil
+		}
+		m, err := parseMessage(body)
+		if err != nil {
+			return err
+		}
+		pm := pendingMessage{seq: it.seq, msg: m}
+		if it.r.indexed {
+			pm.key = m.LogTime
+		}
+		it.seq++
+		heap.Push(&it.pending, pm)
+		return nil
+	}
+	return forEachRecord(data, func(op opcode, body []byte) error {
+		if op != opChunk {
+			return push(op, body)
+		}
+		_, _, records, err := chunkContents(body)
+		if err != nil {
+			return err
+		}
+		return forEachRecord(records, push)
+	})
+}
+
+// pendingMessage is a loaded message waiting to be returned by a
+// MessageIterator. Messages are ordered by key, which is the log time if the
+// file is indexed and zero otherwise, and then by the order they were loaded.
+type pendingMessage struct {
+	key uint64
+	seq uint64
+	msg *Message
+}
+
+type messageHeap []pendingMessage
+
+func (h messageHeap) Len() int { return len(h) }
+func (h messageHeap) Less(i, j int) bool {
+	if h[i].key != h[j].key {
+		return h[i].key < h[j].key
+	}
+	return h[i].seq < h[j].seq
+}
+func (h messageHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *messageHeap) Push(x interface{}) { *h = append(*h, x.(pendingMessage)) }
+func (h *messageHeap) Pop() interface{} {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
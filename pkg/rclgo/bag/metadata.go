@@ -0,0 +1,150 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package bag
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// MetadataFileName is the name of the file describing the contents of a bag
+// directory.
+const MetadataFileName = "metadata.yaml"
+
+// MetadataVersion is the version of the metadata file format written by
+// Writer. Version 6 added custom_data and version 7 type_description_hash.
+const MetadataVersion = 7
+
+// StorageIdentifier is the rosbag2 storage plugin identifier of MCAP files.
+const StorageIdentifier = "mcap"
+
+// qosDuration is a duration as represented in offered_qos_profiles.
+type qosDuration struct {
+	Sec  int64 `yaml:"sec"`
+	Nsec int64 `yaml:"nsec"`
+}
+
+func newQosDuration(d time.Duration) qosDuration {
+	return qosDuration{
+		Sec:  int64(d / time.Second),
+		Nsec: int64(d % time.Second),
+	}
+}
+
+func (d qosDuration) duration() time.Duration {
+	return time.Duration(d.Sec)*time.Second + time.Duration(d.Nsec)
+}
+
+// offeredQos is a QoS profile in the format used by rosbag2 in
+// offered_qos_profiles. Policies are represented by their rmw enum values.
+type offeredQos struct {
+	History                      int         `yaml:"history"`
+	Depth                        int         `yaml:"depth"`
+	Reliability                  int         `yaml:"reliability"`
+	Durability                   int         `yaml:"durability"`
+	Deadline                     qosDuration `yaml:"deadline"`
+	Lifespan                     qosDuration `yaml:"lifespan"`
+	Liveliness                   int         `yaml:"liveliness"`
+	LivelinessLeaseDuration      qosDuration `yaml:"liveliness_lease_duration"`
+	AvoidRosNamespaceConventions bool        `yaml:"avoid_ros_namespace_conventions"`
+}
+
+func marshalOfferedQos(profiles []offeredQos) (string, error) {
+	if len(profiles) == 0 {
+		return "", nil
+	}
+	data, err := yaml.Marshal(profiles)
+	return string(data), err
+}
+
+func unmarshalOfferedQos(s string) ([]offeredQos, error) {
+	var profiles []offeredQos
+	err := yaml.Unmarshal([]byte(s), &profiles)
+	return profiles, err
+}
+
+type nanoseconds struct {
+	Nanoseconds int64 `yaml:"nanoseconds"`
+}
+
+type nanosecondsSinceEpoch struct {
+	NanosecondsSinceEpoch int64 `yaml:"nanoseconds_since_epoch"`
+}
+
+// TopicMetadata describes a topic stored in a bag.
+type TopicMetadata struct {
+	Name                string `yaml:"name"`
+	Type                string `yaml:"type"`
+	SerializationFormat string `yaml:"serialization_format"`
+	OfferedQosProfiles  string `yaml:"offered_qos_profiles"`
+	// TypeDescriptionHash is the RIHS01 type hash of the message type or
+	// empty if it is not known.
+	TypeDescriptionHash string `yaml:"type_description_hash"`
+}
+
+// TopicInfo contains the metadata and the message count of a topic.
+type TopicInfo struct {
+	TopicMetadata TopicMetadata `yaml:"topic_metadata"`
+	MessageCount  uint64        `yaml:"message_count"`
+}
+
+// FileInfo describes a single file of a bag.
+type FileInfo struct {
+	Path         string                `yaml:"path"`
+	StartingTime nanosecondsSinceEpoch `yaml:"starting_time"`
+	Duration     nanoseconds           `yaml:"duration"`
+	MessageCount uint64                `yaml:"message_count"`
+}
+
+// Metadata is the content of the metadata.yaml file of a bag in the format
+// used by rosbag2.
+type Metadata struct {
+	Version                int                   `yaml:"version"`
+	StorageIdentifier      string                `yaml:"storage_identifier"`
+	Duration               nanoseconds           `yaml:"duration"`
+	StartingTime           nanosecondsSinceEpoch `yaml:"starting_time"`
+	MessageCount           uint64                `yaml:"message_count"`
+	TopicsWithMessageCount []TopicInfo           `yaml:"topics_with_message_count"`
+	CompressionFormat      string                `yaml:"compression_format"`
+	CompressionMode        string                `yaml:"compression_mode"`
+	RelativeFilePaths      []string              `yaml:"relative_file_paths"`
+	Files                  []FileInfo            `yaml:"files"`
+	CustomData             map[string]string     `yaml:"custom_data"`
+}
+
+type metadataFile struct {
+	Info Metadata `yaml:"rosbag2_bagfile_information"`
+}
+
+// ReadMetadata reads the metadata file of the bag in directory dir.
+func ReadMetadata(dir string) (*Metadata, error) {
+	data, err := os.ReadFile(filepath.Join(dir, MetadataFileName))
+	if err != nil {
+		return nil, err
+	}
+	var f metadataFile
+	if err := yaml.Unmarshal(data, &f); err != nil {
+		return nil, err
+	}
+	return &f.Info, nil
+}
+
+// WriteMetadata writes m to the metadata file of the bag in directory dir.
+func WriteMetadata(dir string, m *Metadata) error {
+	data, err := yaml.Marshal(&metadataFile{Info: *m})
+	if err != nil {
+		return err
+	}
+	//#nosec G306 -- Bags don't contain secrets.
+	return os.WriteFile(filepath.Join(dir, MetadataFileName), data, 0644)
+}
@@ -0,0 +1,317 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package bag
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/tiiuae/rclgo/pkg/rclgo"
+)
+
+// ClockTopicName is the topic on which a Player publishes the bag time.
+const ClockTopicName = "/clock"
+
+// PlayerOptions contains options for a Player.
+type PlayerOptions struct {
+	// Rate scales the playback speed. If it is not positive, 1 is used.
+	Rate float64
+	// StartOffset is the offset from the start of the bag at which playback
+	// starts.
+	StartOffset time.Duration
+	// Topics lists the topics to play. If empty, all topics are played.
+	Topics []string
+	// Remap maps recorded topic names to the names they are published on.
+	Remap map[string]string
+	// Loop restarts playback after the last message.
+	Loop bool
+	// StartPaused starts playback in the paused state.
+	StartPaused bool
+	// ClockFrequency is the frequency in Hz at which the bag time is
+	// published to ClockTopicName. Zero disables publishing the clock.
+	ClockFrequency float64
+}
+
+// NewDefaultPlayerOptions returns the default options of a Player.
+func NewDefaultPlayerOptions() *PlayerOptions {
+	return &PlayerOptions{Rate: 1}
+}
+
+// Player publishes the messages of a bag with their original timing.
+type Player struct {
+	node   *rclgo.Node
+	reader *Reader
+	opts   PlayerOptions
+	clock  *playbackClock
+
+	pubs     map[string]*rclgo.Publisher
+	clockPub *rclgo.Publisher
+	start    int64 // Log time of the first message of the bag
+}
+
+// NewPlayer returns a Player which publishes the messages of the bag at path
+// using publishers created in node. Publishers are created using the QoS
+// profiles recorded in the bag. If opts is nil, NewDefaultPlayerOptions is
+// used.
+//
+// opts must not be modified after passing it to this function.
+func NewPlayer(node *rclgo.Node, path string, opts *PlayerOptions) (_ *Player, err error) {
+	if opts == nil {
+		opts = NewDefaultPlayerOptions()
+	}
+	reader, err := NewReader(path)
+	if err != nil {
+		return nil, err
+	}
+	p := &Player{
+		node:   node,
+		reader: reader,
+		opts:   *opts,
+		pubs:   make(map[string]*rclgo.Publisher),
+	}
+	if p.opts.Rate <= 0 {
+		p.opts.Rate = 1
+	}
+	p.opts.Remap = make(map[string]string, len(opts.Remap))
+	for from, to := range opts.Remap {
+		p.opts.Remap[normalizeTopic(from)] = to
+	}
+	defer func() {
+		if err != nil {
+			p.Close() //nolint:errcheck
+		}
+	}()
+	if err := p.createPublishers(); err != nil {
+		return nil, err
+	}
+	if p.opts.ClockFrequency > 0 {
+		opts := rclgo.NewDefaultPublisherOptions()
+		opts.Qos.Depth = 1
+		ts, err := rclgo.LoadMessageTypeSupport("rosgraph_msgs/msg/Clock")
+		if err != nil {
+			return nil, fmt.Errorf("failed to load clock message type: %w", err)
+		}
+		if p.clockPub, err = node.NewPublisher(ClockTopicName, ts, opts); err != nil {
+			return nil, err
+		}
+	}
+	if p.start, err = p.startTime(); err != nil {
+		return nil, err
+	}
+	p.clock = newPlaybackClock(p.opts.Rate, p.opts.StartPaused, nil)
+	return p, nil
+}
+
+func (p *Player) selected(topic string) bool {
+	if len(p.opts.Topics) == 0 {
+		return true
+	}
+	for _, t := range p.opts.Topics {
+		if normalizeTopic(t) == topic {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Player) createPublishers() error {
+	topics, err := p.reader.Topics()
+	if err != nil {
+		return err
+	}
+	for _, t := range topics {
+		if !p.selected(t.Name) {
+			continue
+		}
+		ts, err := rclgo.LoadMessageTypeSupport(t.Type)
+		if err != nil {
+			return fmt.Errorf("failed to load type of topic %s: %w", t.Name, err)
+		}
+		offered, err := unmarshalOfferedQos(t.OfferedQosProfiles)
+		if err != nil {
+			return fmt.Errorf("invalid QoS profiles of topic %s: %w", t.Name, err)
+		}
+		opts := rclgo.NewDefaultPublisherOptions()
+		opts.Qos = playbackQos(offered)
+		name := t.Name
+		if remapped, ok := p.opts.Remap[name]; ok {
+			name = remapped
+		}
+		pub, err := p.node.NewPublisher(name, ts, opts)
+		if err != nil {
+			return err
+		}
+		p.pubs[t.Name] = pub
+	}
+	return nil
+}
+
+// playbackQos returns a QoS profile for publishing messages recorded from
+// publishers offering profiles.
+func playbackQos(profiles []offeredQos) rclgo.QosProfile {
+	qos := rclgo.NewDefaultQosProfile()
+	if len(profiles) == 0 {
+		return qos
+	}
+	reliable, transientLocal := true, true
+	for _, o := range profiles {
+		reliable = reliable && rclgo.ReliabilityPolicy(o.Reliability) == rclgo.ReliabilityReliable
+		transientLocal = transientLocal && rclgo.DurabilityPolicy(o.Durability) == rclgo.DurabilityTransientLocal
+		if o.Depth > qos.Depth {
+			qos.Depth = o.Depth
+		}
+	}
+	if !reliable {
+		qos.Reliability = rclgo.ReliabilityBestEffort
+	}
+	if transientLocal {
+		qos.Durability = rclgo.DurabilityTransientLocal
+	}
+	return qos
+}
+
+var errStop = errors.New("stop")
+
+func (p *Player) startTime() (int64, error) {
+	var start int64
+	err := p.reader.ForEach(func(_ *TopicMetadata, msg *Message) error {
+		start = int64(msg.LogTime)
+		return errStop
+	})
+	if err != nil && !errors.Is(err, errStop) {
+		return 0, err
+	}
+	return start, nil
+}
+
+// Play publishes the messages of the bag until the bag ends, or if looping is
+// enabled, until ctx is canceled.
+func (p *Player) Play(ctx context.Context) error {
+	if p.clockPub != nil {
+		ctx, cancel := context.WithCancel(ctx)
+		var wg sync.WaitGroup
+		wg.Add(1)
+		defer wg.Wait()
+		defer cancel()
+		go func() {
+			defer wg.Done()
+			p.publishClock(ctx)
+		}()
+	}
+	for {
+		if err := p.playOnce(ctx); err != nil {
+			return err
+		}
+		if !p.opts.Loop {
+			return nil
+		}
+	}
+}
+
+func (p *Player) playOnce(ctx context.Context) error {
+	first := p.start + int64(p.opts.StartOffset)
+	p.clock.Reset(first)
+	return p.reader.ForEach(func(topic *TopicMetadata, msg *Message) error {
+		pub := p.pubs[topic.Name]
+		if pub == nil || int64(msg.LogTime) < first {
+			return nil
+		}
+		if err := p.clock.WaitUntil(ctx, int64(msg.LogTime)); err != nil {
+			return err
+		}
+		if err := pub.PublishSerialized(msg.Data); err != nil {
+			return fmt.Errorf("failed to publish message of topic %s: %w", topic.Name, err)
+		}
+		return nil
+	})
+}
+
+func (p *Player) publishClock(ctx context.Context) {
+	ticker := time.NewTicker(time.Duration(float64(time.Second) / p.opts.ClockFrequency))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if err := p.clockPub.PublishSerialized(serializeClock(p.clock.BagTime())); err != nil {
+			p.node.Logger().Warnf("failed to publish clock: %v", err) //nolint:errcheck
+		}
+	}
+}
+
+// serializeClock returns a CDR serialized rosgraph_msgs/msg/Clock message
+// containing time t in nanoseconds since the epoch.
+func serializeClock(t int64) []byte {
+	buf := []byte{0x00, 0x01, 0x00, 0x00} // Little endian CDR encapsulation
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(int32(t/int64(time.Second))))
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(t%int64(time.Second)))
+	return buf
+}
+
+// Pause pauses playback.
+func (p *Player) Pause() {
+	p.clock.SetPaused(true)
+}
+
+// Resume resumes paused playback.
+func (p *Player) Resume() {
+	p.clock.SetPaused(false)
+}
+
+// IsPaused returns true if playback is paused.
+func (p *Player) IsPaused() bool {
+	return p.clock.Paused()
+}
+
+// Step publishes the next message while playback is paused. Returns false if
+// playback is not paused.
+func (p *Player) Step() bool {
+	return p.clock.Step()
+}
+
+// Rate returns the current playback rate.
+func (p *Player) Rate() float64 {
+	return p.clock.Rate()
+}
+
+// SetRate sets the playback rate. rate must be positive.
+func (p *Player) SetRate(rate float64) error {
+	if rate <= 0 {
+		return fmt.Errorf("rate must be positive, got %v", rate)
+	}
+	p.clock.SetRate(rate)
+	return nil
+}
+
+// BagTime returns the current playback position as the time of the bag.
+func (p *Player) BagTime() time.Time {
+	return time.Unix(0, p.clock.BagTime())
+}
+
+// Close closes the publishers of p. Close must not be called while Play is
+// running.
+func (p *Player) Close() error {
+	var err error
+	for topic, pub := range p.pubs {
+		err = errors.Join(err, pub.Close())
+		delete(p.pubs, topic)
+	}
+	if p.clockPub != nil {
+		err = errors.Join(err, p.clockPub.Close())
+		p.clockPub = nil
+	}
+	return err
+}
@@ -0,0 +1,167 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package bag
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// Reader reads bags written by Writer or rosbag2 using the MCAP storage
+// plugin.
+type Reader struct {
+	files    []string
+	metadata *Metadata
+}
+
+// NewReader returns a Reader for the bag at path. path may be a bag directory
+// or a single MCAP file. If a bag directory has no metadata file, the MCAP
+// files in it are read in lexical order.
+func NewReader(path string) (*Reader, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return &Reader{files: []string{path}}, nil
+	}
+	r := &Reader{}
+	r.metadata, err = ReadMetadata(path)
+	switch {
+	case err == nil:
+		if r.metadata.StorageIdentifier != StorageIdentifier {
+			return nil, fmt.Errorf("unsupported storage identifier: %q", r.metadata.StorageIdentifier)
+		}
+		for _, f := range r.metadata.RelativeFilePaths {
+			r.files = append(r.files, filepath.Join(path, f))
+		}
+	case errors.Is(err, os.ErrNotExist):
+		r.files, err = filepath.Glob(filepath.Join(path, "*.mcap"))
+		if err != nil {
+			return nil, err
+		}
+		sort.Strings(r.files)
+	default:
+		return nil, err
+	}
+	if len(r.files) == 0 {
+		return nil, fmt.Errorf("no MCAP files found in %s", path)
+	}
+	return r, nil
+}
+
+// Files returns the paths of the MCAP files of the bag.
+func (r *Reader) Files() []string {
+	return r.files
+}
+
+// Metadata returns the metadata of the bag or nil if the bag has no metadata
+// file.
+func (r *Reader) Metadata() *Metadata {
+	return r.metadata
+}
+
+func (r *Reader) forEachFile(f func(mr *MCAPReader) error) error {
+	for _, path := range r.files {
+		err := func() error {
+			//#nosec G304 -- Reading user specified bags is intended.
+			file, err := os.Open(path)
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+			mr, err := NewMCAPReader(file)
+			if err != nil {
+				return fmt.Errorf("failed to read %s: %w", path, err)
+			}
+			return f(mr)
+		}()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// topicMetadata returns the metadata of the topic of channel c. Channels
+// without a schema get their type from the metadata file, if any.
+func (r *Reader) topicMetadata(mr *MCAPReader, c *Channel) TopicMetadata {
+	m := TopicMetadata{
+		Name:                c.Topic,
+		SerializationFormat: c.MessageEncoding,
+		OfferedQosProfiles:  c.Metadata["offered_qos_profiles"],
+		TypeDescriptionHash: c.Metadata["topic_type_hash"],
+	}
+	if s := mr.Schemas()[c.SchemaID]; s != nil {
+		m.Type = s.Name
+	} else if r.metadata != nil {
+		for _, t := range r.metadata.TopicsWithMessageCount {
+			if t.TopicMetadata.Name == c.Topic {
+				m.Type = t.TopicMetadata.Type
+				break
+			}
+		}
+	}
+	return m
+}
+
+// Topics returns the metadata of the topics of the bag sorted by name.
+func (r *Reader) Topics() ([]TopicMetadata, error) {
+	topics := make(map[string]TopicMetadata)
+	err := r.forEachFile(func(mr *MCAPReader) error {
+		for _, c := range mr.Channels() {
+			if _, ok := topics[c.Topic]; !ok {
+				topics[c.Topic] = r.topicMetadata(mr, c)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sorted := make([]TopicMetadata, 0, len(topics))
+	for _, t := range topics {
+		sorted = append(sorted, t)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Name < sorted[j].Name })
+	return sorted, nil
+}
+
+// ForEach calls f for each message of the bag in log time order within each
+// file. If f returns an error, iteration stops and the error is returned.
+func (r *Reader) ForEach(f func(topic *TopicMetadata, msg *Message) error) error {
+	return r.forEachFile(func(mr *MCAPReader) error {
+		topics := make(map[uint16]*TopicMetadata)
+		for id, c := range mr.Channels() {
+			m := r.topicMetadata(mr, c)
+			topics[id] = &m
+		}
+		it := mr.Messages()
+		for {
+			msg, err := it.Next()
+			if errors.Is(err, io.EOF) {
+				return nil
+			} else if err != nil {
+				return err
+			}
+			topic := topics[msg.ChannelID]
+			if topic == nil {
+				return invalidf("message refers to unknown channel %d", msg.ChannelID)
+			}
+			if err := f(topic, msg); err != nil {
+				return err
+			}
+		}
+	})
+}
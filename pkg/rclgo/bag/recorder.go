@@ -0,0 +1,265 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package bag
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
+)
+
+// DefaultDiscoveryInterval is the default interval at which a Recorder looks
+// for new topics.
+const DefaultDiscoveryInterval = 100 * time.Millisecond
+
+// RecorderOptions contains options for a Recorder.
+type RecorderOptions struct {
+	// Topics lists the names of the topics to record.
+	Topics []string
+	// TopicRegex selects the topics to record in addition to Topics. If nil,
+	// no additional topics are selected.
+	TopicRegex *regexp.Regexp
+	// All selects all topics.
+	All bool
+	// Exclude excludes topics matching it from the selected topics. If nil,
+	// no topics are excluded.
+	Exclude *regexp.Regexp
+	// DiscoveryInterval is the interval at which the ROS graph is checked for
+	// new topics. If it is not positive, DefaultDiscoveryInterval is used.
+	DiscoveryInterval time.Duration
+	// Writer contains the options for the bag writer. If nil,
+	// NewDefaultWriterOptions is used.
+	Writer *WriterOptions
+	// SchemaLoader is used to load the message definitions stored in the bag.
+	// If nil, NewSchemaLoader is used. If a definition is not found, the
+	// definition embedded in the generated bindings registered in package
+	// typemap is used.
+	SchemaLoader *SchemaLoader
+}
+
+// NewDefaultRecorderOptions returns the default options of a Recorder, which
+// record all topics.
+func NewDefaultRecorderOptions() *RecorderOptions {
+	return &RecorderOptions{
+		All:               true,
+		DiscoveryInterval: DefaultDiscoveryInterval,
+		Writer:            NewDefaultWriterOptions(),
+	}
+}
+
+// Recorder records serialized messages published on selected topics to a bag.
+// New topics are discovered using the ROS graph while recording.
+type Recorder struct {
+	node   *rclgo.Node
+	opts   RecorderOptions
+	topics map[string]bool
+	writer *Writer
+
+	mutex sync.Mutex
+	subs  map[string]*rclgo.GenericSubscription
+}
+
+// NewRecorder returns a Recorder which uses node to subscribe to topics and
+// writes the received messages to a new bag in directory dir. If opts is nil,
+// NewDefaultRecorderOptions is used.
+//
+// opts must not be modified after passing it to this function.
+func NewRecorder(node *rclgo.Node, dir string, opts *RecorderOptions) (*Recorder, error) {
+	if opts == nil {
+		opts = NewDefaultRecorderOptions()
+	}
+	r := &Recorder{
+		node:   node,
+		opts:   *opts,
+		topics: make(map[string]bool),
+		subs:   make(map[string]*rclgo.GenericSubscription),
+	}
+	if r.opts.DiscoveryInterval <= 0 {
+		r.opts.DiscoveryInterval = DefaultDiscoveryInterval
+	}
+	if r.opts.SchemaLoader == nil {
+		r.opts.SchemaLoader = NewSchemaLoader()
+	}
+	for _, t := range opts.Topics {
+		r.topics[normalizeTopic(t)] = true
+	}
+	var err error
+	r.writer, err = NewWriter(dir, r.opts.Writer)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func normalizeTopic(topic string) string {
+	if !strings.HasPrefix(topic, "/") {
+		return "/" + topic
+	}
+	return topic
+}
+
+// Writer returns the bag writer of r.
+func (r *Recorder) Writer() *Writer {
+	return r.writer
+}
+
+func (r *Recorder) selected(topic string) bool {
+	if r.opts.Exclude != nil && r.opts.Exclude.MatchString(topic) {
+		return false
+	}
+	return r.opts.All ||
+		r.topics[topic] ||
+		(r.opts.TopicRegex != nil && r.opts.TopicRegex.MatchString(topic))
+}
+
+// Record records messages until ctx is canceled or an error occurs.
+func (r *Recorder) Record(ctx context.Context) error {
+	for {
+		if err := r.discover(); err != nil {
+			return err
+		}
+		err := r.node.Context().SpinSubscriptionsFor(ctx, r.opts.DiscoveryInterval, r.subscriptions()...)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (r *Recorder) subscriptions() []*rclgo.Subscription {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	var subs []*rclgo.Subscription
+	for _, sub := range r.subs {
+		subs = append(subs, sub.Subscriptions()...)
+	}
+	return subs
+}
+
+func (r *Recorder) discover() error {
+	topics, err := r.node.GetTopicNamesAndTypes(true)
+	if err != nil {
+		return err
+	}
+	for topic, typeNames := range topics {
+		if !r.selected(topic) || len(typeNames) == 0 {
+			continue
+		}
+		r.mutex.Lock()
+		sub := r.subs[topic]
+		r.mutex.Unlock()
+		if sub == nil {
+			if len(typeNames) > 1 {
+				r.node.Logger().Warnf("topic %s has multiple types %v, recording %s", topic, typeNames, typeNames[0]) //nolint:errcheck
+			}
+			sub, err = r.newSubscription(topic)
+			if err != nil {
+				r.node.Logger().Warnf("not recording topic %s: %v", topic, err) //nolint:errcheck
+				continue
+			}
+		}
+		// A bag topic has a single type, so only the first type is recorded.
+		if err := sub.Subscribe(typeNames[0]); err != nil {
+			r.node.Logger().Warnf("not recording topic %s: %v", topic, err) //nolint:errcheck
+		}
+	}
+	return nil
+}
+
+func (r *Recorder) newSubscription(topic string) (*rclgo.GenericSubscription, error) {
+	opts := rclgo.NewDefaultGenericSubscriptionOptions()
+	opts.AdaptQos = true
+	opts.OnSubscribe = func(typeName string, pubs []rclgo.TopicEndpointInfo) error {
+		return r.addTopic(topic, typeName, pubs)
+	}
+	s, err := r.node.NewGenericSubscription(topic, opts, func(msg *rclgo.GenericMessage) {
+		r.receive(topic, msg)
+	})
+	if err != nil {
+		return nil, err
+	}
+	r.mutex.Lock()
+	r.subs[topic] = s
+	r.mutex.Unlock()
+	return s, nil
+}
+
+// addTopic adds topic to the bag before its first message is received.
+func (r *Recorder) addTopic(topic, typeName string, pubs []rclgo.TopicEndpointInfo) error {
+	if !r.writer.HasTopic(topic) {
+		offered := make([]offeredQos, len(pubs))
+		for i := range pubs {
+			offered[i] = offeredQosFromProfile(&pubs[i].QosProfile)
+		}
+		qosYAML, err := marshalOfferedQos(offered)
+		if err != nil {
+			return err
+		}
+		schema, err := r.opts.SchemaLoader.Load(typeName)
+		if err != nil {
+			if def, ok := typemap.GetMessageDefinition(typeName); ok {
+				schema = []byte(def)
+			} else {
+				r.node.Logger().Warnf("recording topic %s without schema: %v", topic, err) //nolint:errcheck
+			}
+		}
+		hash, _ := typemap.GetTypeHash(typeName)
+		err = r.writer.AddTopic(TopicMetadata{
+			Name:                topic,
+			Type:                typeName,
+			SerializationFormat: MessageEncodingCDR,
+			OfferedQosProfiles:  qosYAML,
+			TypeDescriptionHash: hash,
+		}, schema)
+		if err != nil {
+			return err
+		}
+	}
+	r.node.Logger().Infof("recording topic %s", topic) //nolint:errcheck
+	return nil
+}
+
+func (r *Recorder) receive(topic string, msg *rclgo.GenericMessage) {
+	if err := r.writer.Write(topic, time.Now(), msg.Info.SourceTimestamp, msg.Data); err != nil {
+		r.node.Logger().Errorf("failed to write message of topic %s: %v", topic, err) //nolint:errcheck
+	}
+}
+
+func offeredQosFromProfile(p *rclgo.QosProfile) offeredQos {
+	return offeredQos{
+		History:                      int(p.History),
+		Depth:                        p.Depth,
+		Reliability:                  int(p.Reliability),
+		Durability:                   int(p.Durability),
+		Deadline:                     newQosDuration(p.Deadline),
+		Lifespan:                     newQosDuration(p.Lifespan),
+		Liveliness:                   int(p.Liveliness),
+		LivelinessLeaseDuration:      newQosDuration(p.LivelinessLeaseDuration),
+		AvoidRosNamespaceConventions: p.AvoidRosNamespaceConventions,
+	}
+}
+
+// Close stops recording, closes the subscriptions of r and finishes the bag.
+// Close must not be called while Record is running.
+func (r *Recorder) Close() error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	var err error
+	for topic, sub := range r.subs {
+		err = errors.Join(err, sub.Close())
+		delete(r.subs, topic)
+	}
+	return errors.Join(err, r.writer.Close())
+}
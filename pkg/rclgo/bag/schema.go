@@ -0,0 +1,179 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package bag
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+var primitiveTypes = map[string]bool{
+	"bool":    true,
+	"byte":    true,
+	"char":    true,
+	"float32": true,
+	"float64": true,
+	"int8":    true,
+	"uint8":   true,
+	"int16":   true,
+	"uint16":  true,
+	"int32":   true,
+	"uint32":  true,
+	"int64":   true,
+	"uint64":  true,
+	"string":  true,
+	"wstring": true,
+}
+
+// SplitTypeName splits a ROS 2 message type name of the form
+// "package/msg/Type" or "package/Type" to a package name and a type name.
+func SplitTypeName(typeName string) (pkg, name string, err error) {
+	parts := strings.Split(typeName, "/")
+	switch {
+	case len(parts) == 2 && parts[0] != "" && parts[1] != "":
+		return parts[0], parts[1], nil
+	case len(parts) == 3 && parts[0] != "" && parts[1] == "msg" && parts[2] != "":
+		return parts[0], parts[2], nil
+	}
+	return "", "", fmt.Errorf("invalid message type name: %q", typeName)
+}
+
+// SchemaLoader loads ROS 2 message definitions from the share directories of
+// installed packages and builds the concatenated ros2msg schemas used in MCAP
+// files. Loaded definitions are cached.
+type SchemaLoader struct {
+	// Prefixes are the install prefixes searched for message definitions.
+	// Definitions of package pkg are looked up in
+	// <prefix>/share/<pkg>/msg/<Type>.msg.
+	Prefixes []string
+
+	cache map[string][]byte
+}
+
+// NewSchemaLoader returns a SchemaLoader which searches the prefixes listed in
+// environment variable AMENT_PREFIX_PATH.
+func NewSchemaLoader() *SchemaLoader {
+	return &SchemaLoader{
+		Prefixes: filepath.SplitList(os.Getenv("AMENT_PREFIX_PATH")),
+	}
+}
+
+func (l *SchemaLoader) loadDefinition(pkg, name string) ([]byte, error) {
+	key := pkg + "/msg/" + name
+	if def, ok := l.cache[key]; ok {
+		return def, nil
+	}
+	for _, prefix := range l.Prefixes {
+		def, err := os.ReadFile(filepath.Join(prefix, "share", pkg, "msg", name+".msg"))
+		if err == nil {
+			if l.cache == nil {
+				l.cache = make(map[string][]byte)
+			}
+			l.cache[key] = def
+			return def, nil
+		}
+		if !os.IsNotExist(err) {
+			return nil, err
+		}
+	}
+	return nil, fmt.Errorf("definition of message type %s not found", key)
+}
+
+// dependencies returns the full names of the non-primitive types of the fields
+// in def in the order of their first appearance.
+func dependencies(pkg string, def []byte) []string {
+	var deps []string
+	seen := make(map[string]bool)
+	scanner := bufio.NewScanner(bytes.NewReader(def))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		typ := fields[0]
+		if i := strings.IndexByte(typ, '['); i >= 0 {
+			typ = typ[:i]
+		}
+		if i := strings.Index(typ, "<="); i >= 0 {
+			typ = typ[:i]
+		}
+		if primitiveTypes[typ] {
+			continue
+		}
+		var dep string
+		switch {
+		case strings.Contains(typ, "/"):
+			depPkg, depName, err := SplitTypeName(typ)
+			if err != nil {
+				continue
+			}
+			dep = depPkg + "/msg/" + depName
+		case typ == "Header":
+			dep = "std_msgs/msg/Header"
+		default:
+			dep = pkg + "/msg/" + typ
+		}
+		if !seen[dep] {
+			seen[dep] = true
+			deps = append(deps, dep)
+		}
+	}
+	return deps
+}
+
+const schemaSeparator = "================================================================================\n"
+
+// Load returns the ros2msg schema of message type typeName. The schema
+// consists of the definition of the type followed by the definitions of all of
+// its dependencies, each preceded by a separator line and a line containing
+// "MSG: " and the name of the dependency.
+func (l *SchemaLoader) Load(typeName string) ([]byte, error) {
+	pkg, name, err := SplitTypeName(typeName)
+	if err != nil {
+		return nil, err
+	}
+	root, err := l.loadDefinition(pkg, name)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	buf.Write(root)
+	seen := map[string]bool{pkg + "/msg/" + name: true}
+	queue := dependencies(pkg, root)
+	for len(queue) > 0 {
+		dep := queue[0]
+		queue = queue[1:]
+		if seen[dep] {
+			continue
+		}
+		seen[dep] = true
+		depPkg, depName, _ := SplitTypeName(dep)
+		def, err := l.loadDefinition(depPkg, depName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load dependency of %s: %w", typeName, err)
+		}
+		if buf.Len() > 0 && buf.Bytes()[buf.Len()-1] != '\n' {
+			buf.WriteByte('\n')
+		}
+		buf.WriteString(schemaSeparator)
+		buf.WriteString("MSG: " + dep + "\n")
+		buf.Write(def)
+		queue = append(queue, dependencies(depPkg, def)...)
+	}
+	return buf.Bytes(), nil
+}
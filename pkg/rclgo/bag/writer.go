@@ -0,0 +1,326 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package bag
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// WriterOptions contains options for a Writer.
+type WriterOptions struct {
+	// MaxFileSize is the size in bytes after which a new file is started. Zero
+	// disables splitting by size.
+	MaxFileSize uint64
+	// MaxFileDuration is the duration of recorded messages after which a new
+	// file is started. Zero disables splitting by duration.
+	MaxFileDuration time.Duration
+	// ChunkSize is the uncompressed size of MCAP chunks. If it is not
+	// positive, DefaultChunkSize is used.
+	ChunkSize int
+}
+
+// NewDefaultWriterOptions returns the default options of a Writer.
+func NewDefaultWriterOptions() *WriterOptions {
+	return &WriterOptions{ChunkSize: DefaultChunkSize}
+}
+
+type writerTopic struct {
+	metadata TopicMetadata
+	schema   []byte
+	count    uint64
+	sequence uint32
+}
+
+type writerFile struct {
+	file     *os.File
+	buf      *bufio.Writer
+	mcap     *MCAPWriter
+	channels map[string]uint16
+	schemas  map[string]uint16
+	info     FileInfo
+	start    time.Time
+	end      time.Time
+}
+
+// Writer writes a rosbag2 compatible bag consisting of a directory containing
+// one or more MCAP files and a metadata file. Writer is safe for concurrent
+// use.
+type Writer struct {
+	mutex  sync.Mutex
+	dir    string
+	opts   WriterOptions
+	topics map[string]*writerTopic
+	order  []string
+	file   *writerFile
+	files  []FileInfo
+	count  uint64
+	start  time.Time
+	end    time.Time
+	closed bool
+}
+
+// NewWriter creates directory dir and returns a Writer writing a bag to it.
+// dir must not exist. If opts is nil, NewDefaultWriterOptions is used.
+func NewWriter(dir string, opts *WriterOptions) (*Writer, error) {
+	if opts == nil {
+		opts = NewDefaultWriterOptions()
+	}
+	//#nosec G301 -- Bags don't contain secrets.
+	if err := os.Mkdir(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create bag directory: %w", err)
+	}
+	return &Writer{
+		dir:    dir,
+		opts:   *opts,
+		topics: make(map[string]*writerTopic),
+	}, nil
+}
+
+// Dir returns the directory of the bag.
+func (w *Writer) Dir() string {
+	return w.dir
+}
+
+// AddTopic adds a topic to the bag. schema is the ros2msg definition of the
+// message type of the topic and may be nil if it is not available, in which
+// case the channels of the topic have no schema. Topics of the same type share
+// a schema record. Messages can be written to a topic only after adding it.
+func (w *Writer) AddTopic(metadata TopicMetadata, schema []byte) error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	if w.closed {
+		return errors.New("bag writer is closed")
+	}
+	if _, ok := w.topics[metadata.Name]; ok {
+		return fmt.Errorf("topic %s has already been added", metadata.Name)
+	}
+	if metadata.SerializationFormat == "" {
+		metadata.SerializationFormat = MessageEncodingCDR
+	}
+	w.topics[metadata.Name] = &writerTopic{metadata: metadata, schema: schema}
+	w.order = append(w.order, metadata.Name)
+	return nil
+}
+
+// HasTopic returns true if topic has been added to the bag.
+func (w *Writer) HasTopic(topic string) bool {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	_, ok := w.topics[topic]
+	return ok
+}
+
+// Write writes a serialized message to topic. logTime is the time the message
+// was received and publishTime the time it was published.
+func (w *Writer) Write(topic string, logTime, publishTime time.Time, data []byte) error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	if w.closed {
+		return errors.New("bag writer is closed")
+	}
+	t := w.topics[topic]
+	if t == nil {
+		return fmt.Errorf("topic %s has not been added", topic)
+	}
+	if w.file != nil && w.shouldSplit(logTime) {
+		if err := w.closeFile(); err != nil {
+			return err
+		}
+	}
+	if w.file == nil {
+		if err := w.openFile(); err != nil {
+			return err
+		}
+	}
+	f := w.file
+	channelID, ok := f.channels[topic]
+	if !ok {
+		var err error
+		channelID, err = w.addChannel(f, t)
+		if err != nil {
+			return err
+		}
+	}
+	t.sequence++
+	err := f.mcap.WriteMessage(&Message{
+		ChannelID:   channelID,
+		Sequence:    t.sequence,
+		LogTime:     uint64(logTime.UnixNano()),
+		PublishTime: uint64(publishTime.UnixNano()),
+		Data:        data,
+	})
+	if err != nil {
+		return err
+	}
+	t.count++
+	w.count++
+	f.info.MessageCount++
+	updateRange(&f.start, &f.end, logTime)
+	updateRange(&w.start, &w.end, logTime)
+	return nil
+}
+
+func updateRange(start, end *time.Time, t time.Time) {
+	if start.IsZero() || t.Before(*start) {
+		*start = t
+	}
+	if end.IsZero() || t.After(*end) {
+		*end = t
+	}
+}
+
+func (w *Writer) shouldSplit(logTime time.Time) bool {
+	f := w.file
+	if f.info.MessageCount == 0 {
+		return false
+	}
+	if w.opts.MaxFileSize > 0 && f.mcap.Size() >= w.opts.MaxFileSize {
+		return true
+	}
+	return w.opts.MaxFileDuration > 0 && logTime.Sub(f.start) >= w.opts.MaxFileDuration
+}
+
+func (w *Writer) addChannel(f *writerFile, t *writerTopic) (uint16, error) {
+	schemaID, err := w.addSchema(f, t)
+	if err != nil {
+		return 0, err
+	}
+	metadata := map[string]string{"offered_qos_profiles": t.metadata.OfferedQosProfiles}
+	if t.metadata.TypeDescriptionHash != "" {
+		metadata["topic_type_hash"] = t.metadata.TypeDescriptionHash
+	}
+	channelID, err := f.mcap.AddChannel(
+		schemaID,
+		t.metadata.Name,
+		t.metadata.SerializationFormat,
+		metadata,
+	)
+	if err != nil {
+		return 0, err
+	}
+	f.channels[t.metadata.Name] = channelID
+	return channelID, nil
+}
+
+// addSchema returns the ID of the schema of the message type of t in f, adding
+// it if necessary. Topics without a schema use the schema ID zero.
+func (w *Writer) addSchema(f *writerFile, t *writerTopic) (uint16, error) {
+	if t.schema == nil {
+		return 0, nil
+	}
+	if id, ok := f.schemas[t.metadata.Type]; ok {
+		return id, nil
+	}
+	id, err := f.mcap.AddSchema(t.metadata.Type, SchemaEncodingROS2Msg, t.schema)
+	if err != nil {
+		return 0, err
+	}
+	f.schemas[t.metadata.Type] = id
+	return id, nil
+}
+
+func (w *Writer) openFile() error {
+	name := fmt.Sprintf("%s_%d.mcap", filepath.Base(w.dir), len(w.files))
+	//#nosec G304 -- The path is constructed from the bag directory.
+	file, err := os.Create(filepath.Join(w.dir, name))
+	if err != nil {
+		return err
+	}
+	buf := bufio.NewWriter(file)
+	mcapOpts := NewDefaultMCAPWriterOptions()
+	mcapOpts.ChunkSize = w.opts.ChunkSize
+	mw, err := NewMCAPWriter(buf, mcapOpts)
+	if err != nil {
+		file.Close()
+		return err
+	}
+	w.file = &writerFile{
+		file:     file,
+		buf:      buf,
+		mcap:     mw,
+		channels: make(map[string]uint16),
+		schemas:  make(map[string]uint16),
+		info:     FileInfo{Path: name},
+	}
+	return nil
+}
+
+func (w *Writer) closeFile() error {
+	f := w.file
+	w.file = nil
+	err := f.mcap.Close()
+	if err == nil {
+		err = f.buf.Flush()
+	}
+	err = errors.Join(err, f.file.Close())
+	f.info.StartingTime.NanosecondsSinceEpoch = unixNano(f.start)
+	f.info.Duration.Nanoseconds = int64(f.end.Sub(f.start))
+	w.files = append(w.files, f.info)
+	return err
+}
+
+func unixNano(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixNano()
+}
+
+// Metadata returns the metadata of the bag as it would be written to the
+// metadata file if the writer was closed now.
+func (w *Writer) Metadata() *Metadata {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	return w.metadata()
+}
+
+func (w *Writer) metadata() *Metadata {
+	m := &Metadata{
+		Version:           MetadataVersion,
+		StorageIdentifier: StorageIdentifier,
+		MessageCount:      w.count,
+		Files:             append([]FileInfo(nil), w.files...),
+		CustomData:        map[string]string{},
+	}
+	m.StartingTime.NanosecondsSinceEpoch = unixNano(w.start)
+	m.Duration.Nanoseconds = int64(w.end.Sub(w.start))
+	for _, f := range m.Files {
+		m.RelativeFilePaths = append(m.RelativeFilePaths, f.Path)
+	}
+	for _, name := range w.order {
+		t := w.topics[name]
+		m.TopicsWithMessageCount = append(m.TopicsWithMessageCount, TopicInfo{
+			TopicMetadata: t.metadata,
+			MessageCount:  t.count,
+		})
+	}
+	return m
+}
+
+// Close finishes the current file and writes the metadata file of the bag.
+func (w *Writer) Close() error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	if w.closed {
+		return errors.New("bag writer is closed")
+	}
+	w.closed = true
+	var err error
+	if w.file != nil {
+		err = w.closeFile()
+	}
+	return errors.Join(err, WriteMetadata(w.dir, w.metadata()))
+}
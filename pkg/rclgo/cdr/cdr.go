@@ -0,0 +1,414 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package cdr
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"unicode/utf16"
+)
+
+// Encapsulation identifiers stored in the second byte of the encapsulation
+// header.
+const (
+	encapsulationCDRBE = 0x00
+	encapsulationCDRLE = 0x01
+	headerSize         = 4
+)
+
+// Marshaler is implemented by messages which can be encoded using CDR.
+type Marshaler interface {
+	// EncodeCDR writes the fields of the message to e.
+	EncodeCDR(e *Encoder)
+}
+
+// Unmarshaler is implemented by messages which can be decoded from CDR.
+type Unmarshaler interface {
+	// DecodeCDR reads the fields of the message from d.
+	DecodeCDR(d *Decoder)
+}
+
+// Marshal encodes msg using little endian CDR, which is the byte order used
+// by the ROS 2 middlewares on all supported platforms.
+func Marshal(msg Marshaler) ([]byte, error) {
+	e := NewEncoder(binary.LittleEndian)
+	msg.EncodeCDR(e)
+	return e.Bytes(), e.Err()
+}
+
+// Unmarshal decodes data encoded using little or big endian CDR to msg. The
+// byte order is read from the encapsulation header of data.
+func Unmarshal(data []byte, msg Unmarshaler) error {
+	d, err := NewDecoder(data)
+	if err != nil {
+		return err
+	}
+	msg.DecodeCDR(d)
+	return d.Err()
+}
+
+// Encoder writes values using CDR. Values are aligned to their size relative
+// to the end of the encapsulation header. Errors are sticky: after an error
+// has occurred, the rest of the values are ignored and Err returns the first
+// error.
+type Encoder struct {
+	buf       []byte
+	order     binary.ByteOrder
+	wcharSize int
+	err       error
+}
+
+// NewEncoder returns an Encoder which writes values in byte order order,
+// which must be binary.LittleEndian or binary.BigEndian. The encapsulation
+// header is written immediately.
+func NewEncoder(order binary.ByteOrder) *Encoder {
+	kind := byte(encapsulationCDRLE)
+	if order == binary.BigEndian {
+		kind = encapsulationCDRBE
+	}
+	return &Encoder{
+		buf:       []byte{0, kind, 0, 0},
+		order:     order,
+		wcharSize: 4,
+	}
+}
+
+// SetWCharSize sets the number of bytes used to encode each UTF-16 code unit
+// of a wstring. size must be 2 or 4. The default is 4.
+func (e *Encoder) SetWCharSize(size int) {
+	e.wcharSize = checkWCharSize(size)
+}
+
+// Bytes returns the encoded data including the encapsulation header.
+func (e *Encoder) Bytes() []byte {
+	return e.buf
+}
+
+// Err returns the first error that occurred while encoding.
+func (e *Encoder) Err() error {
+	return e.err
+}
+
+func (e *Encoder) setErr(err error) {
+	if e.err == nil {
+		e.err = err
+	}
+}
+
+// grow aligns the buffer to size and returns a slice of the next size bytes.
+func (e *Encoder) grow(size int) []byte {
+	for (len(e.buf)-headerSize)%size != 0 {
+		e.buf = append(e.buf, 0)
+	}
+	n := len(e.buf)
+	e.buf = append(e.buf, make([]byte, size)...)
+	return e.buf[n:]
+}
+
+// Bool writes v as a byte which is 1 if v is true and 0 otherwise.
+func (e *Encoder) Bool(v bool) {
+	if v {
+		e.Uint8(1)
+	} else {
+		e.Uint8(0)
+	}
+}
+
+// Uint8 writes v as a single byte.
+func (e *Encoder) Uint8(v uint8) {
+	e.buf = append(e.buf, v)
+}
+
+// Int8 is like Uint8 but for int8 values.
+func (e *Encoder) Int8(v int8) {
+	e.Uint8(uint8(v))
+}
+
+// Uint16 writes v aligned to 2 bytes.
+func (e *Encoder) Uint16(v uint16) {
+	e.order.PutUint16(e.grow(2), v)
+}
+
+// Int16 is like Uint16 but for int16 values.
+func (e *Encoder) Int16(v int16) {
+	e.Uint16(uint16(v))
+}
+
+// Uint32 writes v aligned to 4 bytes.
+func (e *Encoder) Uint32(v uint32) {
+	e.order.PutUint32(e.grow(4), v)
+}
+
+// Int32 is like Uint32 but for int32 values.
+func (e *Encoder) Int32(v int32) {
+	e.Uint32(uint32(v))
+}
+
+// Uint64 writes v aligned to 8 bytes.
+func (e *Encoder) Uint64(v uint64) {
+	e.order.PutUint64(e.grow(8), v)
+}
+
+// Int64 is like Uint64 but for int64 values.
+func (e *Encoder) Int64(v int64) {
+	e.Uint64(uint64(v))
+}
+
+// Float32 writes the IEEE 754 representation of v aligned to 4 bytes.
+func (e *Encoder) Float32(v float32) {
+	e.Uint32(math.Float32bits(v))
+}
+
+// Float64 writes the IEEE 754 representation of v aligned to 8 bytes.
+func (e *Encoder) Float64(v float64) {
+	e.Uint64(math.Float64bits(v))
+}
+
+// Uint8s writes the elements of v without a length prefix.
+func (e *Encoder) Uint8s(v []uint8) {
+	e.buf = append(e.buf, v...)
+}
+
+// String writes v as a length prefixed, null terminated string.
+func (e *Encoder) String(v string) {
+	e.Uint32(uint32(len(v) + 1))
+	e.buf = append(e.buf, v...)
+	e.buf = append(e.buf, 0)
+}
+
+// WString writes v as the length of v in UTF-16 code units followed by the
+// code units.
+func (e *Encoder) WString(v string) {
+	units := utf16.Encode([]rune(v))
+	e.Uint32(uint32(len(units)))
+	for _, u := range units {
+		if e.wcharSize == 2 {
+			e.Uint16(u)
+		} else {
+			e.Uint32(uint32(u))
+		}
+	}
+}
+
+// SequenceLength writes the length n of a sequence. If bound is positive, it
+// is the upper bound of the sequence, and exceeding it is an error.
+func (e *Encoder) SequenceLength(n, bound int) {
+	if bound > 0 && n > bound {
+		e.setErr(fmt.Errorf("sequence length %d exceeds the upper bound %d", n, bound))
+	}
+	e.Uint32(uint32(n))
+}
+
+// Decoder reads values encoded using CDR. Errors are sticky: after an error
+// has occurred, all reads return zero values and Err returns the first error.
+type Decoder struct {
+	buf       []byte
+	pos       int
+	order     binary.ByteOrder
+	wcharSize int
+	err       error
+}
+
+// NewDecoder returns a Decoder which reads values from data. data must begin
+// with an encapsulation header specifying little or big endian CDR.
+func NewDecoder(data []byte) (*Decoder, error) {
+	if len(data) < headerSize {
+		return nil, fmt.Errorf("invalid CDR data: %w", io.ErrUnexpectedEOF)
+	}
+	d := &Decoder{buf: data, pos: headerSize, wcharSize: 4}
+	switch {
+	case data[0] == 0 && data[1] == encapsulationCDRLE:
+		d.order = binary.LittleEndian
+	case data[0] == 0 && data[1] == encapsulationCDRBE:
+		d.order = binary.BigEndian
+	default:
+		return nil, fmt.Errorf("unsupported CDR encapsulation 0x%02x%02x", data[0], data[1])
+	}
+	return d, nil
+}
+
+// SetWCharSize sets the number of bytes used to encode each UTF-16 code unit
+// of a wstring. size must be 2 or 4. The default is 4.
+func (d *Decoder) SetWCharSize(size int) {
+	d.wcharSize = checkWCharSize(size)
+}
+
+// Err returns the first error that occurred while decoding.
+func (d *Decoder) Err() error {
+	return d.err
+}
+
+func (d *Decoder) setErr(err error) {
+	if d.err == nil {
+		d.err = err
+	}
+}
+
+// remaining returns the number of bytes left to read.
+func (d *Decoder) remaining() int {
+	return len(d.buf) - d.pos
+}
+
+// next aligns the position to align and returns the next size bytes, or nil
+// if there is not enough data.
+func (d *Decoder) next(size, align int) []byte {
+	if d.err != nil {
+		return nil
+	}
+	pos := d.pos
+	if rem := (pos - headerSize) % align; rem != 0 {
+		pos += align - rem
+	}
+	if pos+size > len(d.buf) {
+		d.setErr(fmt.Errorf("invalid CDR data: %w", io.ErrUnexpectedEOF))
+		return nil
+	}
+	d.pos = pos + size
+	return d.buf[pos:d.pos]
+}
+
+// Bool reads a byte and reports whether it is not zero.
+func (d *Decoder) Bool() bool {
+	return d.Uint8() != 0
+}
+
+// Uint8 reads a single byte.
+func (d *Decoder) Uint8() uint8 {
+	if b := d.next(1, 1); b != nil {
+		return b[0]
+	}
+	return 0
+}
+
+// Int8 is like Uint8 but for int8 values.
+func (d *Decoder) Int8() int8 {
+	return int8(d.Uint8())
+}
+
+// Uint16 reads a value aligned to 2 bytes.
+func (d *Decoder) Uint16() uint16 {
+	if b := d.next(2, 2); b != nil {
+		return d.order.Uint16(b)
+	}
+	return 0
+}
+
+// Int16 is like Uint16 but for int16 values.
+func (d *Decoder) Int16() int16 {
+	return int16(d.Uint16())
+}
+
+// Uint32 reads a value aligned to 4 bytes.
+func (d *Decoder) Uint32() uint32 {
+	if b := d.next(4, 4); b != nil {
+		return d.order.Uint32(b)
+	}
+	return 0
+}
+
+// Int32 is like Uint32 but for int32 values.
+func (d *Decoder) Int32() int32 {
+	return int32(d.Uint32())
+}
+
+// Uint64 reads a value aligned to 8 bytes.
+func (d *Decoder) Uint64() uint64 {
+	if b := d.next(8, 8); b != nil {
+		return d.order.Uint64(b)
+	}
+	return 0
+}
+
+// Int64 is like Uint64 but for int64 values.
+func (d *Decoder) Int64() int64 {
+	return int64(d.Uint64())
+}
+
+// Float32 reads an IEEE 754 value aligned to 4 bytes.
+func (d *Decoder) Float32() float32 {
+	return math.Float32frombits(d.Uint32())
+}
+
+// Float64 reads an IEEE 754 value aligned to 8 bytes.
+func (d *Decoder) Float64() float64 {
+	return math.Float64frombits(d.Uint64())
+}
+
+// Uint8s reads len(v) bytes to v.
+func (d *Decoder) Uint8s(v []uint8) {
+	copy(v, d.next(len(v), 1))
+}
+
+// String reads a string written by Encoder.String.
+func (d *Decoder) String() string {
+	n := d.length(1)
+	b := d.next(n, 1)
+	if len(b) > 0 && b[len(b)-1] == 0 {
+		b = b[:len(b)-1]
+	}
+	return string(b)
+}
+
+// WString reads a string written by Encoder.WString.
+func (d *Decoder) WString() string {
+	n := d.length(d.wcharSize)
+	units := make([]uint16, n)
+	for i := range units {
+		if d.wcharSize == 2 {
+			units[i] = d.Uint16()
+		} else {
+			units[i] = uint16(d.Uint32())
+		}
+	}
+	return string(utf16.Decode(units))
+}
+
+// SequenceLength reads the length of a sequence. If bound is positive, it is
+// the upper bound of the sequence, and exceeding it is an error. Every element
+// of a sequence is encoded using at least one byte, so lengths larger than the
+// amount of remaining data are reported as errors.
+func (d *Decoder) SequenceLength(bound int) int {
+	n := d.length(1)
+	if bound > 0 && n > bound {
+		d.setErr(fmt.Errorf("sequence length %d exceeds the upper bound %d", n, bound))
+		return 0
+	}
+	return n
+}
+
+// length reads a length prefix of n elements of at least elemSize bytes each.
+func (d *Decoder) length(elemSize int) int {
+	n := d.Uint32()
+	if uint64(n)*uint64(elemSize) > uint64(d.remaining()) {
+		d.setErr(fmt.Errorf("invalid CDR data: length %d exceeds the remaining data", n))
+		return 0
+	}
+	return int(n)
+}
+
+// MakeSlice reads the length of a sequence whose upper bound is bound, or
+// which is unbounded if bound is zero, and returns a slice of that length, or
+// nil if the sequence is empty.
+func MakeSlice[T any](d *Decoder, bound int) []T {
+	if n := d.SequenceLength(bound); n > 0 {
+		return make([]T, n)
+	}
+	return nil
+}
+
+func checkWCharSize(size int) int {
+	if size != 2 && size != 4 {
+		panic(errors.New("cdr: wchar size must be 2 or 4"))
+	}
+	return size
+}
@@ -0,0 +1,107 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package cdr
+
+import (
+	"encoding/binary"
+	"io"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey" //nolint:revive
+)
+
+type testMsg struct {
+	B   uint8
+	I64 int64
+	S   string
+	W   string
+	Seq []int16
+}
+
+func (m *testMsg) EncodeCDR(e *Encoder) {
+	e.Uint8(m.B)
+	e.Int64(m.I64)
+	e.String(m.S)
+	e.WString(m.W)
+	e.SequenceLength(len(m.Seq), 3)
+	for i := range m.Seq {
+		e.Int16(m.Seq[i])
+	}
+}
+
+func (m *testMsg) DecodeCDR(d *Decoder) {
+	m.B = d.Uint8()
+	m.I64 = d.Int64()
+	m.S = d.String()
+	m.W = d.WString()
+	m.Seq = MakeSlice[int16](d, 3)
+	for i := range m.Seq {
+		m.Seq[i] = d.Int16()
+	}
+}
+
+func TestCDR(t *testing.T) {
+	Convey("Scenario: values are encoded using CDR", t, func() {
+		msg := &testMsg{B: 1, I64: -2, S: "ab", W: "é", Seq: []int16{3, 4}}
+
+		Convey("Little endian values are aligned relative to the payload", func() {
+			data, err := Marshal(msg)
+			So(err, ShouldBeNil)
+			So(data, ShouldResemble, []byte{
+				0, 1, 0, 0,
+				1, 0, 0, 0, 0, 0, 0, 0,
+				0xfe, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+				3, 0, 0, 0, 'a', 'b', 0, 0,
+				1, 0, 0, 0, 0xe9, 0, 0, 0,
+				2, 0, 0, 0, 3, 0, 4, 0,
+			})
+			decoded := &testMsg{}
+			So(Unmarshal(data, decoded), ShouldBeNil)
+			So(decoded, ShouldResemble, msg)
+		})
+		Convey("Big endian data is decoded and wstrings can use 2 bytes per unit", func() {
+			e := NewEncoder(binary.BigEndian)
+			e.SetWCharSize(2)
+			msg.EncodeCDR(e)
+			So(e.Err(), ShouldBeNil)
+			So(e.Bytes()[:4], ShouldResemble, []byte{0, 0, 0, 0})
+			So(e.Bytes()[28:34], ShouldResemble, []byte{0, 0, 0, 1, 0, 0xe9})
+			d, err := NewDecoder(e.Bytes())
+			So(err, ShouldBeNil)
+			d.SetWCharSize(2)
+			decoded := &testMsg{}
+			decoded.DecodeCDR(d)
+			So(d.Err(), ShouldBeNil)
+			So(decoded, ShouldResemble, msg)
+		})
+		Convey("Sequence bounds are enforced", func() {
+			msg.Seq = []int16{1, 2, 3, 4}
+			_, err := Marshal(msg)
+			So(err, ShouldNotBeNil)
+			e := NewEncoder(binary.LittleEndian)
+			e.Uint8(0)
+			e.Int64(0)
+			e.String("")
+			e.WString("")
+			e.SequenceLength(4, 0)
+			e.Uint64(0)
+			So(Unmarshal(e.Bytes(), &testMsg{}), ShouldNotBeNil)
+		})
+		Convey("Invalid data is reported", func() {
+			data, err := Marshal(msg)
+			So(err, ShouldBeNil)
+			So(Unmarshal(data[:len(data)-1], &testMsg{}), ShouldWrap, io.ErrUnexpectedEOF)
+			So(Unmarshal(data[:2], &testMsg{}), ShouldWrap, io.ErrUnexpectedEOF)
+			So(Unmarshal([]byte{0, 2, 0, 0}, &testMsg{}), ShouldNotBeNil)
+			data[20] = 0xff
+			So(Unmarshal(data, &testMsg{}), ShouldNotBeNil)
+		})
+	})
+}
@@ -0,0 +1,28 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+/*
+Package cdr implements the classic (XCDR1) Common Data Representation used by
+the ROS 2 middlewares to serialize messages, without depending on cgo or a ROS
+installation.
+
+The generated message types implement Marshaler and Unmarshaler, and also
+provide MarshalCDR and UnmarshalCDR methods which use Marshal and Unmarshal:
+
+	data, err := msg.MarshalCDR()
+	...
+	err = msg.UnmarshalCDR(data)
+
+The output of Marshal is identical to the output of rclgo.Serialize when using
+rmw_fastrtps_cpp, including the 4-byte encapsulation header. wstrings are
+encoded using 4 bytes per UTF-16 code unit like Fast DDS does. Use
+Encoder.SetWCharSize and Decoder.SetWCharSize to interoperate with Cyclone DDS,
+which uses 2 bytes per code unit.
+*/
+package cdr
@@ -0,0 +1,185 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package rclgo
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/tiiuae/rclgo/pkg/rclgo/types"
+)
+
+// BackpressurePolicy determines what a channel-based subscription does with a
+// newly received message when its channel buffer is full.
+type BackpressurePolicy int
+
+const (
+	// DropOldest discards the oldest buffered message to make room for the
+	// newly received message.
+	DropOldest BackpressurePolicy = iota
+	// DropNewest discards the newly received message.
+	DropNewest
+	// Block blocks until the consumer has made room for the newly received
+	// message. Note that blocking also blocks the spinning of every other
+	// resource in the same wait set.
+	Block
+)
+
+func (p BackpressurePolicy) String() string {
+	switch p {
+	case DropOldest:
+		return "DropOldest"
+	case DropNewest:
+		return "DropNewest"
+	case Block:
+		return "Block"
+	default:
+		return fmt.Sprintf("BackpressurePolicy(%d)", int(p))
+	}
+}
+
+// Received is a message received by a ChanSubscription.
+type Received struct {
+	Msg  types.Message
+	Info *MessageInfo
+}
+
+// ChanSubscription is a subscription that delivers received messages to a Go
+// channel instead of a callback.
+//
+// C is closed when the subscription is closed, either directly or by closing
+// the node or context owning it. Messages are only received while the
+// subscription is being spun.
+type ChanSubscription struct {
+	*Subscription
+
+	// C is the channel received messages are delivered to.
+	C <-chan Received
+
+	ch      chan Received
+	done    chan struct{}
+	mutex   sync.Mutex
+	closed  bool
+	policy  BackpressurePolicy
+	dropped atomic.Uint64
+}
+
+// SubscribeChan creates a new subscription which delivers received messages to
+// a channel with a buffer of bufSize messages. policy determines what happens
+// when a message is received while the buffer is full.
+//
+// bufSize must be positive unless policy is Block, in which case bufSize may
+// also be zero.
+//
+// options must not be modified after passing it to this function. If options is
+// nil, default options are used.
+func (n *Node) SubscribeChan(
+	topicName string,
+	ros2msg types.MessageTypeSupport,
+	options *SubscriptionOptions,
+	bufSize int,
+	policy BackpressurePolicy,
+) (*ChanSubscription, error) {
+	switch policy {
+	case DropOldest, DropNewest:
+		if bufSize < 1 {
+			return nil, fmt.Errorf("buffer size must be positive with policy %v, got %d", policy, bufSize)
+		}
+	case Block:
+		if bufSize < 0 {
+			return nil, fmt.Errorf("buffer size must not be negative, got %d", bufSize)
+		}
+	default:
+		return nil, fmt.Errorf("invalid backpressure policy: %v", policy)
+	}
+	s := &ChanSubscription{
+		ch:     make(chan Received, bufSize),
+		done:   make(chan struct{}),
+		policy: policy,
+	}
+	s.C = s.ch
+	sub, err := n.NewSubscription(topicName, ros2msg, options, s.receive)
+	if err != nil {
+		return nil, err
+	}
+	sub.onClose = s.close
+	s.Subscription = sub
+	return s, nil
+}
+
+// Policy returns the backpressure policy of s.
+func (s *ChanSubscription) Policy() BackpressurePolicy {
+	return s.policy
+}
+
+// Dropped returns the number of messages dropped so far due to the buffer of s
+// being full.
+func (s *ChanSubscription) Dropped() uint64 {
+	return s.dropped.Load()
+}
+
+func (s *ChanSubscription) receive(sub *Subscription) {
+	msg := sub.Ros2MsgType.New()
+	info, err := sub.TakeMessage(msg)
+	if err != nil {
+		var takeFailed *SubscriptionTakeFailed
+		if !errors.As(err, &takeFailed) {
+			sub.node.Logger().Debug(err)
+		}
+		return
+	}
+	s.send(Received{Msg: msg, Info: info})
+}
+
+func (s *ChanSubscription) send(r Received) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.closed {
+		return
+	}
+	switch s.policy {
+	case DropOldest:
+		for {
+			select {
+			case s.ch <- r:
+				return
+			default:
+			}
+			select {
+			case <-s.ch:
+				s.dropped.Add(1)
+			default:
+			}
+		}
+	case DropNewest:
+		select {
+		case s.ch <- r:
+		default:
+			s.dropped.Add(1)
+		}
+	case Block:
+		select {
+		case s.ch <- r:
+		case <-s.done:
+		}
+	}
+}
+
+func (s *ChanSubscription) close() {
+	// Closing done first unblocks a possible pending send so that the mutex
+	// can be acquired.
+	close(s.done)
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.closed = true
+	close(s.ch)
+}
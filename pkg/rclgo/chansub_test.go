@@ -0,0 +1,103 @@
+package rclgo_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey" //nolint:revive
+	std_msgs "github.com/tiiuae/rclgo/internal/msgs/std_msgs/msg"
+	"github.com/tiiuae/rclgo/pkg/rclgo"
+)
+
+func TestSubscribeChan(t *testing.T) {
+	var (
+		rclCtx    *rclgo.Context
+		pub       *rclgo.Publisher
+		blockSub  *rclgo.ChanSubscription
+		oldestSub *rclgo.ChanSubscription
+		newestSub *rclgo.ChanSubscription
+
+		spinCtx, cancelSpin = context.WithCancel(context.Background())
+		spinErr             = make(chan error, 1)
+	)
+	defer func() {
+		cancelSpin()
+		if rclCtx != nil {
+			rclCtx.Close()
+		}
+	}()
+	publish := func(count int) {
+		for i := 0; i < count; i++ {
+			publishString(pub, fmt.Sprint(i))
+			time.Sleep(50 * time.Millisecond)
+		}
+	}
+	receive := func(sub *rclgo.ChanSubscription) string {
+		var r rclgo.Received
+		timeOut(1000, func() { r = <-sub.C }, "Subscriber waiting for messages")
+		So(r.Info, ShouldNotBeNil)
+		return r.Msg.(*std_msgs.String).Data
+	}
+	Convey("Scenario: channel-based subscriptions apply backpressure policies", t, func() {
+		Convey("Given a context with a publisher and channel subscriptions", func() {
+			var err error
+			rclCtx, pub, err = newContextWithPublisher(nil, "pub", "", "/chan_topic", std_msgs.StringTypeSupport)
+			So(err, ShouldBeNil)
+			node, err := rclCtx.NewNode("sub", "")
+			So(err, ShouldBeNil)
+			opts := rclgo.NewDefaultSubscriptionOptions()
+			opts.Qos = reliableQos
+			blockSub, err = node.SubscribeChan("/chan_topic", std_msgs.StringTypeSupport, opts, 10, rclgo.Block)
+			So(err, ShouldBeNil)
+			oldestSub, err = node.SubscribeChan("/chan_topic", std_msgs.StringTypeSupport, opts, 2, rclgo.DropOldest)
+			So(err, ShouldBeNil)
+			newestSub, err = node.SubscribeChan("/chan_topic", std_msgs.StringTypeSupport, opts, 2, rclgo.DropNewest)
+			So(err, ShouldBeNil)
+			go func() { spinErr <- rclCtx.Spin(spinCtx) }()
+			time.Sleep(200 * time.Millisecond)
+		})
+		Convey("Drop policies must have a positive buffer size", func() {
+			node, err := rclCtx.NewNode("invalid", "")
+			So(err, ShouldBeNil)
+			defer node.Close()
+			_, err = node.SubscribeChan("/chan_topic", std_msgs.StringTypeSupport, nil, 0, rclgo.DropOldest)
+			So(err, ShouldNotBeNil)
+		})
+		Convey("When five messages are published", func() {
+			publish(5)
+		})
+		Convey("Then the blocking subscription receives every message", func() {
+			for i := 0; i < 5; i++ {
+				So(receive(blockSub), ShouldEqual, fmt.Sprint(i))
+			}
+			So(blockSub.Dropped(), ShouldEqual, 0)
+		})
+		Convey("And the drop-oldest subscription keeps the latest messages", func() {
+			So(receive(oldestSub), ShouldEqual, "3")
+			So(receive(oldestSub), ShouldEqual, "4")
+			So(oldestSub.Dropped(), ShouldEqual, 3)
+		})
+		Convey("And the drop-newest subscription keeps the earliest messages", func() {
+			So(receive(newestSub), ShouldEqual, "0")
+			So(receive(newestSub), ShouldEqual, "1")
+			So(newestSub.Dropped(), ShouldEqual, 3)
+		})
+		Convey("When a subscription is closed its channel is closed", func() {
+			So(blockSub.Close(), ShouldBeNil)
+			_, ok := <-blockSub.C
+			So(ok, ShouldBeFalse)
+		})
+		Convey("When the context is closed the remaining channels are closed", func() {
+			cancelSpin()
+			So(<-spinErr, shouldContainError, context.Canceled)
+			So(rclCtx.Close(), ShouldBeNil)
+			rclCtx = nil
+			_, ok := <-oldestSub.C
+			So(ok, ShouldBeFalse)
+			_, ok = <-newestSub.C
+			So(ok, ShouldBeFalse)
+		})
+	})
+}
@@ -35,17 +35,20 @@ char* formatString(const char* format, ...) {
 	return buf;
 }
 
-typedef rosidl_message_type_support_t * (*GetTypeSupportFunc)();
+typedef const void * (*GetTypeSupportFunc)();
 
 const char* loadTypeSupport(
+	const char* typeSupportName,
+	const char* kind,
 	const char* pkgName,
+	const char* ifaceNamespace,
 	const char* ifaceName,
 	void** lib,
 	void** typeSupport
 ) {
 	char* libName = formatString(
-		"lib%s__rosidl_typesupport_c.so",
-		pkgName
+		"lib%s__%s.so",
+		pkgName, typeSupportName
 	);
 	if (libName == NULL) {
 		return "allocation failed";
@@ -56,8 +59,8 @@ const char* loadTypeSupport(
 		return dlerror();
 	}
 	char* tsName = formatString(
-		"rosidl_typesupport_c__get_message_type_support_handle__%s__msg__%s",
-		pkgName, ifaceName
+		"%s__get_%s_type_support_handle__%s__%s__%s",
+		typeSupportName, kind, pkgName, ifaceNamespace, ifaceName
 	);
 	if (tsName == NULL) {
 		free(libName);
@@ -71,7 +74,7 @@ const char* loadTypeSupport(
 		free(tsName);
 		return dlerror();
 	}
-	*typeSupport = ((GetTypeSupportFunc)tsSym)();
+	*typeSupport = (void*)((GetTypeSupportFunc)tsSym)();
 	free(libName);
 	free(tsName);
 	return NULL;
@@ -82,61 +85,113 @@ import "C"
 import (
 	"fmt"
 	"runtime"
+	"sync"
 	"unsafe"
 
+	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
 	"github.com/tiiuae/rclgo/pkg/rclgo/types"
 )
 
-type dynamicMessageTypeSupport struct {
-	lib         unsafe.Pointer // void*
-	typeSupport unsafe.Pointer // rosidl_message_type_support_t*
+// dynamicLib holds handles to dynamically loaded libraries and closes them
+// when it is garbage collected.
+type dynamicLib struct {
+	mutex   sync.Mutex
+	handles []unsafe.Pointer // void*
+
+	// messages contains the message type supports created using the libraries.
+	// It is only accessed while loading type supports, which happens
+	// synchronously in the Load* functions.
+	messages map[unsafe.Pointer]*DynamicMessageTypeSupport // key is MessageMembers*
+}
+
+func newDynamicLib() *dynamicLib {
+	lib := &dynamicLib{
+		messages: make(map[unsafe.Pointer]*DynamicMessageTypeSupport),
+	}
+	runtime.SetFinalizer(lib, func(l *dynamicLib) {
+		for _, h := range l.handles {
+			C.dlclose(h)
+		}
+	})
+	return lib
+}
+
+// load loads the type support typeSupportName of interface
+// pkgName/namespace/name. kind is "message", "service" or "action". namespace is
+// "msg" for plain messages and for example "srv" or "action" for messages that
+// are part of services and actions.
+func (l *dynamicLib) load(typeSupportName, kind, pkgName, namespace, name string) (unsafe.Pointer, error) {
+	cTypeSupportName := C.CString(typeSupportName)
+	defer C.free(unsafe.Pointer(cTypeSupportName))
+	cKind := C.CString(kind)
+	defer C.free(unsafe.Pointer(cKind))
+	cPkgName := C.CString(pkgName)
+	defer C.free(unsafe.Pointer(cPkgName))
+	cIfaceNamespace := C.CString(namespace)
+	defer C.free(unsafe.Pointer(cIfaceNamespace))
+	cIfaceName := C.CString(name)
+	defer C.free(unsafe.Pointer(cIfaceName))
+	var handle, ts unsafe.Pointer
+	if err := C.loadTypeSupport(cTypeSupportName, cKind, cPkgName, cIfaceNamespace, cIfaceName, &handle, &ts); err != nil {
+		return nil, fmt.Errorf("failed to load type support: %v", C.GoString(err))
+	}
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.handles = append(l.handles, handle)
+	return ts, nil
 }
 
 // LoadDynamicMessageTypeSupport loads a message type support implementation
 // dynamically.
 //
+// If the introspection type support of the message type is installed, the
+// returned MessageTypeSupport is a *DynamicMessageTypeSupport which supports
+// all operations using *DynamicMessage as the message type. Otherwise
 // MessageTypeSupport instances returned by LoadDynamicMessageTypeSupport
-// support use cases related to handling only serialized messages. Methods New,
-// PrepareMemory, ReleaseMemory, AsCStruct and AsGoStruct will panic.
+// support use cases related to handling only serialized messages, and methods
+// New, PrepareMemory, ReleaseMemory, AsCStruct and AsGoStruct will panic.
 //
 // Backwards compatibility is not guaranteed for this API. Use it only if
 // necessary.
 func LoadDynamicMessageTypeSupport(pkgName, msgName string) (types.MessageTypeSupport, error) {
-	cPkgName := C.CString(pkgName)
-	defer C.free(unsafe.Pointer(cPkgName))
-	cIfaceName := C.CString(msgName)
-	defer C.free(unsafe.Pointer(cIfaceName))
-	ts := new(dynamicMessageTypeSupport)
-	err := C.loadTypeSupport(cPkgName, cIfaceName, &ts.lib, &ts.typeSupport)
+	lib := newDynamicLib()
+	ts, err := lib.load("rosidl_typesupport_c", "message", pkgName, "msg", msgName)
 	if err != nil {
-		return nil, fmt.Errorf("failed to load type support: %v", C.GoString(err))
+		return nil, err
 	}
-	runtime.SetFinalizer(ts, func(g *dynamicMessageTypeSupport) {
-		C.dlclose(g.lib)
-	})
-	return ts, nil
-}
-
-func (g *dynamicMessageTypeSupport) New() types.Message {
-	panic("not supported")
-}
-
-func (g *dynamicMessageTypeSupport) PrepareMemory() unsafe.Pointer {
-	panic("not supported")
-}
-
-func (g *dynamicMessageTypeSupport) ReleaseMemory(unsafe.Pointer) {
-	panic("not supported")
-}
-
-func (g *dynamicMessageTypeSupport) AsCStruct(unsafe.Pointer, types.Message) {
-	panic("not supported")
-}
-
-func (g *dynamicMessageTypeSupport) AsGoStruct(types.Message, unsafe.Pointer) {
-	panic("not supported")
+	introspection, err := lib.load("rosidl_typesupport_introspection_c", "message", pkgName, "msg", msgName)
+	if err != nil {
+		return &DynamicMessageTypeSupport{
+			lib:         lib,
+			pkgName:     pkgName,
+			namespace:   "msg",
+			name:        msgName,
+			typeSupport: ts,
+			loadErr:     err,
+		}, nil
+	}
+	return newDynamicMessageTypeSupport(
+		lib,
+		(*C.rosidl_message_type_support_t)(introspection),
+		ts,
+	), nil
 }
 
-func (g *dynamicMessageTypeSupport) TypeSupport() unsafe.Pointer { // *C.rosidl_message_type_support_t
-	return g.typeSupport
+// LoadMessageTypeSupport returns the type support of the message type
+// typeName, for example "std_msgs/msg/String". The type support is loaded
+// using LoadDynamicMessageTypeSupport if possible, and otherwise looked up from
+// package typemap, which contains the types compiled into the program.
+func LoadMessageTypeSupport(typeName string) (types.MessageTypeSupport, error) {
+	pkg, name, err := splitInterfaceName(typeName, "msg")
+	if err != nil {
+		return nil, err
+	}
+	ts, err := LoadDynamicMessageTypeSupport(pkg, name)
+	if err == nil {
+		return ts, nil
+	}
+	if ts, ok := typemap.GetMessage(typeName); ok {
+		return ts, nil
+	}
+	return nil, err
 }
@@ -0,0 +1,813 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package rclgo
+
+/*
+#include <stdbool.h>
+#include <stdint.h>
+#include <stdlib.h>
+#include <string.h>
+
+#include <rosidl_runtime_c/message_initialization.h>
+#include <rosidl_runtime_c/message_type_support_struct.h>
+#include <rosidl_runtime_c/string.h>
+#include <rosidl_runtime_c/string_functions.h>
+#include <rosidl_runtime_c/u16string.h>
+#include <rosidl_runtime_c/u16string_functions.h>
+#include <rosidl_typesupport_introspection_c/field_types.h>
+#include <rosidl_typesupport_introspection_c/message_introspection.h>
+
+typedef rosidl_typesupport_introspection_c__MessageMembers MessageMembers;
+typedef rosidl_typesupport_introspection_c__MessageMember MessageMember;
+
+// GenericSequence has the same memory layout as every sequence type generated
+// by rosidl_generator_c.
+typedef struct {
+	void* data;
+	size_t size;
+	size_t capacity;
+} GenericSequence;
+
+static const MessageMembers* getMessageMembers(const rosidl_message_type_support_t* ts) {
+	return (const MessageMembers*)ts->data;
+}
+
+static const MessageMember* getMember(const MessageMembers* members, uint32_t i) {
+	return &members->members_[i];
+}
+
+static const MessageMembers* getNestedMembers(const MessageMember* member) {
+	if (member->members_ == NULL) {
+		return NULL;
+	}
+	return getMessageMembers(member->members_);
+}
+
+static void* allocMessage(const MessageMembers* members) {
+	void* msg = calloc(1, members->size_of_);
+	if (msg != NULL) {
+		members->init_function(msg, ROSIDL_RUNTIME_C_MSG_INIT_ALL);
+	}
+	return msg;
+}
+
+static void freeMessage(const MessageMembers* members, void* msg) {
+	members->fini_function(msg);
+	free(msg);
+}
+
+static bool resizeMember(const MessageMember* member, void* field, size_t size) {
+	if (member->resize_function == NULL) {
+		return false;
+	}
+	return member->resize_function(field, size);
+}
+
+static double getLongDouble(const void* p) {
+	return (double)*(const long double*)p;
+}
+
+static void setLongDouble(void* p, double v) {
+	*(long double*)p = v;
+}
+
+static size_t sizeOfLongDouble() {
+	return sizeof(long double);
+}
+
+static bool assignString(rosidl_runtime_c__String* str, const char* data, size_t size) {
+	if (size == 0) {
+		return rosidl_runtime_c__String__assign(str, "");
+	}
+	return rosidl_runtime_c__String__assignn(str, data, size);
+}
+
+static bool assignU16String(rosidl_runtime_c__U16String* str, const uint16_t* data, size_t size) {
+	static const uint16_t empty = 0;
+	if (size == 0) {
+		data = &empty;
+	}
+	return rosidl_runtime_c__U16String__assignn(str, data, size);
+}
+*/
+import "C"
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"unicode/utf16"
+	"unsafe"
+
+	"github.com/tiiuae/rclgo/pkg/rclgo/types"
+)
+
+// FieldType is the ROS type of a field of a DynamicMessage, or the element type
+// in case of array and sequence fields.
+type FieldType uint8
+
+const (
+	FieldTypeFloat32    FieldType = C.rosidl_typesupport_introspection_c__ROS_TYPE_FLOAT
+	FieldTypeFloat64    FieldType = C.rosidl_typesupport_introspection_c__ROS_TYPE_DOUBLE
+	FieldTypeLongDouble FieldType = C.rosidl_typesupport_introspection_c__ROS_TYPE_LONG_DOUBLE
+	FieldTypeChar       FieldType = C.rosidl_typesupport_introspection_c__ROS_TYPE_CHAR
+	FieldTypeWChar      FieldType = C.rosidl_typesupport_introspection_c__ROS_TYPE_WCHAR
+	FieldTypeBool       FieldType = C.rosidl_typesupport_introspection_c__ROS_TYPE_BOOLEAN
+	FieldTypeByte       FieldType = C.rosidl_typesupport_introspection_c__ROS_TYPE_OCTET
+	FieldTypeUint8      FieldType = C.rosidl_typesupport_introspection_c__ROS_TYPE_UINT8
+	FieldTypeInt8       FieldType = C.rosidl_typesupport_introspection_c__ROS_TYPE_INT8
+	FieldTypeUint16     FieldType = C.rosidl_typesupport_introspection_c__ROS_TYPE_UINT16
+	FieldTypeInt16      FieldType = C.rosidl_typesupport_introspection_c__ROS_TYPE_INT16
+	FieldTypeUint32     FieldType = C.rosidl_typesupport_introspection_c__ROS_TYPE_UINT32
+	FieldTypeInt32      FieldType = C.rosidl_typesupport_introspection_c__ROS_TYPE_INT32
+	FieldTypeUint64     FieldType = C.rosidl_typesupport_introspection_c__ROS_TYPE_UINT64
+	FieldTypeInt64      FieldType = C.rosidl_typesupport_introspection_c__ROS_TYPE_INT64
+	FieldTypeString     FieldType = C.rosidl_typesupport_introspection_c__ROS_TYPE_STRING
+	FieldTypeWString    FieldType = C.rosidl_typesupport_introspection_c__ROS_TYPE_WSTRING
+	FieldTypeMessage    FieldType = C.rosidl_typesupport_introspection_c__ROS_TYPE_MESSAGE
+)
+
+var fieldTypeNames = map[FieldType]string{
+	FieldTypeFloat32:    "float32",
+	FieldTypeFloat64:    "float64",
+	FieldTypeLongDouble: "long double",
+	FieldTypeChar:       "char",
+	FieldTypeWChar:      "wchar",
+	FieldTypeBool:       "bool",
+	FieldTypeByte:       "byte",
+	FieldTypeUint8:      "uint8",
+	FieldTypeInt8:       "int8",
+	FieldTypeUint16:     "uint16",
+	FieldTypeInt16:      "int16",
+	FieldTypeUint32:     "uint32",
+	FieldTypeInt32:      "int32",
+	FieldTypeUint64:     "uint64",
+	FieldTypeInt64:      "int64",
+	FieldTypeString:     "string",
+	FieldTypeWString:    "wstring",
+	FieldTypeMessage:    "message",
+}
+
+func (t FieldType) String() string {
+	if name, ok := fieldTypeNames[t]; ok {
+		return name
+	}
+	return fmt.Sprintf("FieldType(%d)", int(t))
+}
+
+// goType returns the Go type used to represent a single value of type t in a
+// DynamicMessage.
+func (t FieldType) goType() reflect.Type {
+	switch t {
+	case FieldTypeFloat32:
+		return reflect.TypeOf(float32(0))
+	case FieldTypeFloat64, FieldTypeLongDouble:
+		return reflect.TypeOf(float64(0))
+	case FieldTypeChar, FieldTypeByte, FieldTypeUint8:
+		return reflect.TypeOf(uint8(0))
+	case FieldTypeWChar, FieldTypeUint16:
+		return reflect.TypeOf(uint16(0))
+	case FieldTypeBool:
+		return reflect.TypeOf(false)
+	case FieldTypeInt8:
+		return reflect.TypeOf(int8(0))
+	case FieldTypeInt16:
+		return reflect.TypeOf(int16(0))
+	case FieldTypeUint32:
+		return reflect.TypeOf(uint32(0))
+	case FieldTypeInt32:
+		return reflect.TypeOf(int32(0))
+	case FieldTypeUint64:
+		return reflect.TypeOf(uint64(0))
+	case FieldTypeInt64:
+		return reflect.TypeOf(int64(0))
+	case FieldTypeString, FieldTypeWString:
+		return reflect.TypeOf("")
+	case FieldTypeMessage:
+		return reflect.TypeOf((*DynamicMessage)(nil))
+	default:
+		panic(fmt.Sprintf("unsupported field type: %v", t))
+	}
+}
+
+// isPlain returns true if the C representation of t is identical to the Go
+// representation of t.
+func (t FieldType) isPlain() bool {
+	switch t {
+	case FieldTypeLongDouble, FieldTypeString, FieldTypeWString, FieldTypeMessage:
+		return false
+	default:
+		return true
+	}
+}
+
+// DynamicField describes a field of a message type loaded using
+// LoadDynamicMessageTypeSupport.
+//
+// Values of fields are represented in DynamicMessage as follows:
+//
+//   - float32 as float32, float64 and long double as float64
+//   - char, byte and uint8 as uint8, wchar as uint16
+//   - other integer types and bool as the corresponding Go type
+//   - string and wstring as string
+//   - nested messages as *DynamicMessage
+//   - arrays and sequences as slices of the element representation, for
+//     example []int32 or []*DynamicMessage
+type DynamicField struct {
+	// Name is the name of the field.
+	Name string
+	// Type is the type of the field, or the element type if the field is an
+	// array or a sequence.
+	Type FieldType
+	// Message is the type of the nested message if Type is FieldTypeMessage.
+	Message *DynamicMessageTypeSupport
+	// IsArray is true if the field is an array or a sequence.
+	IsArray bool
+	// ArraySize is the size of an array or the upper bound of a bounded
+	// sequence. ArraySize is zero for unbounded sequences.
+	ArraySize int
+	// IsUpperBound is true if the field is a bounded sequence.
+	IsUpperBound bool
+	// StringUpperBound is the maximum length of string and wstring values, or
+	// zero if the length is unbounded.
+	StringUpperBound int
+
+	member *C.MessageMember
+	offset uintptr
+	stride uintptr
+	goType reflect.Type
+}
+
+// IsFixedSizeArray returns true if f is an array, as opposed to a sequence.
+func (f *DynamicField) IsFixedSizeArray() bool {
+	return f.IsArray && f.ArraySize > 0 && !f.IsUpperBound
+}
+
+// IsSequence returns true if f is a bounded or an unbounded sequence.
+func (f *DynamicField) IsSequence() bool {
+	return f.IsArray && !f.IsFixedSizeArray()
+}
+
+// RosType returns the ROS interface definition syntax of the type of f, for
+// example "string<=10", "int32[3]" or "std_msgs/Header[<=5]".
+func (f *DynamicField) RosType() string {
+	var b strings.Builder
+	if f.Type == FieldTypeMessage {
+		b.WriteString(f.Message.pkgName)
+		b.WriteString("/")
+		b.WriteString(f.Message.name)
+	} else {
+		b.WriteString(f.Type.String())
+	}
+	if f.StringUpperBound > 0 {
+		fmt.Fprintf(&b, "<=%d", f.StringUpperBound)
+	}
+	switch {
+	case f.IsFixedSizeArray():
+		fmt.Fprintf(&b, "[%d]", f.ArraySize)
+	case f.IsUpperBound:
+		fmt.Fprintf(&b, "[<=%d]", f.ArraySize)
+	case f.IsArray:
+		b.WriteString("[]")
+	}
+	return b.String()
+}
+
+// valueType returns the Go type of the whole value of f.
+func (f *DynamicField) valueType() reflect.Type {
+	if f.IsArray {
+		return reflect.SliceOf(f.goType)
+	}
+	return f.goType
+}
+
+func (f *DynamicField) validate(value interface{}) error {
+	if value == nil {
+		return fmt.Errorf("field %s: value must not be nil", f.Name)
+	}
+	rv := reflect.ValueOf(value)
+	if rv.Type() != f.valueType() {
+		return fmt.Errorf("field %s: expected a value of type %v, got %T", f.Name, f.valueType(), value)
+	}
+	if !f.IsArray {
+		return f.validateElem(rv)
+	}
+	switch n := rv.Len(); {
+	case f.IsFixedSizeArray() && n != f.ArraySize:
+		return fmt.Errorf("field %s: expected %d elements, got %d", f.Name, f.ArraySize, n)
+	case f.IsUpperBound && n > f.ArraySize:
+		return fmt.Errorf("field %s: expected at most %d elements, got %d", f.Name, f.ArraySize, n)
+	}
+	for i := 0; i < rv.Len(); i++ {
+		if err := f.validateElem(rv.Index(i)); err != nil {
+			return fmt.Errorf("index %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (f *DynamicField) validateElem(rv reflect.Value) error {
+	switch f.Type {
+	case FieldTypeString:
+		if f.StringUpperBound > 0 && rv.Len() > f.StringUpperBound {
+			return fmt.Errorf("field %s: string is longer than %d bytes", f.Name, f.StringUpperBound)
+		}
+	case FieldTypeWString:
+		n := len(utf16.Encode([]rune(rv.String())))
+		if f.StringUpperBound > 0 && n > f.StringUpperBound {
+			return fmt.Errorf("field %s: wstring is longer than %d characters", f.Name, f.StringUpperBound)
+		}
+	case FieldTypeMessage:
+		msg := rv.Interface().(*DynamicMessage)
+		if msg == nil {
+			return fmt.Errorf("field %s: message must not be nil", f.Name)
+		}
+		if msg.ts != f.Message {
+			return fmt.Errorf("field %s: expected a message of type %s, got %s", f.Name, f.Message.Name(), msg.ts.Name())
+		}
+	}
+	return nil
+}
+
+// DynamicMessageTypeSupport is a message type support loaded at runtime using
+// LoadDynamicMessageTypeSupport. The message type of a
+// DynamicMessageTypeSupport is *DynamicMessage.
+type DynamicMessageTypeSupport struct {
+	lib       *dynamicLib
+	pkgName   string
+	namespace string
+	name      string
+	loadErr   error
+
+	typeSupportOnce sync.Once
+	typeSupport     unsafe.Pointer // rosidl_message_type_support_t*
+
+	members    *C.MessageMembers
+	fields     []DynamicField
+	fieldIndex map[string]int
+
+	defaultsOnce sync.Once
+	defaults     []interface{}
+}
+
+func newDynamicMessageTypeSupport(
+	lib *dynamicLib,
+	introspection *C.rosidl_message_type_support_t,
+	typeSupport unsafe.Pointer,
+) *DynamicMessageTypeSupport {
+	ts := newDynamicMessageTypeSupportFromMembers(lib, C.getMessageMembers(introspection))
+	if ts.typeSupport == nil {
+		ts.typeSupport = typeSupport
+	}
+	return ts
+}
+
+// newDynamicMessageTypeSupportFromMembers returns the type support described
+// by members. Type supports are cached in lib so that every message type,
+// including nested ones, has a single type support per lib.
+func newDynamicMessageTypeSupportFromMembers(
+	lib *dynamicLib,
+	members *C.MessageMembers,
+) *DynamicMessageTypeSupport {
+	if ts, ok := lib.messages[unsafe.Pointer(members)]; ok {
+		return ts
+	}
+	// message_namespace_ is of the form "pkg__msg".
+	pkgName, namespace, _ := strings.Cut(C.GoString(members.message_namespace_), "__")
+	ts := &DynamicMessageTypeSupport{
+		lib:        lib,
+		pkgName:    pkgName,
+		namespace:  namespace,
+		name:       C.GoString(members.message_name_),
+		members:    members,
+		fields:     make([]DynamicField, members.member_count_),
+		fieldIndex: make(map[string]int, members.member_count_),
+	}
+	lib.messages[unsafe.Pointer(members)] = ts
+	for i := range ts.fields {
+		m := C.getMember(members, C.uint32_t(i))
+		f := &ts.fields[i]
+		f.Name = C.GoString(m.name_)
+		f.Type = FieldType(m.type_id_)
+		f.IsArray = bool(m.is_array_)
+		f.ArraySize = int(m.array_size_)
+		f.IsUpperBound = bool(m.is_upper_bound_)
+		f.StringUpperBound = int(m.string_upper_bound_)
+		f.member = m
+		f.offset = uintptr(m.offset_)
+		f.goType = f.Type.goType()
+		switch f.Type {
+		case FieldTypeLongDouble:
+			f.stride = uintptr(C.sizeOfLongDouble())
+		case FieldTypeString:
+			f.stride = unsafe.Sizeof(C.rosidl_runtime_c__String{})
+		case FieldTypeWString:
+			f.stride = unsafe.Sizeof(C.rosidl_runtime_c__U16String{})
+		case FieldTypeMessage:
+			f.Message = newDynamicMessageTypeSupportFromMembers(lib, C.getNestedMembers(m))
+			f.stride = uintptr(f.Message.members.size_of_)
+		default:
+			f.stride = f.goType.Size()
+		}
+		ts.fieldIndex[f.Name] = i
+	}
+	return ts
+}
+
+func (t *DynamicMessageTypeSupport) checkSupported() {
+	if t.members == nil {
+		panic(fmt.Sprintf("not supported: introspection type support not available: %v", t.loadErr))
+	}
+}
+
+// Name returns the full name of the message type, for example
+// "std_msgs/msg/String".
+func (t *DynamicMessageTypeSupport) Name() string {
+	return t.pkgName + "/" + t.namespace + "/" + t.name
+}
+
+// Fields returns the fields of the message type. The returned slice must not
+// be modified. Fields returns nil if the introspection type support of the
+// message type is not available.
+func (t *DynamicMessageTypeSupport) Fields() []DynamicField {
+	return t.fields
+}
+
+// Field returns the field called name.
+func (t *DynamicMessageTypeSupport) Field(name string) (*DynamicField, bool) {
+	i, ok := t.fieldIndex[name]
+	if !ok {
+		return nil, false
+	}
+	return &t.fields[i], true
+}
+
+func (t *DynamicMessageTypeSupport) New() types.Message {
+	t.checkSupported()
+	msg := &DynamicMessage{ts: t}
+	msg.SetDefaults()
+	return msg
+}
+
+func (t *DynamicMessageTypeSupport) PrepareMemory() unsafe.Pointer {
+	t.checkSupported()
+	p := C.allocMessage(t.members)
+	if p == nil {
+		panic("failed to allocate message")
+	}
+	return p
+}
+
+func (t *DynamicMessageTypeSupport) ReleaseMemory(p unsafe.Pointer) {
+	t.checkSupported()
+	C.freeMessage(t.members, p)
+}
+
+func (t *DynamicMessageTypeSupport) AsCStruct(dst unsafe.Pointer, msg types.Message) {
+	t.checkSupported()
+	m := msg.(*DynamicMessage)
+	if m.ts != t {
+		panic(fmt.Sprintf("expected a message of type %s, got %s", t.Name(), m.ts.Name()))
+	}
+	t.writeStruct(dst, m.values)
+}
+
+func (t *DynamicMessageTypeSupport) AsGoStruct(msg types.Message, ptr unsafe.Pointer) {
+	t.checkSupported()
+	m := msg.(*DynamicMessage)
+	m.ts = t
+	m.values = t.readStruct(ptr)
+}
+
+// TypeSupport returns the rosidl_typesupport_c type support of the message
+// type, which is used for example to create publishers and subscriptions. The
+// type support of nested message types is loaded on first use. TypeSupport
+// returns nil if loading it fails.
+func (t *DynamicMessageTypeSupport) TypeSupport() unsafe.Pointer {
+	t.typeSupportOnce.Do(func() {
+		if t.typeSupport != nil {
+			return
+		}
+		ts, err := t.lib.load("rosidl_typesupport_c", "message", t.pkgName, t.namespace, t.name)
+		if err == nil {
+			t.typeSupport = ts
+		}
+	})
+	return t.typeSupport
+}
+
+func (t *DynamicMessageTypeSupport) defaultValues() []interface{} {
+	t.defaultsOnce.Do(func() {
+		p := t.PrepareMemory()
+		defer t.ReleaseMemory(p)
+		t.defaults = t.readStruct(p)
+	})
+	return t.defaults
+}
+
+func (t *DynamicMessageTypeSupport) readStruct(p unsafe.Pointer) []interface{} {
+	values := make([]interface{}, len(t.fields))
+	for i := range t.fields {
+		f := &t.fields[i]
+		fp := unsafe.Add(p, f.offset)
+		if !f.IsArray {
+			values[i] = f.readElem(fp)
+			continue
+		}
+		base, n := fp, f.ArraySize
+		if f.IsSequence() {
+			seq := (*C.GenericSequence)(fp)
+			base, n = seq.data, int(seq.size)
+		}
+		slice := reflect.MakeSlice(f.valueType(), n, n)
+		if f.Type.isPlain() {
+			if n > 0 {
+				C.memcpy(slice.UnsafePointer(), base, C.size_t(uintptr(n)*f.stride))
+			}
+		} else {
+			for j := 0; j < n; j++ {
+				slice.Index(j).Set(reflect.ValueOf(f.readElem(unsafe.Add(base, uintptr(j)*f.stride))))
+			}
+		}
+		values[i] = slice.Interface()
+	}
+	return values
+}
+
+func (t *DynamicMessageTypeSupport) writeStruct(p unsafe.Pointer, values []interface{}) {
+	for i := range t.fields {
+		f := &t.fields[i]
+		fp := unsafe.Add(p, f.offset)
+		if !f.IsArray {
+			f.writeElem(fp, values[i])
+			continue
+		}
+		slice := reflect.ValueOf(values[i])
+		base, n := fp, slice.Len()
+		if f.IsSequence() {
+			if !C.resizeMember(f.member, fp, C.size_t(n)) {
+				panic(fmt.Sprintf("field %s: failed to resize sequence to %d elements", f.Name, n))
+			}
+			base = (*C.GenericSequence)(fp).data
+		} else if n > f.ArraySize {
+			n = f.ArraySize
+		}
+		if f.Type.isPlain() {
+			if n > 0 {
+				C.memcpy(base, slice.UnsafePointer(), C.size_t(uintptr(n)*f.stride))
+			}
+		} else {
+			for j := 0; j < n; j++ {
+				f.writeElem(unsafe.Add(base, uintptr(j)*f.stride), slice.Index(j).Interface())
+			}
+		}
+	}
+}
+
+func (f *DynamicField) readElem(p unsafe.Pointer) interface{} {
+	switch f.Type {
+	case FieldTypeLongDouble:
+		return float64(C.getLongDouble(p))
+	case FieldTypeString:
+		s := (*C.rosidl_runtime_c__String)(p)
+		return C.GoStringN(s.data, C.int(s.size))
+	case FieldTypeWString:
+		s := (*C.rosidl_runtime_c__U16String)(p)
+		if s.size == 0 {
+			return ""
+		}
+		return string(utf16.Decode(unsafe.Slice((*uint16)(unsafe.Pointer(s.data)), s.size)))
+	case FieldTypeMessage:
+		return &DynamicMessage{ts: f.Message, values: f.Message.readStruct(p)}
+	default:
+		return reflect.NewAt(f.goType, p).Elem().Interface()
+	}
+}
+
+func (f *DynamicField) writeElem(p unsafe.Pointer, v interface{}) {
+	switch f.Type {
+	case FieldTypeLongDouble:
+		C.setLongDouble(p, C.double(v.(float64)))
+	case FieldTypeString:
+		s := v.(string)
+		if !C.assignString(
+			(*C.rosidl_runtime_c__String)(p),
+			(*C.char)(unsafe.Pointer(unsafe.StringData(s))),
+			C.size_t(len(s)),
+		) {
+			panic(fmt.Sprintf("field %s: failed to assign string", f.Name))
+		}
+	case FieldTypeWString:
+		s := utf16.Encode([]rune(v.(string)))
+		var data *C.uint16_t
+		if len(s) > 0 {
+			data = (*C.uint16_t)(unsafe.Pointer(&s[0]))
+		}
+		if !C.assignU16String((*C.rosidl_runtime_c__U16String)(p), data, C.size_t(len(s))) {
+			panic(fmt.Sprintf("field %s: failed to assign wstring", f.Name))
+		}
+	case FieldTypeMessage:
+		f.Message.writeStruct(p, v.(*DynamicMessage).values)
+	default:
+		reflect.NewAt(f.goType, p).Elem().Set(reflect.ValueOf(v))
+	}
+}
+
+// DynamicMessage is a message whose type is loaded at runtime using
+// LoadDynamicMessageTypeSupport. Field values are accessed by name using Get
+// and Set. See DynamicField for the Go representation of field values.
+type DynamicMessage struct {
+	ts     *DynamicMessageTypeSupport
+	values []interface{}
+}
+
+// ErrUnknownField is returned when accessing a field that does not exist in a
+// DynamicMessage, and wrapped by the errors returned by GetField and SetField
+// when a field path contains a field that does not exist.
+var ErrUnknownField = errors.New("unknown field")
+
+// Type returns the type support of m.
+func (m *DynamicMessage) Type() *DynamicMessageTypeSupport {
+	return m.ts
+}
+
+// Fields returns the fields of m. The returned slice must not be modified.
+func (m *DynamicMessage) Fields() []DynamicField {
+	return m.ts.fields
+}
+
+// Get returns the value of the field called name. The returned value is not
+// copied, so modifying a returned slice or message modifies m.
+func (m *DynamicMessage) Get(name string) (interface{}, error) {
+	i, ok := m.ts.fieldIndex[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownField, name)
+	}
+	return m.values[i], nil
+}
+
+// Set sets the value of the field called name. The type of value must match
+// the representation documented in DynamicField, and the lengths of arrays,
+// bounded sequences and bounded strings must be within limits. value is not
+// copied.
+func (m *DynamicMessage) Set(name string, value interface{}) error {
+	i, ok := m.ts.fieldIndex[name]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownField, name)
+	}
+	if err := m.ts.fields[i].validate(value); err != nil {
+		return err
+	}
+	m.values[i] = value
+	return nil
+}
+
+// mustSet is like Set but panics on error. It is used for fields whose type is
+// known beforehand.
+func (m *DynamicMessage) mustSet(name string, value interface{}) {
+	if err := m.Set(name, value); err != nil {
+		panic(err)
+	}
+}
+
+// goalUUID returns the UUID in field goal_id or goal_info.goal_id of m, or nil
+// if m has no such field.
+func (m *DynamicMessage) goalUUID() []uint8 {
+	msg := m
+	if info, err := msg.Get("goal_info"); err == nil {
+		msg, _ = info.(*DynamicMessage)
+	}
+	if msg == nil {
+		return nil
+	}
+	id, err := msg.Get("goal_id")
+	if err != nil {
+		return nil
+	}
+	return uuidOf(id)
+}
+
+func uuidOf(goalID interface{}) []uint8 {
+	msg, ok := goalID.(*DynamicMessage)
+	if !ok {
+		return nil
+	}
+	uuid, err := msg.Get("uuid")
+	if err != nil {
+		return nil
+	}
+	b, ok := uuid.([]uint8)
+	if !ok || len(b) != types.GoalIDLen {
+		return nil
+	}
+	return b
+}
+
+// The following methods implement the same functionality for action messages
+// as the corresponding methods of generated action message types. They allow
+// using DynamicMessage with ActionClient and ActionServer.
+
+// GetGoalID returns a pointer to the goal ID of an action message, or nil if
+// m does not contain a goal ID. Modifying the pointed value modifies m.
+func (m *DynamicMessage) GetGoalID() *types.GoalID {
+	uuid := m.goalUUID()
+	if uuid == nil {
+		return nil
+	}
+	return (*types.GoalID)(uuid)
+}
+
+// SetGoalID sets the goal ID of an action message. SetGoalID does nothing if
+// m does not contain a goal ID.
+func (m *DynamicMessage) SetGoalID(id *types.GoalID) {
+	copy(m.goalUUID(), id[:])
+}
+
+// GetGoalDescription returns the goal of an action SendGoal request.
+func (m *DynamicMessage) GetGoalDescription() types.Message {
+	goal, _ := m.Get("goal")
+	msg, _ := goal.(*DynamicMessage)
+	return msg
+}
+
+// SetGoalDescription sets the goal of an action SendGoal request. desc must be
+// a *DynamicMessage whose type is the goal type of the action.
+func (m *DynamicMessage) SetGoalDescription(desc types.Message) {
+	m.mustSet("goal", desc)
+}
+
+// GetGoalAccepted returns whether the goal was accepted in an action SendGoal
+// response.
+func (m *DynamicMessage) GetGoalAccepted() bool {
+	accepted, _ := m.Get("accepted")
+	b, _ := accepted.(bool)
+	return b
+}
+
+// CallForEach calls f with the goal ID of every canceled goal in an
+// action_msgs/srv/CancelGoal response, or with every goal status in an
+// action_msgs/msg/GoalStatusArray message.
+func (m *DynamicMessage) CallForEach(f func(interface{})) {
+	if canceling, err := m.Get("goals_canceling"); err == nil {
+		for _, info := range canceling.([]*DynamicMessage) {
+			if id := info.GetGoalID(); id != nil {
+				f(id)
+			}
+		}
+	} else if statuses, err := m.Get("status_list"); err == nil {
+		for _, status := range statuses.([]*DynamicMessage) {
+			f(status)
+		}
+	}
+}
+
+func (m *DynamicMessage) CloneMsg() types.Message {
+	return m.clone()
+}
+
+func (m *DynamicMessage) clone() *DynamicMessage {
+	return &DynamicMessage{ts: m.ts, values: cloneDynamicValues(m.values)}
+}
+
+func (m *DynamicMessage) SetDefaults() {
+	m.values = cloneDynamicValues(m.ts.defaultValues())
+}
+
+func (m *DynamicMessage) GetTypeSupport() types.MessageTypeSupport {
+	return m.ts
+}
+
+func cloneDynamicValues(values []interface{}) []interface{} {
+	clone := make([]interface{}, len(values))
+	for i, v := range values {
+		switch v := v.(type) {
+		case *DynamicMessage:
+			clone[i] = v.clone()
+		case []*DynamicMessage:
+			msgs := make([]*DynamicMessage, len(v))
+			for j, msg := range v {
+				msgs[j] = msg.clone()
+			}
+			clone[i] = msgs
+		default:
+			rv := reflect.ValueOf(v)
+			if rv.Kind() == reflect.Slice {
+				c := reflect.MakeSlice(rv.Type(), rv.Len(), rv.Len())
+				reflect.Copy(c, rv)
+				clone[i] = c.Interface()
+			} else {
+				clone[i] = v
+			}
+		}
+	}
+	return clone
+}
@@ -0,0 +1,122 @@
+package rclgo_test
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey" //nolint:revive
+	test_msgs "github.com/tiiuae/rclgo/internal/msgs/test_msgs/msg"
+	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/types"
+)
+
+func loadDynamic(msgName string) *rclgo.DynamicMessageTypeSupport {
+	ts, err := rclgo.LoadDynamicMessageTypeSupport("test_msgs", msgName)
+	So(err, ShouldBeNil)
+	So(ts, ShouldHaveSameTypeAs, &rclgo.DynamicMessageTypeSupport{})
+	return ts.(*rclgo.DynamicMessageTypeSupport)
+}
+
+// convertMsg converts msg to a message of type ts by serializing and
+// deserializing it.
+func convertMsg(msg types.Message, ts types.MessageTypeSupport) types.Message {
+	buf, err := rclgo.Serialize(msg)
+	So(err, ShouldBeNil)
+	converted, err := rclgo.Deserialize(buf, ts)
+	So(err, ShouldBeNil)
+	return converted
+}
+
+func getField(msg *rclgo.DynamicMessage, name string) interface{} {
+	v, err := msg.Get(name)
+	So(err, ShouldBeNil)
+	return v
+}
+
+func TestDynamicMessage(t *testing.T) {
+	Convey("Scenario: messages are handled using runtime introspection", t, func() {
+		Convey("Basic types are introspected and round-tripped", func() {
+			ts := loadDynamic("BasicTypes")
+			So(ts.Name(), ShouldEqual, "test_msgs/msg/BasicTypes")
+			f, ok := ts.Field("char_value")
+			So(ok, ShouldBeTrue)
+			So(f.Type, ShouldEqual, rclgo.FieldTypeChar)
+			So(f.IsArray, ShouldBeFalse)
+
+			orig := test_msgs.NewBasicTypes()
+			orig.BoolValue = true
+			orig.ByteValue = 7
+			orig.CharValue = 'a'
+			orig.Float32Value = 1.5
+			orig.Float64Value = -2.25
+			orig.Int8Value = -8
+			orig.Uint16Value = 16
+			orig.Int64Value = -64
+			orig.Uint64Value = 1 << 60
+			msg := convertMsg(orig, ts).(*rclgo.DynamicMessage)
+			So(getField(msg, "bool_value"), ShouldEqual, true)
+			So(getField(msg, "byte_value"), ShouldEqual, uint8(7))
+			So(getField(msg, "char_value"), ShouldEqual, uint8('a'))
+			So(getField(msg, "float32_value"), ShouldEqual, float32(1.5))
+			So(getField(msg, "float64_value"), ShouldEqual, -2.25)
+			So(getField(msg, "int8_value"), ShouldEqual, int8(-8))
+			So(getField(msg, "uint64_value"), ShouldEqual, uint64(1<<60))
+
+			So(msg.Set("int32_value", int32(-32)), ShouldBeNil)
+			So(msg.Set("int32_value", 32), ShouldNotBeNil)
+			_, err := msg.Get("no_such_field")
+			So(err, ShouldWrap, rclgo.ErrUnknownField)
+			orig.Int32Value = -32
+			So(convertMsg(msg, test_msgs.BasicTypesTypeSupport), ShouldResemble, convertMsg(orig, test_msgs.BasicTypesTypeSupport))
+		})
+		Convey("Arrays, sequences and nested messages are round-tripped", func() {
+			ts := loadDynamic("UnboundedSequences")
+			f, ok := ts.Field("basic_types_values")
+			So(ok, ShouldBeTrue)
+			So(f.Type, ShouldEqual, rclgo.FieldTypeMessage)
+			So(f.IsSequence(), ShouldBeTrue)
+			So(f.RosType(), ShouldEqual, "test_msgs/BasicTypes[]")
+
+			orig := test_msgs.NewUnboundedSequences()
+			orig.Int32Values = []int32{1, 2, 3}
+			orig.StringValues = []string{"a", "", "bc"}
+			orig.BasicTypesValues = []test_msgs.BasicTypes{{Int16Value: 5}, {Int16Value: 6}}
+			msg := convertMsg(orig, ts).(*rclgo.DynamicMessage)
+			So(getField(msg, "int32_values"), ShouldResemble, []int32{1, 2, 3})
+			So(getField(msg, "string_values"), ShouldResemble, []string{"a", "", "bc"})
+			nested := getField(msg, "basic_types_values").([]*rclgo.DynamicMessage)
+			So(nested, ShouldHaveLength, 2)
+			So(getField(nested[1], "int16_value"), ShouldEqual, int16(6))
+
+			clone := msg.CloneMsg().(*rclgo.DynamicMessage)
+			So(nested[0].Set("int16_value", int16(50)), ShouldBeNil)
+			So(getField(getField(clone, "basic_types_values").([]*rclgo.DynamicMessage)[0], "int16_value"), ShouldEqual, int16(5))
+			So(msg.Set("uint8_values", []uint8{9, 8}), ShouldBeNil)
+			orig.BasicTypesValues[0].Int16Value = 50
+			orig.Uint8Values = []uint8{9, 8}
+			So(convertMsg(msg, test_msgs.UnboundedSequencesTypeSupport), ShouldResemble, convertMsg(orig, test_msgs.UnboundedSequencesTypeSupport))
+
+			arrays := loadDynamic("Arrays")
+			arraysMsg := arrays.New().(*rclgo.DynamicMessage)
+			f, ok = arrays.Field("int32_values")
+			So(ok, ShouldBeTrue)
+			So(f.IsFixedSizeArray(), ShouldBeTrue)
+			So(arraysMsg.Set("int32_values", []int32{1, 2}), ShouldNotBeNil)
+			So(arraysMsg.Set("int32_values", []int32{1, 2, 3}), ShouldBeNil)
+			So(convertMsg(arraysMsg, test_msgs.ArraysTypeSupport).(*test_msgs.Arrays).Int32Values, ShouldResemble, [3]int32{1, 2, 3})
+		})
+		Convey("Defaults and strings are handled", func() {
+			ts := loadDynamic("Strings")
+			msg := ts.New().(*rclgo.DynamicMessage)
+			So(convertMsg(msg, test_msgs.StringsTypeSupport), ShouldResemble, convertMsg(test_msgs.NewStrings(), test_msgs.StringsTypeSupport))
+			f, ok := ts.Field("bounded_string_value")
+			So(ok, ShouldBeTrue)
+			So(f.StringUpperBound, ShouldBeGreaterThan, 0)
+			So(msg.Set("bounded_string_value", string(make([]byte, f.StringUpperBound+1))), ShouldNotBeNil)
+
+			wts := loadDynamic("WStrings")
+			wmsg := wts.New().(*rclgo.DynamicMessage)
+			So(wmsg.Set("wstring_value", "äö€"), ShouldBeNil)
+			So(convertMsg(wmsg, test_msgs.WStringsTypeSupport).(*test_msgs.WStrings).WstringValue, ShouldEqual, "äö€")
+		})
+	})
+}
@@ -0,0 +1,283 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package rclgo
+
+/*
+#include <rosidl_runtime_c/message_type_support_struct.h>
+*/
+import "C"
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unsafe"
+
+	"github.com/tiiuae/rclgo/pkg/rclgo/types"
+)
+
+// splitInterfaceName splits a fully qualified interface name of the form
+// "pkg/namespace/Name" or "pkg/Name" to the package and interface names.
+func splitInterfaceName(typeName, namespace string) (pkg, name string, err error) {
+	parts := strings.Split(typeName, "/")
+	switch {
+	case len(parts) == 2 && parts[0] != "" && parts[1] != "":
+		return parts[0], parts[1], nil
+	case len(parts) == 3 && parts[0] != "" && parts[1] == namespace && parts[2] != "":
+		return parts[0], parts[2], nil
+	}
+	return "", "", fmt.Errorf("invalid %s type name: %q", namespace, typeName)
+}
+
+// loadDynamicMessage loads the type support of message pkgName/namespace/name
+// including the introspection type support.
+func loadDynamicMessage(lib *dynamicLib, pkgName, namespace, name string) (*DynamicMessageTypeSupport, error) {
+	ts, err := lib.load("rosidl_typesupport_c", "message", pkgName, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	introspection, err := lib.load("rosidl_typesupport_introspection_c", "message", pkgName, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	return newDynamicMessageTypeSupport(
+		lib,
+		(*C.rosidl_message_type_support_t)(introspection),
+		ts,
+	), nil
+}
+
+// DynamicServiceTypeSupport is a service type support loaded at runtime using
+// LoadDynamicServiceTypeSupport. The request and response types are
+// *DynamicMessage.
+type DynamicServiceTypeSupport struct {
+	request     *DynamicMessageTypeSupport
+	response    *DynamicMessageTypeSupport
+	typeSupport unsafe.Pointer // rosidl_service_type_support_t*
+}
+
+// LoadDynamicServiceTypeSupport loads a service type support implementation
+// dynamically. The introspection type support of the service type must be
+// installed.
+//
+// Backwards compatibility is not guaranteed for this API. Use it only if
+// necessary.
+func LoadDynamicServiceTypeSupport(pkgName, srvName string) (*DynamicServiceTypeSupport, error) {
+	return loadDynamicService(newDynamicLib(), pkgName, "srv", srvName)
+}
+
+func loadDynamicService(lib *dynamicLib, pkgName, namespace, srvName string) (*DynamicServiceTypeSupport, error) {
+	var err error
+	s := &DynamicServiceTypeSupport{}
+	s.typeSupport, err = lib.load("rosidl_typesupport_c", "service", pkgName, namespace, srvName)
+	if err != nil {
+		return nil, err
+	}
+	s.request, err = loadDynamicMessage(lib, pkgName, namespace, srvName+"_Request")
+	if err != nil {
+		return nil, err
+	}
+	s.response, err = loadDynamicMessage(lib, pkgName, namespace, srvName+"_Response")
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *DynamicServiceTypeSupport) Request() types.MessageTypeSupport {
+	return s.request
+}
+
+func (s *DynamicServiceTypeSupport) Response() types.MessageTypeSupport {
+	return s.response
+}
+
+func (s *DynamicServiceTypeSupport) TypeSupport() unsafe.Pointer {
+	return s.typeSupport
+}
+
+// DynamicActionTypeSupport is an action type support loaded at runtime using
+// LoadDynamicActionTypeSupport. All messages of the action are
+// *DynamicMessage.
+type DynamicActionTypeSupport struct {
+	goal            *DynamicMessageTypeSupport
+	result          *DynamicMessageTypeSupport
+	feedback        *DynamicMessageTypeSupport
+	feedbackMessage *DynamicMessageTypeSupport
+	goalStatusArray *DynamicMessageTypeSupport
+	sendGoal        *DynamicServiceTypeSupport
+	getResult       *DynamicServiceTypeSupport
+	cancelGoal      *DynamicServiceTypeSupport
+	typeSupport     unsafe.Pointer // rosidl_action_type_support_t*
+}
+
+// LoadDynamicActionTypeSupport loads an action type support implementation
+// dynamically. The introspection type supports of the action type and of
+// package action_msgs must be installed.
+//
+// Backwards compatibility is not guaranteed for this API. Use it only if
+// necessary.
+func LoadDynamicActionTypeSupport(pkgName, actionName string) (*DynamicActionTypeSupport, error) {
+	var err error
+	lib := newDynamicLib()
+	a := &DynamicActionTypeSupport{}
+	a.typeSupport, err = lib.load("rosidl_typesupport_c", "action", pkgName, "action", actionName)
+	if err != nil {
+		return nil, err
+	}
+	messages := []struct {
+		dst       **DynamicMessageTypeSupport
+		pkg, name string
+		namespace string
+	}{
+		{&a.goal, pkgName, actionName + "_Goal", "action"},
+		{&a.result, pkgName, actionName + "_Result", "action"},
+		{&a.feedback, pkgName, actionName + "_Feedback", "action"},
+		{&a.feedbackMessage, pkgName, actionName + "_FeedbackMessage", "action"},
+		{&a.goalStatusArray, "action_msgs", "GoalStatusArray", "msg"},
+	}
+	for _, m := range messages {
+		if *m.dst, err = loadDynamicMessage(lib, m.pkg, m.namespace, m.name); err != nil {
+			return nil, err
+		}
+	}
+	if a.sendGoal, err = loadDynamicService(lib, pkgName, "action", actionName+"_SendGoal"); err != nil {
+		return nil, err
+	}
+	if a.getResult, err = loadDynamicService(lib, pkgName, "action", actionName+"_GetResult"); err != nil {
+		return nil, err
+	}
+	if a.cancelGoal, err = loadDynamicService(lib, "action_msgs", "srv", "CancelGoal"); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+func (a *DynamicActionTypeSupport) Goal() types.MessageTypeSupport {
+	return a.goal
+}
+
+func (a *DynamicActionTypeSupport) SendGoal() types.ServiceTypeSupport {
+	return a.sendGoal
+}
+
+func (a *DynamicActionTypeSupport) NewSendGoalResponse(accepted bool, stamp time.Duration) types.Message {
+	msg := a.sendGoal.response.New().(*DynamicMessage)
+	msg.mustSet("accepted", accepted)
+	if s, err := msg.Get("stamp"); err == nil {
+		s := s.(*DynamicMessage)
+		s.mustSet("sec", int32(stamp/time.Second))
+		s.mustSet("nanosec", uint32(stamp%time.Second))
+	}
+	return msg
+}
+
+func (a *DynamicActionTypeSupport) Result() types.MessageTypeSupport {
+	return a.result
+}
+
+func (a *DynamicActionTypeSupport) GetResult() types.ServiceTypeSupport {
+	return a.getResult
+}
+
+func (a *DynamicActionTypeSupport) NewGetResultResponse(status int8, result types.Message) types.Message {
+	msg := a.getResult.response.New().(*DynamicMessage)
+	msg.mustSet("status", status)
+	if result != nil {
+		msg.mustSet("result", result)
+	}
+	return msg
+}
+
+func (a *DynamicActionTypeSupport) CancelGoal() types.ServiceTypeSupport {
+	return a.cancelGoal
+}
+
+func (a *DynamicActionTypeSupport) Feedback() types.MessageTypeSupport {
+	return a.feedback
+}
+
+func (a *DynamicActionTypeSupport) FeedbackMessage() types.MessageTypeSupport {
+	return a.feedbackMessage
+}
+
+func (a *DynamicActionTypeSupport) NewFeedbackMessage(goalID *types.GoalID, feedback types.Message) types.Message {
+	msg := a.feedbackMessage.New().(*DynamicMessage)
+	msg.SetGoalID(goalID)
+	msg.mustSet("feedback", feedback)
+	return msg
+}
+
+func (a *DynamicActionTypeSupport) GoalStatusArray() types.MessageTypeSupport {
+	return a.goalStatusArray
+}
+
+func (a *DynamicActionTypeSupport) TypeSupport() unsafe.Pointer {
+	return a.typeSupport
+}
+
+// NewDynamicClient creates a new client for a service whose type is given by
+// name, for example "example_interfaces/srv/AddTwoInts". The type support of
+// the service is loaded using LoadDynamicServiceTypeSupport, and requests and
+// responses are *DynamicMessage.
+//
+// options must not be modified after passing it to this function. If options is
+// nil, default options are used.
+func (n *Node) NewDynamicClient(serviceName, typeName string, options *ClientOptions) (*Client, error) {
+	pkg, name, err := splitInterfaceName(typeName, "srv")
+	if err != nil {
+		return nil, err
+	}
+	ts, err := LoadDynamicServiceTypeSupport(pkg, name)
+	if err != nil {
+		return nil, err
+	}
+	return n.NewClient(serviceName, ts, options)
+}
+
+// NewDynamicService creates a new service whose type is given by name, for
+// example "example_interfaces/srv/AddTwoInts". The type support of the service
+// is loaded using LoadDynamicServiceTypeSupport, and requests and responses are
+// *DynamicMessage.
+//
+// options must not be modified after passing it to this function. If options is
+// nil, default options are used.
+func (n *Node) NewDynamicService(
+	name string,
+	typeName string,
+	options *ServiceOptions,
+	handler ServiceRequestHandler,
+) (*Service, error) {
+	pkg, srvName, err := splitInterfaceName(typeName, "srv")
+	if err != nil {
+		return nil, err
+	}
+	ts, err := LoadDynamicServiceTypeSupport(pkg, srvName)
+	if err != nil {
+		return nil, err
+	}
+	return n.NewService(name, ts, options, handler)
+}
+
+// NewDynamicActionClient creates an action client for an action whose type is
+// given by name, for example "example_interfaces/action/Fibonacci". The type
+// support of the action is loaded using LoadDynamicActionTypeSupport, and all
+// goals, results and feedback are *DynamicMessage.
+func (n *Node) NewDynamicActionClient(name, typeName string, opts *ActionClientOptions) (*ActionClient, error) {
+	pkg, actionName, err := splitInterfaceName(typeName, "action")
+	if err != nil {
+		return nil, err
+	}
+	ts, err := LoadDynamicActionTypeSupport(pkg, actionName)
+	if err != nil {
+		return nil, err
+	}
+	return n.NewActionClient(name, ts, opts)
+}
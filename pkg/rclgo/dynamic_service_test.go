@@ -0,0 +1,146 @@
+package rclgo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey" //nolint:revive
+	example_interfaces_srv "github.com/tiiuae/rclgo/internal/msgs/example_interfaces/srv"
+	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/types"
+)
+
+func TestDynamicServicesAndActions(t *testing.T) {
+	var (
+		rclctx          *rclgo.Context
+		node            *rclgo.Node
+		dynClient       *rclgo.Client
+		generatedClient *rclgo.Client
+		actionClient    *rclgo.ActionClient
+		err             error
+
+		spinCtx, cancelSpin = context.WithCancel(context.Background())
+		spinErr             = make(chan error, 1)
+		qosProfile          = rclgo.NewDefaultServiceQosProfile()
+	)
+	qosProfile.History = rclgo.HistoryKeepAll
+	defer func() {
+		cancelSpin()
+		if rclctx != nil {
+			rclctx.Close()
+		}
+	}()
+	Convey("Scenario: services and actions are used by type name", t, func() {
+		Convey("Given a node with generated and dynamic services and an action server", func() {
+			rclctx, err = newDefaultRCLContext()
+			So(err, ShouldBeNil)
+			node, err = rclctx.NewNode("dynamic_node", "/test")
+			So(err, ShouldBeNil)
+			_, err = node.NewService(
+				"generated_add",
+				example_interfaces_srv.AddTwoIntsTypeSupport,
+				&rclgo.ServiceOptions{Qos: qosProfile},
+				func(_ *rclgo.ServiceInfo, msg types.Message, sender rclgo.ServiceResponseSender) {
+					req := msg.(*example_interfaces_srv.AddTwoInts_Request)
+					resp := example_interfaces_srv.NewAddTwoInts_Response()
+					resp.Sum = req.A + req.B
+					sender.SendResponse(resp) //nolint:errcheck
+				},
+			)
+			So(err, ShouldBeNil)
+			var dynService *rclgo.Service
+			dynService, err = node.NewDynamicService(
+				"dynamic_add",
+				"example_interfaces/srv/AddTwoInts",
+				&rclgo.ServiceOptions{Qos: qosProfile},
+				func(_ *rclgo.ServiceInfo, msg types.Message, sender rclgo.ServiceResponseSender) {
+					req := msg.(*rclgo.DynamicMessage)
+					a, _ := req.Get("a")
+					b, _ := req.Get("b")
+					resp := dynService.TypeSupport().Response().New().(*rclgo.DynamicMessage)
+					if err := resp.Set("sum", a.(int64)+b.(int64)); err != nil {
+						panic(err)
+					}
+					sender.SendResponse(resp) //nolint:errcheck
+				},
+			)
+			So(err, ShouldBeNil)
+			action := &fibonacciAction{continueChan: make(chan struct{})}
+			close(action.continueChan)
+			_, err = node.NewActionServer("fibonacci", action, actionServerOpts)
+			So(err, ShouldBeNil)
+		})
+		Convey("And clients created by type name", func() {
+			dynClient, err = node.NewDynamicClient(
+				"generated_add",
+				"example_interfaces/srv/AddTwoInts",
+				&rclgo.ClientOptions{Qos: qosProfile},
+			)
+			So(err, ShouldBeNil)
+			generatedClient, err = node.NewClient(
+				"dynamic_add",
+				example_interfaces_srv.AddTwoIntsTypeSupport,
+				&rclgo.ClientOptions{Qos: qosProfile},
+			)
+			So(err, ShouldBeNil)
+			actionClient, err = node.NewDynamicActionClient("fibonacci", "test_msgs/action/Fibonacci", actionClientOpts)
+			So(err, ShouldBeNil)
+			go func() { spinErr <- rclctx.Spin(spinCtx) }()
+		})
+		Convey("Invalid type names are rejected", func() {
+			_, err := node.NewDynamicClient("generated_add", "example_interfaces/msg/AddTwoInts", nil)
+			So(err, ShouldNotBeNil)
+			_, err = node.NewDynamicActionClient("fibonacci", "test_msgs/srv/Fibonacci", nil)
+			So(err, ShouldNotBeNil)
+		})
+		Convey("A dynamic client calls a generated service", func() {
+			req := dynClient.TypeSupport().Request().New().(*rclgo.DynamicMessage)
+			So(req.Set("a", int64(2)), ShouldBeNil)
+			So(req.Set("b", int64(40)), ShouldBeNil)
+			ctx, cancel := context.WithTimeout(spinCtx, 5*time.Second)
+			defer cancel()
+			resp, _, err := dynClient.Send(ctx, req)
+			So(err, ShouldBeNil)
+			sum, err := resp.(*rclgo.DynamicMessage).Get("sum")
+			So(err, ShouldBeNil)
+			So(sum, ShouldEqual, int64(42))
+		})
+		Convey("A generated client calls a dynamic service", func() {
+			req := example_interfaces_srv.NewAddTwoInts_Request()
+			req.A = 3
+			req.B = 4
+			ctx, cancel := context.WithTimeout(spinCtx, 5*time.Second)
+			defer cancel()
+			resp, _, err := generatedClient.Send(ctx, req)
+			So(err, ShouldBeNil)
+			So(resp.(*example_interfaces_srv.AddTwoInts_Response).Sum, ShouldEqual, 7)
+		})
+		Convey("A dynamic action client sends a goal to a generated action server", func() {
+			goal := actionClient.TypeSupport().Goal().New().(*rclgo.DynamicMessage)
+			So(goal.Set("order", int32(5)), ShouldBeNil)
+			feedbacks := make(chan types.Message, 10)
+			ctx, cancel := context.WithTimeout(spinCtx, 5*time.Second)
+			defer cancel()
+			result, goalID, err := actionClient.WatchGoal(ctx, goal, func(_ context.Context, msg types.Message) {
+				feedbacks <- msg
+			})
+			So(err, ShouldBeNil)
+			So(goalID, ShouldNotBeNil)
+			status, err := result.(*rclgo.DynamicMessage).Get("status")
+			So(err, ShouldBeNil)
+			So(status, ShouldEqual, int8(rclgo.GoalSucceeded))
+			res, err := result.(*rclgo.DynamicMessage).Get("result")
+			So(err, ShouldBeNil)
+			seq, err := res.(*rclgo.DynamicMessage).Get("sequence")
+			So(err, ShouldBeNil)
+			So(seq, ShouldResemble, []int32{0, 1, 1, 2, 3})
+			fb := <-feedbacks
+			So(fb.(*rclgo.DynamicMessage).GetGoalID(), ShouldResemble, goalID)
+		})
+		Convey("Spinning stops when the context is canceled", func() {
+			cancelSpin()
+			So(<-spinErr, shouldContainError, context.Canceled)
+		})
+	})
+}
@@ -0,0 +1,301 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package rclgo
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/tiiuae/rclgo/pkg/rclgo/types"
+)
+
+// ErrInvalidFieldPath is wrapped by the errors returned by GetField and
+// SetField when a field path is malformed or refers to an element which does
+// not exist.
+var ErrInvalidFieldPath = errors.New("invalid field path")
+
+// fieldPathElem is a single element of a field path, such as "poses[3]".
+type fieldPathElem struct {
+	name  string
+	index int // -1 if the element is not indexed
+}
+
+func (e fieldPathElem) String() string {
+	return fieldPath(e.name, e.index)
+}
+
+// parseFieldPath parses a field path of the form "a.b[3].c".
+func parseFieldPath(path string) ([]fieldPathElem, error) {
+	if path == "" {
+		return nil, fmt.Errorf("%w: empty path", ErrInvalidFieldPath)
+	}
+	parts := strings.Split(path, ".")
+	elems := make([]fieldPathElem, len(parts))
+	for i, part := range parts {
+		elem := fieldPathElem{name: part, index: -1}
+		if j := strings.IndexByte(part, '['); j >= 0 {
+			if !strings.HasSuffix(part, "]") {
+				return nil, fmt.Errorf("%w: %q: missing ]", ErrInvalidFieldPath, path)
+			}
+			n, err := strconv.Atoi(part[j+1 : len(part)-1])
+			if err != nil || n < 0 {
+				return nil, fmt.Errorf("%w: %q: invalid index in %q", ErrInvalidFieldPath, path, part)
+			}
+			elem.name, elem.index = part[:j], n
+		}
+		if elem.name == "" {
+			return nil, fmt.Errorf("%w: %q: empty field name", ErrInvalidFieldPath, path)
+		}
+		elems[i] = elem
+	}
+	return elems, nil
+}
+
+// GetField returns the value of the field of msg at path, which consists of
+// ROS field names separated by dots and optional element indices, for example
+// "header.stamp.sec" or "poses[3].position.x".
+//
+// msg must be a generated message or a *DynamicMessage. Fields of generated
+// messages are accessed using the descriptor returned by the type support of
+// the message, see types.FieldDescriber. Nested messages of generated messages
+// are returned as values, not pointers. Slices are not copied.
+func GetField(msg types.Message, path string) (interface{}, error) {
+	elems, err := parseFieldPath(path)
+	if err != nil {
+		return nil, err
+	}
+	if dm, ok := msg.(*DynamicMessage); ok {
+		return getDynamicField(dm, elems)
+	}
+	v, _, err := lookupField(msg, elems)
+	if err != nil {
+		return nil, err
+	}
+	return v.Interface(), nil
+}
+
+// SetField sets the value of the field of msg at path. See GetField for the
+// syntax of path.
+//
+// Numeric values are converted to the type of the field if they can be
+// represented exactly in it, except that conversions to floating point types
+// may lose precision. Nested messages may be passed as values or pointers.
+// The lengths of bounded sequences and strings are checked, and an error
+// wrapping ErrBoundExceeded is returned if they are too long. Elements of
+// sequences must exist before they can be set.
+func SetField(msg types.Message, path string, value interface{}) error {
+	elems, err := parseFieldPath(path)
+	if err != nil {
+		return err
+	}
+	if dm, ok := msg.(*DynamicMessage); ok {
+		return setDynamicField(dm, elems, value)
+	}
+	v, f, err := lookupField(msg, elems)
+	if err != nil {
+		return err
+	}
+	last := elems[len(elems)-1]
+	src, err := convertFieldValue(v.Type(), value)
+	if err != nil {
+		return fmt.Errorf("field %s: %w", path, err)
+	}
+	if err := checkFieldBounds(f, last.index >= 0, src); err != nil {
+		return &ValidationError{Field: path, Err: err}
+	}
+	v.Set(src)
+	return nil
+}
+
+// lookupField returns the field of msg at the path consisting of elems, and
+// the descriptor of the last field of the path.
+func lookupField(msg types.Message, elems []fieldPathElem) (reflect.Value, *types.FieldDescriptor, error) {
+	ts, ok := msg.GetTypeSupport().(types.FieldDescriber)
+	if !ok {
+		return reflect.Value{}, nil, fmt.Errorf("message type %T has no field descriptor", msg)
+	}
+	v := reflect.ValueOf(msg)
+	if v.Kind() != reflect.Pointer || v.IsNil() {
+		return reflect.Value{}, nil, fmt.Errorf("message must be a non-nil pointer, got %T", msg)
+	}
+	v = v.Elem()
+	desc := ts.Descriptor()
+	var f *types.FieldDescriptor
+	for i, elem := range elems {
+		if desc == nil {
+			return reflect.Value{}, nil, fmt.Errorf(
+				"%w: field %s is not a message", ErrInvalidFieldPath, elems[i-1],
+			)
+		}
+		f, ok = desc.Field(elem.name)
+		if !ok {
+			return reflect.Value{}, nil, fmt.Errorf("%w: %s in %s", ErrUnknownField, elem.name, desc.TypeName)
+		}
+		v = v.Field(f.GoIndex)
+		if elem.index >= 0 {
+			if !f.IsArray {
+				return reflect.Value{}, nil, fmt.Errorf("%w: field %s is not an array", ErrInvalidFieldPath, elem.name)
+			}
+			if elem.index >= v.Len() {
+				return reflect.Value{}, nil, fmt.Errorf(
+					"%w: index %d of field %s is out of range with length %d",
+					ErrInvalidFieldPath, elem.index, elem.name, v.Len(),
+				)
+			}
+			v = v.Index(elem.index)
+		}
+		desc = nil
+		if elem.index >= 0 || !f.IsArray {
+			desc = f.Message
+		}
+	}
+	return v, f, nil
+}
+
+func isNumericKind(k reflect.Kind) bool {
+	return reflect.Int <= k && k <= reflect.Float64
+}
+
+// convertFieldValue converts value to type t as documented in SetField.
+func convertFieldValue(t reflect.Type, value interface{}) (reflect.Value, error) {
+	src := reflect.ValueOf(value)
+	switch {
+	case !src.IsValid():
+		return src, errors.New("value must not be nil")
+	case src.Type().AssignableTo(t):
+		return src, nil
+	case src.Kind() == reflect.Pointer && src.Type().Elem().AssignableTo(t):
+		if src.IsNil() {
+			return src, errors.New("value must not be nil")
+		}
+		return src.Elem(), nil
+	case isNumericKind(src.Kind()) && isNumericKind(t.Kind()):
+		dst := src.Convert(t)
+		isFloat := t.Kind() == reflect.Float32 || t.Kind() == reflect.Float64
+		if !isFloat && dst.Convert(src.Type()).Interface() != src.Interface() {
+			return src, fmt.Errorf("value %v cannot be represented as %v", value, t)
+		}
+		return dst, nil
+	case src.Kind() == reflect.String && t.Kind() == reflect.String:
+		return src.Convert(t), nil
+	}
+	return src, fmt.Errorf("cannot assign a value of type %T to a field of type %v", value, t)
+}
+
+// checkFieldBounds checks that value, which is assigned to field f or to an
+// element of f if isElem is true, does not exceed the bounds of f.
+func checkFieldBounds(f *types.FieldDescriptor, isElem bool, value reflect.Value) error {
+	if f.IsUpperBound && !isElem && value.Len() > f.ArraySize {
+		return fmt.Errorf("%w: length %d is greater than %d", ErrBoundExceeded, value.Len(), f.ArraySize)
+	}
+	if f.StringUpperBound == 0 {
+		return nil
+	}
+	check := func(s string) error {
+		n := len(s)
+		if f.Type == "wstring" {
+			n = len(utf16.Encode([]rune(s)))
+		}
+		if n > f.StringUpperBound {
+			return fmt.Errorf("%w: length %d is greater than %d", ErrBoundExceeded, n, f.StringUpperBound)
+		}
+		return nil
+	}
+	if f.IsArray && !isElem {
+		for i := 0; i < value.Len(); i++ {
+			if err := check(value.Index(i).String()); err != nil {
+				return fmt.Errorf("index %d: %w", i, err)
+			}
+		}
+		return nil
+	}
+	return check(value.String())
+}
+
+// lookupDynamicField returns the message containing the last field of the path
+// consisting of elems.
+func lookupDynamicField(msg *DynamicMessage, elems []fieldPathElem) (*DynamicMessage, error) {
+	for _, elem := range elems[:len(elems)-1] {
+		v, err := getDynamicElem(msg, elem)
+		if err != nil {
+			return nil, err
+		}
+		nested, ok := v.(*DynamicMessage)
+		if !ok {
+			return nil, fmt.Errorf("%w: field %s is not a message", ErrInvalidFieldPath, elem)
+		}
+		msg = nested
+	}
+	return msg, nil
+}
+
+func getDynamicElem(msg *DynamicMessage, elem fieldPathElem) (interface{}, error) {
+	v, err := msg.Get(elem.name)
+	if err != nil || elem.index < 0 {
+		return v, err
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice {
+		return nil, fmt.Errorf("%w: field %s is not an array", ErrInvalidFieldPath, elem.name)
+	}
+	if elem.index >= rv.Len() {
+		return nil, fmt.Errorf(
+			"%w: index %d of field %s is out of range with length %d",
+			ErrInvalidFieldPath, elem.index, elem.name, rv.Len(),
+		)
+	}
+	return rv.Index(elem.index).Interface(), nil
+}
+
+func getDynamicField(msg *DynamicMessage, elems []fieldPathElem) (interface{}, error) {
+	msg, err := lookupDynamicField(msg, elems)
+	if err != nil {
+		return nil, err
+	}
+	return getDynamicElem(msg, elems[len(elems)-1])
+}
+
+func setDynamicField(msg *DynamicMessage, elems []fieldPathElem, value interface{}) error {
+	msg, err := lookupDynamicField(msg, elems)
+	if err != nil {
+		return err
+	}
+	last := elems[len(elems)-1]
+	f, ok := msg.ts.Field(last.name)
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownField, last.name)
+	}
+	if last.index < 0 {
+		if f.IsArray {
+			return msg.Set(last.name, value)
+		}
+		src, err := convertFieldValue(f.goType, value)
+		if err != nil {
+			return fmt.Errorf("field %s: %w", last.name, err)
+		}
+		return msg.Set(last.name, src.Interface())
+	}
+	if _, err := getDynamicElem(msg, last); err != nil {
+		return err
+	}
+	src, err := convertFieldValue(f.goType, value)
+	if err != nil {
+		return fmt.Errorf("field %s: %w", last, err)
+	}
+	if err := f.validateElem(src); err != nil {
+		return err
+	}
+	reflect.ValueOf(msg.values[msg.ts.fieldIndex[last.name]]).Index(last.index).Set(src)
+	return nil
+}
@@ -17,6 +17,7 @@ package rclgo
 #cgo CFLAGS: "-I/usr/include/rmw"
 #cgo CFLAGS: "-I/usr/include/rosidl_runtime_c"
 #cgo CFLAGS: "-I/usr/include/rosidl_typesupport_interface"
+#cgo CFLAGS: "-I/usr/include/rosidl_typesupport_introspection_c"
 #cgo CFLAGS: "-I/usr/include/rcutils"
 #cgo CFLAGS: "-I/usr/include/rcl_action"
 #cgo CFLAGS: "-I/usr/include/action_msgs"
@@ -29,6 +30,7 @@ package rclgo
 #cgo CFLAGS: "-I/opt/ros/humble/include/rmw"
 #cgo CFLAGS: "-I/opt/ros/humble/include/rosidl_runtime_c"
 #cgo CFLAGS: "-I/opt/ros/humble/include/rosidl_typesupport_interface"
+#cgo CFLAGS: "-I/opt/ros/humble/include/rosidl_typesupport_introspection_c"
 #cgo CFLAGS: "-I/opt/ros/humble/include/rcutils"
 #cgo CFLAGS: "-I/opt/ros/humble/include/rcl_action"
 #cgo CFLAGS: "-I/opt/ros/humble/include/action_msgs"
@@ -0,0 +1,294 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package rclgo
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/tiiuae/rclgo/pkg/rclgo/types"
+)
+
+// DefaultDiscoveryInterval is the default interval at which a
+// GenericSubscription looks for new types of its topic while spinning.
+const DefaultDiscoveryInterval = 100 * time.Millisecond
+
+// GenericMessage is a serialized message received by a GenericSubscription.
+type GenericMessage struct {
+	// TypeName is the type of the message, for example "std_msgs/msg/String".
+	TypeName string
+	// TypeSupport is the type support of TypeName. It can be used to
+	// deserialize Data using Deserialize.
+	TypeSupport types.MessageTypeSupport
+	// Data is the serialized message.
+	Data []byte
+	// Info contains metadata of the message.
+	Info *MessageInfo
+}
+
+// GenericSubscriptionCallback is called for every message received by a
+// GenericSubscription.
+type GenericSubscriptionCallback func(*GenericMessage)
+
+// GenericSubscriptionOptions contains options for a GenericSubscription.
+type GenericSubscriptionOptions struct {
+	// Qos is the QoS profile of the subscriptions. It is ignored if AdaptQos
+	// is true.
+	Qos QosProfile
+	// AdaptQos selects the QoS profile of each subscription using
+	// AdaptQosProfile based on the publishers of the topic. If AdaptQos is
+	// true, a type is subscribed to only after a publisher of the type has
+	// been discovered.
+	AdaptQos bool
+	// DiscoveryInterval is the interval at which the ROS graph is checked for
+	// new types of the topic in Spin. If it is not positive,
+	// DefaultDiscoveryInterval is used.
+	DiscoveryInterval time.Duration
+	// OnSubscribe is called before subscribing to a new type with the type
+	// name and the publishers of the type. If OnSubscribe returns an error, the
+	// type is not subscribed to and subscribing is retried on the next
+	// discovery. OnSubscribe may be nil.
+	OnSubscribe func(typeName string, publishers []TopicEndpointInfo) error
+}
+
+// NewDefaultGenericSubscriptionOptions returns the default options of a
+// GenericSubscription.
+func NewDefaultGenericSubscriptionOptions() *GenericSubscriptionOptions {
+	return &GenericSubscriptionOptions{
+		Qos:               NewDefaultQosProfile(),
+		DiscoveryInterval: DefaultDiscoveryInterval,
+	}
+}
+
+// GenericSubscription receives serialized messages from a topic whose type is
+// discovered at runtime using the ROS graph. The topic may appear after the
+// subscription is created, and it may have multiple types, in which case a
+// separate Subscription is created for each type.
+//
+// Type supports are loaded using LoadMessageTypeSupport. Types whose type
+// support can't be loaded are ignored.
+//
+// Types are discovered and messages are received while spinning the
+// subscription using Spin. Because
+// new Subscriptions are created while spinning, the Subscriptions of a
+// GenericSubscription should not be spun using other wait sets, such as the
+// ones used by Node.Spin and Context.Spin. If the subscriptions are spun using
+// a custom wait set, Discover or Subscribe must be called to find new types and
+// the wait set must be recreated to include the new Subscriptions.
+type GenericSubscription struct {
+	TopicName string
+
+	node     *Node
+	opts     GenericSubscriptionOptions
+	callback GenericSubscriptionCallback
+
+	mutex  sync.Mutex
+	subs   map[string]*Subscription
+	failed map[string]bool
+	closed bool
+}
+
+// NewGenericSubscription creates a subscription to topicName, whose type is
+// discovered at runtime. Received messages are passed to callback. The topic
+// name is expanded using the name and the namespace of n.
+//
+// options must not be modified after passing it to this function. If options is
+// nil, default options are used.
+func (n *Node) NewGenericSubscription(
+	topicName string,
+	options *GenericSubscriptionOptions,
+	callback GenericSubscriptionCallback,
+) (*GenericSubscription, error) {
+	if options == nil {
+		options = NewDefaultGenericSubscriptionOptions()
+	}
+	topicName, err := ExpandTopicName(topicName, n.Name(), n.Namespace(), nil)
+	if err != nil {
+		return nil, err
+	}
+	s := &GenericSubscription{
+		TopicName: topicName,
+		node:      n,
+		opts:      *options,
+		callback:  callback,
+		subs:      make(map[string]*Subscription),
+		failed:    make(map[string]bool),
+	}
+	if s.opts.DiscoveryInterval <= 0 {
+		s.opts.DiscoveryInterval = DefaultDiscoveryInterval
+	}
+	return s, nil
+}
+
+// Node returns the node s belongs to.
+func (s *GenericSubscription) Node() *Node {
+	return s.node
+}
+
+// Types returns the sorted names of the types s is subscribed to.
+func (s *GenericSubscription) Types() []string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	typeNames := make([]string, 0, len(s.subs))
+	for typeName := range s.subs {
+		typeNames = append(typeNames, typeName)
+	}
+	sort.Strings(typeNames)
+	return typeNames
+}
+
+// Subscriptions returns the Subscriptions created by s so far.
+func (s *GenericSubscription) Subscriptions() []*Subscription {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	subs := make([]*Subscription, 0, len(s.subs))
+	for _, sub := range s.subs {
+		subs = append(subs, sub)
+	}
+	return subs
+}
+
+// Discover looks up the types of the topic from the ROS graph and subscribes to
+// the types not subscribed to yet.
+func (s *GenericSubscription) Discover() error {
+	topics, err := s.node.GetTopicNamesAndTypes(true)
+	if err != nil {
+		return err
+	}
+	for _, typeName := range topics[s.TopicName] {
+		if err := s.Subscribe(typeName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Subscribe subscribes to type typeName of the topic if s is not already
+// subscribed to it. Failing to load the type support is not considered an
+// error, and a warning is logged instead.
+func (s *GenericSubscription) Subscribe(typeName string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.closed {
+		return closeErr("generic subscription")
+	}
+	if s.subs[typeName] != nil || s.failed[typeName] {
+		return nil
+	}
+	ts, err := LoadMessageTypeSupport(typeName)
+	if err != nil {
+		s.failed[typeName] = true
+		s.node.Logger().Warnf("ignoring type %s of topic %s: %v", typeName, s.TopicName, err) //nolint:errcheck
+		return nil
+	}
+	allPubs, err := s.node.GetPublishersInfoByTopic(s.TopicName, false)
+	if err != nil {
+		return err
+	}
+	var pubs []TopicEndpointInfo
+	for _, p := range allPubs {
+		if p.TopicType == typeName {
+			pubs = append(pubs, p)
+		}
+	}
+	opts := NewDefaultSubscriptionOptions()
+	opts.Qos = s.opts.Qos
+	if s.opts.AdaptQos {
+		if len(pubs) == 0 {
+			// Wait for publishers so that the QoS can be adapted to them.
+			return nil
+		}
+		opts.Qos = AdaptQosProfile(pubs)
+	}
+	if s.opts.OnSubscribe != nil {
+		if err := s.opts.OnSubscribe(typeName, pubs); err != nil {
+			s.node.Logger().Warnf("not subscribing to type %s of topic %s: %v", typeName, s.TopicName, err) //nolint:errcheck
+			return nil
+		}
+	}
+	sub, err := s.node.NewSubscription(s.TopicName, ts, opts, func(sub *Subscription) {
+		s.receive(sub, typeName)
+	})
+	if err != nil {
+		return err
+	}
+	s.subs[typeName] = sub
+	return nil
+}
+
+func (s *GenericSubscription) receive(sub *Subscription, typeName string) {
+	data, info, err := sub.TakeSerializedMessage()
+	if err != nil {
+		var takeFailed *SubscriptionTakeFailed
+		if !errors.As(err, &takeFailed) {
+			s.node.Logger().Debug(err)
+		}
+		return
+	}
+	s.callback(&GenericMessage{
+		TypeName:    typeName,
+		TypeSupport: sub.Ros2MsgType,
+		Data:        data,
+		Info:        info,
+	})
+}
+
+// Spin receives messages and discovers new types of the topic until ctx is
+// canceled or an error occurs.
+func (s *GenericSubscription) Spin(ctx context.Context) error {
+	for {
+		if err := s.Discover(); err != nil {
+			return err
+		}
+		if err := s.node.Context().SpinSubscriptionsFor(ctx, s.opts.DiscoveryInterval, s.Subscriptions()...); err != nil {
+			return err
+		}
+	}
+}
+
+// SpinSubscriptionsFor spins subs using a new wait set until timeout has
+// elapsed, ctx is canceled or an error occurs. Reaching the timeout is not
+// considered an error. SpinSubscriptionsFor is useful when subscriptions are
+// created while spinning, because the subscriptions of a wait set can't be
+// changed while it is running.
+func (c *Context) SpinSubscriptionsFor(ctx context.Context, timeout time.Duration, subs ...*Subscription) error {
+	ws, err := c.NewWaitSet()
+	if err != nil {
+		return err
+	}
+	defer ws.Close()
+	ws.AddSubscriptions(subs...)
+	runCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	err = ws.Run(runCtx)
+	if ctx.Err() == nil && errors.Is(err, context.DeadlineExceeded) {
+		return nil
+	}
+	return err
+}
+
+// Close closes the Subscriptions of s.
+func (s *GenericSubscription) Close() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.closed {
+		return closeErr("generic subscription")
+	}
+	s.closed = true
+	var err error
+	for typeName, sub := range s.subs {
+		err = errors.Join(err, sub.Close())
+		delete(s.subs, typeName)
+	}
+	return err
+}
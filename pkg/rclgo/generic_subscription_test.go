@@ -0,0 +1,74 @@
+package rclgo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey" //nolint:revive
+	std_msgs "github.com/tiiuae/rclgo/internal/msgs/std_msgs/msg"
+	"github.com/tiiuae/rclgo/pkg/rclgo"
+)
+
+func TestGenericSubscription(t *testing.T) {
+	var (
+		rclCtx   *rclgo.Context
+		node     *rclgo.Node
+		sub      *rclgo.GenericSubscription
+		pub      *rclgo.Publisher
+		received = make(chan *rclgo.GenericMessage, 10)
+
+		spinCtx, cancelSpin = context.WithCancel(context.Background())
+		spinErr             = make(chan error, 1)
+	)
+	defer func() {
+		cancelSpin()
+		if rclCtx != nil {
+			rclCtx.Close()
+		}
+	}()
+	Convey("Scenario: a generic subscription discovers the type of its topic", t, func() {
+		Convey("Given a generic subscription to a topic without publishers", func() {
+			var err error
+			rclCtx, err = newDefaultRCLContext()
+			So(err, ShouldBeNil)
+			node, err = rclCtx.NewNode("generic_sub", "/test")
+			So(err, ShouldBeNil)
+			opts := rclgo.NewDefaultGenericSubscriptionOptions()
+			opts.Qos = reliableQos
+			sub, err = node.NewGenericSubscription("generic_topic", opts, func(msg *rclgo.GenericMessage) {
+				received <- msg
+			})
+			So(err, ShouldBeNil)
+			So(sub.TopicName, ShouldEqual, "/test/generic_topic")
+			go func() { spinErr <- sub.Spin(spinCtx) }()
+			time.Sleep(200 * time.Millisecond)
+			So(sub.Types(), ShouldBeEmpty)
+		})
+		Convey("When a publisher appears and publishes a message", func() {
+			opts := rclgo.NewDefaultPublisherOptions()
+			opts.Qos = reliableQos
+			var err error
+			pub, err = node.NewPublisher("/test/generic_topic", std_msgs.StringTypeSupport, opts)
+			So(err, ShouldBeNil)
+			publishString(pub, "hello")
+		})
+		Convey("Then the message is received with its type", func() {
+			var msg *rclgo.GenericMessage
+			timeOut(2000, func() { msg = <-received }, "Generic subscription waiting for messages")
+			So(msg.TypeName, ShouldEqual, "std_msgs/msg/String")
+			So(msg.Info, ShouldNotBeNil)
+			So(msg.TypeSupport, ShouldNotBeNil)
+			decoded, err := rclgo.Deserialize(msg.Data, std_msgs.StringTypeSupport)
+			So(err, ShouldBeNil)
+			So(decoded.(*std_msgs.String).Data, ShouldEqual, "hello")
+			So(sub.Types(), ShouldResemble, []string{"std_msgs/msg/String"})
+		})
+		Convey("Spinning stops when the context is canceled", func() {
+			cancelSpin()
+			So(<-spinErr, shouldContainError, context.Canceled)
+			So(sub.Close(), ShouldBeNil)
+			So(sub.Subscribe("std_msgs/msg/String"), ShouldNotBeNil)
+		})
+	})
+}
@@ -0,0 +1,410 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package rclgo
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
+	"github.com/tiiuae/rclgo/pkg/rclgo/types"
+)
+
+// messageField is a field of a message value being encoded or decoded.
+type messageField struct {
+	name  string
+	value reflect.Value
+}
+
+var dynamicMessageType = reflect.TypeOf((*DynamicMessage)(nil))
+
+// messageFields returns the fields of v in definition order if v is a message,
+// that is, a generated message struct, a pointer to one or a *DynamicMessage.
+// Field names are the names used in the ROS interface definition, which
+// generated structs store in their yaml tags.
+func messageFields(v reflect.Value) ([]messageField, bool) {
+	if v.Type() == dynamicMessageType {
+		m := v.Interface().(*DynamicMessage)
+		if m == nil {
+			return nil, true
+		}
+		fields := make([]messageField, len(m.ts.fields))
+		for i := range fields {
+			fields[i] = messageField{m.ts.fields[i].Name, reflect.ValueOf(m.values[i])}
+		}
+		return fields, true
+	}
+	if v.Kind() == reflect.Pointer {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil, false
+	}
+	t := v.Type()
+	fields := make([]messageField, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		if sf := t.Field(i); sf.IsExported() {
+			fields = append(fields, messageField{rosFieldName(sf), v.Field(i)})
+		}
+	}
+	return fields, true
+}
+
+func rosFieldName(f reflect.StructField) string {
+	if name, _, _ := strings.Cut(f.Tag.Get("yaml"), ","); name != "" {
+		return name
+	}
+	return f.Name
+}
+
+// MarshalJSON encodes msg as a JSON object whose keys are the field names of
+// the ROS interface definition. msg must be a generated message or a
+// *DynamicMessage.
+//
+// Fields are encoded as follows:
+//
+//   - nested messages, including builtin_interfaces/Time and Duration, as
+//     objects, for example {"sec":1,"nanosec":500}
+//   - arrays and sequences of byte, char and uint8 as base64 strings
+//   - other arrays and sequences as JSON arrays
+//   - NaN and infinite floating point values as the strings "NaN",
+//     "Infinity" and "-Infinity"
+//   - other values as the corresponding JSON values
+func MarshalJSON(msg types.Message) ([]byte, error) {
+	var b bytes.Buffer
+	if err := encodeJSONValue(&b, reflect.ValueOf(msg)); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+func encodeJSONValue(b *bytes.Buffer, v reflect.Value) error {
+	if fields, ok := messageFields(v); ok {
+		b.WriteByte('{')
+		for i, f := range fields {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			writeJSONString(b, f.name)
+			b.WriteByte(':')
+			if err := encodeJSONValue(b, f.value); err != nil {
+				return fmt.Errorf("%s: %w", f.name, err)
+			}
+		}
+		b.WriteByte('}')
+		return nil
+	}
+	switch v.Kind() {
+	case reflect.Bool:
+		b.WriteString(strconv.FormatBool(v.Bool()))
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		b.WriteString(strconv.FormatInt(v.Int(), 10))
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		b.WriteString(strconv.FormatUint(v.Uint(), 10))
+	case reflect.Float32, reflect.Float64:
+		return writeJSONFloat(b, v)
+	case reflect.String:
+		writeJSONString(b, v.String())
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			buf := make([]byte, v.Len())
+			reflect.Copy(reflect.ValueOf(buf), v)
+			writeJSONString(b, base64.StdEncoding.EncodeToString(buf))
+			return nil
+		}
+		b.WriteByte('[')
+		for i := 0; i < v.Len(); i++ {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			if err := encodeJSONValue(b, v.Index(i)); err != nil {
+				return fmt.Errorf("index %d: %w", i, err)
+			}
+		}
+		b.WriteByte(']')
+	default:
+		return fmt.Errorf("unsupported type %v", v.Type())
+	}
+	return nil
+}
+
+func writeJSONString(b *bytes.Buffer, s string) {
+	data, _ := json.Marshal(s)
+	b.Write(data)
+}
+
+func writeJSONFloat(b *bytes.Buffer, v reflect.Value) error {
+	f := v.Float()
+	switch {
+	case math.IsNaN(f):
+		b.WriteString(`"NaN"`)
+	case math.IsInf(f, 1):
+		b.WriteString(`"Infinity"`)
+	case math.IsInf(f, -1):
+		b.WriteString(`"-Infinity"`)
+	default:
+		data, err := json.Marshal(v.Interface())
+		if err != nil {
+			return err
+		}
+		b.Write(data)
+	}
+	return nil
+}
+
+// UnmarshalJSON decodes JSON encoded by MarshalJSON to msg, which must be a
+// pointer to a generated message or a *DynamicMessage. Fields missing from
+// data are left unchanged, so decoding to a message created using New or
+// SetDefaults preserves the default values of missing fields. Arrays and
+// sequences of byte, char and uint8 may be encoded either as base64 strings
+// or as JSON arrays of integers. Unknown fields are reported using
+// ErrUnknownField.
+func UnmarshalJSON(data []byte, msg types.Message) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var src interface{}
+	if err := dec.Decode(&src); err != nil {
+		return err
+	}
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return errors.New("invalid JSON: unexpected data after top-level value")
+	}
+	v := reflect.ValueOf(msg)
+	if v.Kind() != reflect.Pointer || v.IsNil() {
+		return fmt.Errorf("cannot decode JSON to %T", msg)
+	}
+	if v.Type() == dynamicMessageType {
+		return decodeDynamicJSON(msg.(*DynamicMessage), src)
+	}
+	return decodeJSONValue(v.Elem(), src, nil)
+}
+
+// DecodeJSON decodes JSON encoded by MarshalJSON to a new message of type
+// typeName, for example "std_msgs/msg/String". The type must be registered in
+// package typemap, which means that its generated Go package must be imported
+// by the program. Fields missing from data have their default values.
+func DecodeJSON(typeName string, data []byte) (types.Message, error) {
+	ts, ok := typemap.GetMessage(typeName)
+	if !ok {
+		return nil, fmt.Errorf("message type %s is not registered in typemap", typeName)
+	}
+	msg := ts.New()
+	if err := UnmarshalJSON(data, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// decodeJSONValue decodes src, which is a value decoded by encoding/json
+// using UseNumber, to dst. dynType is used to create new values if dst is a
+// *DynamicMessage.
+func decodeJSONValue(dst reflect.Value, src interface{}, dynType *DynamicMessageTypeSupport) error {
+	if src == nil {
+		return nil
+	}
+	if dst.Type() == dynamicMessageType {
+		if dst.IsNil() {
+			dst.Set(reflect.ValueOf(dynType.New()))
+		}
+		return decodeDynamicJSON(dst.Interface().(*DynamicMessage), src)
+	}
+	switch dst.Kind() {
+	case reflect.Struct:
+		return decodeStructJSON(dst, src)
+	case reflect.Bool:
+		b, ok := src.(bool)
+		if !ok {
+			return jsonTypeError(src, dst)
+		}
+		dst.SetBool(b)
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, ok := src.(json.Number)
+		if !ok {
+			return jsonTypeError(src, dst)
+		}
+		i, err := strconv.ParseInt(n.String(), 10, dst.Type().Bits())
+		if err != nil {
+			return err
+		}
+		dst.SetInt(i)
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, ok := src.(json.Number)
+		if !ok {
+			return jsonTypeError(src, dst)
+		}
+		u, err := strconv.ParseUint(n.String(), 10, dst.Type().Bits())
+		if err != nil {
+			return err
+		}
+		dst.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := decodeJSONFloat(src, dst.Type().Bits())
+		if err != nil {
+			return err
+		}
+		dst.SetFloat(f)
+	case reflect.String:
+		s, ok := src.(string)
+		if !ok {
+			return jsonTypeError(src, dst)
+		}
+		dst.SetString(s)
+	case reflect.Slice, reflect.Array:
+		return decodeListJSON(dst, src, dynType)
+	default:
+		return fmt.Errorf("unsupported type %v", dst.Type())
+	}
+	return nil
+}
+
+func decodeStructJSON(dst reflect.Value, src interface{}) error {
+	obj, ok := src.(map[string]interface{})
+	if !ok {
+		return jsonTypeError(src, dst)
+	}
+	fields, _ := messageFields(dst)
+	for name, value := range obj {
+		i := indexOfField(fields, name)
+		if i < 0 {
+			return fmt.Errorf("%w: %s", ErrUnknownField, name)
+		}
+		if err := decodeJSONValue(fields[i].value, value, nil); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func indexOfField(fields []messageField, name string) int {
+	for i := range fields {
+		if fields[i].name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func decodeDynamicJSON(m *DynamicMessage, src interface{}) error {
+	obj, ok := src.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("cannot decode JSON %T to message %s", src, m.ts.Name())
+	}
+	for name, value := range obj {
+		i, ok := m.ts.fieldIndex[name]
+		if !ok {
+			return fmt.Errorf("%w: %s", ErrUnknownField, name)
+		}
+		if value == nil {
+			continue
+		}
+		f := &m.ts.fields[i]
+		v := reflect.New(f.valueType()).Elem()
+		if !f.IsArray {
+			// Decode nested messages and other single values on top of the
+			// current value to preserve the values of missing fields.
+			v.Set(reflect.ValueOf(m.values[i]))
+		}
+		if err := decodeJSONValue(v, value, f.Message); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		if err := m.Set(name, v.Interface()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func decodeListJSON(dst reflect.Value, src interface{}, dynType *DynamicMessageTypeSupport) error {
+	var items []interface{}
+	switch src := src.(type) {
+	case []interface{}:
+		items = src
+	case string:
+		if dst.Type().Elem().Kind() != reflect.Uint8 {
+			return jsonTypeError(src, dst)
+		}
+		data, err := base64.StdEncoding.DecodeString(src)
+		if err != nil {
+			return err
+		}
+		if err := setListLen(dst, len(data)); err != nil {
+			return err
+		}
+		reflect.Copy(dst, reflect.ValueOf(data))
+		return nil
+	default:
+		return jsonTypeError(src, dst)
+	}
+	if err := setListLen(dst, len(items)); err != nil {
+		return err
+	}
+	for i, item := range items {
+		if err := decodeJSONValue(dst.Index(i), item, dynType); err != nil {
+			return fmt.Errorf("index %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// setListLen replaces dst with a new slice of length n if dst is a slice, or
+// with nil if n is zero. If dst is an array, setListLen checks that its length
+// is n.
+func setListLen(dst reflect.Value, n int) error {
+	switch {
+	case dst.Kind() == reflect.Array:
+		if dst.Len() != n {
+			return fmt.Errorf("expected %d elements, got %d", dst.Len(), n)
+		}
+	case n == 0:
+		dst.Set(reflect.Zero(dst.Type()))
+	default:
+		dst.Set(reflect.MakeSlice(dst.Type(), n, n))
+	}
+	return nil
+}
+
+func decodeJSONFloat(src interface{}, bits int) (float64, error) {
+	switch src := src.(type) {
+	case json.Number:
+		return strconv.ParseFloat(src.String(), bits)
+	case string:
+		switch src {
+		case "NaN":
+			return math.NaN(), nil
+		case "Infinity":
+			return math.Inf(1), nil
+		case "-Infinity":
+			return math.Inf(-1), nil
+		}
+	}
+	return 0, fmt.Errorf("cannot decode JSON %v to a float%d", src, bits)
+}
+
+func jsonTypeError(src interface{}, dst reflect.Value) error {
+	return fmt.Errorf("cannot decode JSON %T to %v", src, dst.Type())
+}
+
+// MarshalJSON implements json.Marshaler using MarshalJSON.
+func (m *DynamicMessage) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(m)
+}
+
+// UnmarshalJSON implements json.Unmarshaler using UnmarshalJSON.
+func (m *DynamicMessage) UnmarshalJSON(data []byte) error {
+	return UnmarshalJSON(data, m)
+}
@@ -0,0 +1,95 @@
+package rclgo_test
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey" //nolint:revive
+	std_msgs "github.com/tiiuae/rclgo/internal/msgs/std_msgs/msg"
+	test_msgs "github.com/tiiuae/rclgo/internal/msgs/test_msgs/msg"
+	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"gopkg.in/yaml.v3"
+)
+
+func TestMessageEncoding(t *testing.T) {
+	Convey("Scenario: messages are encoded as JSON and YAML", t, func() {
+		Convey("Builtin types are encoded as objects using ROS field names", func() {
+			msg := test_msgs.NewBuiltins()
+			msg.TimeValue.Sec = 12
+			msg.TimeValue.Nanosec = 345
+			data, err := json.Marshal(msg)
+			So(err, ShouldBeNil)
+			So(string(data), ShouldEqual, `{"duration_value":{"sec":0,"nanosec":0},"time_value":{"sec":12,"nanosec":345}}`)
+		})
+		Convey("Messages are round-tripped through JSON", func() {
+			msg := test_msgs.NewUnboundedSequences()
+			msg.Uint8Values = []uint8{1, 2, 255}
+			msg.Float64Values = []float64{1.5, math.Inf(-1)}
+			msg.StringValues = []string{"a", ""}
+			msg.BasicTypesValues = []test_msgs.BasicTypes{{Int64Value: math.MinInt64, Uint64Value: math.MaxUint64}}
+			data, err := json.Marshal(msg)
+			So(err, ShouldBeNil)
+			decoded := test_msgs.NewUnboundedSequences()
+			So(json.Unmarshal(data, decoded), ShouldBeNil)
+			So(decoded, ShouldResemble, msg)
+		})
+		Convey("Byte sequences are decoded from base64 and from integer lists", func() {
+			msg := test_msgs.NewUnboundedSequences()
+			So(rclgo.UnmarshalJSON([]byte(`{"byte_values":"AQI=","uint8_values":[3,4]}`), msg), ShouldBeNil)
+			So(msg.ByteValues, ShouldResemble, []byte{1, 2})
+			So(msg.Uint8Values, ShouldResemble, []uint8{3, 4})
+			So(rclgo.UnmarshalJSON([]byte(`{"uint8_values":[256]}`), msg), ShouldNotBeNil)
+		})
+		Convey("Messages are decoded by type name with defaults for missing fields", func() {
+			msg, err := rclgo.DecodeJSON("test_msgs/msg/Defaults", []byte(`{"int32_value":7}`))
+			So(err, ShouldBeNil)
+			expected := test_msgs.NewDefaults()
+			expected.Int32Value = 7
+			So(msg, ShouldResemble, expected)
+			_, err = rclgo.DecodeJSON("test_msgs/msg/Defaults", []byte(`{"no_such_field":1}`))
+			So(err, ShouldWrap, rclgo.ErrUnknownField)
+			_, err = rclgo.DecodeJSON("test_msgs/msg/NoSuchType", []byte(`{}`))
+			So(err, ShouldNotBeNil)
+			arrays := test_msgs.NewArrays()
+			So(rclgo.UnmarshalJSON([]byte(`{"int32_values":[1,2]}`), arrays), ShouldNotBeNil)
+		})
+		Convey("YAML matches the output of ros2 topic echo", func() {
+			msg := test_msgs.NewNested()
+			msg.BasicTypesValue.BoolValue = true
+			msg.BasicTypesValue.Float32Value = 0.5
+			msg.BasicTypesValue.Float64Value = 1e20
+			data, err := rclgo.MarshalYAML(msg)
+			So(err, ShouldBeNil)
+			So(string(data), ShouldEqual, `basic_types_value:
+  bool_value: true
+  byte_value: 0
+  char_value: 0
+  float32_value: 0.5
+  float64_value: 1.0e+20
+  int8_value: 0
+  uint8_value: 0
+  int16_value: 0
+  uint16_value: 0
+  int32_value: 0
+  uint32_value: 0
+  int64_value: 0
+  uint64_value: 0
+`)
+			seqs := test_msgs.NewUnboundedSequences()
+			seqs.StringValues = []string{"", "yes", "plain"}
+			seqs.BasicTypesValues = []test_msgs.BasicTypes{{Int8Value: -1}}
+			data, err = rclgo.MarshalYAML(seqs)
+			So(err, ShouldBeNil)
+			So(string(data), ShouldContainSubstring, "bool_values: []\n")
+			So(string(data), ShouldContainSubstring, "string_values:\n- ''\n- 'yes'\n- plain\n")
+			So(string(data), ShouldContainSubstring, "basic_types_values:\n- bool_value: false\n  byte_value: 0\n")
+
+			str := std_msgs.NewString()
+			str.Data = "hello"
+			data, err = yaml.Marshal(str)
+			So(err, ShouldBeNil)
+			So(string(data), ShouldEqual, "data: hello\n")
+		})
+	})
+}
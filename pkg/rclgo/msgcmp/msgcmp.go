@@ -0,0 +1,193 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+/*
+Package msgcmp implements the comparison and hashing helpers used by the Equal,
+EqualApprox and Hash methods of generated messages.
+
+The methods compare messages using ROS semantics instead of the semantics of
+reflect.DeepEqual: nil and empty sequences are equal, NaN is equal to NaN, and
+positive and negative zero are equal. Hash is consistent with Equal, so
+messages which are equal have the same hash. Hashes are stable across
+processes, but not across changes to message definitions.
+*/
+package msgcmp
+
+import (
+	"math"
+)
+
+// Float64 reports whether a and b differ by at most tol. NaN values are
+// equal to each other, and infinite values are equal to infinite values with
+// the same sign.
+func Float64(a, b, tol float64) bool {
+	switch {
+	case a == b:
+		return true
+	case math.IsNaN(a) || math.IsNaN(b):
+		return math.IsNaN(a) && math.IsNaN(b)
+	case math.IsInf(a, 0) || math.IsInf(b, 0):
+		return false
+	default:
+		return math.Abs(a-b) <= tol
+	}
+}
+
+// Float32 is like Float64 but for float32 values.
+func Float32(a, b float32, tol float64) bool {
+	return Float64(float64(a), float64(b), tol)
+}
+
+// Float64s reports whether a and b have the same length and their elements
+// are equal according to Float64.
+func Float64s(a, b []float64, tol float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !Float64(a[i], b[i], tol) {
+			return false
+		}
+	}
+	return true
+}
+
+// Float32s is like Float64s but for float32 values.
+func Float32s(a, b []float32, tol float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !Float32(a[i], b[i], tol) {
+			return false
+		}
+	}
+	return true
+}
+
+// Slices reports whether a and b have the same length and equal elements.
+// Nil and empty slices are equal.
+func Slices[T comparable](a, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+const (
+	fnvOffset64 = 14695981039346656037
+	fnvPrime64  = 1099511628211
+)
+
+// Hasher computes a 64-bit FNV-1a hash of message fields.
+type Hasher struct {
+	sum uint64
+}
+
+// NewHasher returns a new Hasher.
+func NewHasher() *Hasher {
+	return &Hasher{sum: fnvOffset64}
+}
+
+// Sum64 returns the hash of the values written so far.
+func (h *Hasher) Sum64() uint64 {
+	return h.sum
+}
+
+// Bool hashes v as the byte 1 if v is true and 0 otherwise.
+func (h *Hasher) Bool(v bool) {
+	if v {
+		h.Uint8(1)
+	} else {
+		h.Uint8(0)
+	}
+}
+
+// Uint8 hashes v.
+func (h *Hasher) Uint8(v uint8) {
+	h.sum ^= uint64(v)
+	h.sum *= fnvPrime64
+}
+
+// Int8 hashes v like Uint8.
+func (h *Hasher) Int8(v int8) {
+	h.Uint8(uint8(v))
+}
+
+// Uint16 hashes the bytes of v in little endian order.
+func (h *Hasher) Uint16(v uint16) {
+	h.Uint8(uint8(v))
+	h.Uint8(uint8(v >> 8))
+}
+
+// Int16 hashes v like Uint16.
+func (h *Hasher) Int16(v int16) {
+	h.Uint16(uint16(v))
+}
+
+// Uint32 hashes the bytes of v in little endian order.
+func (h *Hasher) Uint32(v uint32) {
+	h.Uint16(uint16(v))
+	h.Uint16(uint16(v >> 16))
+}
+
+// Int32 hashes v like Uint32.
+func (h *Hasher) Int32(v int32) {
+	h.Uint32(uint32(v))
+}
+
+// Uint64 hashes the bytes of v in little endian order.
+func (h *Hasher) Uint64(v uint64) {
+	h.Uint32(uint32(v))
+	h.Uint32(uint32(v >> 32))
+}
+
+// Int64 hashes v like Uint64.
+func (h *Hasher) Int64(v int64) {
+	h.Uint64(uint64(v))
+}
+
+// Float32 hashes v. All NaN values and both zeros have the same hash, so that
+// values which are equal according to Float32 have the same hash. Values which
+// are only equal within a tolerance may have different hashes.
+func (h *Hasher) Float32(v float32) {
+	h.Float64(float64(v))
+}
+
+// Float64 hashes v like Float32.
+func (h *Hasher) Float64(v float64) {
+	switch {
+	case v == 0:
+		h.Uint64(0)
+	case math.IsNaN(v):
+		h.Uint64(0x7ff8000000000001)
+	default:
+		h.Uint64(math.Float64bits(v))
+	}
+}
+
+// String hashes the length and the bytes of v.
+func (h *Hasher) String(v string) {
+	h.Len(len(v))
+	for i := 0; i < len(v); i++ {
+		h.Uint8(v[i])
+	}
+}
+
+// Len hashes the length of a sequence. Hashing the lengths of sequences
+// prevents the hashes of adjacent sequences from colliding when elements move
+// from one sequence to the other.
+func (h *Hasher) Len(n int) {
+	h.Uint64(uint64(n))
+}
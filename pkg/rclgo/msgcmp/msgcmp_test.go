@@ -0,0 +1,59 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package msgcmp
+
+import (
+	"math"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey" //nolint:revive
+)
+
+func hashFloat(v float64) uint64 {
+	h := NewHasher()
+	h.Float64(v)
+	return h.Sum64()
+}
+
+func hashStrings(vs ...string) uint64 {
+	h := NewHasher()
+	for _, v := range vs {
+		h.String(v)
+	}
+	return h.Sum64()
+}
+
+func TestMsgcmp(t *testing.T) {
+	Convey("Scenario: values are compared using ROS semantics", t, func() {
+		Convey("Floats", func() {
+			So(Float64(math.NaN(), math.NaN(), 0), ShouldBeTrue)
+			So(Float64(math.NaN(), 1, math.Inf(1)), ShouldBeFalse)
+			So(Float64(math.Inf(1), math.Inf(1), 0), ShouldBeTrue)
+			So(Float64(math.Inf(1), math.Inf(-1), math.Inf(1)), ShouldBeFalse)
+			So(Float64(0, math.Copysign(0, -1), 0), ShouldBeTrue)
+			So(Float64(1, 1.05, 0), ShouldBeFalse)
+			So(Float64(1, 1.05, 0.1), ShouldBeTrue)
+			So(Float32s([]float32{1, 2}, []float32{1, 2.01}, 0.1), ShouldBeTrue)
+			So(Float32s([]float32{1, 2}, []float32{1}, 0.1), ShouldBeFalse)
+		})
+		Convey("Nil and empty slices are equal", func() {
+			So(Slices([]int{}, nil), ShouldBeTrue)
+			So(Float64s(nil, []float64{}, 0), ShouldBeTrue)
+			So(Slices([]string{"a"}, []string{"b"}), ShouldBeFalse)
+		})
+		Convey("Hashes are consistent with equality", func() {
+			So(hashFloat(math.NaN()), ShouldEqual, hashFloat(-math.NaN()))
+			So(hashFloat(0), ShouldEqual, hashFloat(math.Copysign(0, -1)))
+			So(hashFloat(1), ShouldNotEqual, hashFloat(2))
+			So(hashStrings("ab", ""), ShouldNotEqual, hashStrings("a", "b"))
+			So(NewHasher().Sum64(), ShouldEqual, uint64(14695981039346656037))
+		})
+	})
+}
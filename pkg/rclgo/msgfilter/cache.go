@@ -0,0 +1,163 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package msgfilter
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/tiiuae/rclgo/pkg/rclgo/types"
+)
+
+// Cache stores the last messages it has received ordered by their header
+// stamps. Cache is also a Source which outputs every message added to it.
+type Cache struct {
+	signal
+	errorReporter
+	mutex sync.Mutex
+	size  int
+	msgs  []stampedMessage
+}
+
+// NewCache returns a Cache which stores at most size messages. If src is not
+// nil, the messages output by src are added to the cache and errors adding them
+// are passed to the error callback of the cache.
+func NewCache(size int, src Source) *Cache {
+	if size < 1 {
+		size = 1
+	}
+	c := &Cache{size: size}
+	if src != nil {
+		src.Connect(func(msg types.Message) {
+			if err := c.Add(msg); err != nil {
+				c.report(msg, err)
+			}
+		})
+	}
+	return c
+}
+
+// Add adds msg to c. If c is full, the message with the oldest stamp is
+// removed. Returns an error if msg does not have a header stamp.
+func (c *Cache) Add(msg types.Message) error {
+	sm, err := newStampedMessage(msg)
+	if err != nil {
+		return err
+	}
+	c.mutex.Lock()
+	i := sort.Search(len(c.msgs), func(i int) bool {
+		return c.msgs[i].stamp.After(sm.stamp)
+	})
+	c.msgs = append(c.msgs, stampedMessage{})
+	copy(c.msgs[i+1:], c.msgs[i:])
+	c.msgs[i] = sm
+	if len(c.msgs) > c.size {
+		c.msgs = c.msgs[len(c.msgs)-c.size:]
+	}
+	c.mutex.Unlock()
+	c.emit(msg)
+	return nil
+}
+
+// Len returns the number of messages in c.
+func (c *Cache) Len() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return len(c.msgs)
+}
+
+// Interval returns the messages whose stamps are in the closed interval
+// [start, end] ordered by stamp.
+func (c *Cache) Interval(start, end time.Time) []types.Message {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	var msgs []types.Message
+	for _, m := range c.msgs {
+		if !m.stamp.Before(start) && !m.stamp.After(end) {
+			msgs = append(msgs, m.msg)
+		}
+	}
+	return msgs
+}
+
+// SurroundingInterval is like Interval but additionally includes the latest
+// message before start and the earliest message after end, if they exist.
+func (c *Cache) SurroundingInterval(start, end time.Time) []types.Message {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	first := 0
+	for i, m := range c.msgs {
+		if m.stamp.After(start) {
+			break
+		}
+		first = i
+	}
+	last := len(c.msgs) - 1
+	for i := len(c.msgs) - 1; i >= 0; i-- {
+		if c.msgs[i].stamp.Before(end) {
+			break
+		}
+		last = i
+	}
+	var msgs []types.Message
+	for i := first; i <= last; i++ {
+		msgs = append(msgs, c.msgs[i].msg)
+	}
+	return msgs
+}
+
+// ElemBeforeTime returns the latest message whose stamp is not after t or nil
+// if there is no such message.
+func (c *Cache) ElemBeforeTime(t time.Time) types.Message {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for i := len(c.msgs) - 1; i >= 0; i-- {
+		if !c.msgs[i].stamp.After(t) {
+			return c.msgs[i].msg
+		}
+	}
+	return nil
+}
+
+// ElemAfterTime returns the earliest message whose stamp is not before t or
+// nil if there is no such message.
+func (c *Cache) ElemAfterTime(t time.Time) types.Message {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for _, m := range c.msgs {
+		if !m.stamp.Before(t) {
+			return m.msg
+		}
+	}
+	return nil
+}
+
+// OldestTime returns the stamp of the oldest message in c or the zero time if
+// c is empty.
+func (c *Cache) OldestTime() time.Time {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if len(c.msgs) == 0 {
+		return time.Time{}
+	}
+	return c.msgs[0].stamp
+}
+
+// LatestTime returns the stamp of the latest message in c or the zero time if
+// c is empty.
+func (c *Cache) LatestTime() time.Time {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if len(c.msgs) == 0 {
+		return time.Time{}
+	}
+	return c.msgs[len(c.msgs)-1].stamp
+}
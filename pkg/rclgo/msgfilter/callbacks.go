@@ -0,0 +1,75 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package msgfilter
+
+import "github.com/tiiuae/rclgo/pkg/rclgo/types"
+
+// Callback2 adapts a type safe callback taking two messages to a SyncCallback.
+func Callback2[T1, T2 types.Message](cb func(T1, T2)) SyncCallback {
+	return func(m []types.Message) {
+		cb(m[0].(T1), m[1].(T2))
+	}
+}
+
+// Callback3 adapts a type safe callback taking three messages to a
+// SyncCallback.
+func Callback3[T1, T2, T3 types.Message](cb func(T1, T2, T3)) SyncCallback {
+	return func(m []types.Message) {
+		cb(m[0].(T1), m[1].(T2), m[2].(T3))
+	}
+}
+
+// Callback4 adapts a type safe callback taking four messages to a
+// SyncCallback.
+func Callback4[T1, T2, T3, T4 types.Message](cb func(T1, T2, T3, T4)) SyncCallback {
+	return func(m []types.Message) {
+		cb(m[0].(T1), m[1].(T2), m[2].(T3), m[3].(T4))
+	}
+}
+
+// Callback5 adapts a type safe callback taking five messages to a
+// SyncCallback.
+func Callback5[T1, T2, T3, T4, T5 types.Message](cb func(T1, T2, T3, T4, T5)) SyncCallback {
+	return func(m []types.Message) {
+		cb(m[0].(T1), m[1].(T2), m[2].(T3), m[3].(T4), m[4].(T5))
+	}
+}
+
+// Callback6 adapts a type safe callback taking six messages to a
+// SyncCallback.
+func Callback6[T1, T2, T3, T4, T5, T6 types.Message](cb func(T1, T2, T3, T4, T5, T6)) SyncCallback {
+	return func(m []types.Message) {
+		cb(m[0].(T1), m[1].(T2), m[2].(T3), m[3].(T4), m[4].(T5), m[5].(T6))
+	}
+}
+
+// Callback7 adapts a type safe callback taking seven messages to a
+// SyncCallback.
+func Callback7[T1, T2, T3, T4, T5, T6, T7 types.Message](cb func(T1, T2, T3, T4, T5, T6, T7)) SyncCallback {
+	return func(m []types.Message) {
+		cb(m[0].(T1), m[1].(T2), m[2].(T3), m[3].(T4), m[4].(T5), m[5].(T6), m[6].(T7))
+	}
+}
+
+// Callback8 adapts a type safe callback taking eight messages to a
+// SyncCallback.
+func Callback8[T1, T2, T3, T4, T5, T6, T7, T8 types.Message](cb func(T1, T2, T3, T4, T5, T6, T7, T8)) SyncCallback {
+	return func(m []types.Message) {
+		cb(m[0].(T1), m[1].(T2), m[2].(T3), m[3].(T4), m[4].(T5), m[5].(T6), m[6].(T7), m[7].(T8))
+	}
+}
+
+// Callback9 adapts a type safe callback taking nine messages to a
+// SyncCallback.
+func Callback9[T1, T2, T3, T4, T5, T6, T7, T8, T9 types.Message](cb func(T1, T2, T3, T4, T5, T6, T7, T8, T9)) SyncCallback {
+	return func(m []types.Message) {
+		cb(m[0].(T1), m[1].(T2), m[2].(T3), m[3].(T4), m[4].(T5), m[5].(T6), m[6].(T7), m[7].(T8), m[8].(T9))
+	}
+}
@@ -0,0 +1,98 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+/*
+Package msgfilter implements message filters similar to the ROS 2
+message_filters package.
+
+Filters are connected to each other to form a pipeline. A typical pipeline
+starts with one Subscriber per topic, whose outputs are connected to a Cache,
+a TimeSequencer or a synchronizer. Filters which order or pair messages by time
+use the std_msgs/Header.stamp field of messages, so they only work with
+generated message types which have a Header field.
+
+Filters are safe for concurrent use. Callbacks are called without holding any
+locks of the calling filter.
+
+Messages which a filter cannot accept, such as messages without a header, are
+rejected with an error by the Add method of the filter. Errors for messages
+output by the inputs of a filter are passed to the callback set using
+SetErrorCallback.
+*/
+package msgfilter
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/tiiuae/rclgo/pkg/rclgo/types"
+)
+
+// ErrOutOfOrder is returned when a message is dropped because a message with a
+// later stamp has already been processed.
+var ErrOutOfOrder = errors.New("message is older than already processed messages")
+
+// Callback is called by a filter when it outputs a message.
+type Callback func(msg types.Message)
+
+// Source is a filter whose output can be connected to other filters.
+type Source interface {
+	// Connect registers cb to be called each time the source outputs a
+	// message.
+	Connect(cb Callback)
+}
+
+// signal implements Source. The zero value is ready for use.
+type signal struct {
+	mutex     sync.Mutex
+	callbacks []Callback
+}
+
+func (s *signal) Connect(cb Callback) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.callbacks = append(s.callbacks, cb)
+}
+
+func (s *signal) emit(msg types.Message) {
+	s.mutex.Lock()
+	callbacks := s.callbacks
+	s.mutex.Unlock()
+	for _, cb := range callbacks {
+		cb(msg)
+	}
+}
+
+// ErrorCallback is called by a filter when a message output by one of its
+// inputs is rejected with err.
+type ErrorCallback func(msg types.Message, err error)
+
+// errorReporter implements SetErrorCallback. The zero value is ready for use.
+type errorReporter struct {
+	mutex    sync.Mutex
+	callback ErrorCallback
+}
+
+// SetErrorCallback sets cb to be called when a message output by an input of
+// the filter is rejected. If cb is nil, which is the default, such errors are
+// ignored.
+func (r *errorReporter) SetErrorCallback(cb ErrorCallback) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.callback = cb
+}
+
+func (r *errorReporter) report(msg types.Message, err error) {
+	r.mutex.Lock()
+	cb := r.callback
+	r.mutex.Unlock()
+	if cb != nil {
+		cb(msg, err)
+	}
+}
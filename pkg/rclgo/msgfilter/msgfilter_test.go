@@ -0,0 +1,230 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package msgfilter
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey" //nolint:revive
+	"github.com/tiiuae/rclgo/pkg/rclgo/types"
+)
+
+type testTime struct {
+	Sec     int32
+	Nanosec uint32
+}
+
+type testHeader struct {
+	Stamp   testTime
+	FrameId string //nolint:revive
+}
+
+type testMsg struct {
+	Header testHeader
+	Data   int
+}
+
+func (m *testMsg) CloneMsg() types.Message                  { c := *m; return &c }
+func (m *testMsg) SetDefaults()                             { *m = testMsg{} }
+func (m *testMsg) GetTypeSupport() types.MessageTypeSupport { return nil }
+
+type noHeaderMsg struct{}
+
+func (m *noHeaderMsg) CloneMsg() types.Message                  { return &noHeaderMsg{} }
+func (m *noHeaderMsg) SetDefaults()                             {}
+func (m *noHeaderMsg) GetTypeSupport() types.MessageTypeSupport { return nil }
+
+func msgAt(ms int, data int) *testMsg {
+	m := &testMsg{Data: data}
+	m.Header.Stamp.Sec = int32(ms / 1000)
+	m.Header.Stamp.Nanosec = uint32(ms%1000) * 1e6
+	return m
+}
+
+func at(ms int) time.Time {
+	return time.Unix(0, int64(ms)*int64(time.Millisecond))
+}
+
+func datas(msgs []types.Message) []int {
+	d := make([]int, len(msgs))
+	for i, m := range msgs {
+		d[i] = m.(*testMsg).Data
+	}
+	return d
+}
+
+func TestStamp(t *testing.T) {
+	Convey("Stamp reads the header stamp of a message", t, func() {
+		stamp, err := Stamp(msgAt(1500, 0))
+		So(err, ShouldBeNil)
+		So(stamp.Equal(at(1500)), ShouldBeTrue)
+	})
+	Convey("Stamp fails for messages without a header", t, func() {
+		_, err := Stamp(&noHeaderMsg{})
+		So(err, ShouldWrap, ErrNoHeader)
+	})
+}
+
+func TestCache(t *testing.T) {
+	Convey("Given a cache of size 3", t, func() {
+		c := NewCache(3, nil)
+		var emitted []int
+		c.Connect(func(msg types.Message) { emitted = append(emitted, msg.(*testMsg).Data) })
+		for i, ms := range []int{100, 300, 200, 400} {
+			So(c.Add(msgAt(ms, i)), ShouldBeNil)
+		}
+		Convey("The oldest messages are discarded", func() {
+			So(c.Len(), ShouldEqual, 3)
+			So(c.OldestTime().Equal(at(200)), ShouldBeTrue)
+			So(c.LatestTime().Equal(at(400)), ShouldBeTrue)
+		})
+		Convey("Added messages are passed on", func() {
+			So(emitted, ShouldResemble, []int{0, 1, 2, 3})
+		})
+		Convey("Messages can be queried by time", func() {
+			So(datas(c.Interval(at(250), at(400))), ShouldResemble, []int{1, 3})
+			So(datas(c.SurroundingInterval(at(250), at(350))), ShouldResemble, []int{2, 1, 3})
+			So(c.ElemBeforeTime(at(350)).(*testMsg).Data, ShouldEqual, 1)
+			So(c.ElemAfterTime(at(350)).(*testMsg).Data, ShouldEqual, 3)
+			So(c.ElemBeforeTime(at(100)), ShouldBeNil)
+		})
+		Convey("Messages without a header are rejected", func() {
+			So(c.Add(&noHeaderMsg{}), ShouldWrap, ErrNoHeader)
+		})
+	})
+}
+
+func TestTimeSequencer(t *testing.T) {
+	Convey("Given a time sequencer with a delay", t, func() {
+		s := NewTimeSequencer(100*time.Millisecond, 10, nil)
+		var emitted []int
+		s.Connect(func(msg types.Message) { emitted = append(emitted, msg.(*testMsg).Data) })
+		So(s.Add(msgAt(300, 0)), ShouldBeNil)
+		So(s.Add(msgAt(100, 1)), ShouldBeNil)
+		So(s.Add(msgAt(200, 2)), ShouldBeNil)
+		Convey("Messages are dispatched in stamp order after the delay", func() {
+			s.Dispatch(at(250))
+			So(emitted, ShouldResemble, []int{1})
+			s.Dispatch(at(400))
+			So(emitted, ShouldResemble, []int{1, 2, 0})
+		})
+		Convey("Messages older than the dispatched ones are dropped", func() {
+			s.Dispatch(at(400))
+			So(s.Add(msgAt(150, 3)), ShouldWrap, ErrOutOfOrder)
+			s.Dispatch(at(1000))
+			So(emitted, ShouldResemble, []int{1, 2, 0})
+		})
+	})
+}
+
+func TestErrorCallback(t *testing.T) {
+	Convey("Given filters connected to a source", t, func() {
+		src := &signal{}
+		type report struct {
+			filter string
+			err    error
+		}
+		var reports []report
+		reporter := func(filter string) ErrorCallback {
+			return func(msg types.Message, err error) {
+				reports = append(reports, report{filter, err})
+			}
+		}
+		c := NewCache(10, src)
+		c.SetErrorCallback(reporter("cache"))
+		s := NewTimeSequencer(0, 10, src)
+		s.SetErrorCallback(reporter("sequencer"))
+		synchronizer, err := NewApproximateTimeSynchronizer(10, nil, src, nil)
+		So(err, ShouldBeNil)
+		synchronizer.SetErrorCallback(reporter("sync"))
+		Convey("Messages without a header are reported", func() {
+			src.emit(&noHeaderMsg{})
+			So(reports, ShouldHaveLength, 3)
+			for i, filter := range []string{"cache", "sequencer", "sync"} {
+				So(reports[i].filter, ShouldEqual, filter)
+				So(reports[i].err, ShouldWrap, ErrNoHeader)
+			}
+		})
+		Convey("Messages dropped for being out of order are reported", func() {
+			src.emit(msgAt(200, 0))
+			s.Dispatch(at(200))
+			So(reports, ShouldBeEmpty)
+			src.emit(msgAt(100, 1))
+			So(reports, ShouldHaveLength, 2)
+			So(reports[0].filter, ShouldEqual, "sequencer")
+			So(reports[0].err, ShouldWrap, ErrOutOfOrder)
+			So(reports[1].filter, ShouldEqual, "sync")
+			So(reports[1].err, ShouldWrap, ErrOutOfOrder)
+		})
+	})
+}
+
+func TestExactTimeSynchronizer(t *testing.T) {
+	Convey("The number of inputs is validated", t, func() {
+		_, err := NewExactTimeSynchronizer(10, nil, nil)
+		So(err, ShouldNotBeNil)
+		_, err = NewExactTimeSynchronizer(10, nil, make([]Source, MaxSyncInputs+1)...)
+		So(err, ShouldNotBeNil)
+	})
+	Convey("Given an exact time synchronizer with connected inputs", t, func() {
+		in1, in2 := NewCache(10, nil), NewCache(10, nil)
+		var sets [][]int
+		_, err := NewExactTimeSynchronizer(10, Callback2(func(a, b *testMsg) {
+			sets = append(sets, []int{a.Data, b.Data})
+		}), in1, in2)
+		So(err, ShouldBeNil)
+		Convey("Only messages with equal stamps are matched", func() {
+			So(in1.Add(msgAt(100, 1)), ShouldBeNil)
+			So(in2.Add(msgAt(101, 2)), ShouldBeNil)
+			So(sets, ShouldBeEmpty)
+			So(in2.Add(msgAt(100, 3)), ShouldBeNil)
+			So(sets, ShouldResemble, [][]int{{1, 3}})
+		})
+		Convey("Sets older than a matched set are discarded", func() {
+			So(in1.Add(msgAt(100, 1)), ShouldBeNil)
+			So(in1.Add(msgAt(200, 2)), ShouldBeNil)
+			So(in2.Add(msgAt(200, 3)), ShouldBeNil)
+			So(in2.Add(msgAt(100, 4)), ShouldBeNil)
+			So(sets, ShouldResemble, [][]int{{2, 3}})
+		})
+	})
+}
+
+func TestApproximateTimeSynchronizer(t *testing.T) {
+	Convey("Given an approximate time synchronizer fed manually", t, func() {
+		var sets [][]int
+		s, err := NewApproximateTimeSynchronizer(10, func(msgs []types.Message) {
+			sets = append(sets, datas(msgs))
+		}, nil, nil, nil)
+		So(err, ShouldBeNil)
+		Convey("Invalid input indices are rejected", func() {
+			So(s.Add(3, msgAt(0, 0)), ShouldNotBeNil)
+		})
+		Convey("Messages closest to each other are matched", func() {
+			So(s.Add(0, msgAt(100, 1)), ShouldBeNil)
+			So(s.Add(1, msgAt(90, 2)), ShouldBeNil)
+			So(s.Add(1, msgAt(105, 3)), ShouldBeNil)
+			So(s.Add(2, msgAt(98, 4)), ShouldBeNil)
+			So(sets, ShouldBeEmpty)
+			So(s.Add(2, msgAt(130, 5)), ShouldBeNil)
+			So(sets, ShouldResemble, [][]int{{1, 3, 4}})
+		})
+		Convey("Sets exceeding the maximum interval are not output", func() {
+			s.SetMaxInterval(20 * time.Millisecond)
+			So(s.Add(0, msgAt(100, 1)), ShouldBeNil)
+			So(s.Add(1, msgAt(150, 2)), ShouldBeNil)
+			So(s.Add(2, msgAt(150, 3)), ShouldBeNil)
+			So(sets, ShouldBeEmpty)
+			So(s.Add(0, msgAt(155, 4)), ShouldBeNil)
+			So(sets, ShouldResemble, [][]int{{4, 2, 3}})
+		})
+	})
+}
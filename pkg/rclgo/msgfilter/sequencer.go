@@ -0,0 +1,92 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package msgfilter
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/tiiuae/rclgo/pkg/rclgo/types"
+)
+
+// TimeSequencer outputs messages in the order of their header stamps. Each
+// message is delayed until the time passed to Dispatch is at least the stamp of
+// the message plus the delay of the sequencer. Messages which arrive after a
+// message with a later stamp has already been output are dropped.
+//
+// Dispatch is typically called periodically from an rclgo.Timer callback.
+type TimeSequencer struct {
+	signal
+	errorReporter
+	mutex     sync.Mutex
+	delay     time.Duration
+	queueSize int
+	queue     []stampedMessage
+	lastStamp time.Time
+}
+
+// NewTimeSequencer returns a new TimeSequencer which delays messages by delay
+// and queues at most queueSize messages. If queueSize is less than one, the
+// queue size is unlimited. If src is not nil, the messages output by src are
+// added to the sequencer and errors adding them are passed to the error
+// callback of the sequencer.
+func NewTimeSequencer(delay time.Duration, queueSize int, src Source) *TimeSequencer {
+	s := &TimeSequencer{delay: delay, queueSize: queueSize}
+	if src != nil {
+		src.Connect(func(msg types.Message) {
+			if err := s.Add(msg); err != nil {
+				s.report(msg, err)
+			}
+		})
+	}
+	return s
+}
+
+// Add queues msg. Returns an error if msg does not have a header stamp or if
+// it is dropped because a message with a later stamp has already been output.
+func (s *TimeSequencer) Add(msg types.Message) error {
+	sm, err := newStampedMessage(msg)
+	if err != nil {
+		return err
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if !s.lastStamp.IsZero() && sm.stamp.Before(s.lastStamp) {
+		return fmt.Errorf("%w: stamp %v is before the last output stamp %v", ErrOutOfOrder, sm.stamp, s.lastStamp)
+	}
+	i := sort.Search(len(s.queue), func(i int) bool {
+		return s.queue[i].stamp.After(sm.stamp)
+	})
+	s.queue = append(s.queue, stampedMessage{})
+	copy(s.queue[i+1:], s.queue[i:])
+	s.queue[i] = sm
+	if s.queueSize > 0 && len(s.queue) > s.queueSize {
+		s.queue = s.queue[len(s.queue)-s.queueSize:]
+	}
+	return nil
+}
+
+// Dispatch outputs every queued message whose stamp plus the delay of s is not
+// after now.
+func (s *TimeSequencer) Dispatch(now time.Time) {
+	s.mutex.Lock()
+	var ready []stampedMessage
+	for len(s.queue) > 0 && !s.queue[0].stamp.Add(s.delay).After(now) {
+		ready = append(ready, s.queue[0])
+		s.lastStamp = s.queue[0].stamp
+		s.queue = s.queue[1:]
+	}
+	s.mutex.Unlock()
+	for _, m := range ready {
+		s.emit(m.msg)
+	}
+}
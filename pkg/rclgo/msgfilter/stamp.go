@@ -0,0 +1,94 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package msgfilter
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/tiiuae/rclgo/pkg/rclgo/types"
+)
+
+// ErrNoHeader is returned when a message does not have a header with a stamp.
+var ErrNoHeader = errors.New("message has no Header.Stamp field")
+
+// stampFields caches the index of the Header.Stamp field for message types.
+var stampFields sync.Map // map[reflect.Type][]int, nil if the type has no stamp
+
+func stampIndex(t reflect.Type) []int {
+	if idx, ok := stampFields.Load(t); ok {
+		return idx.([]int)
+	}
+	var idx []int
+	if t.Kind() == reflect.Struct {
+		header, ok := t.FieldByName("Header")
+		if ok && header.Type.Kind() == reflect.Struct {
+			stamp, ok := header.Type.FieldByName("Stamp")
+			if ok && isTimeType(stamp.Type) {
+				idx = append(append(idx, header.Index...), stamp.Index...)
+			}
+		}
+	}
+	stampFields.Store(t, idx)
+	return idx
+}
+
+func isTimeType(t reflect.Type) bool {
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+	sec, ok := t.FieldByName("Sec")
+	if !ok || sec.Type.Kind() != reflect.Int32 {
+		return false
+	}
+	nsec, ok := t.FieldByName("Nanosec")
+	return ok && nsec.Type.Kind() == reflect.Uint32
+}
+
+// Stamp returns the value of the std_msgs/Header.stamp field of msg.
+//
+// msg must be a pointer to a generated message type which has a field named
+// Header, which in turn has a field named Stamp of type
+// builtin_interfaces/Time. Otherwise ErrNoHeader is returned.
+func Stamp(msg types.Message) (time.Time, error) {
+	v := reflect.ValueOf(msg)
+	if v.Kind() != reflect.Pointer || v.IsNil() {
+		return time.Time{}, fmt.Errorf("%w: %T", ErrNoHeader, msg)
+	}
+	v = v.Elem()
+	idx := stampIndex(v.Type())
+	if idx == nil {
+		return time.Time{}, fmt.Errorf("%w: %T", ErrNoHeader, msg)
+	}
+	stamp := v.FieldByIndex(idx)
+	sec := stamp.FieldByName("Sec").Int()
+	nsec := stamp.FieldByName("Nanosec").Uint()
+	return time.Unix(sec, int64(nsec)), nil
+}
+
+// HasStamp returns true if messages of type ts have a header stamp that can be
+// read using Stamp.
+func HasStamp(ts types.MessageTypeSupport) bool {
+	_, err := Stamp(ts.New())
+	return err == nil
+}
+
+type stampedMessage struct {
+	stamp time.Time
+	msg   types.Message
+}
+
+func newStampedMessage(msg types.Message) (stampedMessage, error) {
+	stamp, err := Stamp(msg)
+	return stampedMessage{stamp: stamp, msg: msg}, err
+}
@@ -0,0 +1,66 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package msgfilter
+
+import (
+	"errors"
+
+	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/types"
+)
+
+// Subscriber is a Source which outputs the messages received by a
+// subscription.
+type Subscriber struct {
+	signal
+	sub *rclgo.Subscription
+}
+
+// NewSubscriber creates a new subscription and returns a Subscriber which
+// outputs the messages received by it. Messages are received only while the
+// subscription is being spun. Errors taking messages are logged using the
+// logger of node.
+//
+// options must not be modified after passing it to this function. If options is
+// nil, default options are used.
+func NewSubscriber(
+	node *rclgo.Node,
+	topicName string,
+	ts types.MessageTypeSupport,
+	options *rclgo.SubscriptionOptions,
+) (*Subscriber, error) {
+	s := &Subscriber{}
+	sub, err := node.NewSubscription(topicName, ts, options, func(sub *rclgo.Subscription) {
+		msg := ts.New()
+		if _, err := sub.TakeMessage(msg); err != nil {
+			var takeFailed *rclgo.SubscriptionTakeFailed
+			if !errors.As(err, &takeFailed) {
+				node.Logger().Error("failed to take message: ", err)
+			}
+			return
+		}
+		s.emit(msg)
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.sub = sub
+	return s, nil
+}
+
+// Subscription returns the subscription s receives messages from.
+func (s *Subscriber) Subscription() *rclgo.Subscription {
+	return s.sub
+}
+
+// Close closes the subscription of s.
+func (s *Subscriber) Close() error {
+	return s.sub.Close()
+}
@@ -0,0 +1,301 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package msgfilter
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/tiiuae/rclgo/pkg/rclgo/types"
+)
+
+const (
+	// MinSyncInputs is the minimum number of inputs of a synchronizer.
+	MinSyncInputs = 2
+	// MaxSyncInputs is the maximum number of inputs of a synchronizer.
+	MaxSyncInputs = 9
+)
+
+// SyncCallback is called by a synchronizer with a set of matched messages.
+// msgs[i] is the message received from the i:th input of the synchronizer.
+type SyncCallback func(msgs []types.Message)
+
+func connectInputs(inputs []Source, add func(int, types.Message) error, report ErrorCallback) error {
+	if len(inputs) < MinSyncInputs || len(inputs) > MaxSyncInputs {
+		return fmt.Errorf(
+			"number of synchronizer inputs must be in range [%d, %d], got %d",
+			MinSyncInputs, MaxSyncInputs, len(inputs),
+		)
+	}
+	for i, in := range inputs {
+		if in != nil {
+			i := i
+			in.Connect(func(msg types.Message) {
+				if err := add(i, msg); err != nil {
+					report(msg, err)
+				}
+			})
+		}
+	}
+	return nil
+}
+
+func checkInput(input, count int) error {
+	if input < 0 || input >= count {
+		return fmt.Errorf("synchronizer input index %d out of range [0, %d)", input, count)
+	}
+	return nil
+}
+
+// ExactTimeSynchronizer outputs sets of messages, one from each input, whose
+// header stamps are exactly equal.
+type ExactTimeSynchronizer struct {
+	errorReporter
+	mutex     sync.Mutex
+	inputs    int
+	queueSize int
+	callback  SyncCallback
+	pending   map[time.Time][]types.Message
+	stamps    []time.Time // Stamps of pending, ordered from oldest to latest
+	lastSync  time.Time
+}
+
+// NewExactTimeSynchronizer returns a new ExactTimeSynchronizer which calls cb
+// with each matched message set.
+//
+// The number of inputs determines the number of messages in each set and must
+// be in range [MinSyncInputs, MaxSyncInputs]. Inputs may be nil, in which case
+// messages for that input must be added using Add. Errors adding messages
+// output by the inputs are passed to the error callback of the synchronizer. At
+// most queueSize distinct stamps are kept waiting for a match.
+func NewExactTimeSynchronizer(queueSize int, cb SyncCallback, inputs ...Source) (*ExactTimeSynchronizer, error) {
+	if queueSize < 1 {
+		queueSize = 1
+	}
+	s := &ExactTimeSynchronizer{
+		inputs:    len(inputs),
+		queueSize: queueSize,
+		callback:  cb,
+		pending:   make(map[time.Time][]types.Message),
+	}
+	if err := connectInputs(inputs, s.Add, s.report); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Add adds msg as a message received from the input with index input. Messages
+// whose stamps are not after the latest matched set are dropped with an error
+// wrapping ErrOutOfOrder.
+func (s *ExactTimeSynchronizer) Add(input int, msg types.Message) error {
+	if err := checkInput(input, s.inputs); err != nil {
+		return err
+	}
+	sm, err := newStampedMessage(msg)
+	if err != nil {
+		return err
+	}
+	// Time values are normalized so that they can be used as map keys.
+	stamp := time.Unix(0, sm.stamp.UnixNano())
+	s.mutex.Lock()
+	if !s.lastSync.IsZero() && !stamp.After(s.lastSync) {
+		s.mutex.Unlock()
+		return fmt.Errorf("%w: stamp %v is not after the last matched stamp %v", ErrOutOfOrder, stamp, s.lastSync)
+	}
+	set := s.pending[stamp]
+	if set == nil {
+		set = make([]types.Message, s.inputs)
+		s.pending[stamp] = set
+		i := sort.Search(len(s.stamps), func(i int) bool {
+			return s.stamps[i].After(stamp)
+		})
+		s.stamps = append(s.stamps, time.Time{})
+		copy(s.stamps[i+1:], s.stamps[i:])
+		s.stamps[i] = stamp
+	}
+	set[input] = msg
+	var complete []types.Message
+	if isComplete(set) {
+		complete = set
+		s.lastSync = stamp
+		// Sets older than the completed one can never be completed.
+		for len(s.stamps) > 0 && !s.stamps[0].After(stamp) {
+			delete(s.pending, s.stamps[0])
+			s.stamps = s.stamps[1:]
+		}
+	}
+	for len(s.stamps) > s.queueSize {
+		delete(s.pending, s.stamps[0])
+		s.stamps = s.stamps[1:]
+	}
+	s.mutex.Unlock()
+	if complete != nil && s.callback != nil {
+		s.callback(complete)
+	}
+	return nil
+}
+
+func isComplete(set []types.Message) bool {
+	for _, m := range set {
+		if m == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// ApproximateTimeSynchronizer outputs sets of messages, one from each input,
+// whose header stamps are close to each other. Each message is output at most
+// once and the sets are output in stamp order.
+//
+// The synchronizer waits until each input has received a message. The latest
+// of the oldest queued messages of each input is used as a pivot, and from each
+// input the message closest to the pivot is selected. If a message later than
+// the pivot may still arrive on some input, the synchronizer waits for it,
+// unless the queue of that input is full.
+type ApproximateTimeSynchronizer struct {
+	errorReporter
+	mutex       sync.Mutex
+	queueSize   int
+	maxInterval time.Duration
+	callback    SyncCallback
+	queues      [][]stampedMessage
+}
+
+// NewApproximateTimeSynchronizer returns a new ApproximateTimeSynchronizer
+// which calls cb with each matched message set.
+//
+// The number of inputs determines the number of messages in each set and must
+// be in range [MinSyncInputs, MaxSyncInputs]. Inputs may be nil, in which case
+// messages for that input must be added using Add. Errors adding messages
+// output by the inputs are passed to the error callback of the synchronizer.
+// Each input queues at most queueSize messages.
+func NewApproximateTimeSynchronizer(queueSize int, cb SyncCallback, inputs ...Source) (*ApproximateTimeSynchronizer, error) {
+	if queueSize < 1 {
+		queueSize = 1
+	}
+	s := &ApproximateTimeSynchronizer{
+		queueSize: queueSize,
+		callback:  cb,
+		queues:    make([][]stampedMessage, len(inputs)),
+	}
+	if err := connectInputs(inputs, s.Add, s.report); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// SetMaxInterval sets the maximum difference between the earliest and the
+// latest stamp of a message set. Sets exceeding the limit are never output. A
+// non-positive value disables the limit, which is the default.
+func (s *ApproximateTimeSynchronizer) SetMaxInterval(d time.Duration) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.maxInterval = d
+}
+
+// Add adds msg as a message received from the input with index input. Messages
+// older than the latest message already received from the same input are
+// dropped with an error wrapping ErrOutOfOrder.
+func (s *ApproximateTimeSynchronizer) Add(input int, msg types.Message) error {
+	if err := checkInput(input, len(s.queues)); err != nil {
+		return err
+	}
+	sm, err := newStampedMessage(msg)
+	if err != nil {
+		return err
+	}
+	s.mutex.Lock()
+	q := s.queues[input]
+	if len(q) > 0 && sm.stamp.Before(q[len(q)-1].stamp) {
+		latest := q[len(q)-1].stamp
+		s.mutex.Unlock()
+		return fmt.Errorf("%w: stamp %v is before the latest stamp %v of input %d", ErrOutOfOrder, sm.stamp, latest, input)
+	}
+	q = append(q, sm)
+	if len(q) > s.queueSize {
+		q = q[len(q)-s.queueSize:]
+	}
+	s.queues[input] = q
+	var sets [][]types.Message
+	for {
+		set := s.match()
+		if set == nil {
+			break
+		}
+		sets = append(sets, set)
+	}
+	s.mutex.Unlock()
+	if s.callback != nil {
+		for _, set := range sets {
+			s.callback(set)
+		}
+	}
+	return nil
+}
+
+// match returns the next matched set and removes the used and older messages
+// from the queues. Returns nil if no set can be matched yet.
+func (s *ApproximateTimeSynchronizer) match() []types.Message {
+	for {
+		var pivot time.Time
+		oldest := -1
+		for i, q := range s.queues {
+			if len(q) == 0 {
+				return nil
+			}
+			if q[0].stamp.After(pivot) {
+				pivot = q[0].stamp
+			}
+			if oldest < 0 || q[0].stamp.Before(s.queues[oldest][0].stamp) {
+				oldest = i
+			}
+		}
+		chosen := make([]int, len(s.queues))
+		for i, q := range s.queues {
+			j := 0
+			for j+1 < len(q) && !q[j+1].stamp.After(pivot) {
+				j++
+			}
+			if j+1 < len(q) {
+				if q[j+1].stamp.Sub(pivot) < pivot.Sub(q[j].stamp) {
+					j++
+				}
+			} else if q[j].stamp.Before(pivot) && len(q) < s.queueSize {
+				// A message closer to the pivot may still arrive.
+				return nil
+			}
+			chosen[i] = j
+		}
+		first, last := pivot, pivot
+		for i, j := range chosen {
+			stamp := s.queues[i][j].stamp
+			if stamp.Before(first) {
+				first = stamp
+			}
+			if stamp.After(last) {
+				last = stamp
+			}
+		}
+		if s.maxInterval > 0 && last.Sub(first) > s.maxInterval {
+			// The oldest message cannot be part of any valid set.
+			s.queues[oldest] = s.queues[oldest][1:]
+			continue
+		}
+		set := make([]types.Message, len(s.queues))
+		for i, j := range chosen {
+			set[i] = s.queues[i][j].msg
+			s.queues[i] = s.queues[i][j+1:]
+		}
+		return set
+	}
+}
@@ -116,3 +116,22 @@ func (p *QosProfile) fromCStruct(src *C.rmw_qos_profile_t) {
 	p.LivelinessLeaseDuration = time.Duration(src.liveliness_lease_duration.sec)*time.Second + time.Duration(src.liveliness_lease_duration.nsec)
 	p.AvoidRosNamespaceConventions = bool(src.avoid_ros_namespace_conventions)
 }
+
+// AdaptQosProfile returns a QoS profile for a subscription that is compatible
+// with all of the publishers pubs. The profile is reliable and transient local
+// only if all of the publishers are.
+func AdaptQosProfile(pubs []TopicEndpointInfo) QosProfile {
+	qos := NewDefaultQosProfile()
+	reliable, transientLocal := true, true
+	for _, p := range pubs {
+		reliable = reliable && p.QosProfile.Reliability == ReliabilityReliable
+		transientLocal = transientLocal && p.QosProfile.Durability == DurabilityTransientLocal
+	}
+	if !reliable {
+		qos.Reliability = ReliabilityBestEffort
+	}
+	if transientLocal {
+		qos.Durability = DurabilityTransientLocal
+	}
+	return qos
+}
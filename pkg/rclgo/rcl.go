@@ -394,6 +394,11 @@ func (n *Node) Spin(ctx context.Context) error {
 
 type PublisherOptions struct {
 	Qos QosProfile
+
+	// If Validate is true, Publish calls the Validate method of messages
+	// implementing Validator and returns the error instead of publishing
+	// invalid messages. Generated messages implement Validator.
+	Validate bool
 }
 
 func NewDefaultPublisherOptions() *PublisherOptions {
@@ -407,6 +412,7 @@ type Publisher struct {
 	node            *Node
 	rcl_publisher_t *C.rcl_publisher_t
 	topicName       *C.char
+	validate        bool
 }
 
 // NewPublisher creates a new publisher.
@@ -427,6 +433,7 @@ func (n *Node) NewPublisher(
 		node:            n,
 		rcl_publisher_t: (*C.rcl_publisher_t)(C.malloc(C.sizeof_rcl_publisher_t)),
 		topicName:       C.CString(topicName),
+		validate:        options.Validate,
 	}
 	*pub.rcl_publisher_t = C.rcl_get_zero_initialized_publisher()
 	defer onErr(&err, pub.Close)
@@ -457,6 +464,12 @@ func (p *Publisher) Node() *Node {
 func (p *Publisher) Publish(ros2msg types.Message) error {
 	var rc C.rcl_ret_t
 
+	if v, ok := ros2msg.(Validator); ok && p.validate {
+		if err := v.Validate(); err != nil {
+			return fmt.Errorf("failed to publish to topic '%s': %w", p.TopicName, err)
+		}
+	}
+
 	ptr := p.typeSupport.PrepareMemory()
 	defer p.typeSupport.ReleaseMemory(ptr)
 	p.typeSupport.AsCStruct(ptr, ros2msg)
@@ -576,6 +589,15 @@ func (c *Clock) now() (time.Duration, error) {
 	return time.Duration(t), nil
 }
 
+// Now returns the current time of c.
+func (c *Clock) Now() (time.Time, error) {
+	now, err := c.now()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(0, int64(now)), nil
+}
+
 type Timer struct {
 	rosID
 	waitable    singleUse
@@ -677,6 +699,7 @@ type Subscription struct {
 	node               *Node
 	rcl_subscription_t *C.rcl_subscription_t
 	topicName          *C.char
+	onClose            func()
 }
 
 // NewSubscription creates a new subscription.
@@ -781,6 +804,9 @@ func (s *Subscription) Close() (err error) {
 	if s.rcl_subscription_t == nil {
 		return closeErr("subscription")
 	}
+	if s.onClose != nil {
+		s.onClose()
+	}
 	s.node.removeResource(s)
 	rc := C.rcl_subscription_fini(s.rcl_subscription_t, s.node.rcl_node_t)
 	if rc != C.RCL_RET_OK {
@@ -885,6 +911,7 @@ type Service struct {
 	rclService          *C.rcl_service_t
 	name                *C.char
 	handler             ServiceRequestHandler
+	typeSupport         types.ServiceTypeSupport
 	requestTypeSupport  types.MessageTypeSupport
 	responseTypeSupport types.MessageTypeSupport
 }
@@ -903,6 +930,7 @@ func (n *Node) NewService(
 		options = NewDefaultServiceOptions()
 	}
 	s = &Service{
+		typeSupport:         typeSupport,
 		requestTypeSupport:  typeSupport.Request(),
 		responseTypeSupport: typeSupport.Response(),
 		node:                n,
@@ -948,6 +976,11 @@ func (s *Service) Node() *Node {
 	return s.node
 }
 
+// TypeSupport returns the type support of s.
+func (s *Service) TypeSupport() types.ServiceTypeSupport {
+	return s.typeSupport
+}
+
 func (s *Service) handleRequest() {
 	var reqHeader C.rmw_service_info_t
 	reqBuffer := s.requestTypeSupport.PrepareMemory()
@@ -994,10 +1027,11 @@ func NewDefaultClientOptions() *ClientOptions {
 // Calling Send and Close is thread-safe. Creating clients is not thread-safe.
 type Client struct {
 	rosID
-	waitable  singleUse
-	node      *Node
-	rclClient *C.rcl_client_t
-	sender    requestSender
+	waitable    singleUse
+	node        *Node
+	rclClient   *C.rcl_client_t
+	sender      requestSender
+	typeSupport types.ServiceTypeSupport
 }
 
 // NewClient creates a new client.
@@ -1013,8 +1047,9 @@ func (n *Node) NewClient(
 		options = NewDefaultClientOptions()
 	}
 	c = &Client{
-		node:      n,
-		rclClient: (*C.rcl_client_t)(C.malloc(C.sizeof_rcl_client_t)),
+		node:        n,
+		rclClient:   (*C.rcl_client_t)(C.malloc(C.sizeof_rcl_client_t)),
+		typeSupport: typeSupport,
 	}
 	c.sender = newRequestSender(requestSenderTransport{
 		SendRequest:  c.sendRequest,
@@ -1063,6 +1098,11 @@ func (c *Client) Node() *Node {
 	return c.node
 }
 
+// TypeSupport returns the type support of c.
+func (c *Client) TypeSupport() types.ServiceTypeSupport {
+	return c.typeSupport
+}
+
 func (c *Client) Send(ctx context.Context, req types.Message) (types.Message, *ServiceInfo, error) {
 	resp, info, err := c.sender.Send(ctx, req)
 	if rmwInfo, ok := info.(*ServiceInfo); ok {
@@ -0,0 +1,410 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package tf
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultCacheTime is the default duration for which a Buffer stores
+// non-static transforms.
+const DefaultCacheTime = 10 * time.Second
+
+// maxTreeDepth limits the length of the frame chains walked during lookups so
+// that loops in the transform tree are detected.
+const maxTreeDepth = 1000
+
+var (
+	// ErrLookup is returned when a requested frame does not exist.
+	ErrLookup = errors.New("frame does not exist")
+	// ErrConnectivity is returned when two frames are not connected in the
+	// transform tree.
+	ErrConnectivity = errors.New("frames are not connected")
+	// ErrExtrapolation is returned when a transform is requested for a time
+	// outside the data stored in the buffer.
+	ErrExtrapolation = errors.New("extrapolation into the past or the future")
+	// ErrInvalidArgument is returned when an invalid transform is passed to a
+	// buffer.
+	ErrInvalidArgument = errors.New("invalid argument")
+)
+
+type transformEntry struct {
+	stamp     time.Time
+	parent    string
+	transform Transform
+}
+
+// frameCache stores the transforms from a frame to its parent ordered from
+// oldest to latest.
+type frameCache struct {
+	static  bool
+	entries []transformEntry
+}
+
+func (c *frameCache) insert(e transformEntry, cacheTime time.Duration) {
+	if c.static {
+		c.entries = []transformEntry{e}
+		return
+	}
+	i := sort.Search(len(c.entries), func(i int) bool {
+		return !c.entries[i].stamp.Before(e.stamp)
+	})
+	if i < len(c.entries) && c.entries[i].stamp.Equal(e.stamp) {
+		c.entries[i] = e
+	} else {
+		c.entries = append(c.entries, transformEntry{})
+		copy(c.entries[i+1:], c.entries[i:])
+		c.entries[i] = e
+	}
+	oldest := c.latest().Add(-cacheTime)
+	n := 0
+	for n < len(c.entries)-1 && c.entries[n].stamp.Before(oldest) {
+		n++
+	}
+	c.entries = c.entries[n:]
+}
+
+func (c *frameCache) latest() time.Time {
+	return c.entries[len(c.entries)-1].stamp
+}
+
+// get returns the transform of the frame at time t. If t is zero, the latest
+// transform is returned.
+func (c *frameCache) get(frame string, t time.Time) (transformEntry, error) {
+	if c.static || t.IsZero() {
+		return c.entries[len(c.entries)-1], nil
+	}
+	i := sort.Search(len(c.entries), func(i int) bool {
+		return !c.entries[i].stamp.Before(t)
+	})
+	switch {
+	case i < len(c.entries) && c.entries[i].stamp.Equal(t):
+		return c.entries[i], nil
+	case i == 0:
+		return transformEntry{}, fmt.Errorf(
+			"%w: lookup of frame %q at time %v is before the oldest data at %v",
+			ErrExtrapolation, frame, t, c.entries[0].stamp,
+		)
+	case i == len(c.entries):
+		return transformEntry{}, fmt.Errorf(
+			"%w: lookup of frame %q at time %v is after the latest data at %v",
+			ErrExtrapolation, frame, t, c.latest(),
+		)
+	}
+	a, b := c.entries[i-1], c.entries[i]
+	ratio := float64(t.Sub(a.stamp)) / float64(b.stamp.Sub(a.stamp))
+	return transformEntry{
+		stamp:     t,
+		parent:    a.parent,
+		transform: a.transform.Interpolate(b.transform, ratio),
+	}, nil
+}
+
+// Buffer stores transforms between coordinate frames over time and can be used
+// to look up transforms between any two connected frames in the transform
+// tree. Buffer is safe for concurrent use.
+//
+// Non-static transforms are interpolated between stored transforms.
+// Transforms are stored for the duration given when creating the buffer,
+// measured from the latest transform of each frame. Static transforms are valid
+// at all times.
+type Buffer struct {
+	mutex     sync.Mutex
+	cacheTime time.Duration
+	frames    map[string]*frameCache
+	updated   chan struct{}
+}
+
+// NewBuffer returns a new Buffer which stores non-static transforms for
+// cacheTime. If cacheTime is not positive, DefaultCacheTime is used.
+func NewBuffer(cacheTime time.Duration) *Buffer {
+	if cacheTime <= 0 {
+		cacheTime = DefaultCacheTime
+	}
+	return &Buffer{
+		cacheTime: cacheTime,
+		frames:    make(map[string]*frameCache),
+		updated:   make(chan struct{}),
+	}
+}
+
+// CacheTime returns the duration for which b stores non-static transforms.
+func (b *Buffer) CacheTime() time.Duration {
+	return b.cacheTime
+}
+
+func normalizeFrame(frame string) string {
+	return strings.TrimPrefix(frame, "/")
+}
+
+// SetTransform adds tr to b. If isStatic is true, tr replaces any previous
+// transform of the child frame and is valid at all times.
+func (b *Buffer) SetTransform(tr TransformStamped, isStatic bool) error {
+	child := normalizeFrame(tr.ChildFrameID)
+	parent := normalizeFrame(tr.FrameID)
+	switch {
+	case child == "":
+		return fmt.Errorf("%w: child frame ID is empty", ErrInvalidArgument)
+	case parent == "":
+		return fmt.Errorf("%w: frame ID of child frame %q is empty", ErrInvalidArgument, child)
+	case child == parent:
+		return fmt.Errorf("%w: frame %q is its own parent", ErrInvalidArgument, child)
+	}
+	t := tr.Transform
+	if !isFinite(t.Translation.X, t.Translation.Y, t.Translation.Z) ||
+		!isFinite(t.Rotation.X, t.Rotation.Y, t.Rotation.Z, t.Rotation.W) {
+		return fmt.Errorf("%w: transform of frame %q contains non-finite values", ErrInvalidArgument, child)
+	}
+	if t.Rotation.length() < 1e-9 {
+		return fmt.Errorf("%w: rotation of frame %q is zero", ErrInvalidArgument, child)
+	}
+	t.Rotation = t.Rotation.Normalize()
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	c := b.frames[child]
+	if c == nil || c.static != isStatic {
+		c = &frameCache{static: isStatic}
+		b.frames[child] = c
+	}
+	c.insert(transformEntry{stamp: tr.Stamp, parent: parent, transform: t}, b.cacheTime)
+	close(b.updated)
+	b.updated = make(chan struct{})
+	return nil
+}
+
+func isFinite(vals ...float64) bool {
+	for _, v := range vals {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return false
+		}
+	}
+	return true
+}
+
+// chain walks from frame towards the root of its tree and returns the frames
+// on the way and the transforms from each frame to the next one at time t. The
+// walk ends at the root, at a frame for which stop returns true or at a frame
+// whose transform is not available at t. In the last case the error getting
+// the transform is returned as gatherErr. err is non-nil if the tree contains a
+// loop.
+func (b *Buffer) chain(frame string, t time.Time, stop func(string) bool) (frames []string, transforms []Transform, gatherErr, err error) {
+	frames = []string{frame}
+	for len(frames) <= maxTreeDepth {
+		c := b.frames[frame]
+		if c == nil || (stop != nil && stop(frame)) {
+			return frames, transforms, nil, nil
+		}
+		e, err := c.get(frame, t)
+		if err != nil {
+			return frames, transforms, err, nil
+		}
+		frame = e.parent
+		frames = append(frames, frame)
+		transforms = append(transforms, e.transform)
+	}
+	return nil, nil, nil, fmt.Errorf("%w: transform tree contains a loop at frame %q", ErrConnectivity, frame)
+}
+
+// transformPath contains the frames and transforms between two frames and
+// their closest common ancestor.
+type transformPath struct {
+	// source and target contain the frames from source and target up to but
+	// not including the ancestor.
+	source, target []string
+	// sourceTransforms and targetTransforms contain the transforms from each
+	// of the frames in source and target to its parent.
+	sourceTransforms, targetTransforms []Transform
+}
+
+// path returns the path from source and target to their closest common
+// ancestor at time t. Only transforms below the ancestor need to be available
+// at t, like in tf2.
+func (b *Buffer) path(target, source string, t time.Time) (*transformPath, error) {
+	sframes, strs, sErr, err := b.chain(source, t, nil)
+	if err != nil {
+		return nil, err
+	}
+	sindex := make(map[string]int, len(sframes))
+	for i, f := range sframes {
+		sindex[f] = i
+	}
+	tframes, ttrs, tErr, err := b.chain(target, t, func(f string) bool {
+		_, ok := sindex[f]
+		return ok
+	})
+	if err != nil {
+		return nil, err
+	}
+	si, ok := sindex[tframes[len(tframes)-1]]
+	switch {
+	case ok:
+		return &transformPath{
+			source:           sframes[:si],
+			target:           tframes[:len(tframes)-1],
+			sourceTransforms: strs[:si],
+			targetTransforms: ttrs,
+		}, nil
+	case tErr != nil:
+		return nil, tErr
+	case sErr != nil:
+		return nil, sErr
+	}
+	return nil, fmt.Errorf(
+		"%w: %q and %q are in different trees", ErrConnectivity, target, source,
+	)
+}
+
+// latestCommonTime returns the latest time at which transforms of all
+// non-static frames between source and target are available. Returns zero time
+// if all of the frames are static.
+func (b *Buffer) latestCommonTime(target, source string) (time.Time, error) {
+	path, err := b.path(target, source, time.Time{})
+	if err != nil {
+		return time.Time{}, err
+	}
+	var common time.Time
+	visit := func(frames []string) {
+		for _, f := range frames {
+			if c := b.frames[f]; !c.static {
+				if latest := c.latest(); common.IsZero() || latest.Before(common) {
+					common = latest
+				}
+			}
+		}
+	}
+	visit(path.source)
+	visit(path.target)
+	return common, nil
+}
+
+func (b *Buffer) frameExists(frame string) bool {
+	if b.frames[frame] != nil {
+		return true
+	}
+	for _, c := range b.frames {
+		for _, e := range c.entries {
+			if e.parent == frame {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (b *Buffer) lookup(target, source string, t time.Time) (TransformStamped, error) {
+	target = normalizeFrame(target)
+	source = normalizeFrame(source)
+	result := TransformStamped{
+		Stamp:        t,
+		FrameID:      target,
+		ChildFrameID: source,
+		Transform:    IdentityTransform(),
+	}
+	if target == source {
+		return result, nil
+	}
+	for _, f := range []string{target, source} {
+		if !b.frameExists(f) {
+			return TransformStamped{}, fmt.Errorf("%w: %q", ErrLookup, f)
+		}
+	}
+	if t.IsZero() {
+		var err error
+		t, err = b.latestCommonTime(target, source)
+		if err != nil {
+			return TransformStamped{}, err
+		}
+		result.Stamp = t
+	}
+	path, err := b.path(target, source, t)
+	if err != nil {
+		return TransformStamped{}, err
+	}
+	toAncestor := func(trs []Transform) Transform {
+		acc := IdentityTransform()
+		for _, tr := range trs {
+			acc = tr.Mul(acc)
+		}
+		return acc
+	}
+	result.Transform = toAncestor(path.targetTransforms).Inverse().Mul(toAncestor(path.sourceTransforms))
+	return result, nil
+}
+
+// LookupTransform returns the transform from frame source to frame target at
+// time t, i.e. the transform which maps points expressed in source to points
+// expressed in target. If t is zero, the transform at the latest time at which
+// all of the required transforms are available is returned.
+func (b *Buffer) LookupTransform(target, source string, t time.Time) (TransformStamped, error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return b.lookup(target, source, t)
+}
+
+// CanTransform returns true if the transform from frame source to frame target
+// at time t can be looked up.
+func (b *Buffer) CanTransform(target, source string, t time.Time) bool {
+	_, err := b.LookupTransform(target, source, t)
+	return err == nil
+}
+
+// WaitForTransform waits until the transform from frame source to frame target
+// at time t can be looked up and returns it. If ctx is done before that, an
+// error wrapping both ctx.Err() and the latest lookup error is returned.
+func (b *Buffer) WaitForTransform(ctx context.Context, target, source string, t time.Time) (TransformStamped, error) {
+	for {
+		b.mutex.Lock()
+		tr, err := b.lookup(target, source, t)
+		updated := b.updated
+		b.mutex.Unlock()
+		if err == nil {
+			return tr, nil
+		}
+		select {
+		case <-ctx.Done():
+			return TransformStamped{}, fmt.Errorf("%w: %w", ctx.Err(), err)
+		case <-updated:
+		}
+	}
+}
+
+// Frames returns the IDs of all frames known to b in sorted order.
+func (b *Buffer) Frames() []string {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	set := make(map[string]struct{})
+	for child, c := range b.frames {
+		set[child] = struct{}{}
+		for _, e := range c.entries {
+			set[e.parent] = struct{}{}
+		}
+	}
+	frames := make([]string, 0, len(set))
+	for f := range set {
+		frames = append(frames, f)
+	}
+	sort.Strings(frames)
+	return frames
+}
+
+// Clear removes all transforms from b.
+func (b *Buffer) Clear() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.frames = make(map[string]*frameCache)
+}
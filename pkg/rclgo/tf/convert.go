@@ -0,0 +1,182 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package tf
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/tiiuae/rclgo/pkg/rclgo/types"
+)
+
+// The conversions in this file use reflection so that the package works with
+// the message bindings generated by the user instead of depending on a specific
+// copy of the geometry_msgs and tf2_msgs packages.
+
+func field(v reflect.Value, path ...string) (reflect.Value, error) {
+	for i, name := range path {
+		if v.Kind() != reflect.Struct {
+			return reflect.Value{}, fmt.Errorf("%s is not a struct", strings.Join(path[:i], "."))
+		}
+		v = v.FieldByName(name)
+		if !v.IsValid() {
+			return reflect.Value{}, fmt.Errorf("field %s not found", strings.Join(path[:i+1], "."))
+		}
+	}
+	return v, nil
+}
+
+type fieldVisitor struct {
+	v   reflect.Value
+	err error
+}
+
+func (f *fieldVisitor) get(path ...string) reflect.Value {
+	if f.err != nil {
+		return reflect.Value{}
+	}
+	var v reflect.Value
+	v, f.err = field(f.v, path...)
+	return v
+}
+
+func (f *fieldVisitor) float(path ...string) float64 {
+	if v := f.get(path...); f.err == nil {
+		return v.Float()
+	}
+	return 0
+}
+
+func (f *fieldVisitor) setFloat(x float64, path ...string) {
+	if v := f.get(path...); f.err == nil {
+		v.SetFloat(x)
+	}
+}
+
+func indirect(msg interface{}) reflect.Value {
+	v := reflect.ValueOf(msg)
+	for v.Kind() == reflect.Pointer && !v.IsNil() {
+		v = v.Elem()
+	}
+	return v
+}
+
+// FromMsg converts msg, which must be a generated geometry_msgs/TransformStamped
+// message or a pointer to one, to a TransformStamped.
+func FromMsg(msg interface{}) (TransformStamped, error) {
+	return fromMsgValue(indirect(msg))
+}
+
+func fromMsgValue(v reflect.Value) (TransformStamped, error) {
+	f := fieldVisitor{v: v}
+	var tr TransformStamped
+	sec := f.get("Header", "Stamp", "Sec")
+	nsec := f.get("Header", "Stamp", "Nanosec")
+	frame := f.get("Header", "FrameId")
+	child := f.get("ChildFrameId")
+	tr.Transform = Transform{
+		Translation: Vector3{
+			X: f.float("Transform", "Translation", "X"),
+			Y: f.float("Transform", "Translation", "Y"),
+			Z: f.float("Transform", "Translation", "Z"),
+		},
+		Rotation: Quaternion{
+			X: f.float("Transform", "Rotation", "X"),
+			Y: f.float("Transform", "Rotation", "Y"),
+			Z: f.float("Transform", "Rotation", "Z"),
+			W: f.float("Transform", "Rotation", "W"),
+		},
+	}
+	if f.err != nil {
+		return TransformStamped{}, fmt.Errorf("%v is not a TransformStamped message: %w", v.Type(), f.err)
+	}
+	tr.Stamp = time.Unix(sec.Int(), int64(nsec.Uint()))
+	tr.FrameID = frame.String()
+	tr.ChildFrameID = child.String()
+	return tr, nil
+}
+
+// ToMsg stores tr in dst, which must be a pointer to a generated
+// geometry_msgs/TransformStamped message.
+func (tr TransformStamped) ToMsg(dst interface{}) error {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Pointer || v.IsNil() {
+		return fmt.Errorf("destination must be a non-nil pointer, got %T", dst)
+	}
+	return tr.toMsgValue(v.Elem())
+}
+
+func (tr TransformStamped) toMsgValue(v reflect.Value) error {
+	f := fieldVisitor{v: v}
+	if sec := f.get("Header", "Stamp", "Sec"); f.err == nil {
+		sec.SetInt(tr.Stamp.Unix())
+	}
+	if nsec := f.get("Header", "Stamp", "Nanosec"); f.err == nil {
+		nsec.SetUint(uint64(tr.Stamp.Nanosecond()))
+	}
+	if frame := f.get("Header", "FrameId"); f.err == nil {
+		frame.SetString(tr.FrameID)
+	}
+	if child := f.get("ChildFrameId"); f.err == nil {
+		child.SetString(tr.ChildFrameID)
+	}
+	t := tr.Transform
+	f.setFloat(t.Translation.X, "Transform", "Translation", "X")
+	f.setFloat(t.Translation.Y, "Transform", "Translation", "Y")
+	f.setFloat(t.Translation.Z, "Transform", "Translation", "Z")
+	f.setFloat(t.Rotation.X, "Transform", "Rotation", "X")
+	f.setFloat(t.Rotation.Y, "Transform", "Rotation", "Y")
+	f.setFloat(t.Rotation.Z, "Transform", "Rotation", "Z")
+	f.setFloat(t.Rotation.W, "Transform", "Rotation", "W")
+	if f.err != nil {
+		return fmt.Errorf("%v is not a TransformStamped message: %w", v.Type(), f.err)
+	}
+	return nil
+}
+
+// fromTFMessage returns the transforms contained in a tf2_msgs/TFMessage.
+func fromTFMessage(msg types.Message) ([]TransformStamped, error) {
+	v, err := field(indirect(msg), "Transforms")
+	if err != nil {
+		return nil, fmt.Errorf("%T is not a TFMessage: %w", msg, err)
+	}
+	if v.Kind() != reflect.Slice {
+		return nil, fmt.Errorf("%T is not a TFMessage: Transforms is not a slice", msg)
+	}
+	trs := make([]TransformStamped, v.Len())
+	for i := range trs {
+		if trs[i], err = fromMsgValue(v.Index(i)); err != nil {
+			return nil, err
+		}
+	}
+	return trs, nil
+}
+
+// newTFMessage returns a new tf2_msgs/TFMessage of type ts containing trs.
+func newTFMessage(ts types.MessageTypeSupport, trs []TransformStamped) (types.Message, error) {
+	msg := ts.New()
+	v, err := field(indirect(msg), "Transforms")
+	if err != nil {
+		return nil, fmt.Errorf("%T is not a TFMessage: %w", msg, err)
+	}
+	if v.Kind() != reflect.Slice {
+		return nil, fmt.Errorf("%T is not a TFMessage: Transforms is not a slice", msg)
+	}
+	s := reflect.MakeSlice(v.Type(), len(trs), len(trs))
+	for i, tr := range trs {
+		if err := tr.toMsgValue(s.Index(i)); err != nil {
+			return nil, err
+		}
+	}
+	v.Set(s)
+	return msg, nil
+}
@@ -0,0 +1,23 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+/*
+Package tf implements a tf2 compatible coordinate frame transform library.
+
+Buffer stores transforms over time and looks up transforms between arbitrary
+frames of the transform tree. Listener fills a Buffer with the transforms
+published on /tf and /tf_static, and Broadcaster and StaticBroadcaster publish
+transforms to those topics.
+
+The ROS messages used by this package are accessed using reflection, so the
+generated bindings of tf2_msgs must be imported by the application, for example:
+
+	import _ "example.com/myapp/msgs/tf2_msgs/msg"
+*/
+package tf
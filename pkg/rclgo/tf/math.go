@@ -0,0 +1,211 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package tf
+
+import (
+	"math"
+	"time"
+)
+
+// Vector3 is a vector in 3-dimensional space.
+type Vector3 struct {
+	X, Y, Z float64
+}
+
+// Add returns v + o.
+func (v Vector3) Add(o Vector3) Vector3 {
+	return Vector3{v.X + o.X, v.Y + o.Y, v.Z + o.Z}
+}
+
+// Sub returns v - o.
+func (v Vector3) Sub(o Vector3) Vector3 {
+	return Vector3{v.X - o.X, v.Y - o.Y, v.Z - o.Z}
+}
+
+// Scale returns v multiplied by s.
+func (v Vector3) Scale(s float64) Vector3 {
+	return Vector3{v.X * s, v.Y * s, v.Z * s}
+}
+
+func (v Vector3) cross(o Vector3) Vector3 {
+	return Vector3{
+		v.Y*o.Z - v.Z*o.Y,
+		v.Z*o.X - v.X*o.Z,
+		v.X*o.Y - v.Y*o.X,
+	}
+}
+
+// Quaternion is a rotation in 3-dimensional space.
+type Quaternion struct {
+	X, Y, Z, W float64
+}
+
+// IdentityQuaternion returns a quaternion representing no rotation.
+func IdentityQuaternion() Quaternion {
+	return Quaternion{W: 1}
+}
+
+// QuaternionFromRPY returns the quaternion corresponding to the given roll,
+// pitch and yaw angles in radians. The rotations are applied in the order roll
+// around X, pitch around Y and yaw around Z, all around fixed axes.
+func QuaternionFromRPY(roll, pitch, yaw float64) Quaternion {
+	sr, cr := math.Sincos(roll / 2)
+	sp, cp := math.Sincos(pitch / 2)
+	sy, cy := math.Sincos(yaw / 2)
+	return Quaternion{
+		X: sr*cp*cy - cr*sp*sy,
+		Y: cr*sp*cy + sr*cp*sy,
+		Z: cr*cp*sy - sr*sp*cy,
+		W: cr*cp*cy + sr*sp*sy,
+	}
+}
+
+// RPY returns the roll, pitch and yaw angles of q in radians. It is the inverse
+// of QuaternionFromRPY.
+func (q Quaternion) RPY() (roll, pitch, yaw float64) {
+	roll = math.Atan2(2*(q.W*q.X+q.Y*q.Z), 1-2*(q.X*q.X+q.Y*q.Y))
+	sinp := 2 * (q.W*q.Y - q.Z*q.X)
+	switch {
+	case sinp >= 1:
+		pitch = math.Pi / 2
+	case sinp <= -1:
+		pitch = -math.Pi / 2
+	default:
+		pitch = math.Asin(sinp)
+	}
+	yaw = math.Atan2(2*(q.W*q.Z+q.X*q.Y), 1-2*(q.Y*q.Y+q.Z*q.Z))
+	return roll, pitch, yaw
+}
+
+func (q Quaternion) length() float64 {
+	return math.Sqrt(q.dot(q))
+}
+
+func (q Quaternion) dot(o Quaternion) float64 {
+	return q.X*o.X + q.Y*o.Y + q.Z*o.Z + q.W*o.W
+}
+
+func (q Quaternion) scale(s float64) Quaternion {
+	return Quaternion{q.X * s, q.Y * s, q.Z * s, q.W * s}
+}
+
+// Normalize returns q scaled to unit length.
+func (q Quaternion) Normalize() Quaternion {
+	return q.scale(1 / q.length())
+}
+
+// Mul returns the Hamilton product q * o, which represents rotating first by o
+// and then by q.
+func (q Quaternion) Mul(o Quaternion) Quaternion {
+	return Quaternion{
+		X: q.W*o.X + q.X*o.W + q.Y*o.Z - q.Z*o.Y,
+		Y: q.W*o.Y - q.X*o.Z + q.Y*o.W + q.Z*o.X,
+		Z: q.W*o.Z + q.X*o.Y - q.Y*o.X + q.Z*o.W,
+		W: q.W*o.W - q.X*o.X - q.Y*o.Y - q.Z*o.Z,
+	}
+}
+
+// Inverse returns the inverse rotation of unit quaternion q.
+func (q Quaternion) Inverse() Quaternion {
+	return Quaternion{-q.X, -q.Y, -q.Z, q.W}
+}
+
+// Rotate returns v rotated by unit quaternion q.
+func (q Quaternion) Rotate(v Vector3) Vector3 {
+	u := Vector3{q.X, q.Y, q.Z}
+	t := u.cross(v).Scale(2)
+	return v.Add(t.Scale(q.W)).Add(u.cross(t))
+}
+
+// Slerp spherically interpolates between unit quaternions q and o. t is in
+// range [0, 1], where 0 returns q and 1 returns o.
+func (q Quaternion) Slerp(o Quaternion, t float64) Quaternion {
+	d := q.dot(o)
+	if d < 0 {
+		// Take the shorter path.
+		o = o.scale(-1)
+		d = -d
+	}
+	if d > 0.9995 {
+		// The quaternions are almost equal, so linear interpolation is
+		// accurate and avoids division by a value close to zero.
+		return Quaternion{
+			X: q.X + t*(o.X-q.X),
+			Y: q.Y + t*(o.Y-q.Y),
+			Z: q.Z + t*(o.Z-q.Z),
+			W: q.W + t*(o.W-q.W),
+		}.Normalize()
+	}
+	theta := math.Acos(d)
+	sin := math.Sin(theta)
+	a := math.Sin((1-t)*theta) / sin
+	b := math.Sin(t*theta) / sin
+	return Quaternion{
+		X: a*q.X + b*o.X,
+		Y: a*q.Y + b*o.Y,
+		Z: a*q.Z + b*o.Z,
+		W: a*q.W + b*o.W,
+	}
+}
+
+// Transform is a rigid body transformation consisting of a rotation followed by
+// a translation.
+type Transform struct {
+	Translation Vector3
+	Rotation    Quaternion
+}
+
+// IdentityTransform returns a transform which maps each point to itself.
+func IdentityTransform() Transform {
+	return Transform{Rotation: IdentityQuaternion()}
+}
+
+// Apply returns point p transformed by t.
+func (t Transform) Apply(p Vector3) Vector3 {
+	return t.Rotation.Rotate(p).Add(t.Translation)
+}
+
+// Mul returns the composition t * o, which represents transforming first by o
+// and then by t.
+func (t Transform) Mul(o Transform) Transform {
+	return Transform{
+		Translation: t.Apply(o.Translation),
+		Rotation:    t.Rotation.Mul(o.Rotation),
+	}
+}
+
+// Inverse returns the inverse transformation of t.
+func (t Transform) Inverse() Transform {
+	inv := t.Rotation.Inverse()
+	return Transform{
+		Translation: inv.Rotate(t.Translation).Scale(-1),
+		Rotation:    inv,
+	}
+}
+
+// Interpolate interpolates between transforms t and o. Translations are
+// interpolated linearly and rotations spherically. ratio is in range [0, 1],
+// where 0 returns t and 1 returns o.
+func (t Transform) Interpolate(o Transform, ratio float64) Transform {
+	return Transform{
+		Translation: t.Translation.Add(o.Translation.Sub(t.Translation).Scale(ratio)),
+		Rotation:    t.Rotation.Slerp(o.Rotation, ratio),
+	}
+}
+
+// TransformStamped is a transform from frame ChildFrameID to frame FrameID
+// valid at time Stamp. Transform maps points expressed in the child frame to
+// points expressed in the parent frame.
+type TransformStamped struct {
+	Stamp        time.Time
+	FrameID      string
+	ChildFrameID string
+	Transform    Transform
+}
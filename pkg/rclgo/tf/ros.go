@@ -0,0 +1,230 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package tf
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
+	"github.com/tiiuae/rclgo/pkg/rclgo/types"
+)
+
+const (
+	// TopicName is the topic on which dynamic transforms are published.
+	TopicName = "/tf"
+	// StaticTopicName is the topic on which static transforms are published.
+	StaticTopicName = "/tf_static"
+	// MessageType is the name of the message type used on TopicName and
+	// StaticTopicName. The generated bindings of the type must be imported by
+	// the application so that the type is registered in package typemap.
+	MessageType = "tf2_msgs/msg/TFMessage"
+)
+
+// NewDefaultQosProfile returns the QoS profile used for TopicName.
+func NewDefaultQosProfile() rclgo.QosProfile {
+	qos := rclgo.NewDefaultQosProfile()
+	qos.Depth = 100
+	return qos
+}
+
+// NewStaticQosProfile returns the QoS profile used for StaticTopicName. Static
+// transforms are published once using transient local durability so that late
+// joining subscribers receive them.
+func NewStaticQosProfile() rclgo.QosProfile {
+	qos := rclgo.NewDefaultQosProfile()
+	qos.Depth = 1
+	qos.Durability = rclgo.DurabilityTransientLocal
+	return qos
+}
+
+func tfMessageTypeSupport() (types.MessageTypeSupport, error) {
+	ts, ok := typemap.GetMessage(MessageType)
+	if !ok {
+		return nil, fmt.Errorf(
+			"message type %s is not registered, import the generated tf2_msgs/msg package",
+			MessageType,
+		)
+	}
+	return ts, nil
+}
+
+// Listener subscribes to TopicName and StaticTopicName and stores the received
+// transforms in a Buffer. Transforms are received only while the node of the
+// listener is being spun.
+type Listener struct {
+	buffer    *Buffer
+	tfSub     *rclgo.Subscription
+	staticSub *rclgo.Subscription
+}
+
+// NewListener creates subscriptions to TopicName and StaticTopicName in node
+// and returns a Listener which stores the received transforms in buffer.
+func NewListener(node *rclgo.Node, buffer *Buffer) (_ *Listener, err error) {
+	ts, err := tfMessageTypeSupport()
+	if err != nil {
+		return nil, err
+	}
+	l := &Listener{buffer: buffer}
+	defer func() {
+		if err != nil {
+			l.Close() //nolint:errcheck
+		}
+	}()
+	opts := rclgo.NewDefaultSubscriptionOptions()
+	opts.Qos = NewDefaultQosProfile()
+	l.tfSub, err = node.NewSubscription(TopicName, ts, opts, l.callback(false))
+	if err != nil {
+		return nil, err
+	}
+	opts = rclgo.NewDefaultSubscriptionOptions()
+	opts.Qos = NewStaticQosProfile()
+	opts.Qos.Depth = 100
+	l.staticSub, err = node.NewSubscription(StaticTopicName, ts, opts, l.callback(true))
+	if err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+func (l *Listener) callback(isStatic bool) rclgo.SubscriptionCallback {
+	return func(sub *rclgo.Subscription) {
+		msg := sub.Ros2MsgType.New()
+		if _, err := sub.TakeMessage(msg); err != nil {
+			return
+		}
+		trs, err := fromTFMessage(msg)
+		if err != nil {
+			sub.Node().Logger().Error(err) //nolint:errcheck
+			return
+		}
+		for _, tr := range trs {
+			if err := l.buffer.SetTransform(tr, isStatic); err != nil {
+				sub.Node().Logger().Warnf("ignoring transform received on %s: %v", sub.TopicName, err) //nolint:errcheck
+			}
+		}
+	}
+}
+
+// Buffer returns the buffer l stores transforms in.
+func (l *Listener) Buffer() *Buffer {
+	return l.buffer
+}
+
+// Close closes the subscriptions of l.
+func (l *Listener) Close() error {
+	var err error
+	if l.tfSub != nil {
+		err = errors.Join(err, l.tfSub.Close())
+	}
+	if l.staticSub != nil {
+		err = errors.Join(err, l.staticSub.Close())
+	}
+	return err
+}
+
+// Broadcaster publishes dynamic transforms to TopicName.
+type Broadcaster struct {
+	ts  types.MessageTypeSupport
+	pub *rclgo.Publisher
+}
+
+// NewBroadcaster creates a publisher to TopicName in node and returns a
+// Broadcaster using it.
+func NewBroadcaster(node *rclgo.Node) (*Broadcaster, error) {
+	ts, err := tfMessageTypeSupport()
+	if err != nil {
+		return nil, err
+	}
+	opts := rclgo.NewDefaultPublisherOptions()
+	opts.Qos = NewDefaultQosProfile()
+	pub, err := node.NewPublisher(TopicName, ts, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &Broadcaster{ts: ts, pub: pub}, nil
+}
+
+// SendTransform publishes trs in a single message.
+func (b *Broadcaster) SendTransform(trs ...TransformStamped) error {
+	msg, err := newTFMessage(b.ts, trs)
+	if err != nil {
+		return err
+	}
+	return b.pub.Publish(msg)
+}
+
+// Close closes the publisher of b.
+func (b *Broadcaster) Close() error {
+	return b.pub.Close()
+}
+
+// StaticBroadcaster publishes static transforms to StaticTopicName.
+//
+// Because static transforms are published with transient local durability
+// and a history depth of one, each published message contains every static
+// transform sent using the broadcaster so far.
+type StaticBroadcaster struct {
+	mutex      sync.Mutex
+	ts         types.MessageTypeSupport
+	pub        *rclgo.Publisher
+	transforms []TransformStamped
+}
+
+// NewStaticBroadcaster creates a publisher to StaticTopicName in node and
+// returns a StaticBroadcaster using it.
+func NewStaticBroadcaster(node *rclgo.Node) (*StaticBroadcaster, error) {
+	ts, err := tfMessageTypeSupport()
+	if err != nil {
+		return nil, err
+	}
+	opts := rclgo.NewDefaultPublisherOptions()
+	opts.Qos = NewStaticQosProfile()
+	pub, err := node.NewPublisher(StaticTopicName, ts, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &StaticBroadcaster{ts: ts, pub: pub}, nil
+}
+
+// SendTransform adds trs to the set of static transforms of b and publishes
+// the set. A transform replaces a previously sent transform with the same
+// child frame.
+func (b *StaticBroadcaster) SendTransform(trs ...TransformStamped) error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.transforms = mergeStatic(b.transforms, trs)
+	msg, err := newTFMessage(b.ts, b.transforms)
+	if err != nil {
+		return err
+	}
+	return b.pub.Publish(msg)
+}
+
+// Close closes the publisher of b.
+func (b *StaticBroadcaster) Close() error {
+	return b.pub.Close()
+}
+
+func mergeStatic(all []TransformStamped, trs []TransformStamped) []TransformStamped {
+outer:
+	for _, tr := range trs {
+		for i := range all {
+			if normalizeFrame(all[i].ChildFrameID) == normalizeFrame(tr.ChildFrameID) {
+				all[i] = tr
+				continue outer
+			}
+		}
+		all = append(all, tr)
+	}
+	return all
+}
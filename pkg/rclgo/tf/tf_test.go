@@ -0,0 +1,226 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package tf
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey" //nolint:revive
+)
+
+const eps = 1e-9
+
+func shouldBeCloseVector(actual interface{}, expected ...interface{}) string {
+	a, e := actual.(Vector3), expected[0].(Vector3)
+	if math.Abs(a.X-e.X) > eps || math.Abs(a.Y-e.Y) > eps || math.Abs(a.Z-e.Z) > eps {
+		return ShouldResemble(a, e)
+	}
+	return ""
+}
+
+func tr(ms int, parent, child string, x, yaw float64) TransformStamped {
+	return TransformStamped{
+		Stamp:        time.Unix(0, int64(ms)*int64(time.Millisecond)),
+		FrameID:      parent,
+		ChildFrameID: child,
+		Transform: Transform{
+			Translation: Vector3{X: x},
+			Rotation:    QuaternionFromRPY(0, 0, yaw),
+		},
+	}
+}
+
+func at(ms int) time.Time {
+	return time.Unix(0, int64(ms)*int64(time.Millisecond))
+}
+
+func TestMath(t *testing.T) {
+	Convey("Rotating by a quaternion", t, func() {
+		q := QuaternionFromRPY(0, 0, math.Pi/2)
+		So(q.Rotate(Vector3{X: 1}), shouldBeCloseVector, Vector3{Y: 1})
+	})
+	Convey("RPY is the inverse of QuaternionFromRPY", t, func() {
+		r, p, y := QuaternionFromRPY(0.1, -0.2, 0.3).RPY()
+		So(r, ShouldAlmostEqual, 0.1)
+		So(p, ShouldAlmostEqual, -0.2)
+		So(y, ShouldAlmostEqual, 0.3)
+	})
+	Convey("Composing a transform with its inverse yields identity", t, func() {
+		tf := Transform{Translation: Vector3{1, 2, 3}, Rotation: QuaternionFromRPY(0.4, 0.5, 0.6)}
+		p := Vector3{-1, 5, 2}
+		So(tf.Inverse().Mul(tf).Apply(p), shouldBeCloseVector, p)
+		So(tf.Inverse().Apply(tf.Apply(p)), shouldBeCloseVector, p)
+	})
+	Convey("Interpolation is linear in translation and spherical in rotation", t, func() {
+		a := Transform{Rotation: IdentityQuaternion()}
+		b := Transform{Translation: Vector3{X: 2}, Rotation: QuaternionFromRPY(0, 0, math.Pi/2)}
+		m := a.Interpolate(b, 0.5)
+		So(m.Translation, shouldBeCloseVector, Vector3{X: 1})
+		_, _, yaw := m.Rotation.RPY()
+		So(yaw, ShouldAlmostEqual, math.Pi/4)
+	})
+}
+
+func TestBuffer(t *testing.T) {
+	Convey("Given a buffer with a transform tree", t, func() {
+		b := NewBuffer(time.Second)
+		So(b.SetTransform(tr(0, "map", "odom", 1, 0), true), ShouldBeNil)
+		So(b.SetTransform(tr(100, "odom", "base", 0, 0), false), ShouldBeNil)
+		So(b.SetTransform(tr(200, "odom", "base", 2, 0), false), ShouldBeNil)
+		So(b.SetTransform(tr(0, "base", "laser", 0.5, math.Pi/2), true), ShouldBeNil)
+		So(b.SetTransform(tr(0, "other_root", "other", 1, 0), true), ShouldBeNil)
+
+		Convey("Transforms are interpolated", func() {
+			res, err := b.LookupTransform("map", "base", at(150))
+			So(err, ShouldBeNil)
+			So(res.Transform.Translation, shouldBeCloseVector, Vector3{X: 2})
+		})
+		Convey("Transforms are looked up through the tree", func() {
+			res, err := b.LookupTransform("laser", "map", at(200))
+			So(err, ShouldBeNil)
+			So(res.FrameID, ShouldEqual, "laser")
+			So(res.ChildFrameID, ShouldEqual, "map")
+			// The origin of map is at x = -3.5 in base, which is rotated
+			// by 90 degrees in laser.
+			So(res.Transform.Apply(Vector3{}), shouldBeCloseVector, Vector3{Y: 3.5})
+		})
+		Convey("Zero time looks up the latest common time", func() {
+			res, err := b.LookupTransform("map", "laser", time.Time{})
+			So(err, ShouldBeNil)
+			So(res.Stamp.Equal(at(200)), ShouldBeTrue)
+			So(res.Transform.Translation, shouldBeCloseVector, Vector3{X: 3.5})
+		})
+		Convey("Static transforms are valid at all times", func() {
+			So(b.CanTransform("map", "odom", at(10000)), ShouldBeTrue)
+		})
+		Convey("Lookups outside the stored data fail", func() {
+			_, err := b.LookupTransform("map", "base", at(50))
+			So(err, ShouldWrap, ErrExtrapolation)
+			_, err = b.LookupTransform("map", "base", at(250))
+			So(err, ShouldWrap, ErrExtrapolation)
+		})
+		Convey("Lookups of unknown frames fail", func() {
+			_, err := b.LookupTransform("map", "nowhere", time.Time{})
+			So(err, ShouldWrap, ErrLookup)
+		})
+		Convey("Lookups between disconnected trees fail", func() {
+			_, err := b.LookupTransform("map", "other", time.Time{})
+			So(err, ShouldWrap, ErrConnectivity)
+		})
+		Convey("Invalid transforms are rejected", func() {
+			So(b.SetTransform(tr(0, "a", "a", 0, 0), false), ShouldWrap, ErrInvalidArgument)
+			So(b.SetTransform(tr(0, "", "a", 0, 0), false), ShouldWrap, ErrInvalidArgument)
+			bad := tr(0, "a", "b", math.NaN(), 0)
+			So(b.SetTransform(bad, false), ShouldWrap, ErrInvalidArgument)
+		})
+		Convey("Old transforms are removed", func() {
+			So(b.SetTransform(tr(1150, "odom", "base", 0, 0), false), ShouldBeNil)
+			_, err := b.LookupTransform("odom", "base", at(120))
+			So(err, ShouldWrap, ErrExtrapolation)
+		})
+		Convey("WaitForTransform waits until the transform is available", func() {
+			go func() {
+				time.Sleep(10 * time.Millisecond)
+				b.SetTransform(tr(300, "odom", "base", 4, 0), false) //nolint:errcheck
+			}()
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+			res, err := b.WaitForTransform(ctx, "odom", "base", at(300))
+			So(err, ShouldBeNil)
+			So(res.Transform.Translation, shouldBeCloseVector, Vector3{X: 4})
+		})
+		Convey("WaitForTransform fails when the context is done", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+			defer cancel()
+			_, err := b.WaitForTransform(ctx, "odom", "base", at(300))
+			So(err, ShouldWrap, context.DeadlineExceeded)
+			So(err, ShouldWrap, ErrExtrapolation)
+		})
+	})
+	Convey("Given a buffer with transforms above the frames of a lookup updated less often", t, func() {
+		b := NewBuffer(time.Second)
+		So(b.SetTransform(tr(0, "map", "odom", 1, 0), false), ShouldBeNil)
+		for ms := 0; ms <= 100; ms += 10 {
+			So(b.SetTransform(tr(ms, "odom", "base_link", float64(ms), 0), false), ShouldBeNil)
+			So(b.SetTransform(tr(ms, "base_link", "laser", 0.5, 0), false), ShouldBeNil)
+		}
+
+		Convey("Transforms above the common ancestor are not needed", func() {
+			res, err := b.LookupTransform("base_link", "laser", at(55))
+			So(err, ShouldBeNil)
+			So(res.Transform.Translation, shouldBeCloseVector, Vector3{X: 0.5})
+			res, err = b.LookupTransform("odom", "laser", at(55))
+			So(err, ShouldBeNil)
+			So(res.Transform.Translation, shouldBeCloseVector, Vector3{X: 55.5})
+		})
+		Convey("Zero time ignores frames above the common ancestor", func() {
+			res, err := b.LookupTransform("base_link", "laser", time.Time{})
+			So(err, ShouldBeNil)
+			So(res.Stamp.Equal(at(100)), ShouldBeTrue)
+			res, err = b.LookupTransform("map", "laser", time.Time{})
+			So(err, ShouldBeNil)
+			So(res.Stamp.Equal(at(0)), ShouldBeTrue)
+		})
+		Convey("Missing transforms below the common ancestor fail", func() {
+			_, err := b.LookupTransform("map", "laser", at(55))
+			So(err, ShouldWrap, ErrExtrapolation)
+			_, err = b.LookupTransform("laser", "map", at(55))
+			So(err, ShouldWrap, ErrExtrapolation)
+		})
+	})
+}
+
+type testTime struct {
+	Sec     int32
+	Nanosec uint32
+}
+
+type testHeader struct {
+	Stamp   testTime
+	FrameId string //nolint:revive
+}
+
+type testVector3 struct{ X, Y, Z float64 }
+
+type testQuaternion struct{ X, Y, Z, W float64 }
+
+type testTransform struct {
+	Translation testVector3
+	Rotation    testQuaternion
+}
+
+type testTransformStamped struct {
+	Header       testHeader
+	ChildFrameId string //nolint:revive
+	Transform    testTransform
+}
+
+func TestConvert(t *testing.T) {
+	Convey("Transforms are converted to and from messages", t, func() {
+		in := tr(1500, "map", "odom", 1, 0.5)
+		var msg testTransformStamped
+		So(in.ToMsg(&msg), ShouldBeNil)
+		So(msg.Header.Stamp, ShouldResemble, testTime{Sec: 1, Nanosec: 5e8})
+		So(msg.ChildFrameId, ShouldEqual, "odom")
+		out, err := FromMsg(&msg)
+		So(err, ShouldBeNil)
+		So(out.Stamp.Equal(in.Stamp), ShouldBeTrue)
+		out.Stamp = in.Stamp
+		So(out, ShouldResemble, in)
+	})
+	Convey("Converting values of other types fails", t, func() {
+		_, err := FromMsg(&testTime{})
+		So(err, ShouldNotBeNil)
+		So(tr(0, "a", "b", 0, 0).ToMsg(testTransformStamped{}), ShouldNotBeNil)
+	})
+}
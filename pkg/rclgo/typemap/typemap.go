@@ -108,3 +108,58 @@ func GetActionMust(actionType string) types.ActionTypeSupport {
 	}
 	return action
 }
+
+// describer returns the type support of the message, service or action
+// registered with alias typeName if it implements types.TypeDescriber.
+func describer(typeName string) (types.TypeDescriber, bool) {
+	var ts interface{}
+	if msg, ok := messageTypeMap[typeName]; ok {
+		ts = msg
+	} else if srv, ok := serviceTypeMap[typeName]; ok {
+		ts = srv
+	} else if action, ok := actionTypeMap[typeName]; ok {
+		ts = action
+	}
+	d, ok := ts.(types.TypeDescriber)
+	return d, ok
+}
+
+// GetTypeHash returns the type hash of the message, service or action
+// registered with alias typeName, for example "RIHS01_df668c74...".
+func GetTypeHash(typeName string) (string, bool) {
+	d, ok := describer(typeName)
+	if !ok {
+		return "", false
+	}
+	return d.TypeHash(), true
+}
+
+// GetTypeDescription returns the type description JSON of the message, service
+// or action registered with alias typeName.
+func GetTypeDescription(typeName string) (string, bool) {
+	d, ok := describer(typeName)
+	if !ok {
+		return "", false
+	}
+	return d.TypeDescription(), true
+}
+
+// GetMessageDefinition returns the ros2msg definition of message type msgType
+// including the definitions of its dependencies.
+func GetMessageDefinition(msgType string) (string, bool) {
+	d, ok := messageTypeMap[msgType].(types.MessageDescriber)
+	if !ok {
+		return "", false
+	}
+	return d.MessageDefinition(), true
+}
+
+// GetMessageDescriptor returns the descriptor of the fields of message type
+// msgType.
+func GetMessageDescriptor(msgType string) (*types.MessageDescriptor, bool) {
+	d, ok := messageTypeMap[msgType].(types.FieldDescriber)
+	if !ok {
+		return nil, false
+	}
+	return d.Descriptor(), true
+}
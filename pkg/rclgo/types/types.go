@@ -11,6 +11,7 @@ package types
 
 import (
 	"encoding/hex"
+	"fmt"
 	"time"
 	"unsafe"
 )
@@ -30,6 +31,105 @@ type MessageTypeSupport interface {
 	TypeSupport() unsafe.Pointer // *C.rosidl_message_type_support_t
 }
 
+// TypeHasher is implemented by the type supports of generated interfaces.
+// Bindings generated for Iron and newer return the hash provided by the
+// typesupport library, and bindings generated for older distributions return
+// the hash computed by the generator.
+type TypeHasher interface {
+	// TypeHash returns the type hash of the interface in the form
+	// "RIHS01_<hexadecimal hash value>".
+	TypeHash() string
+}
+
+// TypeDescriber is implemented by the type supports of generated interfaces.
+type TypeDescriber interface {
+	TypeHasher
+	// TypeDescription returns the description of the interface and the types
+	// it references as JSON, in the format of
+	// type_description_interfaces/msg/TypeDescription.
+	TypeDescription() string
+}
+
+// MessageDescriber is implemented by the type supports of generated messages.
+type MessageDescriber interface {
+	MessageTypeSupport
+	TypeDescriber
+	// MessageDefinition returns the ros2msg definition of the message followed
+	// by the definitions of the messages it depends on, each preceded by a
+	// separator line and a line containing "MSG: " and the name of the
+	// dependency.
+	MessageDefinition() string
+}
+
+// FieldDescriber is implemented by the type supports of generated messages.
+type FieldDescriber interface {
+	MessageTypeSupport
+	// Descriptor returns the descriptor of the fields of the message type.
+	Descriptor() *MessageDescriptor
+}
+
+// MessageDescriptor describes the fields of a generated message type. It
+// allows accessing the fields of messages using their ROS names instead of
+// their Go names.
+type MessageDescriptor struct {
+	// TypeName is the full name of the message type, for example
+	// "std_msgs/msg/Header".
+	TypeName string
+	Fields   []FieldDescriptor
+}
+
+// Field returns the descriptor of the field called name.
+func (d *MessageDescriptor) Field(name string) (*FieldDescriptor, bool) {
+	for i := range d.Fields {
+		if d.Fields[i].Name == name {
+			return &d.Fields[i], true
+		}
+	}
+	return nil, false
+}
+
+// FieldDescriptor describes a field of a generated message type.
+type FieldDescriptor struct {
+	// Name is the ROS name of the field, for example "frame_id".
+	Name string
+	// Type is the ROS type of the field, or the element type if the field is
+	// an array or a sequence. Message types are fully qualified, for example
+	// "float64", "wstring" or "builtin_interfaces/msg/Time".
+	Type string
+	// GoIndex is the index of the field in the Go struct of the message, which
+	// can be passed to reflect.Value.Field.
+	GoIndex int
+	// IsArray is true if the field is an array or a sequence.
+	IsArray bool
+	// ArraySize is the size of an array or the upper bound of a bounded
+	// sequence. ArraySize is zero for unbounded sequences.
+	ArraySize int
+	// IsUpperBound is true if the field is a bounded sequence.
+	IsUpperBound bool
+	// StringUpperBound is the maximum length of string and wstring values, or
+	// zero if the length is unbounded.
+	StringUpperBound int
+	// Message is the descriptor of the nested message type, or nil if Type is
+	// not a message type.
+	Message *MessageDescriptor
+}
+
+// IsFixedSizeArray returns true if f is an array, as opposed to a sequence.
+func (f *FieldDescriptor) IsFixedSizeArray() bool {
+	return f.IsArray && f.ArraySize > 0 && !f.IsUpperBound
+}
+
+// IsSequence returns true if f is a bounded or an unbounded sequence.
+func (f *FieldDescriptor) IsSequence() bool {
+	return f.IsArray && !f.IsFixedSizeArray()
+}
+
+// FormatTypeHash returns the string representation of a type hash with the
+// given version and value.
+func FormatTypeHash(version uint8, value []byte) string {
+	return fmt.Sprintf("RIHS%02d_%s", version, hex.EncodeToString(value))
+}
+
 type ServiceTypeSupport interface {
 	Request() MessageTypeSupport
 	Response() MessageTypeSupport
@@ -0,0 +1,75 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package rclgo
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// ErrBoundExceeded is wrapped by the errors returned by Validate methods of
+// generated messages when a bounded sequence or string is too long.
+var ErrBoundExceeded = errors.New("upper bound exceeded")
+
+// Validator is implemented by messages which can check that their values are
+// valid before they are converted to C. Generated messages implement
+// Validator by checking the lengths of bounded sequences and bounded strings,
+// including the fields of nested messages. The sizes of fixed size arrays are
+// enforced by the Go types of the fields, so they are always valid.
+type Validator interface {
+	Validate() error
+}
+
+// ValidationError describes an invalid field of a message.
+type ValidationError struct {
+	// Field is the path to the invalid field using ROS field names, for
+	// example "poses[2].header.frame_id".
+	Field string
+	Err   error
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("invalid field %s: %v", e.Field, e.Err)
+}
+
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// NewBoundError returns an error describing that the length of field exceeds
+// bound. If index is not negative, the error refers to the element index of
+// field. NewBoundError is used by generated code.
+func NewBoundError(field string, index, length, bound int) error {
+	return &ValidationError{
+		Field: fieldPath(field, index),
+		Err:   fmt.Errorf("%w: length %d is greater than %d", ErrBoundExceeded, length, bound),
+	}
+}
+
+// WrapValidationError adds field to the path of err, which is the result of
+// validating the nested message stored in field. If index is not negative, err
+// refers to the element index of field. WrapValidationError is used by
+// generated code.
+func WrapValidationError(field string, index int, err error) error {
+	path := fieldPath(field, index)
+	var verr *ValidationError
+	if errors.As(err, &verr) {
+		return &ValidationError{Field: path + "." + verr.Field, Err: verr.Err}
+	}
+	return &ValidationError{Field: path, Err: err}
+}
+
+func fieldPath(field string, index int) string {
+	if index < 0 {
+		return field
+	}
+	return field + "[" + strconv.Itoa(index) + "]"
+}
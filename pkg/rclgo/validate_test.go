@@ -0,0 +1,59 @@
+package rclgo_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey" //nolint:revive
+	test_msgs "github.com/tiiuae/rclgo/internal/msgs/test_msgs/msg"
+	"github.com/tiiuae/rclgo/pkg/rclgo"
+)
+
+func TestMessageValidation(t *testing.T) {
+	Convey("Scenario: generated messages validate their bounds", t, func() {
+		Convey("Valid messages pass validation", func() {
+			So(test_msgs.NewStrings().Validate(), ShouldBeNil)
+			So(test_msgs.NewBoundedSequences().Validate(), ShouldBeNil)
+			So(test_msgs.NewMultiNested().Validate(), ShouldBeNil)
+		})
+		Convey("Too long bounded strings are reported", func() {
+			msg := test_msgs.NewStrings()
+			msg.BoundedStringValue = strings.Repeat("x", 23)
+			err := msg.Validate()
+			So(err, ShouldWrap, rclgo.ErrBoundExceeded)
+			var verr *rclgo.ValidationError
+			So(errors.As(err, &verr), ShouldBeTrue)
+			So(verr.Field, ShouldEqual, "bounded_string_value")
+		})
+		Convey("Too long bounded sequences in nested messages are reported", func() {
+			msg := test_msgs.NewMultiNested()
+			msg.UnboundedSequenceOfBoundedSequences = make([]test_msgs.BoundedSequences, 2)
+			msg.UnboundedSequenceOfBoundedSequences[1].Int32Values = []int32{1, 2, 3, 4}
+			err := msg.Validate()
+			So(err, ShouldWrap, rclgo.ErrBoundExceeded)
+			var verr *rclgo.ValidationError
+			So(errors.As(err, &verr), ShouldBeTrue)
+			So(verr.Field, ShouldEqual, "unbounded_sequence_of_bounded_sequences[1].int32_values")
+
+			msg = test_msgs.NewMultiNested()
+			msg.BoundedSequenceOfArrays = make([]test_msgs.Arrays, 4)
+			So(msg.Validate(), ShouldWrap, rclgo.ErrBoundExceeded)
+		})
+		Convey("Publishers validate messages if requested", func() {
+			rclctx, err := newDefaultRCLContext()
+			So(err, ShouldBeNil)
+			defer rclctx.Close()
+			node, err := rclctx.NewNode("validate_node", "/test")
+			So(err, ShouldBeNil)
+			opts := rclgo.NewDefaultPublisherOptions()
+			opts.Validate = true
+			pub, err := node.NewPublisher("/validate", test_msgs.StringsTypeSupport, opts)
+			So(err, ShouldBeNil)
+			msg := test_msgs.NewStrings()
+			So(pub.Publish(msg), ShouldBeNil)
+			msg.BoundedStringValue = strings.Repeat("x", 23)
+			So(pub.Publish(msg), ShouldWrap, rclgo.ErrBoundExceeded)
+		})
+	})
+}
@@ -0,0 +1,211 @@
+/*
+This file is part of rclgo
+
+Copyright © 2021 Technology Innovation Institute, United Arab Emirates
+
+Licensed under the Apache License, Version 2.0 (the "License");
+    http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package rclgo
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/tiiuae/rclgo/pkg/rclgo/types"
+	"gopkg.in/yaml.v3"
+)
+
+// MarshalYAML encodes msg as YAML in the format used by ros2 topic echo,
+// without the "---" document separator. msg must be a generated message or a
+// *DynamicMessage.
+//
+// Arrays and sequences are encoded as block sequences, including arrays of
+// bytes, which are encoded as lists of integers. Floating point values are
+// formatted like in Python, for example 1.0, 1.0e+20 and .nan. Long arrays are
+// not truncated.
+func MarshalYAML(msg types.Message) ([]byte, error) {
+	node, err := YAMLNode(msg)
+	if err != nil {
+		return nil, err
+	}
+	var b bytes.Buffer
+	writeYAMLNode(&b, node, 0)
+	return b.Bytes(), nil
+}
+
+// YAMLNode returns the YAML representation of msg used by MarshalYAML as a
+// yaml.Node. Generated messages use YAMLNode to implement yaml.Marshaler, so
+// encoding messages using gopkg.in/yaml.v3 produces the same values as
+// MarshalYAML, though the layout of the output may differ.
+func YAMLNode(msg types.Message) (*yaml.Node, error) {
+	return yamlValueNode(reflect.ValueOf(msg))
+}
+
+func yamlValueNode(v reflect.Value) (*yaml.Node, error) {
+	if fields, ok := messageFields(v); ok {
+		node := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+		if len(fields) == 0 {
+			node.Style = yaml.FlowStyle
+		}
+		for _, f := range fields {
+			value, err := yamlValueNode(f.value)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", f.name, err)
+			}
+			node.Content = append(node.Content, yamlScalar("!!str", f.name), value)
+		}
+		return node, nil
+	}
+	switch v.Kind() {
+	case reflect.Bool:
+		return yamlScalar("!!bool", strconv.FormatBool(v.Bool())), nil
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return yamlScalar("!!int", strconv.FormatInt(v.Int(), 10)), nil
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return yamlScalar("!!int", strconv.FormatUint(v.Uint(), 10)), nil
+	case reflect.Float32, reflect.Float64:
+		return yamlScalar("!!float", pythonFloat(v.Float())), nil
+	case reflect.String:
+		node := yamlScalar("!!str", v.String())
+		node.Style = yamlStringStyle(v.String())
+		return node, nil
+	case reflect.Slice, reflect.Array:
+		node := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+		if v.Len() == 0 {
+			node.Style = yaml.FlowStyle
+		}
+		for i := 0; i < v.Len(); i++ {
+			elem, err := yamlValueNode(v.Index(i))
+			if err != nil {
+				return nil, fmt.Errorf("index %d: %w", i, err)
+			}
+			node.Content = append(node.Content, elem)
+		}
+		return node, nil
+	default:
+		return nil, fmt.Errorf("unsupported type %v", v.Type())
+	}
+}
+
+func yamlScalar(tag, value string) *yaml.Node {
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: tag, Value: value}
+}
+
+// pythonFloat formats f like Python's repr, which is used by ros2 topic echo.
+func pythonFloat(f float64) string {
+	switch {
+	case math.IsNaN(f):
+		return ".nan"
+	case math.IsInf(f, 1):
+		return ".inf"
+	case math.IsInf(f, -1):
+		return "-.inf"
+	}
+	s := strconv.FormatFloat(f, 'e', -1, 64)
+	mantissa, exp, _ := strings.Cut(s, "e")
+	if e, _ := strconv.Atoi(exp); f != 0 && (e < -4 || e >= 16) {
+		if !strings.Contains(mantissa, ".") {
+			mantissa += ".0"
+		}
+		return mantissa + "e" + exp
+	}
+	s = strconv.FormatFloat(f, 'f', -1, 64)
+	if !strings.Contains(s, ".") {
+		s += ".0"
+	}
+	return s
+}
+
+// yamlImplicitRe matches plain scalars which YAML 1.1 resolves to a type other
+// than a string. Strings matching it must be quoted.
+var yamlImplicitRe = regexp.MustCompile(`^(?:` +
+	`~|null|Null|NULL|` +
+	`yes|Yes|YES|no|No|NO|true|True|TRUE|false|False|FALSE|on|On|ON|off|Off|OFF|` +
+	`[-+]?0b[0-1_]+|[-+]?0[0-7_]+|[-+]?(?:0|[1-9][0-9_]*)|[-+]?0x[0-9a-fA-F_]+|[-+]?[1-9][0-9_]*(?::[0-5]?[0-9])+|` +
+	`[-+]?[0-9][0-9_]*\.[0-9_]*(?:[eE][-+][0-9]+)?|\.[0-9_]+(?:[eE][-+][0-9]+)?|` +
+	`[-+]?[0-9][0-9_]*(?::[0-5]?[0-9])+\.[0-9_]*|[-+]?\.(?:inf|Inf|INF)|\.(?:nan|NaN|NAN)|` +
+	`[0-9]{4}-[0-9]{1,2}-[0-9]{1,2}.*|` +
+	`<<|=` +
+	`)$`)
+
+// yamlStringStyle returns the style used for s: plain if possible,
+// single-quoted if s would otherwise be parsed as something else, and
+// double-quoted if s contains characters that can't be written unescaped.
+func yamlStringStyle(s string) yaml.Style {
+	for _, r := range s {
+		if !unicode.IsPrint(r) {
+			return yaml.DoubleQuotedStyle
+		}
+	}
+	switch {
+	case s == "",
+		s[0] == ' ' || s[len(s)-1] == ' ',
+		strings.ContainsAny(s[:1], "#,[]{}&*!|>'\"%@`"),
+		strings.ContainsAny(s[:1], "-?:") && (len(s) == 1 || s[1] == ' '),
+		strings.Contains(s, ": ") || strings.Contains(s, " #") || s[len(s)-1] == ':',
+		yamlImplicitRe.MatchString(s):
+		return yaml.SingleQuotedStyle
+	}
+	return 0
+}
+
+// writeYAMLNode writes node in the block style of PyYAML, which indents
+// sequences nested in mappings at the level of the mapping keys. The current
+// line has already been indented to indent spaces.
+func writeYAMLNode(b *bytes.Buffer, node *yaml.Node, indent int) {
+	switch {
+	case node.Kind == yaml.MappingNode && node.Style != yaml.FlowStyle:
+		for i := 0; i < len(node.Content); i += 2 {
+			if i > 0 {
+				b.WriteString(strings.Repeat(" ", indent))
+			}
+			b.WriteString(node.Content[i].Value)
+			b.WriteByte(':')
+			value := node.Content[i+1]
+			switch {
+			case value.Style == yaml.FlowStyle || value.Kind == yaml.ScalarNode:
+				b.WriteByte(' ')
+				writeYAMLNode(b, value, indent)
+			case value.Kind == yaml.MappingNode:
+				b.WriteByte('\n')
+				b.WriteString(strings.Repeat(" ", indent+2))
+				writeYAMLNode(b, value, indent+2)
+			default:
+				b.WriteByte('\n')
+				b.WriteString(strings.Repeat(" ", indent))
+				writeYAMLNode(b, value, indent)
+			}
+		}
+	case node.Kind == yaml.SequenceNode && node.Style != yaml.FlowStyle:
+		for i, elem := range node.Content {
+			if i > 0 {
+				b.WriteString(strings.Repeat(" ", indent))
+			}
+			b.WriteString("- ")
+			writeYAMLNode(b, elem, indent+2)
+		}
+	case node.Kind == yaml.MappingNode:
+		b.WriteString("{}\n")
+	case node.Kind == yaml.SequenceNode:
+		b.WriteString("[]\n")
+	case node.Style == yaml.SingleQuotedStyle:
+		b.WriteString("'" + strings.ReplaceAll(node.Value, "'", "''") + "'\n")
+	case node.Style == yaml.DoubleQuotedStyle:
+		b.WriteString(strconv.Quote(node.Value) + "\n")
+	default:
+		b.WriteString(node.Value + "\n")
+	}
+}
+
+// MarshalYAML implements yaml.Marshaler using YAMLNode.
+func (m *DynamicMessage) MarshalYAML() (interface{}, error) {
+	return YAMLNode(m)
+}
@@ -0,0 +1,58 @@
+package test
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey" //nolint:revive
+
+	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/types"
+)
+
+type cdrMessage interface {
+	types.Message
+	MarshalCDR() ([]byte, error)
+	UnmarshalCDR(data []byte) error
+}
+
+func TestCDRMatchesRMW(t *testing.T) {
+	SetDefaultFailureMode(FailureContinues)
+
+	fixtures := map[string]cdrMessage{
+		"std_msgs.ColorRGBA":            Fixture_Go_std_msgs__ColorRGBA(),
+		"std_msgs.String":               Fixture_Go_std_msgs__String(),
+		"std_msgs.Int64MultiArray":      Fixture_Go_std_msgs__Int64MultiArray(),
+		"sensor_msgs.ChannelFloat32":    Fixture_Go_sensor_msgs__ChannelFloat32(),
+		"sensor_msgs.Illuminance":       Fixture_Go_sensor_msgs__Illuminance(),
+		"test_msgs.Arrays":              Fixture_Go_test_msgs__Arrays(),
+		"test_msgs.BasicTypes":          Fixture_Go_test_msgs__BasicTypes(),
+		"test_msgs.BoundedSequences":    Fixture_Go_test_msgs__BoundedSequences(),
+		"test_msgs.Builtins":            Fixture_Go_test_msgs__Builtins(),
+		"test_msgs.Constants":           Fixture_Go_test_msgs__Constants(),
+		"test_msgs.Defaults":            Fixture_Go_test_msgs__Defaults(),
+		"test_msgs.Empty":               Fixture_Go_test_msgs__Empty(),
+		"test_msgs.MultiNested":         Fixture_Go_test_msgs__MultiNested(),
+		"test_msgs.Nested":              Fixture_Go_test_msgs__Nested(),
+		"test_msgs.UnboundedSequences":  Fixture_Go_test_msgs__UnboundedSequences(),
+		"test_msgs.WStrings":            Fixture_Go_test_msgs__WStrings(),
+		"test_msgs.UnboundedSequences2": Fixture_Go_test_msgs__UnboundedSequences_no_allocate_memory_on_empty_slice(),
+	}
+	for name, msg := range fixtures {
+		Convey(name, t, func() {
+			data, err := msg.MarshalCDR()
+			So(err, ShouldBeNil)
+			serialized, err := rclgo.Serialize(msg)
+			So(err, ShouldBeNil)
+			So(data, ShouldResemble, serialized)
+
+			decoded := msg.GetTypeSupport().New().(cdrMessage)
+			So(decoded.UnmarshalCDR(serialized), ShouldBeNil)
+			reencoded, err := decoded.MarshalCDR()
+			So(err, ShouldBeNil)
+			So(reencoded, ShouldResemble, data)
+			deserialized, err := rclgo.Deserialize(data, msg.GetTypeSupport())
+			So(err, ShouldBeNil)
+			So(deserialized, ShouldResemble, decoded)
+		})
+	}
+}
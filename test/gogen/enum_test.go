@@ -0,0 +1,37 @@
+package test
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey" //nolint:revive
+
+	action_msgs "github.com/tiiuae/rclgo/internal/msgs/action_msgs/msg"
+	sensor_msgs "github.com/tiiuae/rclgo/internal/msgs/sensor_msgs/msg"
+)
+
+func TestEnums(t *testing.T) {
+	SetDefaultFailureMode(FailureContinues)
+
+	Convey("Groups of constants have named types", t, func() {
+		status := action_msgs.GoalStatus_Status(action_msgs.GoalStatus_STATUS_ACCEPTED)
+		So(status.String(), ShouldEqual, "STATUS_ACCEPTED")
+		So(status.IsValid(), ShouldBeTrue)
+		So(action_msgs.GoalStatus_Status(42).String(), ShouldEqual, "GoalStatus_Status(42)")
+		So(action_msgs.GoalStatus_Status(42).IsValid(), ShouldBeFalse)
+		So(action_msgs.GoalStatus_StatusValues, ShouldHaveLength, 7)
+
+		service := sensor_msgs.NavSatStatus_Service(sensor_msgs.NavSatStatus_SERVICE_GALILEO)
+		So(service.String(), ShouldEqual, "SERVICE_GALILEO")
+		So(sensor_msgs.NavSatStatus_Service(3).String(), ShouldEqual, "NavSatStatus_Service(3)")
+	})
+	Convey("Constants sharing a longer prefix get separate types", t, func() {
+		health := sensor_msgs.BatteryState_PowerSupplyHealth(sensor_msgs.BatteryState_POWER_SUPPLY_HEALTH_GOOD)
+		So(health.String(), ShouldEqual, "POWER_SUPPLY_HEALTH_GOOD")
+		status := sensor_msgs.BatteryState_PowerSupplyStatus(sensor_msgs.BatteryState_POWER_SUPPLY_STATUS_CHARGING)
+		So(status.String(), ShouldEqual, "POWER_SUPPLY_STATUS_CHARGING")
+		So(sensor_msgs.BatteryState_PowerSupplyHealthValues, ShouldHaveLength, 9)
+		So(sensor_msgs.BatteryState_PowerSupplyStatusValues, ShouldHaveLength, 5)
+		So(sensor_msgs.BatteryState_PowerSupplyTechnologyValues, ShouldHaveLength, 7)
+		So(sensor_msgs.BatteryState_PowerSupplyTechnology(7).IsValid(), ShouldBeFalse)
+	})
+}
@@ -0,0 +1,44 @@
+package test
+
+import (
+	"math"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey" //nolint:revive
+
+	test_msgs "github.com/tiiuae/rclgo/internal/msgs/test_msgs/msg"
+)
+
+func TestEqualAndHash(t *testing.T) {
+	SetDefaultFailureMode(FailureContinues)
+
+	Convey("Messages are compared using ROS semantics", t, func() {
+		{
+			goObj := Fixture_Go_test_msgs__MultiNested()
+			clone := goObj.Clone()
+			So(clone.Equal(goObj), ShouldBeTrue)
+			So(clone.Hash(), ShouldEqual, goObj.Hash())
+			clone.BoundedSequenceOfBoundedSequences[0].AlignmentCheck++
+			So(clone.Equal(goObj), ShouldBeFalse)
+			So(clone.Hash(), ShouldNotEqual, goObj.Hash())
+		}
+		{
+			a := Fixture_Go_test_msgs__UnboundedSequences()
+			b := a.Clone()
+			a.Int32Values = nil
+			b.Int32Values = []int32{}
+			a.Float64Values = []float64{math.NaN(), 1}
+			b.Float64Values = []float64{math.NaN(), 1.001}
+			So(a.Equal(b), ShouldBeFalse)
+			So(a.EqualApprox(b, 0.01), ShouldBeTrue)
+			b.Float64Values[1] = 1
+			So(a.Equal(b), ShouldBeTrue)
+			So(a.Hash(), ShouldEqual, b.Hash())
+		}
+		{
+			hashes := map[uint64]*test_msgs.Empty{}
+			hashes[test_msgs.NewEmpty().Hash()] = test_msgs.NewEmpty()
+			So(hashes, ShouldContainKey, (&test_msgs.Empty{}).Hash())
+		}
+	})
+}
@@ -0,0 +1,65 @@
+package test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey" //nolint:revive
+
+	test_msgs "github.com/tiiuae/rclgo/internal/msgs/test_msgs/msg"
+	"github.com/tiiuae/rclgo/pkg/rclgo"
+	"github.com/tiiuae/rclgo/pkg/rclgo/typemap"
+)
+
+func TestFieldPaths(t *testing.T) {
+	SetDefaultFailureMode(FailureContinues)
+
+	Convey("Descriptors are registered in the typemap", t, func() {
+		desc, ok := typemap.GetMessageDescriptor("test_msgs/msg/MultiNested")
+		So(ok, ShouldBeTrue)
+		So(desc, ShouldEqual, test_msgs.MultiNestedDescriptor)
+		f, ok := desc.Field("bounded_sequence_of_arrays")
+		So(ok, ShouldBeTrue)
+		So(f.IsSequence(), ShouldBeTrue)
+		So(f.ArraySize, ShouldEqual, 3)
+		So(f.Message, ShouldEqual, test_msgs.ArraysDescriptor)
+	})
+
+	Convey("Nested fields are accessed by path", t, func() {
+		msg := test_msgs.NewMultiNested()
+		So(rclgo.SetField(msg, "array_of_arrays[1].int32_values[2]", 7), ShouldBeNil)
+		So(msg.ArrayOfArrays[1].Int32Values[2], ShouldEqual, 7)
+		v, err := rclgo.GetField(msg, "array_of_arrays[1].int32_values[2]")
+		So(err, ShouldBeNil)
+		So(v, ShouldEqual, int32(7))
+
+		So(rclgo.SetField(msg, "unbounded_sequence_of_arrays", []test_msgs.Arrays{{}}), ShouldBeNil)
+		So(rclgo.SetField(msg, "unbounded_sequence_of_arrays[0].string_values[0]", "abc"), ShouldBeNil)
+		So(msg.UnboundedSequenceOfArrays[0].StringValues[0], ShouldEqual, "abc")
+	})
+
+	Convey("Invalid paths and values are rejected", t, func() {
+		msg := test_msgs.NewMultiNested()
+		_, err := rclgo.GetField(msg, "array_of_arrays[3]")
+		So(errors.Is(err, rclgo.ErrInvalidFieldPath), ShouldBeTrue)
+		_, err = rclgo.GetField(msg, "no_such_field")
+		So(errors.Is(err, rclgo.ErrUnknownField), ShouldBeTrue)
+		_, err = rclgo.GetField(msg, "array_of_arrays..x")
+		So(errors.Is(err, rclgo.ErrInvalidFieldPath), ShouldBeTrue)
+		err = rclgo.SetField(msg, "array_of_arrays[0].int8_values[0]", 300)
+		So(err, ShouldNotBeNil)
+	})
+
+	Convey("Bounds are checked", t, func() {
+		seqs := test_msgs.NewBoundedSequences()
+		err := rclgo.SetField(seqs, "int32_values", []int32{1, 2, 3, 4})
+		So(errors.Is(err, rclgo.ErrBoundExceeded), ShouldBeTrue)
+		So(rclgo.SetField(seqs, "int32_values", []int32{1, 2, 3}), ShouldBeNil)
+
+		strs := test_msgs.NewStrings()
+		err = rclgo.SetField(strs, "bounded_string_value", "this string is too long")
+		So(errors.Is(err, rclgo.ErrBoundExceeded), ShouldBeTrue)
+		So(rclgo.SetField(strs, "bounded_string_value", "short"), ShouldBeNil)
+		So(strs.BoundedStringValue, ShouldEqual, "short")
+	})
+}
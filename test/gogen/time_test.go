@@ -0,0 +1,48 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey" //nolint:revive
+
+	builtin_interfaces "github.com/tiiuae/rclgo/internal/msgs/builtin_interfaces/msg"
+	std_msgs "github.com/tiiuae/rclgo/internal/msgs/std_msgs/msg"
+)
+
+func TestTimeConversions(t *testing.T) {
+	SetDefaultFailureMode(FailureContinues)
+
+	Convey("Time messages are converted to and from time.Time", t, func() {
+		tm := time.Unix(1700000000, 123456789)
+		msg := builtin_interfaces.TimeFrom(tm)
+		So(msg.Sec, ShouldEqual, 1700000000)
+		So(msg.Nanosec, ShouldEqual, 123456789)
+		So(msg.ToTime().Equal(tm), ShouldBeTrue)
+
+		msg.FromTime(time.Unix(-2, 500))
+		So(msg.Sec, ShouldEqual, -2)
+		So(msg.Nanosec, ShouldEqual, 500)
+	})
+
+	Convey("Duration messages are converted to and from time.Duration", t, func() {
+		msg := builtin_interfaces.DurationFrom(1500 * time.Millisecond)
+		So(msg.Sec, ShouldEqual, 1)
+		So(msg.Nanosec, ShouldEqual, 500000000)
+		So(msg.ToDuration(), ShouldEqual, 1500*time.Millisecond)
+
+		msg.FromDuration(-1500 * time.Millisecond)
+		So(msg.Sec, ShouldEqual, -2)
+		So(msg.Nanosec, ShouldEqual, 500000000)
+		So(msg.ToDuration(), ShouldEqual, -1500*time.Millisecond)
+	})
+
+	Convey("Headers are stamped", t, func() {
+		tm := time.Unix(42, 7)
+		header := std_msgs.NewHeader()
+		header.SetStamp(tm)
+		So(header.Stamp.Sec, ShouldEqual, 42)
+		So(header.Stamp.Nanosec, ShouldEqual, 7)
+		So(header.StampTime().Equal(tm), ShouldBeTrue)
+	})
+}